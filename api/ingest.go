@@ -0,0 +1,216 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/go-chi/chi"
+	"github.com/rs/zerolog/log"
+	"periph.io/x/periph/conn/physic"
+)
+
+// IngestRoutes - Mount the endpoints that remote sensors post their readings to, each sensor becomes a probe
+// POST /ingest/ispindel -> iSpindel HTTP posts, {"name": "iSpindel000", "temperature": 20.5, "temp_units": "C", "gravity": 1.050}
+// POST /ingest/tilt -> TiltPi/Tilt bridge cloud logging posts, JSON or form encoded, {"Color": "RED", "Temp": "68.0", "SG": "1.050"}
+// POST /ingest -> Anything else, {"id": "esp32-a", "temperature": 20.5, "gravity": 1.050}, the temperature is in °C or a string with units like "68F"
+// Gravity is a specific gravity unless the post sets "gravity_unit" to "P" for degrees Plato, Tilts always report a specific gravity
+func IngestRoutes(router chi.Router) {
+	router.Post("/ingest", ingestGeneric)
+	router.Post("/ingest/ispindel", ingestISpindel)
+	router.Post("/ingest/tilt", ingestTilt)
+}
+
+func ingestGeneric(w http.ResponseWriter, r *http.Request) {
+	ingest(w, r, func(values payload) (hardware.RemoteReading, error) {
+		temperature, err := values.temperature("temperature", "C")
+		if err != nil {
+			return hardware.RemoteReading{}, err
+		}
+		gravity, err := values.gravity("gravity", values.string("gravity_unit", "gravity_units"))
+		return hardware.RemoteReading{
+			Source:      hardware.GenericSource,
+			ID:          values.string("id"),
+			Temperature: temperature,
+			Gravity:     gravity,
+		}, err
+	})
+}
+
+func ingestISpindel(w http.ResponseWriter, r *http.Request) {
+	ingest(w, r, func(values payload) (hardware.RemoteReading, error) {
+		id := values.string("name")
+		if len(strings.TrimSpace(id)) == 0 {
+			id = values.string("id")
+		}
+		unit := values.string("temp_units")
+		if len(unit) == 0 {
+			unit = "C"
+		}
+
+		temperature, err := values.temperature("temperature", unit)
+		if err != nil {
+			return hardware.RemoteReading{}, err
+		}
+		gravity, err := values.gravity("gravity", values.string("gravity_unit", "gravity_units"))
+		return hardware.RemoteReading{
+			Source:      hardware.ISpindelSource,
+			ID:          id,
+			Temperature: temperature,
+			Gravity:     gravity,
+		}, err
+	})
+}
+
+func ingestTilt(w http.ResponseWriter, r *http.Request) {
+	ingest(w, r, func(values payload) (hardware.RemoteReading, error) {
+		// Tilts report Fahrenheit unless the bridge says otherwise
+		unit := values.string("tempunit", "temp_unit")
+		if len(unit) == 0 {
+			unit = "F"
+		}
+
+		temperature, err := values.temperature("temp", unit)
+		if err != nil {
+			return hardware.RemoteReading{}, err
+		}
+		gravity, err := values.gravity("sg", "SG")
+		return hardware.RemoteReading{
+			Source:      hardware.TiltSource,
+			ID:          values.string("color"),
+			Temperature: temperature,
+			Gravity:     gravity,
+		}, err
+	})
+}
+
+// maxIngestBytes limits the size of a post, sensors send a few hundred bytes
+const maxIngestBytes = 64 << 10
+
+// payload holds a decoded JSON object or form, keys are matched case insensitively
+type payload map[string]interface{}
+
+func ingest(w http.ResponseWriter, r *http.Request, parse func(payload) (hardware.RemoteReading, error)) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxIngestBytes)
+	values, err := decode(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	reading, err := parse(values)
+	if err == nil {
+		_, err = hardware.UpdateRemoteProbe(reading)
+	}
+	if err != nil {
+		log.Warn().Err(err).Msgf("Rejected a reading posted to %v", r.URL.Path)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{
+		"physAddr": hardware.RemotePhysAddr(reading.Source, reading.ID),
+	})
+}
+
+func decode(r *http.Request) (payload, error) {
+	values := payload{}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if err := r.ParseForm(); err != nil {
+			return nil, err
+		}
+		for key := range r.PostForm {
+			values[strings.ToLower(key)] = r.PostForm.Get(key)
+		}
+		return values, nil
+	}
+
+	raw := map[string]interface{}{}
+	if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %v", err)
+	}
+	for key, value := range raw {
+		values[strings.ToLower(key)] = value
+	}
+	return values, nil
+}
+
+func (p payload) get(keys ...string) interface{} {
+	for _, key := range keys {
+		if value, ok := p[key]; ok && value != nil {
+			return value
+		}
+	}
+	return nil
+}
+
+func (p payload) string(keys ...string) string {
+	switch value := p.get(keys...).(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+// number reads a value that may be sent as a JSON number or a string
+func (p payload) number(key string) (float64, bool) {
+	switch value := p.get(key).(type) {
+	case float64:
+		return value, true
+	case string:
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		return number, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// temperature reads a number in the unit, or a string that includes its unit like "68F"
+func (p payload) temperature(key string, unit string) (physic.Temperature, error) {
+	value, ok := p.number(key)
+	if !ok {
+		var temperature physic.Temperature
+		text, isString := p.get(key).(string)
+		if !isString || temperature.Set(strings.ToUpper(strings.TrimSpace(text))) != nil {
+			return 0, fmt.Errorf("a %v is required", key)
+		}
+		return temperature, nil
+	}
+
+	// Converted in Celsius, physic.Fahrenheit is rounded to the nano kelvin
+	switch strings.ToUpper(strings.TrimSpace(unit)) {
+	case "C":
+	case "F":
+		value = (value - 32) * 5 / 9
+	case "K":
+		value = value - 273.15
+	default:
+		return 0, fmt.Errorf("unknown temperature unit '%v'", unit)
+	}
+	return physic.ZeroCelsius + physic.Temperature(math.Round(value*float64(physic.Celsius))), nil
+}
+
+// gravity reads a gravity in the unit, a specific gravity when it is empty or "SG" and degrees Plato for "P", 0 is not measured
+func (p payload) gravity(key string, unit string) (*float64, error) {
+	value, ok := p.number(key)
+	if !ok || value <= 0 {
+		return nil, nil
+	}
+
+	switch strings.ToUpper(strings.TrimSpace(unit)) {
+	case "", "SG":
+	case "P", "PLATO", "°P":
+		value = hardware.PlatoToSG(value)
+	default:
+		return nil, fmt.Errorf("unknown gravity unit '%v'", unit)
+	}
+	return &value, nil
+}
@@ -0,0 +1,99 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dougedey/elsinore/api"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/physic"
+)
+
+func post(t *testing.T, path string, contentType string, body string) *httptest.ResponseRecorder {
+	router := chi.NewRouter()
+	api.IngestRoutes(router)
+
+	request := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	request.Header.Set("Content-Type", contentType)
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, request)
+	return response
+}
+
+func celsius(value float64) physic.Temperature {
+	return physic.ZeroCelsius + physic.Temperature(value*float64(physic.Celsius))
+}
+
+func TestIngest(t *testing.T) {
+	t.Run("iSpindel posts create a probe with gravity", func(t *testing.T) {
+		response := post(t, "/ingest/ispindel", "application/json",
+			`{"name":"iSpindel 001","ID":1234567,"angle":55.2,"temperature":20.5,"temp_units":"C","battery":4.1,"gravity":1.048,"interval":900,"RSSI":-70}`)
+		require.Equal(t, http.StatusOK, response.Code)
+		require.JSONEq(t, `{"physAddr":"ispindel-ispindel-001"}`, response.Body.String())
+
+		probe := hardware.GetTemperature("ispindel-ispindel-001")
+		require.NotNil(t, probe)
+		require.True(t, probe.Connected)
+		require.Equal(t, hardware.RemoteBus, probe.Bus)
+		require.Equal(t, celsius(20.5), probe.ReadingRaw)
+		require.Equal(t, 1.048, *probe.Gravity)
+	})
+
+	t.Run("iSpindel gravity in Plato is converted", func(t *testing.T) {
+		response := post(t, "/ingest/ispindel", "application/json",
+			`{"name":"Plato","temperature":68,"temp_units":"F","gravity":12,"gravity_unit":"P"}`)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		probe := hardware.GetTemperature("ispindel-plato")
+		require.Equal(t, celsius(20), probe.ReadingRaw)
+		require.InDelta(t, 1.048, *probe.Gravity, 0.001)
+	})
+
+	t.Run("Tilt bridge JSON with string values", func(t *testing.T) {
+		response := post(t, "/ingest/tilt", "application/json",
+			`{"Color":"RED","Temp":"68.0","SG":"1.050","Beer":"Pale Ale","Comment":""}`)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		probe := hardware.GetTemperature("tilt-red")
+		require.Equal(t, celsius(20), probe.ReadingRaw)
+		require.Equal(t, 1.050, *probe.Gravity)
+	})
+
+	t.Run("TiltPi form posts", func(t *testing.T) {
+		response := post(t, "/ingest/tilt", "application/x-www-form-urlencoded",
+			"Timepoint=44000.5&Temp=64.4&SG=1.012&Beer=Stout&Color=BLACK&Comment=")
+		require.Equal(t, http.StatusOK, response.Code)
+
+		probe := hardware.GetTemperature("tilt-black")
+		require.Equal(t, celsius(18), probe.ReadingRaw)
+		require.Equal(t, 1.012, *probe.Gravity)
+	})
+
+	t.Run("Generic posts in Celsius or with units", func(t *testing.T) {
+		response := post(t, "/ingest", "application/json", `{"id":"esp32-a","temperature":19.25}`)
+		require.Equal(t, http.StatusOK, response.Code)
+		probe := hardware.GetTemperature("remote-esp32-a")
+		require.Equal(t, celsius(19.25), probe.ReadingRaw)
+		require.Nil(t, probe.Gravity)
+
+		response = post(t, "/ingest", "application/json", `{"id":"esp32-a","temperature":"66.2F","gravity":1.02}`)
+		require.Equal(t, http.StatusOK, response.Code)
+		require.Equal(t, celsius(19), probe.ReadingRaw)
+		require.Equal(t, 1.02, *probe.Gravity)
+	})
+
+	t.Run("Invalid posts are rejected", func(t *testing.T) {
+		require.Equal(t, http.StatusBadRequest, post(t, "/ingest", "application/json", `{"temperature":19}`).Code)
+		require.Equal(t, http.StatusBadRequest, post(t, "/ingest", "application/json", `{"id":"a"}`).Code)
+		require.Equal(t, http.StatusBadRequest, post(t, "/ingest", "application/json", `{"id":"a","temperature":19,"gravity":50}`).Code)
+		require.Equal(t, http.StatusBadRequest, post(t, "/ingest/ispindel", "application/json", `{"name":"a","temperature":19,"gravity":1.5}`).Code)
+		require.Equal(t, http.StatusBadRequest, post(t, "/ingest", "application/json", `not json`).Code)
+		require.Equal(t, http.StatusBadRequest, post(t, "/ingest/ispindel", "application/json", `{"name":"a","temperature":19,"temp_units":"X"}`).Code)
+		require.Equal(t, http.StatusBadRequest, post(t, "/ingest/ispindel", "application/json", `{"name":"a","temperature":19,"gravity":1.1,"gravity_unit":"Brix"}`).Code)
+		require.Equal(t, http.StatusBadRequest, post(t, "/ingest", "application/json", `{"id":"a","temperature":19,"comment":"`+strings.Repeat("a", 1<<20)+`"}`).Code)
+	})
+}
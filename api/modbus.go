@@ -0,0 +1,22 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/dougedey/elsinore/modbus"
+	"github.com/go-chi/chi"
+	"github.com/rs/zerolog/log"
+)
+
+// ModbusRoutes - Mount the endpoint that documents the Modbus TCP register map
+// GET /modbus/registers -> A markdown table of every coil, discrete input and register of the current devices
+func ModbusRoutes(router chi.Router) {
+	router.Get("/modbus/registers", modbusRegisters)
+}
+
+func modbusRegisters(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+	if err := modbus.WriteRegisterMap(w); err != nil {
+		log.Warn().Err(err).Msg("Failed to write the Modbus register map")
+	}
+}
@@ -0,0 +1,316 @@
+package api
+
+// OpenAPIDocument describes the REST API, it is served at OpenAPIPath
+// Keep it in step with RestRoutes and the resources in rest.go
+const OpenAPIDocument = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "Elsinore REST API",
+    "description": "A JSON API for simple integrations, mirroring the probes, temperature controllers, switches and settings of the GraphQL API at /graphql. Request bodies use the fields of the GraphQL inputs and are validated the same way.",
+    "version": "1.0.0"
+  },
+  "servers": [{"url": "/api/v1"}],
+  "paths": {
+    "/probes": {
+      "get": {
+        "summary": "Every connected or registered probe, by address",
+        "responses": {"200": {"description": "The probes", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Probe"}}}}}}
+      }
+    },
+    "/probes/{address}": {
+      "parameters": [{"name": "address", "in": "path", "required": true, "schema": {"type": "string"}, "description": "The physical address of the probe"}],
+      "get": {
+        "summary": "A probe",
+        "responses": {
+          "200": {"description": "The probe", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Probe"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      },
+      "patch": {
+        "summary": "Name, describe, enable or disable a probe, like the updateProbe mutation",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ProbeUpdate"}}}},
+        "responses": {
+          "200": {"description": "The updated probe", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Probe"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"}
+        }
+      },
+      "delete": {
+        "summary": "Forget the registry entry of a probe, like the forgetProbe mutation",
+        "responses": {
+          "200": {"description": "The probe without its registry entry", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Probe"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/controllers": {
+      "get": {
+        "summary": "Every temperature controller",
+        "responses": {"200": {"description": "The controllers", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Controller"}}}}}}
+      },
+      "post": {
+        "summary": "Assign a probe to a controller, creating the controller if there is none with the name, like the assignProbe mutation",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ControllerCreate"}}}},
+        "responses": {
+          "201": {"description": "The controller", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Controller"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"}
+        }
+      }
+    },
+    "/controllers/{id}": {
+      "parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}],
+      "get": {
+        "summary": "A temperature controller",
+        "responses": {
+          "200": {"description": "The controller", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Controller"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      },
+      "patch": {
+        "summary": "Change the settings of a controller, like the updateTemperatureController mutation",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ControllerUpdate"}}}},
+        "responses": {
+          "200": {"description": "The updated controller", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Controller"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      },
+      "delete": {
+        "summary": "Delete a controller, releasing its probes",
+        "responses": {
+          "200": {"description": "The deleted controller", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/DeletedController"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/switches": {
+      "get": {
+        "summary": "Every switch",
+        "responses": {"200": {"description": "The switches", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Switch"}}}}}}
+      },
+      "post": {
+        "summary": "Create a switch, like the modifySwitch mutation without an id",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/SwitchInput"}}}},
+        "responses": {
+          "201": {"description": "The switch", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Switch"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"}
+        }
+      }
+    },
+    "/switches/{id}": {
+      "parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}],
+      "get": {
+        "summary": "A switch",
+        "responses": {
+          "200": {"description": "The switch", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Switch"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      },
+      "patch": {
+        "summary": "Rename, move, dim or turn a switch on or off, like the modifySwitch mutation",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/SwitchInput"}}}},
+        "responses": {
+          "200": {"description": "The updated switch", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Switch"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      },
+      "delete": {
+        "summary": "Delete a switch",
+        "responses": {
+          "200": {"description": "The ID of the deleted switch", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Deleted"}}}},
+          "404": {"$ref": "#/components/responses/NotFound"}
+        }
+      }
+    },
+    "/settings": {
+      "get": {
+        "summary": "The system settings",
+        "responses": {"200": {"description": "The settings", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Settings"}}}}}
+      },
+      "patch": {
+        "summary": "Change the system settings, like the updateSettings mutation",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Settings"}}}},
+        "responses": {
+          "200": {"description": "The updated settings", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Settings"}}}},
+          "400": {"$ref": "#/components/responses/BadRequest"}
+        }
+      }
+    }
+  },
+  "components": {
+    "responses": {
+      "BadRequest": {"description": "The body is not valid JSON, has unknown fields, or has invalid values", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}},
+      "NotFound": {"description": "There is nothing with this ID or address", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Error"}}}}
+    },
+    "schemas": {
+      "Error": {
+        "type": "object",
+        "properties": {"error": {"type": "string"}}
+      },
+      "Deleted": {
+        "type": "object",
+        "properties": {"id": {"type": "string"}}
+      },
+      "Probe": {
+        "type": "object",
+        "properties": {
+          "physAddr": {"type": "string"},
+          "name": {"type": "string", "description": "Defaults to the physical address"},
+          "location": {"type": "string"},
+          "notes": {"type": "string"},
+          "sensorType": {"type": "string"},
+          "enabled": {"type": "boolean", "description": "Disabled probes cannot be assigned to a controller"},
+          "connected": {"type": "boolean"},
+          "reading": {"type": "string", "description": "e.g. 20.5°C, missing when the probe has never been seen"},
+          "updated": {"type": "string", "format": "date-time"},
+          "gravity": {"type": "number", "description": "The specific gravity of a hydrometer probe"},
+          "lowLimit": {"type": "string", "description": "A probeOutOfRange event is sent when the reading goes below this"},
+          "highLimit": {"type": "string", "description": "A probeOutOfRange event is sent when the reading goes above this"}
+        }
+      },
+      "ProbeUpdate": {
+        "type": "object",
+        "additionalProperties": false,
+        "properties": {
+          "name": {"type": "string"},
+          "location": {"type": "string"},
+          "notes": {"type": "string"},
+          "sensorType": {"type": "string"},
+          "enabled": {"type": "boolean"},
+          "resolution": {"type": "integer", "description": "9 to 12 bits, 0 for the default of 10"},
+          "lowLimit": {"type": "string", "description": "e.g. 2C, empty to remove the limit"},
+          "highLimit": {"type": "string", "description": "e.g. 24C, empty to remove the limit"}
+        }
+      },
+      "PidSettings": {
+        "type": "object",
+        "properties": {
+          "configured": {"type": "boolean"},
+          "proportional": {"type": "number"},
+          "integral": {"type": "number"},
+          "derivative": {"type": "number"},
+          "cycleTime": {"type": "integer", "description": "Seconds"},
+          "delay": {"type": "integer", "description": "Seconds"},
+          "gpio": {"type": "string"},
+          "frequency": {"type": "integer", "description": "PWM frequency in Hz, 0 cycles the output on and off"}
+        }
+      },
+      "HysteriaSettings": {
+        "type": "object",
+        "properties": {
+          "configured": {"type": "boolean"},
+          "maxTemp": {"type": "string"},
+          "minTemp": {"type": "string"},
+          "minTime": {"type": "integer", "description": "Seconds"}
+        }
+      },
+      "ManualSettings": {
+        "type": "object",
+        "properties": {
+          "configured": {"type": "boolean"},
+          "dutyCycle": {"type": "integer", "minimum": -100, "maximum": 100, "description": "Negative duty cycles cool"},
+          "cycleTime": {"type": "integer", "minimum": 0, "description": "Seconds"}
+        }
+      },
+      "ControllerProbe": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "string"},
+          "physAddr": {"type": "string"},
+          "name": {"type": "string"},
+          "reading": {"type": "string"},
+          "status": {"type": "string", "enum": ["ok", "stale", "faulted", "disabled"]},
+          "contributing": {"type": "boolean", "description": "True when this probe was used for the last temperature of the controller"}
+        }
+      },
+      "Controller": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "string"},
+          "name": {"type": "string"},
+          "mode": {"type": "string", "enum": ["off", "auto", "manual", "hysteria"]},
+          "setPoint": {"type": "string", "description": "e.g. 18°C, empty when it is not set"},
+          "dutyCycle": {"type": "integer"},
+          "calculatedDuty": {"type": "integer", "description": "The PID duty cycle for auto mode"},
+          "running": {"type": "boolean"},
+          "interlocked": {"type": "boolean", "description": "True when an interlock input is holding the outputs off"},
+          "aggregation": {"type": "string", "enum": ["mean", "median", "min", "max", "weighted", "primary"]},
+          "staleAfter": {"type": "integer", "description": "Seconds without an update before a probe is ignored, 0 for the default"},
+          "probes": {"type": "array", "items": {"$ref": "#/components/schemas/ControllerProbe"}},
+          "heatSettings": {"$ref": "#/components/schemas/PidSettings"},
+          "coolSettings": {"$ref": "#/components/schemas/PidSettings"},
+          "hysteriaSettings": {"$ref": "#/components/schemas/HysteriaSettings"},
+          "manualSettings": {"$ref": "#/components/schemas/ManualSettings"}
+        }
+      },
+      "ControllerCreate": {
+        "type": "object",
+        "required": ["name", "probe"],
+        "additionalProperties": false,
+        "properties": {
+          "name": {"type": "string", "description": "The controller to create, or to add the probe to"},
+          "probe": {"type": "string", "description": "The physical address of the probe"}
+        }
+      },
+      "ControllerUpdate": {
+        "type": "object",
+        "additionalProperties": false,
+        "description": "The fields of TemperatureControllerSettingsInput, only the fields that are set are changed",
+        "properties": {
+          "name": {"type": "string"},
+          "mode": {"type": "string", "enum": ["off", "auto", "manual", "hysteria"]},
+          "setPoint": {"type": "string", "description": "A temperature with units, e.g. 65F or 18.5C, empty to clear it"},
+          "aggregation": {"type": "string", "enum": ["mean", "median", "min", "max", "weighted", "primary"]},
+          "staleAfter": {"type": "integer", "minimum": 0},
+          "heatSettings": {"type": "object", "description": "The fields of PidSettingsInput"},
+          "coolSettings": {"type": "object", "description": "The fields of PidSettingsInput"},
+          "hysteriaSettings": {"type": "object", "description": "The fields of HysteriaSettingsInput"},
+          "manualSettings": {"type": "object", "description": "The fields of ManualSettingsInput"}
+        }
+      },
+      "DeletedController": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "string"},
+          "temperatureProbes": {"type": "array", "items": {"type": "string"}, "description": "The probes that were assigned to the controller"}
+        }
+      },
+      "Switch": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "string"},
+          "name": {"type": "string"},
+          "gpio": {"type": "string"},
+          "state": {"type": "string", "enum": ["on", "off"]},
+          "networked": {"type": "boolean", "description": "True when the output is a relay on the network"},
+          "outputError": {"type": "string", "description": "Why the output could not be switched, missing when it is working"},
+          "frequency": {"type": "integer", "description": "PWM frequency in Hz, 0 for a plain on/off switch"},
+          "duty": {"type": "integer", "description": "PWM duty in percent for a dimmer, 0 is off, defaults to 100"},
+          "maxOnSeconds": {"type": "integer", "description": "The longest the switch stays on before it is turned off, 0 for no limit"}
+        }
+      },
+      "SwitchInput": {
+        "type": "object",
+        "additionalProperties": false,
+        "description": "The fields of SwitchSettingsInput, name and gpio are required to create a switch",
+        "properties": {
+          "name": {"type": "string"},
+          "gpio": {"type": "string", "description": "A GPIO, expander pin, network output URL or serial relay"},
+          "state": {"type": "string", "enum": ["on", "off"]},
+          "frequency": {"type": "integer"},
+          "duty": {"type": "integer"},
+          "maxOnSeconds": {"type": "integer"}
+        }
+      },
+      "Settings": {
+        "type": "object",
+        "additionalProperties": false,
+        "properties": {
+          "breweryName": {"type": "string"},
+          "timezone": {"type": "string", "description": "An IANA timezone such as America/Toronto, empty for the local time of the server"}
+        }
+      }
+    }
+  }
+}
+`
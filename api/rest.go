@@ -0,0 +1,488 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/dougedey/elsinore/system"
+	"github.com/go-chi/chi"
+	"github.com/rs/zerolog/log"
+)
+
+// RestPrefix is where the REST API is mounted
+const RestPrefix = "/api/v1"
+
+// OpenAPIPath is where the OpenAPI document of the REST API is served
+const OpenAPIPath = RestPrefix + "/openapi.json"
+
+// RestRoutes - Mount a JSON API for scripts and microcontrollers that cannot easily build GraphQL queries, see OpenAPIPath for the details
+// Request bodies use the same fields as the GraphQL inputs, and are applied with the same devices functions as the mutations
+//
+// GET/PATCH/DELETE /api/v1/probes/{address} -> A probe, its registry entry (updateProbe), forgetting it (forgetProbe)
+// GET/POST /api/v1/controllers, GET/PATCH/DELETE /api/v1/controllers/{id} -> Temperature controllers (assignProbe, updateTemperatureController)
+// GET/POST /api/v1/switches, GET/PATCH/DELETE /api/v1/switches/{id} -> Switches (modifySwitch), PATCH {"state": "on"} toggles them
+// GET/PATCH /api/v1/settings -> The system settings (updateSettings)
+func RestRoutes(router chi.Router) {
+	router.Route(RestPrefix, func(r chi.Router) {
+		r.Get("/openapi.json", openAPI)
+
+		r.Get("/probes", listProbes)
+		r.Get("/probes/{address}", getProbe)
+		r.Patch("/probes/{address}", patchProbe)
+		r.Delete("/probes/{address}", deleteProbe)
+
+		r.Get("/controllers", listControllers)
+		r.Post("/controllers", createController)
+		r.Get("/controllers/{id}", getController)
+		r.Patch("/controllers/{id}", patchController)
+		r.Delete("/controllers/{id}", deleteController)
+
+		r.Get("/switches", listSwitches)
+		r.Post("/switches", createSwitch)
+		r.Get("/switches/{id}", getSwitch)
+		r.Patch("/switches/{id}", patchSwitch)
+		r.Delete("/switches/{id}", deleteSwitch)
+
+		r.Get("/settings", getSettings)
+		r.Patch("/settings", patchSettings)
+	})
+}
+
+// probeResource is a probe, joining the live probe with its registry entry
+type probeResource struct {
+	PhysAddr   string     `json:"physAddr"`
+	Name       string     `json:"name"`
+	Location   string     `json:"location,omitempty"`
+	Notes      string     `json:"notes,omitempty"`
+	SensorType string     `json:"sensorType,omitempty"`
+	Enabled    bool       `json:"enabled"`
+	Connected  bool       `json:"connected"`
+	Reading    *string    `json:"reading,omitempty"`
+	Updated    *time.Time `json:"updated,omitempty"`
+	Gravity    *float64   `json:"gravity,omitempty"`
+	LowLimit   string     `json:"lowLimit,omitempty"`
+	HighLimit  string     `json:"highLimit,omitempty"`
+}
+
+type pidSettingsResource struct {
+	Configured   bool    `json:"configured"`
+	Proportional float64 `json:"proportional"`
+	Integral     float64 `json:"integral"`
+	Derivative   float64 `json:"derivative"`
+	CycleTime    int64   `json:"cycleTime"`
+	Delay        int64   `json:"delay"`
+	Gpio         string  `json:"gpio"`
+	Frequency    int64   `json:"frequency"`
+}
+
+type hysteriaSettingsResource struct {
+	Configured bool   `json:"configured"`
+	MaxTemp    string `json:"maxTemp"`
+	MinTemp    string `json:"minTemp"`
+	MinTime    int64  `json:"minTime"`
+}
+
+type manualSettingsResource struct {
+	Configured bool  `json:"configured"`
+	DutyCycle  int64 `json:"dutyCycle"`
+	CycleTime  int64 `json:"cycleTime"`
+}
+
+type controllerProbeResource struct {
+	ID           string            `json:"id"`
+	PhysAddr     string            `json:"physAddr"`
+	Name         string            `json:"name"`
+	Reading      string            `json:"reading"`
+	Status       model.ProbeStatus `json:"status"`
+	Contributing bool              `json:"contributing"`
+}
+
+type controllerResource struct {
+	ID               string                    `json:"id"`
+	Name             string                    `json:"name"`
+	Mode             model.ControllerMode      `json:"mode"`
+	SetPoint         string                    `json:"setPoint"`
+	DutyCycle        int64                     `json:"dutyCycle"`
+	CalculatedDuty   int64                     `json:"calculatedDuty"`
+	Running          bool                      `json:"running"`
+	Interlocked      bool                      `json:"interlocked"`
+	Aggregation      model.AggregationMode     `json:"aggregation"`
+	StaleAfter       int64                     `json:"staleAfter"`
+	Probes           []controllerProbeResource `json:"probes"`
+	HeatSettings     pidSettingsResource       `json:"heatSettings"`
+	CoolSettings     pidSettingsResource       `json:"coolSettings"`
+	HysteriaSettings hysteriaSettingsResource  `json:"hysteriaSettings"`
+	ManualSettings   manualSettingsResource    `json:"manualSettings"`
+}
+
+type switchResource struct {
+	ID           string           `json:"id"`
+	Name         string           `json:"name"`
+	Gpio         string           `json:"gpio"`
+	State        model.SwitchMode `json:"state"`
+	Networked    bool             `json:"networked"`
+	OutputError  *string          `json:"outputError,omitempty"`
+	Frequency    int64            `json:"frequency"`
+	Duty         int64            `json:"duty"`
+	MaxOnSeconds int64            `json:"maxOnSeconds"`
+}
+
+type settingsResource struct {
+	BreweryName string `json:"breweryName"`
+	Timezone    string `json:"timezone"`
+}
+
+// createControllerRequest assigns a probe to a controller, creating the controller
+type createControllerRequest struct {
+	Name  string `json:"name"`
+	Probe string `json:"probe"`
+}
+
+// deletedControllerResource is a deleted controller and the probes that were assigned to it
+type deletedControllerResource struct {
+	ID                string    `json:"id"`
+	TemperatureProbes []*string `json:"temperatureProbes"`
+}
+
+// deletedResource is the ID of a deleted resource
+type deletedResource struct {
+	ID string `json:"id"`
+}
+
+// restError is the body of every error response
+type restError struct {
+	Error string `json:"error"`
+}
+
+// toProbeResource flattens the shared probe model, empty strings are left out of the JSON
+func toProbeResource(physAddr string) probeResource {
+	probe := devices.TemperatureProbeModel(physAddr)
+	return probeResource{
+		PhysAddr:   *probe.PhysAddr,
+		Name:       *probe.Name,
+		Location:   stringValue(probe.Location),
+		Notes:      stringValue(probe.Notes),
+		SensorType: stringValue(probe.SensorType),
+		Enabled:    probe.Enabled,
+		Connected:  probe.Connected,
+		Reading:    probe.Reading,
+		Updated:    probe.Updated,
+		Gravity:    probe.Gravity,
+		LowLimit:   stringValue(probe.LowLimit),
+		HighLimit:  stringValue(probe.HighLimit),
+	}
+}
+
+// stringValue dereferences an optional string, empty when it is nil
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func toPidSettingsResource(settings devices.PidSettings) pidSettingsResource {
+	return pidSettingsResource{
+		Configured:   settings.Configured,
+		Proportional: settings.Proportional,
+		Integral:     settings.Integral,
+		Derivative:   settings.Derivative,
+		CycleTime:    settings.CycleTime,
+		Delay:        settings.Delay,
+		Gpio:         settings.Gpio,
+		Frequency:    settings.Frequency,
+	}
+}
+
+func toControllerResource(c *devices.TemperatureController) controllerResource {
+	probes := []controllerProbeResource{}
+	for _, probe := range c.TempProbeDetails {
+		probes = append(probes, controllerProbeResource{
+			ID:           fmt.Sprint(probe.ID),
+			PhysAddr:     probe.PhysAddr,
+			Name:         devices.ProbeName(probe.PhysAddr),
+			Reading:      probe.Reading(),
+			Status:       c.ProbeStatus(probe),
+			Contributing: c.Contributing(probe.PhysAddr),
+		})
+	}
+
+	return controllerResource{
+		ID:             fmt.Sprint(c.ID),
+		Name:           c.Name,
+		Mode:           c.Mode,
+		SetPoint:       c.SetPoint(),
+		DutyCycle:      c.DutyCycle,
+		CalculatedDuty: c.CalculatedDuty,
+		Running:        c.Running,
+		Interlocked:    c.Interlocked(),
+		Aggregation:    c.Aggregation(),
+		StaleAfter:     c.StaleAfter,
+		Probes:         probes,
+		HeatSettings:   toPidSettingsResource(c.HeatSettings),
+		CoolSettings:   toPidSettingsResource(c.CoolSettings),
+		HysteriaSettings: hysteriaSettingsResource{
+			Configured: c.HysteriaSettings.Configured,
+			MaxTemp:    c.HysteriaSettings.MaxTemp(),
+			MinTemp:    c.HysteriaSettings.MinTemp(),
+			MinTime:    c.HysteriaSettings.MinTime,
+		},
+		ManualSettings: manualSettingsResource{
+			Configured: c.ManualSettings.Configured,
+			DutyCycle:  c.ManualSettings.DutyCycle,
+			CycleTime:  c.ManualSettings.CycleTime,
+		},
+	}
+}
+
+func toSwitchResource(s *devices.Switch) switchResource {
+	return switchResource{
+		ID:           fmt.Sprint(s.ID),
+		Name:         s.Name(),
+		Gpio:         s.Gpio(),
+		State:        s.State(),
+		Networked:    s.Networked(),
+		OutputError:  s.OutputError(),
+		Frequency:    s.Frequency,
+		Duty:         s.DimmerDuty(),
+		MaxOnSeconds: s.MaxOnSeconds,
+	}
+}
+
+func toSettingsResource(settings *system.Settings) settingsResource {
+	return settingsResource{BreweryName: settings.BreweryName, Timezone: settings.Timezone}
+}
+
+// respond - Write value as the JSON body
+func respond(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		log.Warn().Err(err).Msg("Failed to write a REST response")
+	}
+}
+
+// fail - Write an error response, logging rejected requests
+func fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if status != http.StatusNotFound {
+		log.Warn().Err(err).Msgf("Rejected %v %v", r.Method, r.URL.Path)
+	}
+	respond(w, status, restError{Error: err.Error()})
+}
+
+// decodeBody - Decode the JSON body into value, unknown fields are rejected so typos are not silently ignored
+func decodeBody(r *http.Request, value interface{}) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(value); err != nil {
+		return fmt.Errorf("invalid JSON: %v", err)
+	}
+	return nil
+}
+
+func openAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(OpenAPIDocument))
+}
+
+// listProbes - Every connected probe and every registered probe, by address
+func listProbes(w http.ResponseWriter, r *http.Request) {
+	addresses := map[string]bool{}
+	for _, device := range hardware.GetProbes() {
+		addresses[device.PhysAddr] = true
+	}
+	for _, settings := range devices.AllProbeSettings() {
+		addresses[settings.PhysAddr] = true
+	}
+
+	probes := []probeResource{}
+	for address := range addresses {
+		probes = append(probes, toProbeResource(address))
+	}
+	sort.Slice(probes, func(i, j int) bool { return probes[i].PhysAddr < probes[j].PhysAddr })
+	respond(w, http.StatusOK, probes)
+}
+
+func getProbe(w http.ResponseWriter, r *http.Request) {
+	address := chi.URLParam(r, "address")
+	if hardware.GetTemperature(address) == nil && devices.FindProbeSettings(address) == nil {
+		fail(w, r, http.StatusNotFound, fmt.Errorf("no device found for address %v", address))
+		return
+	}
+	respond(w, http.StatusOK, toProbeResource(address))
+}
+
+func patchProbe(w http.ResponseWriter, r *http.Request) {
+	settings := model.ProbeSettingsInput{}
+	if err := decodeBody(r, &settings); err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	settings.PhysAddr = chi.URLParam(r, "address")
+	if _, err := devices.UpdateProbeSettings(settings); err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	respond(w, http.StatusOK, toProbeResource(settings.PhysAddr))
+}
+
+func deleteProbe(w http.ResponseWriter, r *http.Request) {
+	address := chi.URLParam(r, "address")
+	if _, err := devices.DeleteProbeSettings(address); err != nil {
+		fail(w, r, http.StatusNotFound, err)
+		return
+	}
+	respond(w, http.StatusOK, toProbeResource(address))
+}
+
+func listControllers(w http.ResponseWriter, r *http.Request) {
+	controllers := []controllerResource{}
+	for _, controller := range devices.AllTemperatureControllers() {
+		controllers = append(controllers, toControllerResource(controller))
+	}
+	respond(w, http.StatusOK, controllers)
+}
+
+func createController(w http.ResponseWriter, r *http.Request) {
+	request := createControllerRequest{}
+	if err := decodeBody(r, &request); err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	controller, err := devices.AssignProbe(request.Name, request.Probe)
+	if err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	respond(w, http.StatusCreated, toControllerResource(controller))
+}
+
+// findController - The controller in the URL, writing a not found response when there is none
+func findController(w http.ResponseWriter, r *http.Request) *devices.TemperatureController {
+	id := chi.URLParam(r, "id")
+	controller := devices.FindTemperatureControllerByID(id)
+	if controller == nil {
+		fail(w, r, http.StatusNotFound, fmt.Errorf("no controller could be found for: %v", id))
+	}
+	return controller
+}
+
+func getController(w http.ResponseWriter, r *http.Request) {
+	if controller := findController(w, r); controller != nil {
+		respond(w, http.StatusOK, toControllerResource(controller))
+	}
+}
+
+func patchController(w http.ResponseWriter, r *http.Request) {
+	controller := findController(w, r)
+	if controller == nil {
+		return
+	}
+	settings := model.TemperatureControllerSettingsInput{}
+	if err := decodeBody(r, &settings); err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	settings.ID = chi.URLParam(r, "id")
+	if err := controller.ApplySettings(settings); err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	respond(w, http.StatusOK, toControllerResource(controller))
+}
+
+func deleteController(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	probes := devices.DeleteTemperatureControllerByID(id)
+	if probes == nil {
+		fail(w, r, http.StatusNotFound, fmt.Errorf("failed to find a controller to delete for: %v", id))
+		return
+	}
+	respond(w, http.StatusOK, deletedControllerResource{ID: id, TemperatureProbes: probes})
+}
+
+func listSwitches(w http.ResponseWriter, r *http.Request) {
+	switches := []switchResource{}
+	for _, s := range devices.AllSwitches() {
+		switches = append(switches, toSwitchResource(s))
+	}
+	respond(w, http.StatusOK, switches)
+}
+
+func createSwitch(w http.ResponseWriter, r *http.Request) {
+	settings := model.SwitchSettingsInput{}
+	if err := decodeBody(r, &settings); err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	settings.ID = nil
+	s, err := devices.ModifySwitch(settings)
+	if err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	respond(w, http.StatusCreated, toSwitchResource(s))
+}
+
+func getSwitch(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	s := devices.FindSwitchByID(id)
+	if s == nil {
+		fail(w, r, http.StatusNotFound, fmt.Errorf("no switch with id: %v found", id))
+		return
+	}
+	respond(w, http.StatusOK, toSwitchResource(s))
+}
+
+func patchSwitch(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if devices.FindSwitchByID(id) == nil {
+		fail(w, r, http.StatusNotFound, fmt.Errorf("no switch with id: %v found", id))
+		return
+	}
+	settings := model.SwitchSettingsInput{}
+	if err := decodeBody(r, &settings); err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	settings.ID = &id
+	s, err := devices.ModifySwitch(settings)
+	if err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	respond(w, http.StatusOK, toSwitchResource(s))
+}
+
+func deleteSwitch(w http.ResponseWriter, r *http.Request) {
+	s, err := devices.DeleteSwitchByID(chi.URLParam(r, "id"))
+	if err != nil {
+		fail(w, r, http.StatusNotFound, err)
+		return
+	}
+	// The output of a deleted switch is released, so it has no state to report
+	respond(w, http.StatusOK, deletedResource{ID: fmt.Sprint(s.ID)})
+}
+
+func getSettings(w http.ResponseWriter, r *http.Request) {
+	respond(w, http.StatusOK, toSettingsResource(system.CurrentSettings()))
+}
+
+func patchSettings(w http.ResponseWriter, r *http.Request) {
+	settings := model.SettingsInput{}
+	if err := decodeBody(r, &settings); err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if err := system.CurrentSettings().Update(settings); err != nil {
+		fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+	respond(w, http.StatusOK, toSettingsResource(system.CurrentSettings()))
+}
@@ -0,0 +1,271 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dougedey/elsinore/api"
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/dougedey/elsinore/system"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/gpio/gpioreg"
+	"periph.io/x/periph/conn/gpio/gpiotest"
+	"periph.io/x/periph/conn/onewire"
+)
+
+func setupTestDb(t *testing.T) {
+	dbName := "test"
+	database.InitDatabase(&dbName,
+		&devices.TempProbeDetail{}, &devices.PidSettings{}, &devices.HysteriaSettings{},
+		&devices.ManualSettings{}, &devices.TemperatureController{}, &system.Settings{},
+		&devices.Switch{}, &devices.ProbeSettings{}, &devices.SPIProbe{}, &devices.InPin{},
+		&devices.FlowMeter{}, &devices.Expander{},
+		&devices.Fermentation{}, &devices.FermentationStep{}, &devices.GravityReading{},
+		&devices.Webhook{}, &devices.WebhookDelivery{}, &devices.AlertRule{}, &devices.AlertChannel{},
+		&devices.AutomationRule{}, &devices.AutomationCondition{}, &devices.AutomationAction{},
+		&devices.Schedule{}, &devices.SwitchGroup{}, &devices.SwitchGroupMember{}, &devices.Scene{}, &devices.SceneStep{},
+	)
+	devices.ClearControllers()
+	devices.ClearProbeSettings()
+	system.ReloadSettings()
+	t.Cleanup(func() {
+		database.Close()
+		devices.ClearControllers()
+		devices.ClearProbeSettings()
+		require.Nil(t, os.Remove("test.db"))
+	})
+}
+
+// call - Make a request to the REST API, decoding the JSON response into result when it is not nil
+func call(t *testing.T, method string, path string, body string, result interface{}) int {
+	router := chi.NewRouter()
+	api.RestRoutes(router)
+
+	request := httptest.NewRequest(method, api.RestPrefix+path, strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, request)
+	require.Equal(t, "application/json", response.Header().Get("Content-Type"))
+	if result != nil {
+		require.Nil(t, json.Unmarshal(response.Body.Bytes(), result), response.Body.String())
+	}
+	return response.Code
+}
+
+type restSwitch struct {
+	ID    string
+	Name  string
+	Gpio  string
+	State string
+}
+
+type restController struct {
+	ID             string
+	Name           string
+	Mode           string
+	SetPoint       string
+	Probes         []struct{ PhysAddr string }
+	ManualSettings struct {
+		Configured bool
+		DutyCycle  int
+		CycleTime  int
+	}
+}
+
+type restError struct {
+	Error string
+}
+
+func TestOpenAPI(t *testing.T) {
+	document := struct {
+		OpenAPI string
+		Paths   map[string]map[string]interface{}
+	}{}
+	require.Equal(t, http.StatusOK, call(t, http.MethodGet, "/openapi.json", "", &document))
+	require.Equal(t, "3.0.3", document.OpenAPI)
+
+	// Every route is documented
+	router := chi.NewRouter()
+	api.RestRoutes(router)
+	err := chi.Walk(router, func(method string, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		path := strings.TrimSuffix(strings.TrimPrefix(route, api.RestPrefix), "/")
+		if path == "/openapi.json" {
+			return nil
+		}
+		require.Contains(t, document.Paths, path)
+		require.Contains(t, document.Paths[path], strings.ToLower(method), path)
+		return nil
+	})
+	require.Nil(t, err)
+}
+
+func TestRestSwitches(t *testing.T) {
+	setupTestDb(t)
+	pin := &gpiotest.Pin{N: "REST_PUMP", Num: 401}
+	require.Nil(t, gpioreg.Register(pin))
+
+	created := restSwitch{}
+	t.Run("A switch is created", func(t *testing.T) {
+		require.Equal(t, http.StatusCreated, call(t, http.MethodPost, "/switches", `{"name": "Wort Pump", "gpio": "REST_PUMP"}`, &created))
+		require.Equal(t, "Wort Pump", created.Name)
+		require.Equal(t, "REST_PUMP", created.Gpio)
+		require.Equal(t, "off", created.State)
+	})
+
+	t.Run("Invalid switches are rejected", func(t *testing.T) {
+		failure := restError{}
+		require.Equal(t, http.StatusBadRequest, call(t, http.MethodPost, "/switches", `{"name": "No GPIO"}`, &failure))
+		require.Equal(t, "GPIO is required when creating a new switch", failure.Error)
+
+		require.Equal(t, http.StatusBadRequest, call(t, http.MethodPost, "/switches", `{"name": "Typo", "gpoi": "REST_PUMP"}`, &failure))
+		require.Contains(t, failure.Error, "unknown field")
+	})
+
+	t.Run("Switches are listed and found", func(t *testing.T) {
+		switches := []restSwitch{}
+		require.Equal(t, http.StatusOK, call(t, http.MethodGet, "/switches", "", &switches))
+		require.Contains(t, switches, created)
+
+		found := restSwitch{}
+		require.Equal(t, http.StatusOK, call(t, http.MethodGet, "/switches/"+created.ID, "", &found))
+		require.Equal(t, created, found)
+		require.Equal(t, http.StatusNotFound, call(t, http.MethodGet, "/switches/9999", "", nil))
+	})
+
+	t.Run("A switch is turned on and off", func(t *testing.T) {
+		updated := restSwitch{}
+		require.Equal(t, http.StatusOK, call(t, http.MethodPatch, "/switches/"+created.ID, `{"state": "on"}`, &updated))
+		require.Equal(t, "on", updated.State)
+		require.Equal(t, gpio.High, pin.Read())
+
+		require.Equal(t, http.StatusBadRequest, call(t, http.MethodPatch, "/switches/"+created.ID, `{"state": "sideways"}`, nil))
+
+		require.Equal(t, http.StatusOK, call(t, http.MethodPatch, "/switches/"+created.ID, `{"state": "off", "name": "Mash Pump"}`, &updated))
+		require.Equal(t, "off", updated.State)
+		require.Equal(t, "Mash Pump", updated.Name)
+		require.Equal(t, gpio.Low, pin.Read())
+	})
+
+	t.Run("A switch is deleted", func(t *testing.T) {
+		deleted := struct{ ID string }{}
+		require.Equal(t, http.StatusOK, call(t, http.MethodDelete, "/switches/"+created.ID, "", &deleted))
+		require.Equal(t, created.ID, deleted.ID)
+		require.Equal(t, http.StatusNotFound, call(t, http.MethodDelete, "/switches/"+created.ID, "", nil))
+	})
+}
+
+func TestRestControllers(t *testing.T) {
+	setupTestDb(t)
+	hardware.SetProbe(&hardware.TemperatureProbe{PhysAddr: "RestProbe", Address: onewire.Address(4040)})
+
+	created := restController{}
+	t.Run("A controller is created for a probe", func(t *testing.T) {
+		require.Equal(t, http.StatusCreated, call(t, http.MethodPost, "/controllers", `{"name": "Kettle", "probe": "RestProbe"}`, &created))
+		require.Equal(t, "Kettle", created.Name)
+		require.Equal(t, "RestProbe", created.Probes[0].PhysAddr)
+
+		require.Equal(t, http.StatusBadRequest, call(t, http.MethodPost, "/controllers", `{"name": "Kettle", "probe": "MissingProbe"}`, nil))
+	})
+
+	t.Run("A controller is updated like the mutation", func(t *testing.T) {
+		updated := restController{}
+		require.Equal(t, http.StatusOK, call(t, http.MethodPatch, "/controllers/"+created.ID,
+			`{"mode": "manual", "setPoint": "65C", "manualSettings": {"configured": true, "dutyCycle": 40, "cycleTime": 10}}`, &updated))
+		require.Equal(t, "manual", updated.Mode)
+		require.Equal(t, "65°C", updated.SetPoint)
+		require.True(t, updated.ManualSettings.Configured)
+		require.Equal(t, 40, updated.ManualSettings.DutyCycle)
+
+		controllers := []restController{}
+		require.Equal(t, http.StatusOK, call(t, http.MethodGet, "/controllers", "", &controllers))
+		require.Equal(t, []restController{updated}, controllers)
+	})
+
+	t.Run("Invalid settings are rejected", func(t *testing.T) {
+		failure := restError{}
+		require.Equal(t, http.StatusBadRequest, call(t, http.MethodPatch, "/controllers/"+created.ID, `{"mode": "turbo"}`, &failure))
+		require.Equal(t, "turbo is not a valid controller mode", failure.Error)
+		require.Equal(t, http.StatusBadRequest, call(t, http.MethodPatch, "/controllers/"+created.ID, `{"manualSettings": {"dutyCycle": 150}}`, &failure))
+		require.Equal(t, "the manual duty cycle must be between -100 and 100, got 150", failure.Error)
+		require.Equal(t, http.StatusBadRequest, call(t, http.MethodPatch, "/controllers/"+created.ID, `{"setPoint": "hot"}`, nil))
+		require.Equal(t, http.StatusNotFound, call(t, http.MethodPatch, "/controllers/9999", `{"mode": "off"}`, nil))
+
+		found := restController{}
+		require.Equal(t, http.StatusOK, call(t, http.MethodGet, "/controllers/"+created.ID, "", &found))
+		require.Equal(t, "manual", found.Mode)
+		require.Equal(t, 40, found.ManualSettings.DutyCycle)
+	})
+
+	t.Run("A controller is deleted", func(t *testing.T) {
+		deleted := struct {
+			ID                string
+			TemperatureProbes []string
+		}{}
+		require.Equal(t, http.StatusOK, call(t, http.MethodDelete, "/controllers/"+created.ID, "", &deleted))
+		require.Equal(t, []string{"RestProbe"}, deleted.TemperatureProbes)
+		require.Equal(t, http.StatusNotFound, call(t, http.MethodGet, "/controllers/"+created.ID, "", nil))
+	})
+}
+
+func TestRestProbes(t *testing.T) {
+	setupTestDb(t)
+	hardware.SetProbe(&hardware.TemperatureProbe{PhysAddr: "RestRegistryProbe", Address: onewire.Address(4041)})
+
+	type restProbe struct {
+		PhysAddr  string
+		Name      string
+		Location  string
+		Enabled   bool
+		Connected bool
+	}
+
+	updated := restProbe{}
+	require.Equal(t, http.StatusOK, call(t, http.MethodPatch, "/probes/RestRegistryProbe", `{"name": "Mash Tun", "location": "Brew stand"}`, &updated))
+	require.Equal(t, restProbe{PhysAddr: "RestRegistryProbe", Name: "Mash Tun", Location: "Brew stand", Enabled: true, Connected: true}, updated)
+
+	probes := []restProbe{}
+	require.Equal(t, http.StatusOK, call(t, http.MethodGet, "/probes", "", &probes))
+	require.Contains(t, probes, updated)
+
+	found := restProbe{}
+	require.Equal(t, http.StatusOK, call(t, http.MethodGet, "/probes/RestRegistryProbe", "", &found))
+	require.Equal(t, updated, found)
+	require.Equal(t, http.StatusNotFound, call(t, http.MethodGet, "/probes/NoSuchProbe", "", nil))
+
+	forgotten := restProbe{}
+	require.Equal(t, http.StatusOK, call(t, http.MethodDelete, "/probes/RestRegistryProbe", "", &forgotten))
+	require.Equal(t, "RestRegistryProbe", forgotten.Name)
+	require.Equal(t, http.StatusNotFound, call(t, http.MethodDelete, "/probes/RestRegistryProbe", "", nil))
+}
+
+func TestRestSettings(t *testing.T) {
+	setupTestDb(t)
+
+	settings := struct{ BreweryName string }{}
+	require.Equal(t, http.StatusOK, call(t, http.MethodPatch, "/settings", `{"breweryName": "Tiny Tun Brewing"}`, &settings))
+	require.Equal(t, "Tiny Tun Brewing", settings.BreweryName)
+
+	require.Equal(t, http.StatusOK, call(t, http.MethodGet, "/settings", "", &settings))
+	require.Equal(t, "Tiny Tun Brewing", settings.BreweryName)
+	require.Equal(t, "Tiny Tun Brewing", system.ReloadSettings().BreweryName)
+
+	require.Equal(t, http.StatusBadRequest, call(t, http.MethodPatch, "/settings", `{"breweryName": `, nil))
+	require.Equal(t, http.StatusBadRequest, call(t, http.MethodPatch, "/settings", `{"name": "Typo"}`, nil))
+
+	timezone := struct{ BreweryName, Timezone string }{}
+	require.Equal(t, http.StatusOK, call(t, http.MethodPatch, "/settings", `{"timezone": "America/Vancouver"}`, &timezone))
+	require.Equal(t, "Tiny Tun Brewing", timezone.BreweryName)
+	require.Equal(t, "America/Vancouver", timezone.Timezone)
+	failure := restError{}
+	require.Equal(t, http.StatusBadRequest, call(t, http.MethodPatch, "/settings", `{"timezone": "Vancouver"}`, &failure))
+	require.Equal(t, "'Vancouver' is not a known timezone", failure.Error)
+	require.Equal(t, "America/Vancouver", system.ReloadSettings().Timezone)
+}
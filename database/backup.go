@@ -0,0 +1,251 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// sqliteHeader is the magic string at the start of every SQLite database file
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// BackupSettings defines where snapshots are written, how often they are taken, and how many are kept
+type BackupSettings struct {
+	Directory string
+	Interval  time.Duration
+	Keep      int
+}
+
+// BackupFile describes a single snapshot on disk
+type BackupFile struct {
+	Name    string
+	Path    string
+	Size    int64
+	Created time.Time
+}
+
+var backupSettings = BackupSettings{Directory: "backups", Keep: 7}
+
+// ConfigureBackups - Set the backup settings used by CreateBackup, ListBackups and ScheduleBackups
+func ConfigureBackups(settings BackupSettings) {
+	backupSettings = settings
+}
+
+// CurrentBackupSettings - Return the backup settings currently in use
+func CurrentBackupSettings() BackupSettings {
+	return backupSettings
+}
+
+// CreateBackup - Take a consistent snapshot of the live database into the backup directory and rotate old snapshots
+func CreateBackup() (*BackupFile, error) {
+	if datastore == nil {
+		return nil, fmt.Errorf("no database configured, cannot create a backup")
+	}
+	if Driver() != SQLite {
+		return nil, fmt.Errorf("backups are only supported for SQLite databases, use the %v tools instead", Driver())
+	}
+
+	if err := os.MkdirAll(backupSettings.Directory, 0750); err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("%v-%v.db", backupPrefix(), time.Now().Format("20060102-150405.000"))
+	path := filepath.Join(backupSettings.Directory, name)
+	// VACUUM INTO takes a transactionally consistent copy without blocking writers for the duration of a file copy
+	result := datastore.Exec("VACUUM INTO ?", path)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	log.Info().Msgf("Created database backup %v", path)
+
+	err := RotateBackups()
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to rotate database backups")
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	return &BackupFile{Name: name, Path: path, Size: info.Size(), Created: info.ModTime()}, nil
+}
+
+// ListBackups - List the snapshots in the backup directory, newest first
+func ListBackups() ([]*BackupFile, error) {
+	entries, err := ioutil.ReadDir(backupSettings.Directory)
+	if os.IsNotExist(err) {
+		return []*BackupFile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	backups := []*BackupFile{}
+	prefix := backupPrefix() + "-"
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), prefix) || filepath.Ext(entry.Name()) != ".db" {
+			continue
+		}
+		backups = append(backups, &BackupFile{
+			Name:    entry.Name(),
+			Path:    filepath.Join(backupSettings.Directory, entry.Name()),
+			Size:    entry.Size(),
+			Created: entry.ModTime(),
+		})
+	}
+
+	// The timestamp in the name sorts lexically, which is more reliable than the modification time
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Name > backups[j].Name
+	})
+	return backups, nil
+}
+
+// RotateBackups - Remove the oldest snapshots so only the configured number are kept, Keep <= 0 keeps everything
+func RotateBackups() error {
+	if backupSettings.Keep <= 0 {
+		return nil
+	}
+
+	backups, err := ListBackups()
+	if err != nil {
+		return err
+	}
+
+	for i := backupSettings.Keep; i < len(backups); i++ {
+		log.Info().Msgf("Removing old database backup %v", backups[i].Path)
+		if err := os.Remove(backups[i].Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BackupPath - Resolve the name of a backup to its path in the backup directory, rejecting anything outside of it
+func BackupPath(name string) (string, error) {
+	if len(strings.TrimSpace(name)) == 0 || filepath.Base(name) != name {
+		return "", fmt.Errorf("invalid backup name '%v'", name)
+	}
+
+	path := filepath.Join(backupSettings.Directory, name)
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("no backup found with name '%v'", name)
+	}
+	return path, nil
+}
+
+// RestoreBackup - Replace the database with the snapshot at path, reconnecting if the database is open
+// Anything holding on to loaded models should be stopped before calling this and reloaded afterwards
+func RestoreBackup(path string) error {
+	if len(dbPath) == 0 {
+		return fmt.Errorf("no SQLite database configured, cannot restore %v", path)
+	}
+
+	if err := verifyBackup(path); err != nil {
+		return err
+	}
+
+	reopen := datastore != nil
+	if reopen {
+		Close()
+	}
+
+	log.Warn().Msgf("Restoring database %v from %v", dbPath, path)
+	err := copyFile(path, dbPath)
+	if err == nil {
+		// Stale journals from the old database must not be replayed over the restored one
+		for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+			if rmErr := os.Remove(dbPath + suffix); rmErr != nil && !os.IsNotExist(rmErr) {
+				log.Warn().Err(rmErr).Msgf("Failed to remove %v%v", dbPath, suffix)
+			}
+		}
+	}
+
+	if reopen {
+		if openErr := open(); openErr != nil {
+			return openErr
+		}
+	}
+	return err
+}
+
+// ScheduleBackups - Take a snapshot on every interval until the context is done, a zero interval disables scheduling
+func ScheduleBackups(ctx context.Context) {
+	if backupSettings.Interval <= 0 {
+		log.Info().Msg("Scheduled database backups are disabled")
+		return
+	}
+	if Driver() != SQLite {
+		log.Info().Msgf("Scheduled database backups are only supported for SQLite, not %v", Driver())
+		return
+	}
+
+	log.Info().Msgf("Backing up the database every %v to %v", backupSettings.Interval, backupSettings.Directory)
+	ticker := time.NewTicker(backupSettings.Interval)
+
+	for {
+		select {
+		case <-ticker.C:
+			_, err := CreateBackup()
+			if err != nil {
+				log.Error().Err(err).Msg("Scheduled database backup failed")
+			}
+		case <-ctx.Done():
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func backupPrefix() string {
+	return strings.TrimSuffix(filepath.Base(dbPath), ".db")
+}
+
+func verifyBackup(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	header := make([]byte, len(sqliteHeader))
+	if _, err := io.ReadFull(file, header); err != nil || !bytes.Equal(header, sqliteHeader) {
+		return fmt.Errorf("%v is not a SQLite database", path)
+	}
+	return nil
+}
+
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	// Write next to the destination and rename, so a failed copy never leaves a truncated database behind
+	tmp := dst + ".restore"
+	out, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err = out.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
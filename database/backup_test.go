@@ -0,0 +1,104 @@
+package database_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type backupRecord struct {
+	gorm.Model
+	Name string
+}
+
+func setupBackupDb(t *testing.T, keep int) string {
+	dir := t.TempDir()
+	dbName := filepath.Join(dir, "test")
+	database.InitDatabase(&dbName, &backupRecord{})
+	database.ConfigureBackups(database.BackupSettings{Directory: filepath.Join(dir, "backups"), Keep: keep})
+
+	t.Cleanup(func() {
+		database.Close()
+	})
+	return dir
+}
+
+func TestCreateBackup(t *testing.T) {
+	setupBackupDb(t, 2)
+	database.Create(&backupRecord{Name: "first"})
+
+	t.Run("No backups are listed before one is created", func(t *testing.T) {
+		backups, err := database.ListBackups()
+		require.Nil(t, err)
+		require.Empty(t, backups)
+	})
+
+	t.Run("CreateBackup writes a snapshot to the backup directory", func(t *testing.T) {
+		backup, err := database.CreateBackup()
+		require.Nil(t, err)
+		require.FileExists(t, backup.Path)
+		require.True(t, backup.Size > 0)
+
+		backups, err := database.ListBackups()
+		require.Nil(t, err)
+		require.Len(t, backups, 1)
+		require.Equal(t, backup.Name, backups[0].Name)
+	})
+
+	t.Run("Old snapshots are rotated out", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			time.Sleep(2 * time.Millisecond)
+			_, err := database.CreateBackup()
+			require.Nil(t, err)
+		}
+
+		backups, err := database.ListBackups()
+		require.Nil(t, err)
+		require.Len(t, backups, 2)
+		require.True(t, backups[0].Name > backups[1].Name, "Expected the newest backup first")
+	})
+}
+
+func TestRestoreBackup(t *testing.T) {
+	dir := setupBackupDb(t, 0)
+	database.Create(&backupRecord{Name: "before"})
+
+	backup, err := database.CreateBackup()
+	require.Nil(t, err)
+	database.Create(&backupRecord{Name: "after"})
+
+	t.Run("BackupPath rejects names outside of the backup directory", func(t *testing.T) {
+		_, err := database.BackupPath("../test.db")
+		require.NotNil(t, err)
+
+		_, err = database.BackupPath("missing.db")
+		require.NotNil(t, err)
+	})
+
+	t.Run("RestoreBackup rejects files that are not SQLite databases", func(t *testing.T) {
+		notADb := filepath.Join(dir, "not_a_db.db")
+		require.Nil(t, ioutil.WriteFile(notADb, []byte("This is not a database"), 0600))
+
+		require.NotNil(t, database.RestoreBackup(notADb))
+
+		var count int64
+		database.FetchDatabase().Model(&backupRecord{}).Count(&count)
+		require.Equal(t, int64(2), count)
+	})
+
+	t.Run("RestoreBackup replaces the live database with the snapshot", func(t *testing.T) {
+		path, err := database.BackupPath(backup.Name)
+		require.Nil(t, err)
+		require.Nil(t, database.RestoreBackup(path))
+
+		var records []backupRecord
+		database.FetchDatabase().Find(&records)
+		require.Len(t, records, 1)
+		require.Equal(t, "before", records[0].Name)
+	})
+}
@@ -4,30 +4,60 @@ import (
 	"fmt"
 
 	"github.com/rs/zerolog/log"
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
 var datastore *gorm.DB
 var dbFile gorm.Dialector
+var dbPath string
+var models []interface{}
 
-// InitDatabase Create a db in the dbName path/filename, and migrate it with the supplied models
+// InitDatabase Create a db from dbName and migrate it with the supplied models
+// dbName is either the path/filename of a local SQLite database, or a DSN (see ParseDSN) for SQLite, PostgreSQL or MySQL
 func InitDatabase(dbName *string, dst ...interface{}) {
-	log.Info().Msgf("Loading database %v", *dbName)
 	var err error
-	dbFile = sqlite.Open(fmt.Sprintf("%v.db", *dbName))
+	dbFile, dbPath, err = ParseDSN(*dbName)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Invalid database! Please check the db flag")
+	}
+	if len(dbPath) > 0 {
+		log.Info().Msgf("Loading %v database %v", dbFile.Name(), dbPath)
+	} else {
+		log.Info().Msgf("Loading %v database", dbFile.Name())
+	}
+
+	models = dst
+	err = open()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load the database! Please check the logs!")
+	}
+}
+
+// open connects to the database and migrates the registered models
+func open() error {
+	var err error
 	datastore, err = gorm.Open(dbFile, &gorm.Config{
 		DisableForeignKeyConstraintWhenMigrating: true,
 	})
 	if err != nil {
-		panic("failed to connect database")
+		return fmt.Errorf("failed to connect database: %w", err)
 	}
 
-	// Migrate the schema
-	err = datastore.AutoMigrate(dst...)
+	err = registerInstanceCallbacks(datastore)
 	if err != nil {
-		log.Fatal().Err(err).Msg("Migration failed! Please check the logs!")
+		return err
+	}
+
+	// Migrate the schema
+	return datastore.AutoMigrate(models...)
+}
+
+// DropTables - Drop the tables for every migrated model, used to clean up shared test databases
+func DropTables() error {
+	if datastore == nil {
+		return fmt.Errorf("no database configured")
 	}
+	return datastore.Migrator().DropTable(models...)
 }
 
 // FetchDatabase Return the current database pointer
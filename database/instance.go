@@ -0,0 +1,82 @@
+package database
+
+import (
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var instanceID string
+
+// InstanceScoped can be embedded in a model so that each Elsinore instance sharing a database only sees its own rows
+type InstanceScoped struct {
+	InstanceID string `gorm:"index"`
+}
+
+// SetInstanceID - Scope every InstanceScoped model to this instance, an empty ID disables scoping
+func SetInstanceID(id string) {
+	instanceID = id
+}
+
+// InstanceID - The ID this instance scopes its rows with
+func InstanceID() string {
+	return instanceID
+}
+
+func registerInstanceCallbacks(db *gorm.DB) error {
+	callback := db.Callback()
+	if err := callback.Create().Before("gorm:create").Register("elsinore:assign_instance", assignInstance); err != nil {
+		return err
+	}
+	if err := callback.Update().Before("gorm:update").Register("elsinore:assign_instance", assignInstance); err != nil {
+		return err
+	}
+	if err := callback.Update().Before("gorm:update").Register("elsinore:scope_instance", scopeInstance); err != nil {
+		return err
+	}
+	if err := callback.Query().Before("gorm:query").Register("elsinore:scope_instance", scopeInstance); err != nil {
+		return err
+	}
+	if err := callback.Delete().Before("gorm:delete").Register("elsinore:scope_instance", scopeInstance); err != nil {
+		return err
+	}
+	return callback.Row().Before("gorm:row").Register("elsinore:scope_instance", scopeInstance)
+}
+
+func instanceField(db *gorm.DB) bool {
+	return len(instanceID) > 0 && db.Statement.Schema != nil && db.Statement.Schema.LookUpField("InstanceID") != nil
+}
+
+// assignInstance stamps new and updated rows with the current instance ID
+func assignInstance(db *gorm.DB) {
+	if !instanceField(db) || !db.Statement.ReflectValue.IsValid() {
+		return
+	}
+
+	field := db.Statement.Schema.LookUpField("InstanceID")
+	value := db.Statement.ReflectValue
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := field.Set(reflect.Indirect(value.Index(i)), instanceID); err != nil {
+				db.AddError(err)
+			}
+		}
+	case reflect.Struct:
+		if err := field.Set(value, instanceID); err != nil {
+			db.AddError(err)
+		}
+	}
+}
+
+// scopeInstance limits reads, updates and deletes to rows owned by the current instance
+func scopeInstance(db *gorm.DB) {
+	if !instanceField(db) {
+		return
+	}
+
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: db.Statement.Table, Name: "instance_id"}, Value: instanceID},
+	}})
+}
@@ -0,0 +1,153 @@
+package devices
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"periph.io/x/periph/conn/physic"
+)
+
+// DefaultStaleAfter is the number of seconds without an update before a probe is ignored, when the controller does not set one
+const DefaultStaleAfter = 30
+
+// Aggregation - How the readings of the probes are combined, defaulting to the mean
+func (c *TemperatureController) Aggregation() model.AggregationMode {
+	if !c.AggregationMode.IsValid() {
+		return model.AggregationModeMean
+	}
+	return c.AggregationMode
+}
+
+// ProbeStatus - Whether a probe can be used by this controller
+// Disabled probes, probes reporting a fault, and probes that have not updated within StaleAfter seconds are not used
+// A probe that has never been updated has no reading yet, so it is stale
+func (c *TemperatureController) ProbeStatus(probe *TempProbeDetail) model.ProbeStatus {
+	if !ProbeEnabled(probe.PhysAddr) {
+		return model.ProbeStatusDisabled
+	}
+	if len(probe.Fault) > 0 {
+		return model.ProbeStatusFaulted
+	}
+
+	staleAfter := c.StaleAfter
+	if staleAfter <= 0 {
+		staleAfter = DefaultStaleAfter
+	}
+	if probe.Updated.IsZero() || time.Since(probe.Updated) > time.Duration(staleAfter)*time.Second {
+		return model.ProbeStatusStale
+	}
+	return model.ProbeStatusOk
+}
+
+// AggregateTemperature - Combine the readings of the usable probes using the aggregation mode, returning the physical addresses of the probes that contributed
+// Nothing on the controller is changed, UpdateOutput stores the contributing probes
+func (c *TemperatureController) AggregateTemperature() (physic.Temperature, []string, error) {
+	usable := []*TempProbeDetail{}
+	for _, probe := range c.TempProbeDetails {
+		if c.ProbeStatus(probe) == model.ProbeStatusOk {
+			usable = append(usable, probe)
+		}
+	}
+
+	if len(usable) == 0 {
+		return 0, []string{}, fmt.Errorf("no usable probes for %v", c.Name)
+	}
+
+	contributing := usable
+	var temperature physic.Temperature
+	switch c.Aggregation() {
+	case model.AggregationModeMedian:
+		readings := make([]physic.Temperature, len(usable))
+		for i, probe := range usable {
+			readings[i] = probe.ReadingRaw
+		}
+		sort.Slice(readings, func(i, j int) bool { return readings[i] < readings[j] })
+		middle := len(readings) / 2
+		if len(readings)%2 == 0 {
+			temperature = readings[middle-1] + (readings[middle]-readings[middle-1])/2
+		} else {
+			temperature = readings[middle]
+		}
+	case model.AggregationModeMin:
+		contributing = []*TempProbeDetail{usable[0]}
+		for _, probe := range usable[1:] {
+			if probe.ReadingRaw < contributing[0].ReadingRaw {
+				contributing[0] = probe
+			}
+		}
+		temperature = contributing[0].ReadingRaw
+	case model.AggregationModeMax:
+		contributing = []*TempProbeDetail{usable[0]}
+		for _, probe := range usable[1:] {
+			if probe.ReadingRaw > contributing[0].ReadingRaw {
+				contributing[0] = probe
+			}
+		}
+		temperature = contributing[0].ReadingRaw
+	case model.AggregationModeWeighted:
+		var total, weights float64
+		for _, probe := range usable {
+			total += float64(probe.ReadingRaw) * probe.weight()
+			weights += probe.weight()
+		}
+		temperature = physic.Temperature(total / weights)
+	case model.AggregationModePrimary:
+		// The lowest priority number wins, ties go to the probe assigned first
+		primary := usable[0]
+		for _, probe := range usable[1:] {
+			if probe.Priority < primary.Priority {
+				primary = probe
+			}
+		}
+		contributing = []*TempProbeDetail{primary}
+		temperature = primary.ReadingRaw
+	default:
+		var total float64
+		for _, probe := range usable {
+			total += float64(probe.ReadingRaw)
+		}
+		temperature = physic.Temperature(total / float64(len(usable)))
+	}
+
+	addresses := make([]string, len(contributing))
+	for i, probe := range contributing {
+		addresses[i] = probe.PhysAddr
+	}
+	return temperature, addresses, nil
+}
+
+// Contributing - Returns true if the probe was used for the last temperature of this controller
+func (c *TemperatureController) Contributing(physAddr string) bool {
+	for _, contributor := range c.ContributingProbes {
+		if contributor == physAddr {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplySettings - Update the aggregation settings for this probe
+func (t *TempProbeDetail) ApplySettings(newSettings model.TempProbeDetailsInput) error {
+	if newSettings.Weight != nil {
+		if *newSettings.Weight < 0 {
+			return fmt.Errorf("weight must not be negative, got %v", *newSettings.Weight)
+		}
+		t.Weight = *newSettings.Weight
+	}
+	if newSettings.Priority != nil {
+		t.Priority = int64(*newSettings.Priority)
+	}
+	database.Save(t)
+	return nil
+}
+
+// weight - Unset weights count as 1
+func (t *TempProbeDetail) weight() float64 {
+	if t.Weight <= 0 {
+		return 1
+	}
+	return t.Weight
+}
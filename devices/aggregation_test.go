@@ -0,0 +1,122 @@
+package devices_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/physic"
+)
+
+func aggregationController(readings ...float64) *devices.TemperatureController {
+	controller := devices.TemperatureController{Name: "Aggregation"}
+	for i, reading := range readings {
+		controller.TempProbeDetails = append(controller.TempProbeDetails, &devices.TempProbeDetail{
+			PhysAddr:   string(rune('A' + i)),
+			ReadingRaw: celsius(reading),
+			Updated:    time.Now(),
+		})
+	}
+	return &controller
+}
+
+func TestAggregateTemperature(t *testing.T) {
+	t.Run("No probes returns an error instead of dividing by zero", func(t *testing.T) {
+		controller := aggregationController()
+		_, _, err := controller.AggregateTemperature()
+		require.NotNil(t, err)
+		require.Equal(t, physic.Temperature(0), controller.AverageTemperature())
+	})
+
+	t.Run("The default aggregation is the mean", func(t *testing.T) {
+		controller := aggregationController(18, 19, 23)
+		temperature, contributing, err := controller.AggregateTemperature()
+		require.Nil(t, err)
+		require.Equal(t, model.AggregationModeMean, controller.Aggregation())
+		require.InDelta(t, float64(celsius(20)), float64(temperature), 1)
+		require.Equal(t, []string{"A", "B", "C"}, contributing)
+		require.Empty(t, controller.ContributingProbes)
+	})
+
+	t.Run("Median uses the middle reading", func(t *testing.T) {
+		controller := aggregationController(18, 30, 19)
+		controller.AggregationMode = model.AggregationModeMedian
+		temperature, _, err := controller.AggregateTemperature()
+		require.Nil(t, err)
+		require.Equal(t, celsius(19), temperature)
+
+		controller.TempProbeDetails = controller.TempProbeDetails[:2]
+		temperature, _, err = controller.AggregateTemperature()
+		require.Nil(t, err)
+		require.Equal(t, celsius(24), temperature)
+	})
+
+	t.Run("Min and max use a single probe", func(t *testing.T) {
+		controller := aggregationController(18, 30, 19)
+		controller.AggregationMode = model.AggregationModeMin
+		temperature, contributing, err := controller.AggregateTemperature()
+		require.Nil(t, err)
+		require.Equal(t, celsius(18), temperature)
+		require.Equal(t, []string{"A"}, contributing)
+
+		controller.AggregationMode = model.AggregationModeMax
+		temperature, contributing, err = controller.AggregateTemperature()
+		require.Nil(t, err)
+		require.Equal(t, celsius(30), temperature)
+		require.Equal(t, []string{"B"}, contributing)
+	})
+
+	t.Run("Weighted uses the probe weights, unset weights count as 1", func(t *testing.T) {
+		controller := aggregationController(10, 20)
+		controller.AggregationMode = model.AggregationModeWeighted
+		controller.TempProbeDetails[1].Weight = 3
+		temperature, _, err := controller.AggregateTemperature()
+		require.Nil(t, err)
+		require.InDelta(t, float64(celsius(17.5)), float64(temperature), 1)
+	})
+
+	t.Run("Primary uses the lowest priority and falls back when it is unusable", func(t *testing.T) {
+		controller := aggregationController(22, 18)
+		controller.AggregationMode = model.AggregationModePrimary
+		controller.TempProbeDetails[0].Priority = 2
+		controller.TempProbeDetails[1].Priority = 1
+		temperature, contributing, err := controller.AggregateTemperature()
+		require.Nil(t, err)
+		require.Equal(t, celsius(18), temperature)
+		require.Equal(t, []string{"B"}, contributing)
+
+		controller.TempProbeDetails[1].Fault = "CRC error"
+		temperature, contributing, err = controller.AggregateTemperature()
+		require.Nil(t, err)
+		require.Equal(t, celsius(22), temperature)
+		require.Equal(t, []string{"A"}, contributing)
+		require.Equal(t, model.ProbeStatusFaulted, controller.ProbeStatus(controller.TempProbeDetails[1]))
+	})
+
+	t.Run("Stale probes are excluded", func(t *testing.T) {
+		controller := aggregationController(20, 40)
+		controller.StaleAfter = 10
+		controller.TempProbeDetails[0].Updated = time.Now()
+		controller.TempProbeDetails[1].Updated = time.Now().Add(-time.Minute)
+		temperature, contributing, err := controller.AggregateTemperature()
+		require.Nil(t, err)
+		require.Equal(t, celsius(20), temperature)
+		require.Equal(t, model.ProbeStatusStale, controller.ProbeStatus(controller.TempProbeDetails[1]))
+		require.Equal(t, []string{"A"}, contributing)
+	})
+
+	t.Run("Probes that have never updated are stale", func(t *testing.T) {
+		controller := aggregationController(20, 40)
+		controller.TempProbeDetails[1].Updated = time.Time{}
+		temperature, _, err := controller.AggregateTemperature()
+		require.Nil(t, err)
+		require.Equal(t, celsius(20), temperature)
+		require.Equal(t, model.ProbeStatusStale, controller.ProbeStatus(controller.TempProbeDetails[1]))
+
+		controller.TempProbeDetails[0].Updated = time.Time{}
+		_, _, err = controller.AggregateTemperature()
+		require.NotNil(t, err)
+	})
+}
@@ -0,0 +1,488 @@
+package devices
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"periph.io/x/periph/conn/physic"
+)
+
+// AlertCheckInterval is how often WatchAlerts evaluates the alert rules
+var AlertCheckInterval = time.Second
+
+var alertRules []*AlertRule = nil
+var alertLock sync.Mutex
+
+// AlertRule notifies its channels when a temperature has been above or below a threshold, or a probe has not updated, for long enough
+// It watches either a probe (Probe is the physical address) or the aggregated temperature of a controller
+type AlertRule struct {
+	gorm.Model
+	database.InstanceScoped
+	Name           string
+	Condition      model.AlertCondition
+	Probe          string
+	ControllerID   *uint
+	ThresholdRaw   *physic.Temperature
+	HysteresisRaw  physic.Temperature // A difference, how far back past the threshold the temperature goes before a firing alert resolves
+	Duration       int64              // Seconds the condition holds before firing, for stale alerts the seconds without an update
+	RepeatInterval int64              // Seconds between notifications while firing and not acknowledged, 0 notifies once
+	ChannelIDs     string             // The channels that are notified, comma separated
+	Enabled        bool
+	SilencedUntil  *time.Time
+	State          model.AlertState `gorm:"-"`
+	Since          *time.Time       `gorm:"-"` // When the rule entered its state
+	Value          *string          `gorm:"-"` // The last temperature, or when a stale probe was last updated
+	Acknowledged   bool             `gorm:"-"`
+	lastNotified   time.Time        `gorm:"-"`
+}
+
+// AllAlertRules returns all the alert rules, loading from the Database if none are loaded
+func AllAlertRules() []*AlertRule {
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	return loadAlertRules()
+}
+
+func loadAlertRules() []*AlertRule {
+	if alertRules == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Alert rules array is nil, checking the database...")
+		database.FetchDatabase().Debug().Find(&alertRules)
+		for _, rule := range alertRules {
+			rule.State = model.AlertStateOk
+		}
+	}
+	return alertRules
+}
+
+// FindAlertRuleByID - Find an alert rule by id
+func FindAlertRuleByID(id string) *AlertRule {
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	for _, rule := range AllAlertRules() {
+		if rule.ID == uint(intID) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// ModifyAlertRule - Create or update an alert rule, a changed rule starts again from ok
+func ModifyAlertRule(settings model.AlertRuleInput) (*AlertRule, error) {
+	rule := &AlertRule{Enabled: true, State: model.AlertStateOk}
+	if settings.ID != nil {
+		rule = FindAlertRuleByID(*settings.ID)
+		if rule == nil {
+			return nil, fmt.Errorf("no alert rule with id: %v found", *settings.ID)
+		}
+	} else if settings.Name == nil || settings.Condition == nil {
+		return nil, fmt.Errorf("name and condition are required when creating an alert rule")
+	}
+
+	updated := *rule
+	if settings.Name != nil {
+		updated.Name = strings.TrimSpace(*settings.Name)
+	}
+	if settings.Condition != nil {
+		updated.Condition = *settings.Condition
+	}
+	if settings.Probe != nil {
+		updated.Probe = strings.TrimSpace(*settings.Probe)
+	}
+	if settings.ControllerID != nil {
+		updated.ControllerID = nil
+		if len(strings.TrimSpace(*settings.ControllerID)) > 0 {
+			controller := FindTemperatureControllerByID(*settings.ControllerID)
+			if controller == nil {
+				return nil, fmt.Errorf("no controller with id: %v found", *settings.ControllerID)
+			}
+			updated.ControllerID = &controller.ID
+		}
+	}
+	if settings.Threshold != nil {
+		threshold, err := parseLimit(settings.Threshold)
+		if err != nil {
+			return nil, err
+		}
+		updated.ThresholdRaw = threshold
+	}
+	if settings.Hysteresis != nil {
+		hysteresis, err := parseDifference(*settings.Hysteresis)
+		if err != nil {
+			return nil, err
+		}
+		updated.HysteresisRaw = hysteresis
+	}
+	if settings.Duration != nil {
+		updated.Duration = int64(*settings.Duration)
+	}
+	if settings.RepeatInterval != nil {
+		updated.RepeatInterval = int64(*settings.RepeatInterval)
+	}
+	if settings.ChannelIds != nil {
+		channelIDs := []string{}
+		for _, id := range settings.ChannelIds {
+			channel := FindAlertChannelByID(id)
+			if channel == nil {
+				return nil, fmt.Errorf("no alert channel with id: %v found", id)
+			}
+			channelIDs = append(channelIDs, fmt.Sprint(channel.ID))
+		}
+		updated.ChannelIDs = strings.Join(channelIDs, ",")
+	}
+	if settings.Enabled != nil {
+		updated.Enabled = *settings.Enabled
+	}
+
+	if err := updated.validate(); err != nil {
+		return nil, err
+	}
+	for _, other := range AllAlertRules() {
+		if other != rule && strings.EqualFold(other.Name, updated.Name) {
+			return nil, fmt.Errorf("alert rule '%v' already exists", updated.Name)
+		}
+	}
+
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	*rule = updated
+	rule.reset()
+	if rule.ID == 0 {
+		alertRules = append(alertRules, rule)
+	}
+	database.Save(rule)
+	return rule, nil
+}
+
+func (r *AlertRule) validate() error {
+	if len(r.Name) == 0 {
+		return fmt.Errorf("an alert rule needs a name")
+	}
+	if !r.Condition.IsValid() {
+		return fmt.Errorf("%v is not an alert condition", r.Condition)
+	}
+	if len(r.Probe) > 0 && r.ControllerID != nil {
+		return fmt.Errorf("an alert rule watches a probe or a controller, not both")
+	}
+	if len(r.Probe) == 0 && r.ControllerID == nil {
+		return fmt.Errorf("an alert rule needs a probe or a controller to watch")
+	}
+	if r.Duration < 0 || r.RepeatInterval < 0 {
+		return fmt.Errorf("the duration and repeat interval must not be negative")
+	}
+	if r.HysteresisRaw < 0 {
+		return fmt.Errorf("the hysteresis must not be negative")
+	}
+	if r.Condition == model.AlertConditionStale {
+		if len(r.Probe) == 0 {
+			return fmt.Errorf("a stale alert watches a probe")
+		}
+		if r.Duration == 0 {
+			return fmt.Errorf("a stale alert needs a duration")
+		}
+	} else if r.ThresholdRaw == nil {
+		return fmt.Errorf("a threshold is required for %v alerts", r.Condition)
+	}
+	return nil
+}
+
+// DeleteAlertRuleByID - Delete an alert rule
+func DeleteAlertRuleByID(id string) (*AlertRule, error) {
+	rule := FindAlertRuleByID(id)
+	if rule == nil {
+		return nil, fmt.Errorf("no alert rule found with id '%v'", id)
+	}
+
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Delete(rule)
+	}
+	for i, r := range alertRules {
+		if r == rule {
+			alertRules[i] = alertRules[len(alertRules)-1]
+			alertRules = alertRules[:len(alertRules)-1]
+			break
+		}
+	}
+	return rule, nil
+}
+
+// ClearAlerts reset the cached alert rules and channels
+func ClearAlerts() {
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	alertRules = nil
+	alertChannels = nil
+}
+
+// Acknowledge - Stop a firing alert repeating until it has resolved and fired again
+func (r *AlertRule) Acknowledge() error {
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	if r.State != model.AlertStateFiring {
+		return fmt.Errorf("alert rule '%v' is not firing", r.Name)
+	}
+	r.Acknowledged = true
+	return nil
+}
+
+// Silence - Stop the rule notifying for a number of minutes, 0 notifies again now
+func (r *AlertRule) Silence(minutes int, now func() time.Time) error {
+	if minutes < 0 {
+		return fmt.Errorf("an alert rule cannot be silenced for %v minutes", minutes)
+	}
+	if now == nil {
+		now = time.Now
+	}
+
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	r.SilencedUntil = nil
+	if minutes > 0 {
+		until := now().Add(time.Duration(minutes) * time.Minute)
+		r.SilencedUntil = &until
+	}
+	database.Save(r)
+	return nil
+}
+
+// Threshold - The temperature for above and below alerts
+func (r *AlertRule) Threshold() *string {
+	return temperatureString(r.ThresholdRaw)
+}
+
+// Hysteresis - How far back past the threshold the temperature goes before a firing alert resolves, e.g. 0.5°C
+func (r *AlertRule) Hysteresis() string {
+	return differenceString(r.HysteresisRaw)
+}
+
+// Controller - The controller that is watched, nil when the rule watches a probe
+func (r *AlertRule) Controller() *TemperatureController {
+	if r.ControllerID == nil {
+		return nil
+	}
+	return FindTemperatureControllerByID(fmt.Sprint(*r.ControllerID))
+}
+
+// Channels - The channels that are notified
+func (r *AlertRule) Channels() []*AlertChannel {
+	channels := []*AlertChannel{}
+	for _, id := range strings.Split(r.ChannelIDs, ",") {
+		if channel := FindAlertChannelByID(id); channel != nil {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+// WatchAlerts - Evaluate the alert rules every AlertCheckInterval until quit is closed
+func WatchAlerts(quit <-chan struct{}) {
+	ticker := time.NewTicker(AlertCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			CheckAlerts(nil)
+		}
+	}
+}
+
+// CheckAlerts - Evaluate every enabled alert rule against the probes and controllers, notifying the channels of the rules that fire or resolve
+func CheckAlerts(now func() time.Time) {
+	if !startCheck() {
+		return
+	}
+	defer finishCheck()
+	if now == nil {
+		now = time.Now
+	}
+	at := now()
+
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	for _, rule := range loadAlertRules() {
+		if !rule.Enabled {
+			rule.reset()
+			continue
+		}
+		rule.evaluate(at)
+	}
+}
+
+func (r *AlertRule) reset() {
+	r.State = model.AlertStateOk
+	r.Since = nil
+	r.Value = nil
+	r.Acknowledged = false
+}
+
+// evaluate moves the rule between ok, pending and firing
+// Pending rules fire once the condition has held for the duration, firing rules resolve once the temperature is back past the hysteresis
+func (r *AlertRule) evaluate(at time.Time) {
+	met, cleared, hold, message := r.check(at)
+	setState := func(state model.AlertState) {
+		r.State = state
+		since := at
+		r.Since = &since
+	}
+
+	switch r.State {
+	case model.AlertStateFiring:
+		if cleared {
+			setState(model.AlertStateOk)
+			r.Acknowledged = false
+			r.notify(at, "Resolved: "+r.Name, message, false)
+		} else if r.RepeatInterval > 0 && !r.Acknowledged && at.Sub(r.lastNotified) >= time.Duration(r.RepeatInterval)*time.Second {
+			r.notify(at, "Firing: "+r.Name, message, true)
+		}
+		return
+	case model.AlertStatePending:
+		if !met {
+			setState(model.AlertStateOk)
+			return
+		}
+	default:
+		if !met {
+			r.State = model.AlertStateOk
+			return
+		}
+		setState(model.AlertStatePending)
+	}
+
+	if at.Sub(*r.Since) >= hold {
+		setState(model.AlertStateFiring)
+		r.notify(at, "Firing: "+r.Name, message, true)
+	}
+}
+
+// check whether the condition is met, whether a firing alert has cleared, how long the condition has to hold, and a message describing the temperature
+// Without a temperature neither is true, so the rule stays as it is
+func (r *AlertRule) check(at time.Time) (bool, bool, time.Duration, string) {
+	duration := time.Duration(r.Duration) * time.Second
+	if r.Condition == model.AlertConditionStale {
+		name := ProbeName(r.Probe)
+		probe := hardware.GetTemperature(r.Probe)
+		if probe == nil || !probe.Connected || probe.Updated.IsZero() {
+			// There is no update to measure from, the rule has to be pending for the duration
+			r.Value = nil
+			return true, false, duration, fmt.Sprintf("%v is not connected", name)
+		}
+		updated := probe.Updated.Format(time.RFC3339)
+		r.Value = &updated
+		age := at.Sub(probe.Updated).Truncate(time.Second)
+		if age >= duration {
+			return true, false, 0, fmt.Sprintf("%v has not updated for %v", name, age)
+		}
+		return false, true, 0, fmt.Sprintf("%v is updating again", name)
+	}
+
+	name, temperature, ok := r.temperature()
+	if !ok {
+		r.Value = nil
+		return false, false, duration, fmt.Sprintf("%v has no temperature", name)
+	}
+	value := temperature.String()
+	r.Value = &value
+
+	threshold := *r.ThresholdRaw
+	if r.Condition == model.AlertConditionAbove {
+		if temperature > threshold {
+			return true, false, duration, fmt.Sprintf("%v is %v, above %v", name, temperature, threshold)
+		}
+		return false, temperature <= threshold-r.HysteresisRaw, duration, fmt.Sprintf("%v is back to %v", name, temperature)
+	}
+	if temperature < threshold {
+		return true, false, duration, fmt.Sprintf("%v is %v, below %v", name, temperature, threshold)
+	}
+	return false, temperature >= threshold+r.HysteresisRaw, duration, fmt.Sprintf("%v is back to %v", name, temperature)
+}
+
+// temperature of the probe or controller that is watched
+func (r *AlertRule) temperature() (string, physic.Temperature, bool) {
+	return watchedTemperature(r.Probe, r.ControllerID)
+}
+
+// watchedTemperature is the reading of the probe, calibrated when it is on a controller, or the aggregated temperature of the controller when there is one
+func watchedTemperature(physAddr string, controllerID *uint) (string, physic.Temperature, bool) {
+	if controllerID != nil {
+		controller := FindTemperatureControllerByID(fmt.Sprint(*controllerID))
+		if controller == nil {
+			return fmt.Sprintf("controller %v", *controllerID), 0, false
+		}
+		temperature, _, err := controller.AggregateTemperature()
+		return controller.Name, temperature, err == nil
+	}
+
+	name := ProbeName(physAddr)
+	probe := hardware.GetTemperature(physAddr)
+	if probe == nil || !probe.Connected || len(probe.Fault) > 0 {
+		return name, 0, false
+	}
+	if detail := FindTempProbeDetail(physAddr); detail != nil {
+		return name, detail.Calibrate(probe.ReadingRaw), true
+	}
+	return name, probe.ReadingRaw, true
+}
+
+// notify sends to every enabled channel in the background, unless the rule is silenced
+func (r *AlertRule) notify(at time.Time, title string, message string, firing bool) {
+	log.Warn().Msgf("%v: %v", title, message)
+	r.lastNotified = at
+	if r.SilencedUntil != nil && at.Before(*r.SilencedUntil) {
+		return
+	}
+	for _, id := range strings.Split(r.ChannelIDs, ",") {
+		channel := findAlertChannel(id)
+		if channel == nil || !channel.Enabled {
+			continue
+		}
+		go channel.send(Notification{Rule: r.Name, Title: title, Message: message, Firing: firing, Time: at})
+	}
+}
+
+// parseDifference reads a temperature difference such as 0.5C or 1F
+func parseDifference(value string) (physic.Temperature, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if len(value) == 0 {
+		return 0, nil
+	}
+	difference := physic.Temperature(0)
+	if err := difference.Set(value); err != nil {
+		return 0, fmt.Errorf("invalid temperature difference '%v': %v", value, err)
+	}
+	switch {
+	case strings.HasSuffix(value, "F"):
+		difference -= physic.ZeroFahrenheit
+	case !strings.HasSuffix(value, "K"):
+		difference -= physic.ZeroCelsius
+	}
+	return difference, nil
+}
+
+// temperatureString formats the temperature, nil when it is not set
+func temperatureString(temperature *physic.Temperature) *string {
+	if temperature == nil {
+		return nil
+	}
+	value := temperature.String()
+	return &value
+}
+
+// differenceString formats a temperature difference in Celsius, e.g. 0.5°C
+func differenceString(difference physic.Temperature) string {
+	return strconv.FormatFloat(math.Round(float64(difference)/float64(physic.MilliKelvin))/1000, 'f', -1, 64) + "°C"
+}
@@ -0,0 +1,378 @@
+package devices
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/system"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// AlertTimeout is how long a channel has to accept a notification
+var AlertTimeout = 10 * time.Second
+
+var alertChannels []*AlertChannel = nil
+
+// AlertChannel is somewhere alert notifications are sent
+// URL -> smtp://host:port or smtps://host:port for email, the topic for ntfy, the server for Gotify, or where webhooks are POSTed
+// Token -> The SMTP password, the ntfy access token, the Gotify application token or the webhook signing secret
+type AlertChannel struct {
+	gorm.Model
+	database.InstanceScoped
+	Name      string
+	Kind      model.AlertChannelKind
+	URL       string
+	Username  string
+	Token     string
+	To        string // The addresses email is sent to, comma separated
+	From      string
+	Enabled   bool
+	LastError string `gorm:"-"` // Why the last notification failed, empty when it was sent
+}
+
+// Notification is what an alert rule sends to its channels when it fires or resolves
+type Notification struct {
+	Rule    string    `json:"rule"`
+	Title   string    `json:"title"`
+	Message string    `json:"message"`
+	Firing  bool      `json:"firing"`
+	Time    time.Time `json:"time"`
+}
+
+// AllAlertChannels returns all the alert channels, loading from the Database if none are loaded
+func AllAlertChannels() []*AlertChannel {
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	return loadAlertChannels()
+}
+
+func loadAlertChannels() []*AlertChannel {
+	if alertChannels == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Alert channels array is nil, checking the database...")
+		database.FetchDatabase().Debug().Find(&alertChannels)
+	}
+	return alertChannels
+}
+
+// FindAlertChannelByID - Find an alert channel by id
+func FindAlertChannelByID(id string) *AlertChannel {
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	return findAlertChannel(id)
+}
+
+// findAlertChannel finds a channel while the alert lock is held
+func findAlertChannel(id string) *AlertChannel {
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	for _, channel := range loadAlertChannels() {
+		if channel.ID == uint(intID) {
+			return channel
+		}
+	}
+	return nil
+}
+
+// ModifyAlertChannel - Create or update an alert channel
+func ModifyAlertChannel(settings model.AlertChannelInput) (*AlertChannel, error) {
+	channel := &AlertChannel{Enabled: true}
+	if settings.ID != nil {
+		channel = FindAlertChannelByID(*settings.ID)
+		if channel == nil {
+			return nil, fmt.Errorf("no alert channel with id: %v found", *settings.ID)
+		}
+	} else if settings.Name == nil || settings.Kind == nil || settings.URL == nil {
+		return nil, fmt.Errorf("name, kind and url are required when creating an alert channel")
+	}
+
+	updated := *channel
+	if settings.Name != nil {
+		updated.Name = strings.TrimSpace(*settings.Name)
+	}
+	if settings.Kind != nil {
+		updated.Kind = *settings.Kind
+	}
+	if settings.URL != nil {
+		updated.URL = strings.TrimSpace(*settings.URL)
+	}
+	if settings.Username != nil {
+		updated.Username = strings.TrimSpace(*settings.Username)
+	}
+	if settings.Token != nil {
+		updated.Token = strings.TrimSpace(*settings.Token)
+	}
+	if settings.To != nil {
+		updated.To = strings.TrimSpace(*settings.To)
+	}
+	if settings.From != nil {
+		updated.From = strings.TrimSpace(*settings.From)
+	}
+	if settings.Enabled != nil {
+		updated.Enabled = *settings.Enabled
+	}
+
+	if err := updated.validate(); err != nil {
+		return nil, err
+	}
+	for _, other := range AllAlertChannels() {
+		if other != channel && strings.EqualFold(other.Name, updated.Name) {
+			return nil, fmt.Errorf("alert channel '%v' already exists", updated.Name)
+		}
+	}
+
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	*channel = updated
+	if channel.ID == 0 {
+		alertChannels = append(alertChannels, channel)
+	}
+	database.Save(channel)
+	return channel, nil
+}
+
+func (c *AlertChannel) validate() error {
+	if len(c.Name) == 0 {
+		return fmt.Errorf("an alert channel needs a name")
+	}
+	if !c.Kind.IsValid() {
+		return fmt.Errorf("%v is not an alert channel kind", c.Kind)
+	}
+	schemes := []string{"http", "https"}
+	if c.Kind == model.AlertChannelKindEmail {
+		schemes = []string{"smtp", "smtps"}
+		if len(c.To) == 0 || len(c.From) == 0 {
+			return fmt.Errorf("email needs a to and a from address")
+		}
+	}
+	parsed, err := url.Parse(c.URL)
+	if err != nil || len(parsed.Host) == 0 || (parsed.Scheme != schemes[0] && parsed.Scheme != schemes[1]) {
+		return fmt.Errorf("'%v' is not a %v or %v URL", c.URL, schemes[0], schemes[1])
+	}
+	return nil
+}
+
+// DeleteAlertChannelByID - Delete an alert channel, it cannot be deleted while an alert rule uses it
+func DeleteAlertChannelByID(id string) (*AlertChannel, error) {
+	channel := FindAlertChannelByID(id)
+	if channel == nil {
+		return nil, fmt.Errorf("no alert channel found with id '%v'", id)
+	}
+	for _, rule := range AllAlertRules() {
+		for _, used := range rule.Channels() {
+			if used == channel {
+				return nil, fmt.Errorf("alert channel '%v' is used by '%v', it cannot be deleted", channel.Name, rule.Name)
+			}
+		}
+	}
+
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Delete(channel)
+	}
+	for i, c := range alertChannels {
+		if c == channel {
+			alertChannels[i] = alertChannels[len(alertChannels)-1]
+			alertChannels = alertChannels[:len(alertChannels)-1]
+			break
+		}
+	}
+	return channel, nil
+}
+
+// Test - Send a test notification now
+func (c *AlertChannel) Test() error {
+	return c.send(Notification{
+		Rule:    "Test",
+		Title:   "Test: " + c.Name,
+		Message: fmt.Sprintf("A test of the %v alert channel", c.Name),
+		Time:    time.Now(),
+	})
+}
+
+// send the notification, recording why it failed in LastError
+func (c *AlertChannel) send(notification Notification) error {
+	var err error
+	switch c.Kind {
+	case model.AlertChannelKindEmail:
+		err = c.sendEmail(notification)
+	case model.AlertChannelKindNtfy:
+		err = c.sendNtfy(notification)
+	case model.AlertChannelKindGotify:
+		err = c.sendGotify(notification)
+	default:
+		err = c.sendWebhook(notification)
+	}
+
+	alertLock.Lock()
+	defer alertLock.Unlock()
+	c.LastError = ""
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to send '%v' to %v", notification.Title, c.Name)
+		c.LastError = err.Error()
+	}
+	return err
+}
+
+// sendEmail sends through the SMTP server, upgrading smtp:// connections with STARTTLS when the server offers it
+func (c *AlertChannel) sendEmail(notification Notification) error {
+	server, err := url.Parse(c.URL)
+	if err != nil {
+		return err
+	}
+	host := server.Hostname()
+	port := server.Port()
+	if len(port) == 0 {
+		port = "25"
+		if server.Scheme == "smtps" {
+			port = "465"
+		}
+	}
+	address := net.JoinHostPort(host, port)
+
+	var conn net.Conn
+	if server.Scheme == "smtps" {
+		conn, err = tls.DialWithDialer(&net.Dialer{Timeout: AlertTimeout}, "tcp", address, &tls.Config{ServerName: host})
+	} else {
+		conn, err = net.DialTimeout("tcp", address, AlertTimeout)
+	}
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(AlertTimeout))
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if server.Scheme == "smtp" {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+				return err
+			}
+		}
+	}
+	if len(c.Username) > 0 {
+		if err := client.Auth(smtp.PlainAuth("", c.Username, c.Token, host)); err != nil {
+			return err
+		}
+	}
+
+	recipients := []string{}
+	for _, to := range strings.Split(c.To, ",") {
+		if to = strings.TrimSpace(to); len(to) > 0 {
+			recipients = append(recipients, to)
+		}
+	}
+	if err := client.Mail(c.From); err != nil {
+		return err
+	}
+	for _, to := range recipients {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	subject := notification.Title
+	if brewery := system.CurrentSettings().BreweryName; len(brewery) > 0 {
+		subject = fmt.Sprintf("[%v] %v", brewery, subject)
+	}
+	message := fmt.Sprintf("From: %v\r\nTo: %v\r\nSubject: %v\r\nDate: %v\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n%v\r\n",
+		c.From, strings.Join(recipients, ", "), subject, notification.Time.Format(time.RFC1123Z), notification.Message)
+	if _, err := writer.Write([]byte(message)); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// sendNtfy publishes the message to the topic, firing alerts are high priority
+func (c *AlertChannel) sendNtfy(notification Notification) error {
+	request, err := http.NewRequest(http.MethodPost, c.URL, strings.NewReader(notification.Message))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Title", notification.Title)
+	if notification.Firing {
+		request.Header.Set("Priority", "high")
+		request.Header.Set("Tags", "warning")
+	} else {
+		request.Header.Set("Tags", "white_check_mark")
+	}
+	if len(c.Token) > 0 {
+		request.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	return post(request)
+}
+
+// sendGotify creates a message with the application token, firing alerts are high priority
+func (c *AlertChannel) sendGotify(notification Notification) error {
+	priority := 4
+	if notification.Firing {
+		priority = 8
+	}
+	body, err := json.Marshal(map[string]interface{}{"title": notification.Title, "message": notification.Message, "priority": priority})
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(c.URL, "/")+"/message", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gotify-Key", c.Token)
+	return post(request)
+}
+
+// sendWebhook POSTs the notification as JSON, signed like webhooks when there is a token
+func (c *AlertChannel) sendWebhook(notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if len(c.Token) > 0 {
+		request.Header.Set("X-Elsinore-Signature", Sign(c.Token, body))
+	}
+	return post(request)
+}
+
+// post sends the request, anything but a 2xx response is an error
+func post(request *http.Request) error {
+	request.Header.Set("User-Agent", "Elsinore")
+	response, err := (&http.Client{Timeout: AlertTimeout}).Do(request)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("%v responded %v", request.URL.Host, response.Status)
+	}
+	return nil
+}
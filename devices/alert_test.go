@@ -0,0 +1,362 @@
+package devices_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/onewire"
+)
+
+// smtpStandIn - A local SMTP server that accepts every message, each is the envelope commands followed by the data
+func smtpStandIn(t *testing.T) (string, chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	messages := make(chan string, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn, messages)
+		}
+	}()
+	return "smtp://" + listener.Addr().String(), messages
+}
+
+func serveSMTP(conn net.Conn, messages chan string) {
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+	text.PrintfLine("220 localhost ESMTP")
+	envelope := []string{}
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		switch strings.ToUpper(strings.SplitN(line, " ", 2)[0]) {
+		case "EHLO", "HELO":
+			text.PrintfLine("250 localhost")
+		case "MAIL", "RCPT":
+			envelope = append(envelope, line)
+			text.PrintfLine("250 OK")
+		case "DATA":
+			text.PrintfLine("354 Go ahead")
+			body, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			messages <- strings.Join(envelope, "\n") + "\n" + string(body)
+			envelope = []string{}
+			text.PrintfLine("250 OK")
+		case "QUIT":
+			text.PrintfLine("221 Bye")
+			return
+		default:
+			text.PrintfLine("502 Not implemented")
+		}
+	}
+}
+
+// after - A clock that is the seconds after start
+func after(start time.Time, seconds int) func() time.Time {
+	return func() time.Time {
+		return start.Add(time.Duration(seconds) * time.Second)
+	}
+}
+
+// requireNoRequest - Fail if a request arrives soon, notifications are sent in the background
+func requireNoRequest(t *testing.T, requests chan webhookRequest) {
+	select {
+	case request := <-requests:
+		require.FailNow(t, "unexpected notification", string(request.body))
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestAlertRules(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearAlerts()
+	t.Cleanup(devices.ClearAlerts)
+
+	probe := &hardware.TemperatureProbe{PhysAddr: "AlertProbe", Address: onewire.Address(7070), ReadingRaw: celsius(20)}
+	hardware.SetProbe(probe)
+	server, requests, _ := webhookReceiver(t)
+	kind := model.AlertChannelKindWebhook
+	channel, err := devices.ModifyAlertChannel(model.AlertChannelInput{Name: strPointer("Home Assistant"), Kind: &kind, URL: strPointer(server.URL), Token: strPointer("s3cret")})
+	require.Nil(t, err)
+	channelID := fmt.Sprint(channel.ID)
+
+	t.Run("Invalid rules are rejected", func(t *testing.T) {
+		above := model.AlertConditionAbove
+		stale := model.AlertConditionStale
+		_, err := devices.ModifyAlertRule(model.AlertRuleInput{Name: strPointer("Hot"), Condition: &above, Threshold: strPointer("21C")})
+		require.Equal(t, "an alert rule needs a probe or a controller to watch", err.Error())
+		_, err = devices.ModifyAlertRule(model.AlertRuleInput{Name: strPointer("Hot"), Condition: &above, Probe: strPointer("AlertProbe")})
+		require.Equal(t, "a threshold is required for above alerts", err.Error())
+		_, err = devices.ModifyAlertRule(model.AlertRuleInput{Name: strPointer("Hot"), Condition: &above, Probe: strPointer("AlertProbe"), Threshold: strPointer("21C"), Hysteresis: strPointer("lots")})
+		require.NotNil(t, err)
+		_, err = devices.ModifyAlertRule(model.AlertRuleInput{Name: strPointer("Quiet"), Condition: &stale, Probe: strPointer("AlertProbe")})
+		require.Equal(t, "a stale alert needs a duration", err.Error())
+		_, err = devices.ModifyAlertRule(model.AlertRuleInput{Name: strPointer("Hot"), Condition: &above, Probe: strPointer("AlertProbe"), Threshold: strPointer("21C"), ChannelIds: []string{"999"}})
+		require.Equal(t, "no alert channel with id: 999 found", err.Error())
+		require.Empty(t, devices.AllAlertRules())
+	})
+
+	above := model.AlertConditionAbove
+	duration := 600
+	repeat := 300
+	rule, err := devices.ModifyAlertRule(model.AlertRuleInput{
+		Name:           strPointer("Fermenter 2 hot"),
+		Condition:      &above,
+		Probe:          strPointer("AlertProbe"),
+		Threshold:      strPointer("21C"),
+		Hysteresis:     strPointer("0.5C"),
+		Duration:       &duration,
+		RepeatInterval: &repeat,
+		ChannelIds:     []string{channelID},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "21°C", *rule.Threshold())
+	require.Equal(t, "0.5°C", rule.Hysteresis())
+	require.Equal(t, []*devices.AlertChannel{channel}, rule.Channels())
+	start := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Channels in use cannot be deleted", func(t *testing.T) {
+		_, err := devices.DeleteAlertChannelByID(channelID)
+		require.Equal(t, "alert channel 'Home Assistant' is used by 'Fermenter 2 hot', it cannot be deleted", err.Error())
+	})
+
+	t.Run("The condition has to hold for the duration", func(t *testing.T) {
+		probe.ReadingRaw = celsius(22)
+		devices.CheckAlerts(after(start, 0))
+		require.Equal(t, model.AlertStatePending, rule.State)
+		require.Equal(t, "22°C", *rule.Value)
+		devices.CheckAlerts(after(start, 599))
+		require.Equal(t, model.AlertStatePending, rule.State)
+		requireNoRequest(t, requests)
+
+		devices.CheckAlerts(after(start, 600))
+		require.Equal(t, model.AlertStateFiring, rule.State)
+		require.Equal(t, after(start, 600)(), *rule.Since)
+		request := nextRequest(t, requests)
+		require.Equal(t, devices.Sign("s3cret", request.body), request.header.Get("X-Elsinore-Signature"))
+		notification := devices.Notification{}
+		require.Nil(t, json.Unmarshal(request.body, &notification))
+		require.Equal(t, devices.Notification{Rule: "Fermenter 2 hot", Title: "Firing: Fermenter 2 hot", Message: "AlertProbe is 22°C, above 21°C", Firing: true, Time: after(start, 600)()}, notification)
+	})
+
+	t.Run("Firing alerts repeat until acknowledged", func(t *testing.T) {
+		devices.CheckAlerts(after(start, 800))
+		requireNoRequest(t, requests)
+		devices.CheckAlerts(after(start, 900))
+		require.Contains(t, string(nextRequest(t, requests).body), "Firing: Fermenter 2 hot")
+
+		require.Nil(t, rule.Acknowledge())
+		require.True(t, rule.Acknowledged)
+		devices.CheckAlerts(after(start, 1200))
+		requireNoRequest(t, requests)
+	})
+
+	t.Run("Firing alerts resolve past the hysteresis", func(t *testing.T) {
+		probe.ReadingRaw = celsius(20.8)
+		devices.CheckAlerts(after(start, 1300))
+		require.Equal(t, model.AlertStateFiring, rule.State)
+
+		probe.ReadingRaw = celsius(20.4)
+		devices.CheckAlerts(after(start, 1400))
+		require.Equal(t, model.AlertStateOk, rule.State)
+		require.False(t, rule.Acknowledged)
+		require.Contains(t, string(nextRequest(t, requests).body), "Resolved: Fermenter 2 hot")
+		require.Equal(t, "alert rule 'Fermenter 2 hot' is not firing", rule.Acknowledge().Error())
+	})
+
+	t.Run("Pending alerts that clear do not fire", func(t *testing.T) {
+		probe.ReadingRaw = celsius(22)
+		devices.CheckAlerts(after(start, 1500))
+		probe.ReadingRaw = celsius(21)
+		devices.CheckAlerts(after(start, 1600))
+		require.Equal(t, model.AlertStateOk, rule.State)
+		probe.ReadingRaw = celsius(22)
+		devices.CheckAlerts(after(start, 2100))
+		require.Equal(t, model.AlertStatePending, rule.State)
+		requireNoRequest(t, requests)
+	})
+
+	t.Run("Silenced alerts fire without notifying", func(t *testing.T) {
+		require.Nil(t, rule.Silence(60, after(start, 2100)))
+		devices.CheckAlerts(after(start, 2700))
+		require.Equal(t, model.AlertStateFiring, rule.State)
+		requireNoRequest(t, requests)
+
+		devices.ClearAlerts()
+		rule = devices.FindAlertRuleByID(fmt.Sprint(rule.ID))
+		require.Equal(t, after(start, 5700)(), *rule.SilencedUntil)
+		require.Equal(t, model.AlertStateOk, rule.State)
+	})
+
+	t.Run("Disabled alerts are not evaluated", func(t *testing.T) {
+		disabled := false
+		_, err := devices.ModifyAlertRule(model.AlertRuleInput{ID: strPointer(fmt.Sprint(rule.ID)), Enabled: &disabled})
+		require.Nil(t, err)
+		devices.CheckAlerts(after(start, 6000))
+		devices.CheckAlerts(after(start, 7000))
+		require.Equal(t, model.AlertStateOk, rule.State)
+		requireNoRequest(t, requests)
+	})
+
+	_, err = devices.DeleteAlertRuleByID(fmt.Sprint(rule.ID))
+	require.Nil(t, err)
+	_, err = devices.DeleteAlertChannelByID(channelID)
+	require.Nil(t, err)
+	require.Empty(t, devices.AllAlertChannels())
+}
+
+func TestStaleAlerts(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearAlerts()
+	t.Cleanup(devices.ClearAlerts)
+
+	start := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	probe := &hardware.TemperatureProbe{PhysAddr: "StaleProbe", Address: onewire.Address(7171), ReadingRaw: celsius(20)}
+	hardware.SetProbe(probe)
+	probe.Updated = start
+
+	server, requests, _ := webhookReceiver(t)
+	kind := model.AlertChannelKindNtfy
+	channel, err := devices.ModifyAlertChannel(model.AlertChannelInput{Name: strPointer("Phone"), Kind: &kind, URL: strPointer(server.URL + "/brewery"), Token: strPointer("tk_ntfy")})
+	require.Nil(t, err)
+
+	stale := model.AlertConditionStale
+	duration := 60
+	rule, err := devices.ModifyAlertRule(model.AlertRuleInput{
+		Name:       strPointer("Probe offline"),
+		Condition:  &stale,
+		Probe:      strPointer("StaleProbe"),
+		Duration:   &duration,
+		ChannelIds: []string{fmt.Sprint(channel.ID)},
+	})
+	require.Nil(t, err)
+
+	devices.CheckAlerts(after(start, 30))
+	require.Equal(t, model.AlertStateOk, rule.State)
+	require.Equal(t, "2021-06-01T12:00:00Z", *rule.Value)
+
+	devices.CheckAlerts(after(start, 61))
+	require.Equal(t, model.AlertStateFiring, rule.State)
+	request := nextRequest(t, requests)
+	require.Equal(t, "StaleProbe has not updated for 1m1s", string(request.body))
+	require.Equal(t, "Firing: Probe offline", request.header.Get("Title"))
+	require.Equal(t, "high", request.header.Get("Priority"))
+	require.Equal(t, "Bearer tk_ntfy", request.header.Get("Authorization"))
+
+	probe.Updated = after(start, 100)()
+	devices.CheckAlerts(after(start, 101))
+	require.Equal(t, model.AlertStateOk, rule.State)
+	request = nextRequest(t, requests)
+	require.Equal(t, "StaleProbe is updating again", string(request.body))
+	require.Empty(t, request.header.Get("Priority"))
+
+	t.Run("Disconnected probes fire after the duration", func(t *testing.T) {
+		probe.Connected = false
+		t.Cleanup(func() { probe.Connected = true })
+		devices.CheckAlerts(after(start, 200))
+		require.Equal(t, model.AlertStatePending, rule.State)
+		require.Nil(t, rule.Value)
+		devices.CheckAlerts(after(start, 260))
+		require.Equal(t, model.AlertStateFiring, rule.State)
+		require.Equal(t, "StaleProbe is not connected", string(nextRequest(t, requests).body))
+	})
+}
+
+func TestAlertChannels(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearAlerts()
+	t.Cleanup(devices.ClearAlerts)
+	email := model.AlertChannelKindEmail
+	gotify := model.AlertChannelKindGotify
+
+	t.Run("Invalid channels are rejected", func(t *testing.T) {
+		_, err := devices.ModifyAlertChannel(model.AlertChannelInput{Name: strPointer("Mail"), Kind: &email, URL: strPointer("smtp://localhost:25")})
+		require.Equal(t, "email needs a to and a from address", err.Error())
+		_, err = devices.ModifyAlertChannel(model.AlertChannelInput{Name: strPointer("Mail"), Kind: &email, URL: strPointer("http://localhost"), To: strPointer("a@example.com"), From: strPointer("b@example.com")})
+		require.Equal(t, "'http://localhost' is not a smtp or smtps URL", err.Error())
+		_, err = devices.ModifyAlertChannel(model.AlertChannelInput{Name: strPointer("Push"), Kind: &gotify, URL: strPointer("ftp://localhost")})
+		require.Equal(t, "'ftp://localhost' is not a http or https URL", err.Error())
+		kind := model.AlertChannelKind("pager")
+		_, err = devices.ModifyAlertChannel(model.AlertChannelInput{Name: strPointer("Push"), Kind: &kind, URL: strPointer("http://localhost")})
+		require.Equal(t, "pager is not an alert channel kind", err.Error())
+		require.Empty(t, devices.AllAlertChannels())
+	})
+
+	t.Run("Email is sent through the SMTP server", func(t *testing.T) {
+		url, messages := smtpStandIn(t)
+		channel, err := devices.ModifyAlertChannel(model.AlertChannelInput{
+			Name: strPointer("Mail"),
+			Kind: &email,
+			URL:  strPointer(url),
+			To:   strPointer("brewer@example.com, assistant@example.com"),
+			From: strPointer("elsinore@example.com"),
+		})
+		require.Nil(t, err)
+		require.True(t, channel.Enabled)
+
+		require.Nil(t, channel.Test())
+		message := <-messages
+		require.Contains(t, message, "MAIL FROM:<elsinore@example.com>")
+		require.Contains(t, message, "RCPT TO:<brewer@example.com>")
+		require.Contains(t, message, "RCPT TO:<assistant@example.com>")
+		require.Contains(t, message, "Subject: Test: Mail\n")
+		require.Contains(t, message, "A test of the Mail alert channel")
+		require.Empty(t, channel.LastError)
+
+		_, err = devices.ModifyAlertChannel(model.AlertChannelInput{Name: strPointer("mail"), Kind: &email, URL: strPointer(url), To: strPointer("a@example.com"), From: strPointer("b@example.com")})
+		require.Equal(t, "alert channel 'mail' already exists", err.Error())
+	})
+
+	t.Run("Gotify messages are created with the application token", func(t *testing.T) {
+		requests := make(chan *http.Request, 1)
+		bodies := make(chan []byte, 1)
+		statuses := make(chan int, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			requests <- r
+			bodies <- body
+			select {
+			case status := <-statuses:
+				w.WriteHeader(status)
+			default:
+			}
+		}))
+		t.Cleanup(server.Close)
+
+		channel, err := devices.ModifyAlertChannel(model.AlertChannelInput{Name: strPointer("Gotify"), Kind: &gotify, URL: strPointer(server.URL + "/"), Token: strPointer("AppToken")})
+		require.Nil(t, err)
+		require.Nil(t, channel.Test())
+		request := <-requests
+		require.Equal(t, "/message", request.URL.Path)
+		require.Equal(t, "AppToken", request.Header.Get("X-Gotify-Key"))
+		require.JSONEq(t, `{"title":"Test: Gotify","message":"A test of the Gotify alert channel","priority":4}`, string(<-bodies))
+
+		statuses <- http.StatusUnauthorized
+		err = channel.Test()
+		<-requests
+		<-bodies
+		require.Contains(t, err.Error(), "401 Unauthorized")
+		require.Equal(t, err.Error(), channel.LastError)
+	})
+}
@@ -0,0 +1,885 @@
+package devices
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/system"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"periph.io/x/periph/conn/physic"
+)
+
+// The number of traces kept for each automation rule
+const automationTraceHistory = 50
+
+// AutomationCheckInterval is how often WatchAutomations checks the automation rules
+var AutomationCheckInterval = time.Second
+
+var automationRules []*AutomationRule = nil
+var automationLock sync.Mutex
+
+// events published since the automation rules were last checked
+var automationEvents []Event
+var automationEventLock sync.Mutex
+
+// AutomationRule runs its actions when its trigger and all of its conditions match
+// The actions run once as the rule starts matching, with Revert the switch, mode and set point actions are undone when it stops
+type AutomationRule struct {
+	gorm.Model
+	database.InstanceScoped
+	Name        string
+	Enabled     bool
+	Revert      bool
+	Checks      []*AutomationCondition `gorm:"ForeignKey:AutomationRuleID"` // The trigger followed by the conditions
+	Actions     []*AutomationAction    `gorm:"ForeignKey:AutomationRuleID"`
+	Active      bool                   `gorm:"-"` // Whether the rule matched when it was last checked
+	lastChecked time.Time              `gorm:"-"`
+	traces      []*AutomationTrace     `gorm:"-"`
+}
+
+// AutomationCondition is the trigger of a rule, one of the conditions that also has to match, or an interlock of a scene
+// Probe/ControllerID -> The temperature that is compared with the threshold, for heating and cooling the controller (nil for any)
+// Time/Until -> HH:MM in the timezone of the system settings, without Until a time of day only matches as the time passes
+// DeviceID -> Only events from this device ID or name, any device when it is empty
+type AutomationCondition struct {
+	gorm.Model
+	AutomationRuleID uint `gorm:"index"`
+	SceneID          uint `gorm:"index"`
+	Trigger          bool
+	Kind             model.AutomationConditionKind
+	Probe            string
+	ControllerID     *uint
+	SwitchID         *uint
+	ThresholdRaw     *physic.Temperature
+	HysteresisRaw    physic.Temperature // A difference, how far back past the threshold the temperature goes before it stops matching
+	Time             string
+	Until            string
+	Event            *model.DeviceEventType
+	DeviceID         string
+	matched          bool `gorm:"-"` // Whether it matched when the rule was last checked, for the hysteresis
+}
+
+// AutomationAction is something a rule or a schedule does, in order of Position
+type AutomationAction struct {
+	gorm.Model
+	AutomationRuleID uint `gorm:"index"`
+	ScheduleID       uint `gorm:"index"`
+	Position         int64
+	Kind             model.AutomationActionKind
+	SwitchID         *uint
+	ControllerID     *uint
+	Mode             *model.ControllerMode
+	SetPoint         *string
+	Profile          string // The fermentation steps to start as JSON
+	previous         string `gorm:"-"` // The mode or set point before the action, restored when the rule reverts
+}
+
+// AutomationTrace records why a rule did or did not match, and what its actions did
+type AutomationTrace struct {
+	Time    time.Time
+	Matched bool
+	Checks  []*AutomationCheck
+	Actions []string
+	Errors  []string
+}
+
+// AutomationCheck is the result of checking a trigger or condition
+type AutomationCheck struct {
+	Description string
+	Matched     bool
+}
+
+// AllAutomationRules returns all the automation rules, loading from the Database if none are loaded
+func AllAutomationRules() []*AutomationRule {
+	automationLock.Lock()
+	defer automationLock.Unlock()
+	return loadAutomationRules()
+}
+
+func loadAutomationRules() []*AutomationRule {
+	if automationRules == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Automation rules array is nil, checking the database...")
+		database.FetchDatabase().Debug().Preload(clause.Associations).Find(&automationRules)
+		for _, rule := range automationRules {
+			rule.sort()
+		}
+	}
+	return automationRules
+}
+
+// FindAutomationRuleByID - Find an automation rule by id
+func FindAutomationRuleByID(id string) *AutomationRule {
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	for _, rule := range AllAutomationRules() {
+		if rule.ID == uint(intID) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// ModifyAutomationRule - Create or update an automation rule, the trigger, conditions and actions that are given replace the existing ones
+// A changed rule starts again as not matching, so its actions run if it matches
+func ModifyAutomationRule(settings model.AutomationRuleInput) (*AutomationRule, error) {
+	rule := &AutomationRule{Enabled: true}
+	if settings.ID != nil {
+		rule = FindAutomationRuleByID(*settings.ID)
+		if rule == nil {
+			return nil, fmt.Errorf("no automation rule with id: %v found", *settings.ID)
+		}
+	} else if settings.Name == nil || settings.Trigger == nil || len(settings.Actions) == 0 {
+		return nil, fmt.Errorf("name, trigger and actions are required when creating an automation rule")
+	}
+
+	updated := *rule
+	if settings.Name != nil {
+		updated.Name = strings.TrimSpace(*settings.Name)
+	}
+	if settings.Enabled != nil {
+		updated.Enabled = *settings.Enabled
+	}
+	if settings.Revert != nil {
+		updated.Revert = *settings.Revert
+	}
+	if settings.Trigger != nil || settings.Conditions != nil {
+		trigger := rule.Trigger()
+		if settings.Trigger != nil {
+			newTrigger, err := newAutomationCondition(*settings.Trigger, true)
+			if err != nil {
+				return nil, err
+			}
+			trigger = newTrigger
+		}
+		conditions := rule.Conditions()
+		if settings.Conditions != nil {
+			conditions = []*AutomationCondition{}
+			for _, conditionSettings := range settings.Conditions {
+				condition, err := newAutomationCondition(*conditionSettings, false)
+				if err != nil {
+					return nil, err
+				}
+				conditions = append(conditions, condition)
+			}
+		}
+		updated.Checks = append([]*AutomationCondition{trigger}, conditions...)
+	}
+	if settings.Actions != nil {
+		if len(settings.Actions) == 0 {
+			return nil, fmt.Errorf("an automation rule needs at least one action")
+		}
+		updated.Actions = []*AutomationAction{}
+		for i, actionSettings := range settings.Actions {
+			action, err := newAutomationAction(*actionSettings, int64(i))
+			if err != nil {
+				return nil, err
+			}
+			updated.Actions = append(updated.Actions, action)
+		}
+	}
+
+	if len(updated.Name) == 0 {
+		return nil, fmt.Errorf("an automation rule needs a name")
+	}
+	for _, other := range AllAutomationRules() {
+		if other != rule && strings.EqualFold(other.Name, updated.Name) {
+			return nil, fmt.Errorf("automation rule '%v' already exists", updated.Name)
+		}
+	}
+
+	automationLock.Lock()
+	defer automationLock.Unlock()
+	if database.FetchDatabase() != nil {
+		for _, check := range rule.Checks {
+			if !containsCondition(updated.Checks, check) {
+				database.FetchDatabase().Debug().Delete(check)
+			}
+		}
+		for _, action := range rule.Actions {
+			if !containsAction(updated.Actions, action) {
+				database.FetchDatabase().Debug().Delete(action)
+			}
+		}
+	}
+	rule.deactivate(time.Now())
+	*rule = updated
+	rule.reset()
+	if rule.ID == 0 {
+		automationRules = append(automationRules, rule)
+	}
+	database.Save(rule)
+	return rule, nil
+}
+
+// newAutomationCondition validates the settings for a trigger or condition
+func newAutomationCondition(settings model.AutomationConditionInput, trigger bool) (*AutomationCondition, error) {
+	condition := &AutomationCondition{Trigger: trigger, Kind: settings.Kind, Event: settings.Event}
+	if !settings.Kind.IsValid() {
+		return nil, fmt.Errorf("%v is not an automation condition", settings.Kind)
+	}
+	if settings.Probe != nil {
+		condition.Probe = strings.TrimSpace(*settings.Probe)
+	}
+	if settings.ControllerID != nil && len(strings.TrimSpace(*settings.ControllerID)) > 0 {
+		controller := FindTemperatureControllerByID(*settings.ControllerID)
+		if controller == nil {
+			return nil, fmt.Errorf("no controller with id: %v found", *settings.ControllerID)
+		}
+		condition.ControllerID = &controller.ID
+	}
+	if settings.SwitchID != nil && len(strings.TrimSpace(*settings.SwitchID)) > 0 {
+		s := FindSwitchByID(*settings.SwitchID)
+		if s == nil {
+			return nil, fmt.Errorf("no switch with id: %v found", *settings.SwitchID)
+		}
+		condition.SwitchID = &s.ID
+	}
+	threshold, err := parseLimit(settings.Threshold)
+	if err != nil {
+		return nil, err
+	}
+	condition.ThresholdRaw = threshold
+	if settings.Hysteresis != nil {
+		hysteresis, err := parseDifference(*settings.Hysteresis)
+		if err != nil {
+			return nil, err
+		}
+		if hysteresis < 0 {
+			return nil, fmt.Errorf("the hysteresis must not be negative")
+		}
+		condition.HysteresisRaw = hysteresis
+	}
+	for _, clock := range []*string{settings.Time, settings.Until} {
+		if clock != nil && len(strings.TrimSpace(*clock)) > 0 {
+			if _, err := time.Parse("15:04", strings.TrimSpace(*clock)); err != nil {
+				return nil, fmt.Errorf("'%v' is not a time of day, use HH:MM", *clock)
+			}
+		}
+	}
+	if settings.Time != nil {
+		condition.Time = strings.TrimSpace(*settings.Time)
+	}
+	if settings.Until != nil {
+		condition.Until = strings.TrimSpace(*settings.Until)
+	}
+	if settings.DeviceID != nil {
+		condition.DeviceID = strings.TrimSpace(*settings.DeviceID)
+	}
+
+	switch condition.Kind {
+	case model.AutomationConditionKindTemperatureAbove, model.AutomationConditionKindTemperatureBelow:
+		if (len(condition.Probe) > 0) == (condition.ControllerID != nil) {
+			return nil, fmt.Errorf("a %v condition needs a probe or a controller", condition.Kind)
+		}
+		if condition.ThresholdRaw == nil {
+			return nil, fmt.Errorf("a threshold is required for %v conditions", condition.Kind)
+		}
+	case model.AutomationConditionKindSwitchOn, model.AutomationConditionKindSwitchOff:
+		if condition.SwitchID == nil {
+			return nil, fmt.Errorf("a %v condition needs a switch", condition.Kind)
+		}
+	case model.AutomationConditionKindTimeOfDay:
+		if len(condition.Time) == 0 {
+			return nil, fmt.Errorf("a time is required for timeOfDay conditions")
+		}
+		if !trigger && len(condition.Until) == 0 {
+			return nil, fmt.Errorf("a timeOfDay condition that is not the trigger needs an until time")
+		}
+	case model.AutomationConditionKindEvent:
+		if !trigger {
+			return nil, fmt.Errorf("only the trigger can be an event")
+		}
+		if condition.Event == nil || !condition.Event.IsValid() {
+			return nil, fmt.Errorf("an event trigger needs a device event")
+		}
+	}
+	return condition, nil
+}
+
+// newAutomationAction validates the settings for an action
+func newAutomationAction(settings model.AutomationActionInput, position int64) (*AutomationAction, error) {
+	action := &AutomationAction{Position: position, Kind: settings.Kind, Mode: settings.Mode}
+	if !settings.Kind.IsValid() {
+		return nil, fmt.Errorf("%v is not an automation action", settings.Kind)
+	}
+	if settings.SwitchID != nil && len(strings.TrimSpace(*settings.SwitchID)) > 0 {
+		s := FindSwitchByID(*settings.SwitchID)
+		if s == nil {
+			return nil, fmt.Errorf("no switch with id: %v found", *settings.SwitchID)
+		}
+		action.SwitchID = &s.ID
+	}
+	if settings.ControllerID != nil && len(strings.TrimSpace(*settings.ControllerID)) > 0 {
+		controller := FindTemperatureControllerByID(*settings.ControllerID)
+		if controller == nil {
+			return nil, fmt.Errorf("no controller with id: %v found", *settings.ControllerID)
+		}
+		action.ControllerID = &controller.ID
+	}
+
+	switch action.Kind {
+	case model.AutomationActionKindSwitchOn, model.AutomationActionKindSwitchOff:
+		if action.SwitchID == nil {
+			return nil, fmt.Errorf("a %v action needs a switch", action.Kind)
+		}
+		return action, nil
+	}
+	if action.ControllerID == nil {
+		return nil, fmt.Errorf("a %v action needs a controller", action.Kind)
+	}
+	switch action.Kind {
+	case model.AutomationActionKindControllerMode:
+		if action.Mode == nil || !action.Mode.IsValid() {
+			return nil, fmt.Errorf("a controllerMode action needs a controller mode")
+		}
+	case model.AutomationActionKindControllerSetPoint:
+		setPoint, err := parseLimit(settings.SetPoint)
+		if err != nil {
+			return nil, err
+		}
+		if setPoint == nil {
+			return nil, fmt.Errorf("a controllerSetPoint action needs a set point")
+		}
+		action.SetPoint = settings.SetPoint
+	case model.AutomationActionKindStartFermentation:
+		for _, step := range settings.Steps {
+			if step.Attenuation <= 0 || step.Attenuation > 100 {
+				return nil, fmt.Errorf("step attenuation must be between 0 and 100%%, got %v", step.Attenuation)
+			}
+			if _, err := parseLimit(step.SetPoint); err != nil {
+				return nil, err
+			}
+		}
+		profile, err := json.Marshal(settings.Steps)
+		if err != nil {
+			return nil, err
+		}
+		action.Profile = string(profile)
+	}
+	return action, nil
+}
+
+// DeleteAutomationRuleByID - Delete an automation rule, an active rule that reverts undoes its actions first
+func DeleteAutomationRuleByID(id string) (*AutomationRule, error) {
+	rule := FindAutomationRuleByID(id)
+	if rule == nil {
+		return nil, fmt.Errorf("no automation rule found with id '%v'", id)
+	}
+
+	automationLock.Lock()
+	defer automationLock.Unlock()
+	rule.deactivate(time.Now())
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Select(clause.Associations).Delete(rule)
+	}
+	for i, r := range automationRules {
+		if r == rule {
+			automationRules[i] = automationRules[len(automationRules)-1]
+			automationRules = automationRules[:len(automationRules)-1]
+			break
+		}
+	}
+	return rule, nil
+}
+
+// ClearAutomations reset the cached automation rules
+func ClearAutomations() {
+	automationLock.Lock()
+	defer automationLock.Unlock()
+	automationRules = nil
+}
+
+// Trigger - The condition that starts the rule
+func (r *AutomationRule) Trigger() *AutomationCondition {
+	for _, check := range r.Checks {
+		if check.Trigger {
+			return check
+		}
+	}
+	return nil
+}
+
+// Conditions - The conditions that have to match as well as the trigger
+func (r *AutomationRule) Conditions() []*AutomationCondition {
+	conditions := []*AutomationCondition{}
+	for _, check := range r.Checks {
+		if !check.Trigger {
+			conditions = append(conditions, check)
+		}
+	}
+	return conditions
+}
+
+// Trace - The most recent times the rule started or stopped matching, newest first, limited to limit when it is set
+func (r *AutomationRule) Trace(limit *int) []*AutomationTrace {
+	automationLock.Lock()
+	defer automationLock.Unlock()
+	traces := []*AutomationTrace{}
+	for i := len(r.traces) - 1; i >= 0; i-- {
+		if limit != nil && len(traces) >= *limit {
+			break
+		}
+		traces = append(traces, r.traces[i])
+	}
+	return traces
+}
+
+// Evaluate - Check the trigger and conditions now without running the actions or changing the rule
+func (r *AutomationRule) Evaluate(now func() time.Time) *AutomationTrace {
+	if now == nil {
+		now = time.Now
+	}
+	automationLock.Lock()
+	defer automationLock.Unlock()
+	return r.check(now(), nil, true)
+}
+
+// WatchAutomations - Check the automation rules every AutomationCheckInterval until quit is closed
+func WatchAutomations(quit <-chan struct{}) {
+	ticker := time.NewTicker(AutomationCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			CheckAutomations(nil)
+		}
+	}
+}
+
+// CheckAutomations - Check every enabled automation rule, running the actions of the rules that start matching and reverting the rules that stop
+// Events published since the last check are used by event triggers, including events the actions publish
+func CheckAutomations(now func() time.Time) {
+	if !startCheck() {
+		return
+	}
+	defer finishCheck()
+	if now == nil {
+		now = time.Now
+	}
+	at := now()
+
+	automationEventLock.Lock()
+	events := automationEvents
+	automationEvents = nil
+	automationEventLock.Unlock()
+
+	automationLock.Lock()
+	defer automationLock.Unlock()
+	for _, rule := range loadAutomationRules() {
+		if !rule.Enabled {
+			rule.deactivate(at)
+			rule.lastChecked = at
+			continue
+		}
+		rule.evaluate(at, events)
+	}
+}
+
+// queueAutomationEvent keeps the event for the next CheckAutomations
+func queueAutomationEvent(event Event) {
+	automationEventLock.Lock()
+	defer automationEventLock.Unlock()
+	automationEvents = append(automationEvents, event)
+	if len(automationEvents) > deviceEventHistory {
+		automationEvents = automationEvents[len(automationEvents)-deviceEventHistory:]
+	}
+}
+
+func (r *AutomationRule) reset() {
+	r.Active = false
+	r.lastChecked = time.Time{}
+	r.traces = nil
+	for _, check := range r.Checks {
+		check.matched = false
+	}
+}
+
+func (r *AutomationRule) sort() {
+	sort.SliceStable(r.Checks, func(i, j int) bool {
+		if r.Checks[i].Trigger != r.Checks[j].Trigger {
+			return r.Checks[i].Trigger
+		}
+		return r.Checks[i].ID < r.Checks[j].ID
+	})
+	sort.SliceStable(r.Actions, func(i, j int) bool { return r.Actions[i].Position < r.Actions[j].Position })
+}
+
+// evaluate runs the actions when the rule starts matching, and reverts them when it stops if the rule reverts
+func (r *AutomationRule) evaluate(at time.Time, events []Event) {
+	trace := r.check(at, events, false)
+	r.lastChecked = at
+	if trace.Matched == r.Active {
+		return
+	}
+	r.Active = trace.Matched
+
+	if trace.Matched {
+		for _, action := range r.Actions {
+			trace.record(action.run())
+		}
+	} else if r.Revert {
+		r.revert(trace)
+	}
+	log.Info().Msgf("Automation %v matched: %v, %v", r.Name, trace.Matched, strings.Join(trace.Actions, ", "))
+	r.addTrace(trace)
+}
+
+// deactivate reverts an active rule that reverts, before it is disabled or changed, so its actions are not left in place
+func (r *AutomationRule) deactivate(at time.Time) {
+	if r.Active && r.Revert {
+		trace := &AutomationTrace{Time: at, Checks: []*AutomationCheck{}, Actions: []string{}, Errors: []string{}}
+		r.revert(trace)
+		log.Info().Msgf("Automation %v stopped, %v", r.Name, strings.Join(trace.Actions, ", "))
+		r.addTrace(trace)
+	}
+	r.Active = false
+}
+
+// revert undoes the actions in reverse order
+func (r *AutomationRule) revert(trace *AutomationTrace) {
+	for i := len(r.Actions) - 1; i >= 0; i-- {
+		trace.record(r.Actions[i].revert())
+	}
+}
+
+func (r *AutomationRule) addTrace(trace *AutomationTrace) {
+	r.traces = append(r.traces, trace)
+	if len(r.traces) > automationTraceHistory {
+		r.traces = r.traces[len(r.traces)-automationTraceHistory:]
+	}
+}
+
+// check every trigger and condition, the hysteresis is only updated when it is not a dry run
+func (r *AutomationRule) check(at time.Time, events []Event, dryRun bool) *AutomationTrace {
+	since := r.lastChecked
+	if since.IsZero() {
+		since = at
+	}
+	trace := &AutomationTrace{Time: at, Matched: len(r.Checks) > 0, Checks: []*AutomationCheck{}, Actions: []string{}, Errors: []string{}}
+	for _, condition := range r.Checks {
+		matched, description := condition.check(at, since, events)
+		if !dryRun {
+			condition.matched = matched
+		}
+		trace.Checks = append(trace.Checks, &AutomationCheck{Description: description, Matched: matched})
+		trace.Matched = trace.Matched && matched
+	}
+	return trace
+}
+
+func (t *AutomationTrace) record(description string, err error) {
+	if err != nil {
+		log.Error().Err(err).Msg("Automation action failed")
+		t.Errors = append(t.Errors, err.Error())
+	} else if len(description) > 0 {
+		t.Actions = append(t.Actions, description)
+	}
+}
+
+// check whether the condition matches and describe why
+// since is when the rule was last checked, a time of day without an until matches when it passed after then
+func (c *AutomationCondition) check(at time.Time, since time.Time, events []Event) (bool, string) {
+	switch c.Kind {
+	case model.AutomationConditionKindTemperatureAbove, model.AutomationConditionKindTemperatureBelow:
+		name, temperature, ok := watchedTemperature(c.Probe, c.ControllerID)
+		if !ok {
+			return false, fmt.Sprintf("%v has no temperature", name)
+		}
+		threshold := *c.ThresholdRaw
+		if c.Kind == model.AutomationConditionKindTemperatureAbove {
+			// Once it matches it keeps matching until the temperature is back past the hysteresis
+			if temperature > threshold || (c.matched && temperature > threshold-c.HysteresisRaw) {
+				return true, fmt.Sprintf("%v is %v, above %v", name, temperature, threshold)
+			}
+			return false, fmt.Sprintf("%v is %v, not above %v", name, temperature, threshold)
+		}
+		if temperature < threshold || (c.matched && temperature < threshold+c.HysteresisRaw) {
+			return true, fmt.Sprintf("%v is %v, below %v", name, temperature, threshold)
+		}
+		return false, fmt.Sprintf("%v is %v, not below %v", name, temperature, threshold)
+	case model.AutomationConditionKindSwitchOn, model.AutomationConditionKindSwitchOff:
+		s := c.Switch()
+		if s == nil {
+			return false, fmt.Sprintf("switch %v no longer exists", *c.SwitchID)
+		}
+		on := s.switchedOn()
+		state := model.SwitchModeOff
+		if on {
+			state = model.SwitchModeOn
+		}
+		return on == (c.Kind == model.AutomationConditionKindSwitchOn), fmt.Sprintf("%v is %v", s.Name(), state)
+	case model.AutomationConditionKindHeating, model.AutomationConditionKindCooling:
+		cooling := c.Kind == model.AutomationConditionKindCooling
+		demand := "heat"
+		if cooling {
+			demand = "cooling"
+		}
+		if c.ControllerID != nil {
+			controller := c.Controller()
+			if controller == nil {
+				return false, fmt.Sprintf("controller %v no longer exists", *c.ControllerID)
+			}
+			if controller.callingFor(cooling) {
+				return true, fmt.Sprintf("%v is calling for %v", controller.Name, demand)
+			}
+			return false, fmt.Sprintf("%v is not calling for %v", controller.Name, demand)
+		}
+		calling := []string{}
+		for _, controller := range AllTemperatureControllers() {
+			if controller.callingFor(cooling) {
+				calling = append(calling, controller.Name)
+			}
+		}
+		if len(calling) == 0 {
+			return false, fmt.Sprintf("No controller is calling for %v", demand)
+		}
+		return true, fmt.Sprintf("Calling for %v: %v", demand, strings.Join(calling, ", "))
+	case model.AutomationConditionKindTimeOfDay:
+		location := system.CurrentSettings().Location()
+		at, since = at.In(location), since.In(location)
+		clock := at.Format("15:04")
+		if len(c.Until) == 0 {
+			if timeOfDayPassed(c.Time, since, at) {
+				return true, fmt.Sprintf("It is %v, %v has passed", clock, c.Time)
+			}
+			return false, fmt.Sprintf("It is %v, waiting for %v", clock, c.Time)
+		}
+		if timeOfDayBetween(c.Time, c.Until, at) {
+			return true, fmt.Sprintf("It is %v, between %v and %v", clock, c.Time, c.Until)
+		}
+		return false, fmt.Sprintf("It is %v, not between %v and %v", clock, c.Time, c.Until)
+	case model.AutomationConditionKindEvent:
+		for _, event := range events {
+			if event.Type == *c.Event && (len(c.DeviceID) == 0 || event.DeviceID == c.DeviceID || event.Device == c.DeviceID) {
+				return true, fmt.Sprintf("%v: %v", event.Type, event.Message)
+			}
+		}
+		if len(c.DeviceID) > 0 {
+			return false, fmt.Sprintf("No %v event from %v", *c.Event, c.DeviceID)
+		}
+		return false, fmt.Sprintf("No %v event", *c.Event)
+	}
+	return false, fmt.Sprintf("%v is not an automation condition", c.Kind)
+}
+
+// Threshold - The temperature for temperatureAbove and temperatureBelow
+func (c *AutomationCondition) Threshold() *string {
+	return temperatureString(c.ThresholdRaw)
+}
+
+// Hysteresis - How far back past the threshold the temperature goes before the condition stops matching, e.g. 0.5°C
+func (c *AutomationCondition) Hysteresis() string {
+	return differenceString(c.HysteresisRaw)
+}
+
+// Controller - The controller that is checked, nil for a probe or any controller
+func (c *AutomationCondition) Controller() *TemperatureController {
+	if c.ControllerID == nil {
+		return nil
+	}
+	return FindTemperatureControllerByID(fmt.Sprint(*c.ControllerID))
+}
+
+// Switch - The switch that is checked
+func (c *AutomationCondition) Switch() *Switch {
+	if c.SwitchID == nil {
+		return nil
+	}
+	return FindSwitchByID(fmt.Sprint(*c.SwitchID))
+}
+
+// run the action, describing what it did
+func (a *AutomationAction) run() (string, error) {
+	switch a.Kind {
+	case model.AutomationActionKindSwitchOn, model.AutomationActionKindSwitchOff:
+		return a.setSwitch(a.Kind == model.AutomationActionKindSwitchOn)
+	}
+
+	controller := a.Controller()
+	if controller == nil {
+		return "", fmt.Errorf("controller %v no longer exists", *a.ControllerID)
+	}
+	id := fmt.Sprint(controller.ID)
+	switch a.Kind {
+	case model.AutomationActionKindControllerMode:
+		a.previous = modeName(controller.Mode)
+		mode := *a.Mode
+		if err := controller.ApplySettings(model.TemperatureControllerSettingsInput{ID: id, Mode: &mode}); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Changed %v to %v", controller.Name, mode), nil
+	case model.AutomationActionKindControllerSetPoint:
+		a.previous = controller.SetPoint()
+		if err := controller.ApplySettings(model.TemperatureControllerSettingsInput{ID: id, SetPoint: a.SetPoint}); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Set %v to %v", controller.Name, controller.SetPoint()), nil
+	case model.AutomationActionKindStartFermentation:
+		steps := []*model.FermentationStepInput{}
+		if err := json.Unmarshal([]byte(a.Profile), &steps); err != nil {
+			return "", err
+		}
+		if _, err := controller.StartFermentation(model.FermentationInput{ControllerID: id, Steps: steps}); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Started a fermentation on %v with %v steps", controller.Name, len(steps)), nil
+	}
+	return "", fmt.Errorf("%v is not an automation action", a.Kind)
+}
+
+// revert undoes the action, fermentations that were started carry on
+func (a *AutomationAction) revert() (string, error) {
+	switch a.Kind {
+	case model.AutomationActionKindSwitchOn, model.AutomationActionKindSwitchOff:
+		return a.setSwitch(a.Kind == model.AutomationActionKindSwitchOff)
+	case model.AutomationActionKindStartFermentation:
+		return "", nil
+	}
+
+	controller := a.Controller()
+	if controller == nil {
+		return "", fmt.Errorf("controller %v no longer exists", *a.ControllerID)
+	}
+	id := fmt.Sprint(controller.ID)
+	previous := a.previous
+	if a.Kind == model.AutomationActionKindControllerMode {
+		mode := model.ControllerMode(previous)
+		if err := controller.ApplySettings(model.TemperatureControllerSettingsInput{ID: id, Mode: &mode}); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Changed %v back to %v", controller.Name, mode), nil
+	}
+	if err := controller.ApplySettings(model.TemperatureControllerSettingsInput{ID: id, SetPoint: &previous}); err != nil {
+		return "", err
+	}
+	if len(previous) == 0 {
+		return fmt.Sprintf("Cleared the set point of %v", controller.Name), nil
+	}
+	return fmt.Sprintf("Set %v back to %v", controller.Name, previous), nil
+}
+
+func (a *AutomationAction) setSwitch(on bool) (string, error) {
+	s := a.Switch()
+	if s == nil {
+		return "", fmt.Errorf("switch %v no longer exists", *a.SwitchID)
+	}
+	if on {
+		s.On()
+		return fmt.Sprintf("Turned on %v", s.Name()), nil
+	}
+	s.Off()
+	return fmt.Sprintf("Turned off %v", s.Name()), nil
+}
+
+// Switch - The switch the action turns on or off
+func (a *AutomationAction) Switch() *Switch {
+	if a.SwitchID == nil {
+		return nil
+	}
+	return FindSwitchByID(fmt.Sprint(*a.SwitchID))
+}
+
+// Controller - The controller the action changes
+func (a *AutomationAction) Controller() *TemperatureController {
+	if a.ControllerID == nil {
+		return nil
+	}
+	return FindTemperatureControllerByID(fmt.Sprint(*a.ControllerID))
+}
+
+// Steps - The steps of the fermentation a startFermentation action starts
+func (a *AutomationAction) Steps() []*FermentationStep {
+	settings := []*model.FermentationStepInput{}
+	if len(a.Profile) > 0 {
+		if err := json.Unmarshal([]byte(a.Profile), &settings); err != nil {
+			log.Error().Err(err).Msgf("Invalid fermentation steps for automation action %v", a.ID)
+		}
+	}
+	steps := []*FermentationStep{}
+	for _, stepSettings := range settings {
+		step := &FermentationStep{Attenuation: stepSettings.Attenuation, Mode: stepSettings.Mode}
+		if stepSettings.Name != nil {
+			step.Name = *stepSettings.Name
+		}
+		if stepSettings.SetPoint != nil {
+			step.SetPoint = *stepSettings.SetPoint
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// callingFor reports whether the controller is running its cooling output, or its heating output
+func (c *TemperatureController) callingFor(cooling bool) bool {
+	if c.OutputControl == nil {
+		return false
+	}
+	if cooling {
+		return c.OutputControl.DutyCycle < 0
+	}
+	return c.OutputControl.DutyCycle > 0
+}
+
+// timeOfDayPassed reports whether the time of day, HH:MM, was reached after since and by at
+func timeOfDayPassed(clock string, since time.Time, at time.Time) bool {
+	parsed, err := time.Parse("15:04", clock)
+	if err != nil {
+		return false
+	}
+	latest := time.Date(at.Year(), at.Month(), at.Day(), parsed.Hour(), parsed.Minute(), 0, 0, at.Location())
+	if latest.After(at) {
+		latest = latest.AddDate(0, 0, -1)
+	}
+	return latest.After(since)
+}
+
+// timeOfDayBetween reports whether at is from start until end, HH:MM, the period can span midnight
+func timeOfDayBetween(start string, end string, at time.Time) bool {
+	from, err := time.Parse("15:04", start)
+	if err != nil {
+		return false
+	}
+	until, err := time.Parse("15:04", end)
+	if err != nil {
+		return false
+	}
+	minute := at.Hour()*60 + at.Minute()
+	fromMinute := from.Hour()*60 + from.Minute()
+	untilMinute := until.Hour()*60 + until.Minute()
+	if fromMinute <= untilMinute {
+		return minute >= fromMinute && minute < untilMinute
+	}
+	return minute >= fromMinute || minute < untilMinute
+}
+
+func containsCondition(conditions []*AutomationCondition, condition *AutomationCondition) bool {
+	for _, c := range conditions {
+		if c == condition {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAction(actions []*AutomationAction, action *AutomationAction) bool {
+	for _, a := range actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,323 @@
+package devices_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/dougedey/elsinore/system"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/gpio/gpioreg"
+	"periph.io/x/periph/conn/gpio/gpiotest"
+	"periph.io/x/periph/conn/onewire"
+)
+
+// automationSwitch - A switch on a test pin that is deleted after the test
+func automationSwitch(t *testing.T, name string, num int) (*devices.Switch, *gpiotest.Pin) {
+	pin := &gpiotest.Pin{N: fmt.Sprintf("AUTOMATION_%v", num), Num: num}
+	require.Nil(t, gpioreg.Register(pin))
+	s, err := devices.CreateSwitch(pin.N, name)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_, err := devices.DeleteSwitchByID(fmt.Sprint(s.ID))
+		require.Nil(t, err)
+	})
+	return s, pin
+}
+
+func checkDescriptions(trace *devices.AutomationTrace) []string {
+	descriptions := []string{}
+	for _, check := range trace.Checks {
+		descriptions = append(descriptions, check.Description)
+	}
+	return descriptions
+}
+
+func TestAutomationRules(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearControllers()
+	devices.ClearAutomations()
+	t.Cleanup(devices.ClearAutomations)
+
+	pump, pumpPin := automationSwitch(t, "Glycol Pump", 701)
+	pumpID := fmt.Sprint(pump.ID)
+	on := model.AutomationActionKindSwitchOn
+
+	t.Run("Invalid rules are rejected", func(t *testing.T) {
+		switchOn := model.AutomationConditionKindSwitchOn
+		timeOfDay := model.AutomationConditionKindTimeOfDay
+		event := model.AutomationConditionKindEvent
+		turnOn := []*model.AutomationActionInput{{Kind: on, SwitchID: &pumpID}}
+
+		_, err := devices.ModifyAutomationRule(model.AutomationRuleInput{Name: strPointer("Pump"), Trigger: &model.AutomationConditionInput{Kind: switchOn, SwitchID: &pumpID}})
+		require.Equal(t, "name, trigger and actions are required when creating an automation rule", err.Error())
+		_, err = devices.ModifyAutomationRule(model.AutomationRuleInput{Name: strPointer("Pump"), Trigger: &model.AutomationConditionInput{Kind: switchOn, SwitchID: strPointer("999")}, Actions: turnOn})
+		require.Equal(t, "no switch with id: 999 found", err.Error())
+		_, err = devices.ModifyAutomationRule(model.AutomationRuleInput{Name: strPointer("Pump"), Trigger: &model.AutomationConditionInput{Kind: timeOfDay, Time: strPointer("25:00")}, Actions: turnOn})
+		require.Equal(t, "'25:00' is not a time of day, use HH:MM", err.Error())
+		_, err = devices.ModifyAutomationRule(model.AutomationRuleInput{
+			Name:       strPointer("Pump"),
+			Trigger:    &model.AutomationConditionInput{Kind: timeOfDay, Time: strPointer("08:00")},
+			Conditions: []*model.AutomationConditionInput{{Kind: event}},
+			Actions:    turnOn,
+		})
+		require.Equal(t, "only the trigger can be an event", err.Error())
+		_, err = devices.ModifyAutomationRule(model.AutomationRuleInput{
+			Name:       strPointer("Pump"),
+			Trigger:    &model.AutomationConditionInput{Kind: switchOn, SwitchID: &pumpID},
+			Conditions: []*model.AutomationConditionInput{{Kind: timeOfDay, Time: strPointer("08:00")}},
+			Actions:    turnOn,
+		})
+		require.Equal(t, "a timeOfDay condition that is not the trigger needs an until time", err.Error())
+		_, err = devices.ModifyAutomationRule(model.AutomationRuleInput{Name: strPointer("Pump"), Trigger: &model.AutomationConditionInput{Kind: switchOn, SwitchID: &pumpID}, Actions: []*model.AutomationActionInput{{Kind: model.AutomationActionKindControllerMode}}})
+		require.Equal(t, "a controllerMode action needs a controller", err.Error())
+		require.Empty(t, devices.AllAutomationRules())
+	})
+
+	fermenter1, err := devices.CreateTemperatureController("Fermenter 1", &devices.TempProbeDetail{PhysAddr: "automation-fermenter-1"})
+	require.Nil(t, err)
+	fermenter2, err := devices.CreateTemperatureController("Fermenter 2", &devices.TempProbeDetail{PhysAddr: "automation-fermenter-2"})
+	require.Nil(t, err)
+	fermenter1.OutputControl = &devices.OutputControl{}
+	fermenter2.OutputControl = &devices.OutputControl{}
+
+	revert := true
+	rule, err := devices.ModifyAutomationRule(model.AutomationRuleInput{
+		Name:    strPointer("Glycol pump"),
+		Revert:  &revert,
+		Trigger: &model.AutomationConditionInput{Kind: model.AutomationConditionKindCooling},
+		Actions: []*model.AutomationActionInput{{Kind: on, SwitchID: &pumpID}},
+	})
+	require.Nil(t, err)
+	require.True(t, rule.Enabled)
+	require.Equal(t, model.AutomationConditionKindCooling, rule.Trigger().Kind)
+	require.Empty(t, rule.Conditions())
+	require.Equal(t, pump, rule.Actions[0].Switch())
+
+	t.Run("Actions run when the rule starts matching", func(t *testing.T) {
+		devices.CheckAutomations(nil)
+		require.False(t, rule.Active)
+		require.Equal(t, gpio.Low, pumpPin.Read())
+
+		fermenter2.OutputControl.DutyCycle = -40
+		devices.CheckAutomations(nil)
+		require.True(t, rule.Active)
+		require.Equal(t, gpio.High, pumpPin.Read())
+
+		trace := rule.Trace(nil)[0]
+		require.True(t, trace.Matched)
+		require.Equal(t, []string{"Calling for cooling: Fermenter 2"}, checkDescriptions(trace))
+		require.Equal(t, []string{"Turned on Glycol Pump"}, trace.Actions)
+
+		// Only once while it keeps matching
+		fermenter1.OutputControl.DutyCycle = -100
+		pump.Off()
+		devices.CheckAutomations(nil)
+		require.Equal(t, gpio.Low, pumpPin.Read())
+		require.Len(t, rule.Trace(nil), 1)
+	})
+
+	t.Run("Reverting rules undo their actions when they stop matching", func(t *testing.T) {
+		pump.On()
+		fermenter1.OutputControl.DutyCycle = 0
+		fermenter2.OutputControl.DutyCycle = 30
+		devices.CheckAutomations(nil)
+		require.False(t, rule.Active)
+		require.Equal(t, gpio.Low, pumpPin.Read())
+
+		traces := rule.Trace(nil)
+		require.Len(t, traces, 2)
+		require.False(t, traces[0].Matched)
+		require.Equal(t, []string{"No controller is calling for cooling"}, checkDescriptions(traces[0]))
+		require.Equal(t, []string{"Turned off Glycol Pump"}, traces[0].Actions)
+		limit := 1
+		require.Len(t, rule.Trace(&limit), 1)
+	})
+
+	t.Run("Active rules are reverted before they are disabled or changed", func(t *testing.T) {
+		fermenter2.OutputControl.DutyCycle = -30
+		devices.CheckAutomations(nil)
+		require.True(t, rule.Active)
+		require.Equal(t, gpio.High, pumpPin.Read())
+
+		disabled, enabled := false, true
+		_, err := devices.ModifyAutomationRule(model.AutomationRuleInput{ID: strPointer(fmt.Sprint(rule.ID)), Enabled: &disabled})
+		require.Nil(t, err)
+		require.False(t, rule.Active)
+		require.Equal(t, gpio.Low, pumpPin.Read())
+		devices.CheckAutomations(nil)
+		require.Equal(t, gpio.Low, pumpPin.Read())
+
+		_, err = devices.ModifyAutomationRule(model.AutomationRuleInput{ID: strPointer(fmt.Sprint(rule.ID)), Enabled: &enabled})
+		require.Nil(t, err)
+		devices.CheckAutomations(nil)
+		require.Equal(t, gpio.High, pumpPin.Read())
+		_, err = devices.ModifyAutomationRule(model.AutomationRuleInput{ID: strPointer(fmt.Sprint(rule.ID)), Name: strPointer("Glycol pump")})
+		require.Nil(t, err)
+		require.Equal(t, gpio.Low, pumpPin.Read())
+
+		fermenter2.OutputControl.DutyCycle = 30
+		devices.CheckAutomations(nil)
+		require.False(t, rule.Active)
+	})
+
+	t.Run("Active rules are reverted before they are deleted", func(t *testing.T) {
+		valve, valvePin := automationSwitch(t, "Glycol Valve", 703)
+		valveID := fmt.Sprint(valve.ID)
+		opened, err := devices.ModifyAutomationRule(model.AutomationRuleInput{
+			Name:    strPointer("Glycol valve"),
+			Revert:  &revert,
+			Trigger: &model.AutomationConditionInput{Kind: model.AutomationConditionKindCooling},
+			Actions: []*model.AutomationActionInput{{Kind: on, SwitchID: &valveID}},
+		})
+		require.Nil(t, err)
+
+		fermenter2.OutputControl.DutyCycle = -30
+		devices.CheckAutomations(nil)
+		require.True(t, opened.Active)
+		require.Equal(t, gpio.High, valvePin.Read())
+
+		_, err = devices.DeleteAutomationRuleByID(fmt.Sprint(opened.ID))
+		require.Nil(t, err)
+		require.False(t, opened.Active)
+		require.Equal(t, gpio.Low, valvePin.Read())
+
+		fermenter2.OutputControl.DutyCycle = 30
+		devices.CheckAutomations(nil)
+		require.False(t, rule.Active)
+	})
+
+	t.Run("Rules are checked against one controller", func(t *testing.T) {
+		controllerID := fmt.Sprint(fermenter1.ID)
+		_, err := devices.ModifyAutomationRule(model.AutomationRuleInput{
+			ID:      strPointer(fmt.Sprint(rule.ID)),
+			Trigger: &model.AutomationConditionInput{Kind: model.AutomationConditionKindCooling, ControllerID: &controllerID},
+		})
+		require.Nil(t, err)
+		require.Empty(t, rule.Trace(nil))
+
+		fermenter2.OutputControl.DutyCycle = -30
+		trace := rule.Evaluate(nil)
+		require.False(t, trace.Matched)
+		require.Equal(t, []string{"Fermenter 1 is not calling for cooling"}, checkDescriptions(trace))
+		require.Empty(t, trace.Actions)
+
+		devices.ClearAutomations()
+		rule = devices.FindAutomationRuleByID(fmt.Sprint(rule.ID))
+		require.Len(t, rule.Checks, 1)
+		require.Equal(t, fermenter1, rule.Trigger().Controller())
+		require.True(t, rule.Revert)
+	})
+
+	t.Run("Temperatures match until they are back past the hysteresis", func(t *testing.T) {
+		probe := &hardware.TemperatureProbe{PhysAddr: "AutomationProbe", Address: onewire.Address(7272), ReadingRaw: celsius(20)}
+		hardware.SetProbe(probe)
+		controllerID := fmt.Sprint(fermenter1.ID)
+		require.Nil(t, fermenter1.UpdateSetPoint("20C"))
+		auto := model.ControllerMode("auto")
+		hot, err := devices.ModifyAutomationRule(model.AutomationRuleInput{
+			Name:       strPointer("Crash cool"),
+			Revert:     &revert,
+			Trigger:    &model.AutomationConditionInput{Kind: model.AutomationConditionKindTemperatureAbove, Probe: strPointer("AutomationProbe"), Threshold: strPointer("21C"), Hysteresis: strPointer("1C")},
+			Conditions: []*model.AutomationConditionInput{{Kind: model.AutomationConditionKindSwitchOff, SwitchID: &pumpID}},
+			Actions: []*model.AutomationActionInput{
+				{Kind: model.AutomationActionKindControllerMode, ControllerID: &controllerID, Mode: &auto},
+				{Kind: model.AutomationActionKindControllerSetPoint, ControllerID: &controllerID, SetPoint: strPointer("2C")},
+			},
+		})
+		require.Nil(t, err)
+		require.Equal(t, "21°C", *hot.Trigger().Threshold())
+		require.Equal(t, "1°C", hot.Trigger().Hysteresis())
+
+		probe.ReadingRaw = celsius(22)
+		devices.CheckAutomations(nil)
+		require.True(t, hot.Active)
+		require.Equal(t, auto, fermenter1.Mode)
+		require.Equal(t, "2°C", fermenter1.SetPoint())
+		trace := hot.Trace(nil)[0]
+		require.Equal(t, []string{"AutomationProbe is 22°C, above 21°C", "Glycol Pump is off"}, checkDescriptions(trace))
+		require.Equal(t, []string{"Changed Fermenter 1 to auto", "Set Fermenter 1 to 2°C"}, trace.Actions)
+
+		probe.ReadingRaw = celsius(20.5)
+		devices.CheckAutomations(nil)
+		require.True(t, hot.Active)
+
+		probe.ReadingRaw = celsius(19.9)
+		devices.CheckAutomations(nil)
+		require.False(t, hot.Active)
+		require.Equal(t, model.ControllerMode("off"), fermenter1.Mode)
+		require.Equal(t, "20°C", fermenter1.SetPoint())
+		require.Equal(t, []string{"Set Fermenter 1 back to 20°C", "Changed Fermenter 1 back to off"}, hot.Trace(nil)[0].Actions)
+
+		_, err = devices.DeleteAutomationRuleByID(fmt.Sprint(hot.ID))
+		require.Nil(t, err)
+	})
+
+	t.Run("Time of day triggers match as the time passes", func(t *testing.T) {
+		system.CurrentSettings().Timezone = "America/Toronto"
+		t.Cleanup(func() { system.CurrentSettings().Timezone = "" })
+		controllerID := fmt.Sprint(fermenter2.ID)
+		// 07:59 in Toronto
+		start := time.Date(2021, 6, 1, 11, 59, 0, 0, time.UTC)
+		morning, err := devices.ModifyAutomationRule(model.AutomationRuleInput{
+			Name:    strPointer("Pitch"),
+			Trigger: &model.AutomationConditionInput{Kind: model.AutomationConditionKindTimeOfDay, Time: strPointer("08:00")},
+			Actions: []*model.AutomationActionInput{{
+				Kind:         model.AutomationActionKindStartFermentation,
+				ControllerID: &controllerID,
+				Steps:        []*model.FermentationStepInput{{Name: strPointer("Free rise"), Attenuation: 50, SetPoint: strPointer("21C")}},
+			}},
+		})
+		require.Nil(t, err)
+		require.Equal(t, "Free rise", morning.Actions[0].Steps()[0].Name)
+
+		devices.CheckAutomations(after(start, 0))
+		devices.CheckAutomations(after(start, 30))
+		require.False(t, morning.Active)
+		require.Nil(t, fermenter2.Fermentation())
+
+		devices.CheckAutomations(after(start, 61))
+		require.True(t, morning.Active)
+		require.Equal(t, []string{"It is 08:00, 08:00 has passed"}, checkDescriptions(morning.Trace(nil)[0]))
+		require.Equal(t, "Free rise", fermenter2.Fermentation().Steps[0].Name)
+
+		devices.CheckAutomations(after(start, 120))
+		require.False(t, morning.Active)
+		require.NotNil(t, fermenter2.Fermentation())
+
+		_, err = devices.DeleteAutomationRuleByID(fmt.Sprint(morning.ID))
+		require.Nil(t, err)
+		_, err = fermenter2.EndFermentation()
+		require.Nil(t, err)
+	})
+
+	t.Run("Event triggers match events since the last check", func(t *testing.T) {
+		disabled := false
+		_, err := devices.ModifyAutomationRule(model.AutomationRuleInput{ID: strPointer(fmt.Sprint(rule.ID)), Enabled: &disabled})
+		require.Nil(t, err)
+
+		fan, fanPin := automationSwitch(t, "Chamber Fan", 702)
+		toggled := model.DeviceEventTypeSwitchToggled
+		followed, err := devices.ModifyAutomationRule(model.AutomationRuleInput{
+			Name:    strPointer("Fan follows the pump"),
+			Trigger: &model.AutomationConditionInput{Kind: model.AutomationConditionKindEvent, Event: &toggled, DeviceID: strPointer("Glycol Pump")},
+			Actions: []*model.AutomationActionInput{{Kind: on, SwitchID: strPointer(fmt.Sprint(fan.ID))}},
+		})
+		require.Nil(t, err)
+
+		devices.CheckAutomations(nil)
+		fan.Off()
+		devices.CheckAutomations(nil)
+		require.False(t, followed.Active)
+
+		pump.On()
+		devices.CheckAutomations(nil)
+		require.Equal(t, gpio.High, fanPin.Read())
+		require.Equal(t, []string{"switchToggled: Glycol Pump turned on"}, checkDescriptions(followed.Trace(nil)[0]))
+		require.Equal(t, []string{"No switchToggled event from Glycol Pump"}, checkDescriptions(followed.Evaluate(nil)))
+	})
+}
@@ -0,0 +1,129 @@
+package devices
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"periph.io/x/periph/conn/physic"
+)
+
+// The reference temperatures used when calibrating without one, an ice bath and boiling water at sea level
+var defaultLowReference = physic.ZeroCelsius
+var defaultHighReference = physic.ZeroCelsius + 100*physic.Celsius
+
+// FindTempProbeDetail returns the details for a probe that is assigned to a temperature controller
+func FindTempProbeDetail(physAddr string) *TempProbeDetail {
+	controller := FindTemperatureControllerForProbe(physAddr)
+	if controller == nil {
+		return nil
+	}
+
+	for _, probe := range controller.TempProbeDetails {
+		if probe.PhysAddr == physAddr {
+			return probe
+		}
+	}
+	return nil
+}
+
+// Calibrate - Apply the calibration for this probe to a raw reading
+func (t *TempProbeDetail) Calibrate(raw physic.Temperature) physic.Temperature {
+	switch t.CalibrationMode {
+	case model.CalibrationModeOffset:
+		return raw + t.CalibrationOffset
+	case model.CalibrationModeTwoPoint:
+		if !t.hasTwoPoints() {
+			return raw
+		}
+		// Linear correction through both points, done in floats as the nano kelvin values overflow int64 when multiplied
+		rawSpan := float64(*t.HighRawReading - *t.LowRawReading)
+		referenceSpan := float64(*t.HighReference - *t.LowReference)
+		scaled := float64(raw-*t.LowRawReading) * referenceSpan / rawSpan
+		return *t.LowReference + physic.Temperature(scaled)
+	default:
+		return raw
+	}
+}
+
+// CaptureCalibrationPoint - Use the current raw reading of the probe as a calibration point
+// reference is the actual temperature of the probe right now, an empty reference uses 0°C for low points and 100°C for high points
+// Capturing an offset point switches to offset calibration, the first of the low or high points also uses an offset until both are captured
+func (t *TempProbeDetail) CaptureCalibrationPoint(point model.CalibrationPoint, reference string) error {
+	probe := hardware.GetTemperature(t.PhysAddr)
+	if probe == nil {
+		return fmt.Errorf("no probe found for %v to calibrate", t.PhysAddr)
+	}
+	raw := probe.ReadingRaw
+
+	var referenceTemp physic.Temperature
+	if len(strings.TrimSpace(reference)) == 0 {
+		switch point {
+		case model.CalibrationPointLow:
+			referenceTemp = defaultLowReference
+		case model.CalibrationPointHigh:
+			referenceTemp = defaultHighReference
+		default:
+			return fmt.Errorf("a reference temperature is required for an offset calibration")
+		}
+	} else if err := referenceTemp.Set(strings.ToUpper(reference)); err != nil {
+		return err
+	}
+
+	switch point {
+	case model.CalibrationPointOffset:
+		t.setOffset(raw, referenceTemp)
+	case model.CalibrationPointLow:
+		t.LowRawReading = &raw
+		t.LowReference = &referenceTemp
+	case model.CalibrationPointHigh:
+		t.HighRawReading = &raw
+		t.HighReference = &referenceTemp
+	default:
+		return fmt.Errorf("unknown calibration point %v", point)
+	}
+
+	if point != model.CalibrationPointOffset {
+		if t.hasTwoPoints() {
+			t.CalibrationMode = model.CalibrationModeTwoPoint
+		} else {
+			t.setOffset(raw, referenceTemp)
+		}
+	}
+
+	t.UncalibratedRaw = raw
+	t.ReadingRaw = t.Calibrate(raw)
+	database.Save(t)
+	return nil
+}
+
+// Offset - The calibration offset as a temperature difference in Celsius, e.g. -0.5°C
+func (t *TempProbeDetail) Offset() string {
+	return strconv.FormatFloat(float64(t.CalibrationOffset)/float64(physic.Celsius), 'f', -1, 64) + "°C"
+}
+
+// ResetCalibration - Remove any calibration from this probe
+func (t *TempProbeDetail) ResetCalibration() {
+	t.CalibrationMode = model.CalibrationModeNone
+	t.CalibrationOffset = 0
+	t.LowRawReading = nil
+	t.LowReference = nil
+	t.HighRawReading = nil
+	t.HighReference = nil
+	t.ReadingRaw = t.UncalibratedRaw
+	database.Save(t)
+}
+
+func (t *TempProbeDetail) setOffset(raw physic.Temperature, reference physic.Temperature) {
+	t.CalibrationMode = model.CalibrationModeOffset
+	t.CalibrationOffset = reference - raw
+}
+
+func (t *TempProbeDetail) hasTwoPoints() bool {
+	return t.LowRawReading != nil && t.LowReference != nil &&
+		t.HighRawReading != nil && t.HighReference != nil &&
+		*t.HighRawReading != *t.LowRawReading
+}
@@ -0,0 +1,91 @@
+package devices_test
+
+import (
+	"testing"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/onewire"
+	"periph.io/x/periph/conn/physic"
+)
+
+func celsius(value float64) physic.Temperature {
+	return physic.ZeroCelsius + physic.Temperature(value*float64(physic.Celsius))
+}
+
+func TestCalibrate(t *testing.T) {
+	t.Run("Without calibration the raw reading is used", func(t *testing.T) {
+		probe := devices.TempProbeDetail{}
+		require.Equal(t, celsius(20), probe.Calibrate(celsius(20)))
+	})
+
+	t.Run("Offset calibration adds the offset", func(t *testing.T) {
+		probe := devices.TempProbeDetail{CalibrationMode: model.CalibrationModeOffset, CalibrationOffset: -physic.Celsius / 2}
+		require.Equal(t, celsius(19.5), probe.Calibrate(celsius(20)))
+		require.Equal(t, "-0.5°C", probe.Offset())
+	})
+
+	t.Run("Two point calibration corrects linearly between the points", func(t *testing.T) {
+		lowRaw, lowRef := celsius(1), celsius(0)
+		highRaw, highRef := celsius(99), celsius(100)
+		probe := devices.TempProbeDetail{
+			CalibrationMode: model.CalibrationModeTwoPoint,
+			LowRawReading:   &lowRaw, LowReference: &lowRef,
+			HighRawReading: &highRaw, HighReference: &highRef,
+		}
+		require.InDelta(t, float64(celsius(0)), float64(probe.Calibrate(celsius(1))), float64(physic.MilliKelvin))
+		require.InDelta(t, float64(celsius(50)), float64(probe.Calibrate(celsius(50))), float64(physic.MilliKelvin))
+		require.InDelta(t, float64(celsius(100)), float64(probe.Calibrate(celsius(99))), float64(physic.MilliKelvin))
+	})
+
+	t.Run("Two point calibration without both points uses the raw reading", func(t *testing.T) {
+		lowRaw, lowRef := celsius(1), celsius(0)
+		probe := devices.TempProbeDetail{CalibrationMode: model.CalibrationModeTwoPoint, LowRawReading: &lowRaw, LowReference: &lowRef}
+		require.Equal(t, celsius(20), probe.Calibrate(celsius(20)))
+	})
+}
+
+func TestCaptureCalibrationPoint(t *testing.T) {
+	hardwareProbe := &hardware.TemperatureProbe{PhysAddr: "CalibrationProbe", Address: onewire.Address(54321)}
+	hardware.SetProbe(hardwareProbe)
+	probe := devices.TempProbeDetail{PhysAddr: "CalibrationProbe"}
+
+	t.Run("An offset point requires a reference temperature", func(t *testing.T) {
+		require.NotNil(t, probe.CaptureCalibrationPoint(model.CalibrationPointOffset, ""))
+	})
+
+	t.Run("An offset point stores the difference from the reference", func(t *testing.T) {
+		hardwareProbe.ReadingRaw = celsius(21)
+		require.Nil(t, probe.CaptureCalibrationPoint(model.CalibrationPointOffset, "20c"))
+		require.Equal(t, model.CalibrationModeOffset, probe.CalibrationMode)
+		require.Equal(t, "-1°C", probe.Offset())
+		require.Equal(t, celsius(20), probe.ReadingRaw)
+	})
+
+	t.Run("The first two point reading is used as an offset", func(t *testing.T) {
+		hardwareProbe.ReadingRaw = celsius(0.5)
+		require.Nil(t, probe.CaptureCalibrationPoint(model.CalibrationPointLow, ""))
+		require.Equal(t, model.CalibrationModeOffset, probe.CalibrationMode)
+		require.Equal(t, "-0.5°C", probe.Offset())
+	})
+
+	t.Run("The second two point reading switches to two point calibration", func(t *testing.T) {
+		hardwareProbe.ReadingRaw = celsius(98.5)
+		require.Nil(t, probe.CaptureCalibrationPoint(model.CalibrationPointHigh, "98C"))
+		require.Equal(t, model.CalibrationModeTwoPoint, probe.CalibrationMode)
+		require.InDelta(t, float64(celsius(98)), float64(probe.ReadingRaw), float64(physic.MilliKelvin))
+
+		hardwareProbe.ReadingRaw = celsius(49.5)
+		probe.UpdateReading()
+		require.Equal(t, celsius(49.5), probe.UncalibratedRaw)
+		require.InDelta(t, float64(celsius(49)), float64(probe.ReadingRaw), float64(physic.MilliKelvin))
+	})
+
+	t.Run("Resetting the calibration uses the raw reading", func(t *testing.T) {
+		probe.ResetCalibration()
+		require.Equal(t, model.CalibrationModeNone, probe.CalibrationMode)
+		require.Equal(t, probe.UncalibratedRaw, probe.ReadingRaw)
+	})
+}
@@ -0,0 +1,176 @@
+package devices
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// How far ahead CronSchedule.Next looks, expressions such as 0 0 30 2 * never match
+const cronSearchYears = 5
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronField is one of the five fields, names are the values from min, e.g. JAN is 1
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"}},
+}
+
+// CronSchedule is a parsed cron expression: minute hour day-of-month month day-of-week
+// Each field is *, a value, a range such as 1-5, a list such as 1,15 or a step such as */15, months and days of the week can be names such as JAN or SAT
+// As with cron, when both the day of the month and the day of the week are restricted a day matches either of them
+type CronSchedule struct {
+	minutes    uint64
+	hours      uint64
+	days       uint64
+	months     uint64
+	weekdays   uint64
+	anyDay     bool
+	anyWeekday bool
+}
+
+// ParseCron - Parse a five field cron expression, or one of @hourly, @daily, @weekly, @monthly and @yearly
+func ParseCron(expression string) (*CronSchedule, error) {
+	fields := strings.Fields(strings.TrimSpace(expression))
+	if len(fields) == 1 {
+		if macro, ok := cronMacros[strings.ToLower(fields[0])]; ok {
+			fields = strings.Fields(macro)
+		}
+	}
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("'%v' is not a cron expression, it needs five fields: minute hour day-of-month month day-of-week", expression)
+	}
+
+	values := make([]uint64, len(fields))
+	for i, field := range fields {
+		bits, err := cronFields[i].parse(field)
+		if err != nil {
+			return nil, fmt.Errorf("'%v' is not a cron expression, %v", expression, err)
+		}
+		values[i] = bits
+	}
+	// Sunday is 0 or 7
+	if values[4]&(1<<7) != 0 {
+		values[4] = values[4]&^(1<<7) | 1
+	}
+	return &CronSchedule{
+		minutes:    values[0],
+		hours:      values[1],
+		days:       values[2],
+		months:     values[3],
+		weekdays:   values[4],
+		anyDay:     strings.HasPrefix(fields[2], "*"),
+		anyWeekday: strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parse the field into a bit for each value
+func (f cronField) parse(field string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		values := part
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			parsed, err := strconv.Atoi(part[i+1:])
+			if err != nil || parsed <= 0 {
+				return 0, fmt.Errorf("'%v' is not a step for the %v", part[i+1:], f.name)
+			}
+			values = part[:i]
+			step = parsed
+		}
+
+		low, high := f.min, f.max
+		if values != "*" {
+			bounds := strings.SplitN(values, "-", 2)
+			var err error
+			if low, err = f.value(bounds[0]); err != nil {
+				return 0, err
+			}
+			high = low
+			if len(bounds) == 2 {
+				if high, err = f.value(bounds[1]); err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				high = f.max
+			}
+			if low > high {
+				return 0, fmt.Errorf("%v-%v is not a range for the %v", bounds[0], bounds[1], f.name)
+			}
+		}
+		for value := low; value <= high; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+// value parses a number or name in the field
+func (f cronField) value(value string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(value, name) {
+			return f.min + i, nil
+		}
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < f.min || parsed > f.max {
+		return 0, fmt.Errorf("'%v' is not a %v, it must be from %v to %v", value, f.name, f.min, f.max)
+	}
+	return parsed, nil
+}
+
+// Next - The first time after after that matches, in the location of after, zero when nothing matches for years
+func (c *CronSchedule) Next(after time.Time) time.Time {
+	next := after.Truncate(time.Minute).Add(time.Minute)
+	limit := next.AddDate(cronSearchYears, 0, 0)
+	for next.Before(limit) {
+		if c.months&(1<<uint(next.Month())) == 0 {
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, next.Location())
+			continue
+		}
+		if !c.dayMatches(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, next.Location())
+			continue
+		}
+		// Minutes are added rather than building the time so hours that repeat when clocks go back are not a loop
+		if c.hours&(1<<uint(next.Hour())) == 0 {
+			next = next.Add(time.Duration(60-next.Minute()) * time.Minute)
+			continue
+		}
+		if c.minutes&(1<<uint(next.Minute())) == 0 {
+			next = next.Add(time.Minute)
+			continue
+		}
+		return next
+	}
+	return time.Time{}
+}
+
+func (c *CronSchedule) dayMatches(at time.Time) bool {
+	day := c.days&(1<<uint(at.Day())) != 0
+	weekday := c.weekdays&(1<<uint(at.Weekday())) != 0
+	if c.anyDay || c.anyWeekday {
+		return day && weekday
+	}
+	return day || weekday
+}
@@ -0,0 +1,193 @@
+package devices
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/rs/zerolog/log"
+	"periph.io/x/periph/conn/physic"
+)
+
+// The number of device events kept for DeviceEvents
+const deviceEventHistory = 50
+
+// ProbeCheckInterval is how often WatchProbes checks the probe limits and faults
+var ProbeCheckInterval = time.Second
+
+// Event is something that happened to a probe, controller or switch
+// Device -> The name of the probe, controller or switch
+// DeviceID -> The ID of the controller or switch, or the physical address of the probe
+// Data -> The details of the event, sent with webhooks
+type Event struct {
+	Type     model.DeviceEventType
+	Time     time.Time
+	Device   string
+	DeviceID string
+	Message  string
+	Data     map[string]string
+}
+
+var deviceListeners []func(Event)
+var deviceEvents []Event
+var deviceEventLock sync.Mutex
+
+// probe state seen by CheckProbes, keyed by physical address
+var probeOutOfRange = map[string]bool{}
+var probeFaults = map[string]string{}
+var probeConnected = map[string]bool{}
+var probeCheckLock sync.Mutex
+
+// OnEvent - Call listener every time a device event is published
+func OnEvent(listener func(Event)) {
+	deviceEventLock.Lock()
+	defer deviceEventLock.Unlock()
+	deviceListeners = append(deviceListeners, listener)
+}
+
+// DeviceEvents - The most recent device events, oldest first
+func DeviceEvents() []Event {
+	deviceEventLock.Lock()
+	defer deviceEventLock.Unlock()
+	events := make([]Event, len(deviceEvents))
+	copy(events, deviceEvents)
+	return events
+}
+
+// publishEvent records the event, calls the listeners and queues it for the webhooks that subscribe to it and the automation rules
+func publishEvent(event Event) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	log.Info().Msgf("%v: %v", event.Type, event.Message)
+
+	deviceEventLock.Lock()
+	deviceEvents = append(deviceEvents, event)
+	if len(deviceEvents) > deviceEventHistory {
+		deviceEvents = deviceEvents[len(deviceEvents)-deviceEventHistory:]
+	}
+	listeners := make([]func(Event), len(deviceListeners))
+	copy(listeners, deviceListeners)
+	deviceEventLock.Unlock()
+
+	for _, listener := range listeners {
+		listener(event)
+	}
+	sendWebhooks(event)
+	queueAutomationEvent(event)
+}
+
+// setMode changes the mode of the controller, publishing an event when it is different
+func (c *TemperatureController) setMode(mode model.ControllerMode) {
+	previous := c.Mode
+	c.Mode = mode
+	if previous == mode {
+		return
+	}
+	publishEvent(Event{
+		Type:     model.DeviceEventTypeControllerMode,
+		Device:   c.Name,
+		DeviceID: fmt.Sprint(c.ID),
+		Message:  fmt.Sprintf("%v changed from %v to %v", c.Name, modeName(previous), mode),
+		Data:     map[string]string{"from": modeName(previous), "to": string(mode)},
+	})
+}
+
+func modeName(mode model.ControllerMode) string {
+	if mode == "" {
+		return "off"
+	}
+	return string(mode)
+}
+
+// publishFault publishes a safety fault for a device
+func publishFault(device string, deviceID string, fault string) {
+	publishEvent(Event{
+		Type:     model.DeviceEventTypeSafetyFault,
+		Device:   device,
+		DeviceID: deviceID,
+		Message:  fmt.Sprintf("%v: %v", device, fault),
+		Data:     map[string]string{"fault": fault},
+	})
+}
+
+// WatchProbes - Check the probe limits and faults every ProbeCheckInterval until quit is closed
+func WatchProbes(quit <-chan struct{}) {
+	ticker := time.NewTicker(ProbeCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			CheckProbes()
+		}
+	}
+}
+
+// CheckProbes - Publish an event for every probe that has gone outside its limits or faulted since the last check
+// Probes assigned to a controller are checked against their calibrated reading
+func CheckProbes() {
+	if !startCheck() {
+		return
+	}
+	defer finishCheck()
+	probeCheckLock.Lock()
+	defer probeCheckLock.Unlock()
+
+	for _, probe := range hardware.GetProbes() {
+		name := ProbeName(probe.PhysAddr)
+		if probe.Fault != probeFaults[probe.PhysAddr] {
+			probeFaults[probe.PhysAddr] = probe.Fault
+			if len(probe.Fault) > 0 {
+				publishFault(name, probe.PhysAddr, probe.Fault)
+			}
+		}
+		if probeConnected[probe.PhysAddr] && !probe.Connected {
+			publishFault(name, probe.PhysAddr, "the probe is no longer connected")
+		}
+		probeConnected[probe.PhysAddr] = probe.Connected
+
+		settings := FindProbeSettings(probe.PhysAddr)
+		if settings == nil || (settings.LowLimit == nil && settings.HighLimit == nil) || !probe.Connected || len(probe.Fault) > 0 {
+			delete(probeOutOfRange, probe.PhysAddr)
+			continue
+		}
+
+		reading := probe.ReadingRaw
+		if detail := FindTempProbeDetail(probe.PhysAddr); detail != nil {
+			reading = detail.Calibrate(probe.ReadingRaw)
+		}
+		outOfRange := (settings.LowLimit != nil && reading < *settings.LowLimit) || (settings.HighLimit != nil && reading > *settings.HighLimit)
+		if outOfRange && !probeOutOfRange[probe.PhysAddr] {
+			publishEvent(Event{
+				Type:     model.DeviceEventTypeProbeOutOfRange,
+				Device:   name,
+				DeviceID: probe.PhysAddr,
+				Message:  fmt.Sprintf("%v read %v, %v", name, reading, outsideLimits(reading, settings)),
+				Data: map[string]string{
+					"reading":   reading.String(),
+					"lowLimit":  limitString(settings.LowLimit),
+					"highLimit": limitString(settings.HighLimit),
+				},
+			})
+		}
+		probeOutOfRange[probe.PhysAddr] = outOfRange
+	}
+}
+
+func outsideLimits(reading physic.Temperature, settings *ProbeSettings) string {
+	if settings.LowLimit != nil && reading < *settings.LowLimit {
+		return fmt.Sprintf("below the low limit of %v", settings.LowLimit)
+	}
+	return fmt.Sprintf("above the high limit of %v", settings.HighLimit)
+}
+
+func limitString(limit *physic.Temperature) string {
+	if limit == nil {
+		return ""
+	}
+	return limit.String()
+}
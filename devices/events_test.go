@@ -0,0 +1,206 @@
+package devices_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/gpio/gpioreg"
+	"periph.io/x/periph/conn/gpio/gpiotest"
+	"periph.io/x/periph/conn/onewire"
+)
+
+// collectEvents - Every device event published from now on, events are dropped once the buffer is full
+func collectEvents() chan devices.Event {
+	events := make(chan devices.Event, 100)
+	devices.OnEvent(func(event devices.Event) {
+		select {
+		case events <- event:
+		default:
+		}
+	})
+	return events
+}
+
+// nextEvent - The next event for the device, failing if there is none
+func nextEvent(t *testing.T, events chan devices.Event, deviceID string) devices.Event {
+	for {
+		select {
+		case event := <-events:
+			if event.DeviceID == deviceID {
+				return event
+			}
+		case <-time.After(time.Second):
+			require.FailNow(t, "no event for "+deviceID)
+		}
+	}
+}
+
+// requireNoEvent - Fail if there is an event for the device waiting
+func requireNoEvent(t *testing.T, events chan devices.Event, deviceID string) {
+	for {
+		select {
+		case event := <-events:
+			require.NotEqual(t, deviceID, event.DeviceID, event.Message)
+		default:
+			return
+		}
+	}
+}
+
+func TestProbeEvents(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearControllers()
+	devices.ClearProbeSettings()
+	t.Cleanup(devices.ClearProbeSettings)
+	events := collectEvents()
+
+	probe := &hardware.TemperatureProbe{PhysAddr: "LimitProbe", Address: onewire.Address(6060), ReadingRaw: celsius(20)}
+	hardware.SetProbe(probe)
+	t.Cleanup(func() {
+		probe.Fault = ""
+		probe.ReadingRaw = celsius(20)
+	})
+
+	t.Run("Invalid limits are rejected", func(t *testing.T) {
+		_, err := devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: "LimitProbe", LowLimit: strPointer("warm")})
+		require.NotNil(t, err)
+		_, err = devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: "LimitProbe", LowLimit: strPointer("24C"), HighLimit: strPointer("2C")})
+		require.Equal(t, "the low limit 24°C must be below the high limit 2°C", err.Error())
+	})
+
+	t.Run("Probes without limits are never out of range", func(t *testing.T) {
+		probe.ReadingRaw = celsius(90)
+		devices.CheckProbes()
+		requireNoEvent(t, events, "LimitProbe")
+	})
+
+	t.Run("An event is published when the reading leaves the limits", func(t *testing.T) {
+		settings, err := devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: "LimitProbe", Name: strPointer("Fermenter 1"), LowLimit: strPointer("2C"), HighLimit: strPointer("24C")})
+		require.Nil(t, err)
+		require.Equal(t, 24.0, settings.HighLimit.Celsius())
+
+		probe.ReadingRaw = celsius(20)
+		devices.CheckProbes()
+		requireNoEvent(t, events, "LimitProbe")
+
+		probe.ReadingRaw = celsius(25)
+		devices.CheckProbes()
+		event := nextEvent(t, events, "LimitProbe")
+		require.Equal(t, model.DeviceEventTypeProbeOutOfRange, event.Type)
+		require.Equal(t, "Fermenter 1", event.Device)
+		require.Equal(t, "Fermenter 1 read 25°C, above the high limit of 24°C", event.Message)
+		require.Equal(t, "2°C", event.Data["lowLimit"])
+
+		// Only once until it comes back into range
+		devices.CheckProbes()
+		requireNoEvent(t, events, "LimitProbe")
+
+		probe.ReadingRaw = celsius(10)
+		devices.CheckProbes()
+		probe.ReadingRaw = celsius(1)
+		devices.CheckProbes()
+		require.Equal(t, "Fermenter 1 read 1°C, below the low limit of 2°C", nextEvent(t, events, "LimitProbe").Message)
+	})
+
+	t.Run("Updating other settings keeps the limits", func(t *testing.T) {
+		settings, err := devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: "LimitProbe", Notes: strPointer("Glycol jacket")})
+		require.Nil(t, err)
+		require.Equal(t, 2.0, settings.LowLimit.Celsius())
+		devices.ClearProbeSettings()
+		require.Equal(t, 24.0, devices.FindProbeSettings("LimitProbe").HighLimit.Celsius())
+
+		settings, err = devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: "LimitProbe", LowLimit: strPointer("")})
+		require.Nil(t, err)
+		require.Nil(t, settings.LowLimit)
+		require.NotNil(t, settings.HighLimit)
+	})
+
+	t.Run("A probe fault is a safety fault", func(t *testing.T) {
+		probe.ReadingRaw = celsius(20)
+		probe.Fault = "CRC error"
+		devices.CheckProbes()
+		event := nextEvent(t, events, "LimitProbe")
+		require.Equal(t, model.DeviceEventTypeSafetyFault, event.Type)
+		require.Equal(t, "CRC error", event.Data["fault"])
+
+		devices.CheckProbes()
+		requireNoEvent(t, events, "LimitProbe")
+	})
+}
+
+func TestDeviceEvents(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearControllers()
+	devices.ClearFermentations()
+	t.Cleanup(devices.ClearFermentations)
+	events := collectEvents()
+
+	t.Run("Switches publish when they change state", func(t *testing.T) {
+		pin := &gpiotest.Pin{N: "EVENT_PUMP", Num: 601}
+		require.Nil(t, gpioreg.Register(pin))
+		pump, err := devices.CreateSwitch("EVENT_PUMP", "Event Pump")
+		require.Nil(t, err)
+		t.Cleanup(func() {
+			_, err := devices.DeleteSwitchByID(fmt.Sprint(pump.ID))
+			require.Nil(t, err)
+		})
+		id := fmt.Sprint(pump.ID)
+
+		pump.Off()
+		requireNoEvent(t, events, id)
+
+		pump.On()
+		require.Equal(t, gpio.High, pin.Read())
+		event := nextEvent(t, events, id)
+		require.Equal(t, model.DeviceEventTypeSwitchToggled, event.Type)
+		require.Equal(t, "Event Pump turned on", event.Message)
+
+		pump.On()
+		requireNoEvent(t, events, id)
+		pump.Off()
+		require.Equal(t, "off", nextEvent(t, events, id).Data["state"])
+	})
+
+	controller, err := devices.CreateTemperatureController("Event Fermenter", &devices.TempProbeDetail{PhysAddr: "event-fermenter"})
+	require.Nil(t, err)
+	id := fmt.Sprint(controller.ID)
+
+	t.Run("Controllers publish when they change mode", func(t *testing.T) {
+		auto := model.ControllerMode("auto")
+		require.Nil(t, controller.ApplySettings(model.TemperatureControllerSettingsInput{ID: id, Mode: &auto}))
+		event := nextEvent(t, events, id)
+		require.Equal(t, model.DeviceEventTypeControllerMode, event.Type)
+		require.Equal(t, "Event Fermenter changed from off to auto", event.Message)
+
+		require.Nil(t, controller.ApplySettings(model.TemperatureControllerSettingsInput{ID: id, Mode: &auto}))
+		requireNoEvent(t, events, id)
+	})
+
+	t.Run("Fermentation steps publish when they start", func(t *testing.T) {
+		hysteria := model.ControllerMode("hysteria")
+		og := 1.050
+		_, err := controller.StartFermentation(model.FermentationInput{
+			ControllerID:    id,
+			OriginalGravity: &og,
+			Steps:           []*model.FermentationStepInput{{Name: strPointer("Diacetyl rest"), Attenuation: 50, SetPoint: strPointer("20C"), Mode: &hysteria}},
+		})
+		require.Nil(t, err)
+		_, err = controller.RecordGravity(1.020)
+		require.Nil(t, err)
+
+		require.Equal(t, "Event Fermenter changed from auto to hysteria", nextEvent(t, events, id).Message)
+		event := nextEvent(t, events, id)
+		require.Equal(t, model.DeviceEventTypeStepComplete, event.Type)
+		require.Equal(t, "Diacetyl rest", event.Data["step"])
+		require.Equal(t, "60.0", event.Data["attenuation"])
+	})
+
+	history := devices.DeviceEvents()
+	require.Equal(t, model.DeviceEventTypeStepComplete, history[len(history)-1].Type)
+}
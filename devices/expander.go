@@ -0,0 +1,172 @@
+package devices
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+var expanders []*Expander = nil
+
+// Expander is a GPIO expander on an I2C bus, its pins can be used by switches, controller outputs and inputs, e.g. mcp23017@0x20:A3
+type Expander struct {
+	gorm.Model
+	database.InstanceScoped
+	Chip         model.ExpanderChip
+	Bus          string // The I2C bus, e.g. 1 for /dev/i2c-1, empty for the first bus
+	Address      int
+	ConnectError string `gorm:"-"` // Why the chip could not be connected, empty when it is connected
+}
+
+// AllExpanders returns all the expanders, loading from the Database and connecting them if none are loaded
+func AllExpanders() []*Expander {
+	if expanders == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Expanders array is nil, checking the database...")
+		database.FetchDatabase().Debug().Find(&expanders)
+		for _, expander := range expanders {
+			expander.connect()
+		}
+	}
+	return expanders
+}
+
+// FindExpanderByID - Find an expander by id
+func FindExpanderByID(id string) *Expander {
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	for _, expander := range AllExpanders() {
+		if expander.ID == uint(intID) {
+			return expander
+		}
+	}
+	return nil
+}
+
+// ModifyExpander - Create or update an expander, connecting it when it is new, its chip, bus or address change or it could not be connected
+// The expander is saved even if the chip cannot be connected, ConnectError says why
+// Reconnecting closes the bus the pins were opened on, so it cannot be done while any of its pins are in use
+func ModifyExpander(settings model.ExpanderInput) (*Expander, error) {
+	expander := &Expander{}
+	if settings.ID != nil {
+		expander = FindExpanderByID(*settings.ID)
+		if expander == nil {
+			return nil, fmt.Errorf("no expander with id: %v found", *settings.ID)
+		}
+	} else if settings.Chip == nil || settings.Address == nil {
+		return nil, fmt.Errorf("chip and address are required when creating an expander")
+	}
+
+	chip, bus, address := expander.Chip, expander.Bus, expander.Address
+	if settings.Chip != nil {
+		chip = *settings.Chip
+	}
+	if settings.Bus != nil {
+		bus = strings.TrimSpace(*settings.Bus)
+	}
+	if settings.Address != nil {
+		address = *settings.Address
+	}
+
+	if !chip.IsValid() {
+		return nil, fmt.Errorf("unknown expander '%v'", chip)
+	}
+	if address < 0 || address > 0x7f {
+		return nil, fmt.Errorf("an I2C address is between 0x00 and 0x7f, got %v", address)
+	}
+	if err := hardware.ValidateExpander(string(chip), uint16(address)); err != nil {
+		return nil, err
+	}
+	name := hardware.ExpanderName(string(chip), uint16(address))
+	for _, other := range AllExpanders() {
+		if other != expander && other.Name() == name {
+			return nil, fmt.Errorf("%v is already configured", name)
+		}
+	}
+	reconnect := expander.ID == 0 || len(expander.ConnectError) > 0 ||
+		expander.Chip != chip || expander.Bus != bus || expander.Address != address
+	if expander.ID != 0 && reconnect {
+		if pin := expander.pinInUse(); pin != "" {
+			return nil, fmt.Errorf("%v is in use, it cannot be reconnected", pin)
+		}
+		hardware.RemoveExpander(string(expander.Chip), uint16(expander.Address))
+	}
+
+	expander.Chip, expander.Bus, expander.Address = chip, bus, address
+	if expander.ID == 0 {
+		expanders = append(expanders, expander)
+	}
+	database.Save(expander)
+	if reconnect {
+		expander.connect()
+	}
+	return expander, nil
+}
+
+// DeleteExpanderByID - Disconnect and delete an expander, it cannot be deleted while any of its pins are in use
+func DeleteExpanderByID(id string) (*Expander, error) {
+	expander := FindExpanderByID(id)
+	if expander == nil {
+		return nil, fmt.Errorf("no expander found with id '%v'", id)
+	}
+	if pin := expander.pinInUse(); pin != "" {
+		return nil, fmt.Errorf("%v is in use, it cannot be deleted", pin)
+	}
+
+	hardware.RemoveExpander(string(expander.Chip), uint16(expander.Address))
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Delete(expander)
+	}
+	for i, e := range expanders {
+		if e == expander {
+			expanders[i] = expanders[len(expanders)-1]
+			expanders = expanders[:len(expanders)-1]
+			break
+		}
+	}
+	return expander, nil
+}
+
+// ClearExpanders disconnects and resets the cached expanders
+func ClearExpanders() {
+	for _, expander := range expanders {
+		hardware.RemoveExpander(string(expander.Chip), uint16(expander.Address))
+	}
+	expanders = nil
+}
+
+// Name - The name of the expander, e.g. mcp23017@0x20, its pins are named after it
+func (e *Expander) Name() string {
+	return hardware.ExpanderName(string(e.Chip), uint16(e.Address))
+}
+
+// Pins - The names of the pins on the expander
+func (e *Expander) Pins() []string {
+	return hardware.ExpanderPinNames(string(e.Chip), uint16(e.Address))
+}
+
+// pinInUse - The first pin of the expander that is in use, empty if none are
+func (e *Expander) pinInUse() string {
+	for _, pin := range e.Pins() {
+		if GpioInUse(pin) {
+			return pin
+		}
+	}
+	return ""
+}
+
+func (e *Expander) connect() {
+	e.ConnectError = ""
+	if _, err := hardware.AddExpander(string(e.Chip), e.Bus, uint16(e.Address)); err != nil {
+		log.Error().Err(err).Msgf("Failed to connect %v", e.Name())
+		e.ConnectError = err.Error()
+	}
+}
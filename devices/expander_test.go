@@ -0,0 +1,125 @@
+package devices_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/dougedey/elsinore/hardware/expandertest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpanders(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearExpanders()
+	devices.ClearInPins()
+	t.Cleanup(devices.ClearExpanders)
+	t.Cleanup(devices.ClearInPins)
+
+	relayBoard := expandertest.NewMCP23017(0x25)
+	_, err := hardware.ConnectExpander(hardware.MCP23017Chip, relayBoard, 0x25)
+	require.Nil(t, err)
+	t.Cleanup(func() { hardware.RemoveExpander(hardware.MCP23017Chip, 0x25) })
+	panel := expandertest.NewPCF8574(0x26)
+	_, err = hardware.ConnectExpander(hardware.PCF8574Chip, panel, 0x26)
+	require.Nil(t, err)
+	t.Cleanup(func() { hardware.RemoveExpander(hardware.PCF8574Chip, 0x26) })
+
+	t.Run("Invalid expanders are rejected", func(t *testing.T) {
+		chip, address := model.ExpanderChipMcp23017, 0x40
+		_, err := devices.ModifyExpander(model.ExpanderInput{Chip: &chip, Address: &address})
+		require.NotNil(t, err)
+
+		_, err = devices.ModifyExpander(model.ExpanderInput{Chip: &chip})
+		require.NotNil(t, err)
+	})
+
+	var expander *devices.Expander
+	t.Run("An expander is saved when it cannot be connected", func(t *testing.T) {
+		chip, address := model.ExpanderChipMcp23017, 0x24
+		expander, err = devices.ModifyExpander(model.ExpanderInput{Chip: &chip, Bus: strPointer("9"), Address: &address})
+		require.Nil(t, err)
+		require.Equal(t, "mcp23017@0x24", expander.Name())
+		require.NotEmpty(t, expander.ConnectError)
+		require.Equal(t, "mcp23017@0x24:A0", expander.Pins()[0])
+
+		_, err = devices.ModifyExpander(model.ExpanderInput{Chip: &chip, Address: &address})
+		require.Equal(t, "mcp23017@0x24 is already configured", err.Error())
+	})
+
+	var relay *devices.Switch
+	t.Run("A switch can use an expander pin", func(t *testing.T) {
+		relay, err = devices.CreateSwitch("MCP23017@0x25:b2", "Expander Relay")
+		require.Nil(t, err)
+		relay.On()
+		require.Equal(t, model.SwitchModeOn, relay.State())
+		require.Equal(t, uint16(0x0400), relayBoard.Latch())
+
+		relay.Off()
+		require.Equal(t, model.SwitchModeOff, relay.State())
+		require.Equal(t, uint16(0), relayBoard.Latch())
+	})
+
+	t.Run("Expander pins are only used once", func(t *testing.T) {
+		require.True(t, devices.GpioInUse("mcp23017@0x25:B2"))
+		_, err := devices.CreateSwitch("mcp23017@0x25:B2", "Another Relay")
+		require.NotNil(t, err)
+	})
+
+	t.Run("A controller output can use an expander pin", func(t *testing.T) {
+		outputControl := devices.OutputControl{}
+		require.Nil(t, outputControl.UpdateGpios("Expander HLT", "mcp23017@0x25:A0", ""))
+		outputControl.CycleTime = 10
+		outputControl.DutyCycle = 100
+		outputControl.CalculateOutput()
+		require.Equal(t, uint16(0x0001), relayBoard.Latch())
+
+		outputControl.DutyCycle = 0
+		outputControl.CalculateOutput()
+		require.Equal(t, uint16(0), relayBoard.Latch())
+		require.Nil(t, outputControl.UpdateGpios("Expander HLT", "", ""))
+	})
+
+	t.Run("An input can use an expander pin", func(t *testing.T) {
+		pull := model.InputPullUp
+		inverted := true
+		button, err := devices.ModifyInPin(model.InPinInput{Name: strPointer("Panel button"), Gpio: strPointer("pcf8574@0x26:P1"), Pull: &pull, Inverted: &inverted})
+		require.Nil(t, err)
+		require.Empty(t, button.ConnectError)
+		require.False(t, button.Active())
+
+		panel.SetInputs(0xfd)
+		require.Eventually(t, button.Active, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("An expander with pins in use cannot be deleted", func(t *testing.T) {
+		pump, err := devices.CreateSwitch("mcp23017@0x24:A0", "Expander Pump")
+		require.Nil(t, err)
+
+		_, err = devices.DeleteExpanderByID(fmt.Sprint(expander.ID))
+		require.Equal(t, "mcp23017@0x24:A0 is in use, it cannot be deleted", err.Error())
+		_, err = devices.ModifyExpander(model.ExpanderInput{ID: strPointer(fmt.Sprint(expander.ID)), Bus: strPointer("8")})
+		require.Equal(t, "mcp23017@0x24:A0 is in use, it cannot be reconnected", err.Error())
+		require.Equal(t, "9", devices.FindExpanderByID(fmt.Sprint(expander.ID)).Bus)
+
+		_, err = devices.DeleteSwitchByID(fmt.Sprint(pump.ID))
+		require.Nil(t, err)
+		_, err = devices.DeleteSwitchByID(fmt.Sprint(relay.ID))
+		require.Nil(t, err)
+	})
+
+	t.Run("An unused expander can be deleted", func(t *testing.T) {
+		chip, address := model.ExpanderChipPcf8574, 0x38
+		spare, err := devices.ModifyExpander(model.ExpanderInput{Chip: &chip, Address: &address})
+		require.Nil(t, err)
+		require.Len(t, devices.AllExpanders(), 2)
+
+		_, err = devices.DeleteExpanderByID(fmt.Sprint(spare.ID))
+		require.Nil(t, err)
+		require.Len(t, devices.AllExpanders(), 1)
+		require.Nil(t, devices.FindExpanderByID(fmt.Sprint(spare.ID)))
+	})
+}
@@ -0,0 +1,368 @@
+package devices
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"periph.io/x/periph/conn/physic"
+)
+
+const (
+	// DefaultTargetAttenuation is the apparent attenuation in percent a fermentation has to reach to be complete rather than stalled
+	DefaultTargetAttenuation = 65
+	// DefaultSlopeWindow is the number of hours of gravity readings used to decide if the gravity has stopped dropping
+	DefaultSlopeWindow = 48
+	// DefaultStableSlope is the drop in specific gravity per day below which the gravity is considered stable, one gravity point
+	DefaultStableSlope = 0.001
+	// gravityRecordInterval limits how often probe readings are stored, hydrometers report far more often than the gravity changes
+	gravityRecordInterval = 15 * time.Minute
+)
+
+var fermentations []*Fermentation = nil
+
+// Fermentation tracks the gravity of the vessel a temperature controller is controlling
+// The original gravity is the first reading unless it is set, steps change the controller once the attenuation reaches their threshold
+type Fermentation struct {
+	gorm.Model
+	database.InstanceScoped
+	TemperatureControllerID uint     `gorm:"index"`
+	OriginalGravity         *float64 // The OG, nil until it is set or the first gravity is read
+	TargetAttenuation       float64  // Percent, a stable gravity below this is stalled rather than complete
+	SlopeWindow             int64    // Hours of readings used for the slope
+	StableSlope             float64  // Specific gravity drop per day that counts as stable
+	Started                 time.Time
+	Steps                   []*FermentationStep `gorm:"ForeignKey:FermentationID"`
+	Readings                []*GravityReading   `gorm:"ForeignKey:FermentationID"`
+}
+
+// FermentationStep changes the set point and/or mode of the controller once, when the apparent attenuation reaches Attenuation
+type FermentationStep struct {
+	gorm.Model
+	FermentationID uint
+	Name           string
+	Attenuation    float64 // Percent
+	SetPoint       string
+	Mode           *model.ControllerMode
+	Triggered      *time.Time
+}
+
+// GravityReading is a specific gravity at a point in time
+type GravityReading struct {
+	gorm.Model
+	FermentationID uint `gorm:"index"`
+	Gravity        float64
+	Time           time.Time
+}
+
+// AllFermentations returns every fermentation, loading them from the Database if none are loaded
+func AllFermentations() []*Fermentation {
+	if fermentations == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Fermentations array is nil, checking the database...")
+		database.FetchDatabase().Debug().Preload(clause.Associations).Find(&fermentations)
+		for _, fermentation := range fermentations {
+			fermentation.sort()
+		}
+	}
+	return fermentations
+}
+
+// ClearFermentations reset the cached fermentations
+func ClearFermentations() {
+	fermentations = nil
+}
+
+// Fermentation - The fermentation being tracked for this controller, nil if there isn't one
+func (c *TemperatureController) Fermentation() *Fermentation {
+	for _, fermentation := range AllFermentations() {
+		if fermentation.TemperatureControllerID == c.ID {
+			return fermentation
+		}
+	}
+	return nil
+}
+
+// StartFermentation - Start tracking a fermentation for the controller, replacing any existing one
+func (c *TemperatureController) StartFermentation(settings model.FermentationInput) (*Fermentation, error) {
+	fermentation := Fermentation{
+		TemperatureControllerID: c.ID,
+		OriginalGravity:         settings.OriginalGravity,
+		TargetAttenuation:       DefaultTargetAttenuation,
+		SlopeWindow:             DefaultSlopeWindow,
+		StableSlope:             DefaultStableSlope,
+		Started:                 time.Now(),
+		Steps:                   []*FermentationStep{},
+		Readings:                []*GravityReading{},
+	}
+	if settings.OriginalGravity != nil && !validGravity(*settings.OriginalGravity) {
+		return nil, fmt.Errorf("original gravity %v is not a specific gravity", *settings.OriginalGravity)
+	}
+	if settings.TargetAttenuation != nil {
+		if *settings.TargetAttenuation <= 0 || *settings.TargetAttenuation > 100 {
+			return nil, fmt.Errorf("target attenuation must be between 0 and 100%%, got %v", *settings.TargetAttenuation)
+		}
+		fermentation.TargetAttenuation = *settings.TargetAttenuation
+	}
+	if settings.SlopeWindow != nil {
+		if *settings.SlopeWindow <= 0 {
+			return nil, fmt.Errorf("slope window must be at least an hour, got %v", *settings.SlopeWindow)
+		}
+		fermentation.SlopeWindow = int64(*settings.SlopeWindow)
+	}
+	if settings.StableSlope != nil {
+		if *settings.StableSlope < 0 {
+			return nil, fmt.Errorf("stable slope must not be negative, got %v", *settings.StableSlope)
+		}
+		fermentation.StableSlope = *settings.StableSlope
+	}
+
+	for _, stepSettings := range settings.Steps {
+		if stepSettings.Attenuation <= 0 || stepSettings.Attenuation > 100 {
+			return nil, fmt.Errorf("step attenuation must be between 0 and 100%%, got %v", stepSettings.Attenuation)
+		}
+		step := FermentationStep{Attenuation: stepSettings.Attenuation, Mode: stepSettings.Mode}
+		if stepSettings.Name != nil {
+			step.Name = *stepSettings.Name
+		}
+		if stepSettings.SetPoint != nil {
+			var setPoint physic.Temperature
+			if err := setPoint.Set(strings.ToUpper(*stepSettings.SetPoint)); err != nil {
+				return nil, err
+			}
+			step.SetPoint = *stepSettings.SetPoint
+		}
+		fermentation.Steps = append(fermentation.Steps, &step)
+	}
+	fermentation.sort()
+
+	if _, err := c.EndFermentation(); err != nil {
+		log.Debug().Msgf("No fermentation to replace for %v", c.Name)
+	}
+	database.Save(&fermentation)
+	fermentations = append(AllFermentations(), &fermentation)
+	return &fermentation, nil
+}
+
+// EndFermentation - Stop tracking the fermentation for the controller, deleting its readings
+func (c *TemperatureController) EndFermentation() (*Fermentation, error) {
+	fermentation := c.Fermentation()
+	if fermentation == nil {
+		return nil, fmt.Errorf("no fermentation is being tracked for %v", c.Name)
+	}
+
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Select(clause.Associations).Delete(fermentation)
+	}
+	for i, f := range fermentations {
+		if f == fermentation {
+			fermentations[i] = fermentations[len(fermentations)-1]
+			fermentations = fermentations[:len(fermentations)-1]
+			break
+		}
+	}
+	return fermentation, nil
+}
+
+// RecordGravity - Add a gravity reading to the controller's fermentation, e.g. from a hydrometer sample, and trigger any steps that have been reached
+func (c *TemperatureController) RecordGravity(gravity float64) (*Fermentation, error) {
+	fermentation := c.Fermentation()
+	if fermentation == nil {
+		return nil, fmt.Errorf("no fermentation is being tracked for %v", c.Name)
+	}
+	if err := fermentation.RecordGravity(gravity, nil); err != nil {
+		return nil, err
+	}
+	c.triggerFermentationSteps(fermentation, nil)
+	return fermentation, nil
+}
+
+// RecordGravity - Add a gravity reading
+func (f *Fermentation) RecordGravity(gravity float64, now func() time.Time) error {
+	if !validGravity(gravity) {
+		return fmt.Errorf("gravity %v is not a specific gravity", gravity)
+	}
+	if now == nil {
+		now = time.Now
+	}
+
+	reading := GravityReading{FermentationID: f.ID, Gravity: gravity, Time: now()}
+	f.Readings = append(f.Readings, &reading)
+	database.Save(&reading)
+	if f.OriginalGravity == nil {
+		f.OriginalGravity = &gravity
+		database.Save(f)
+	}
+	return nil
+}
+
+// CurrentGravity - The most recent gravity, nil before the first reading
+func (f *Fermentation) CurrentGravity() *float64 {
+	if len(f.Readings) == 0 {
+		return nil
+	}
+	return &f.Readings[len(f.Readings)-1].Gravity
+}
+
+// ApparentAttenuation - The percentage of the original gravity that has been fermented, uncorrected for alcohol
+func (f *Fermentation) ApparentAttenuation() *float64 {
+	current := f.CurrentGravity()
+	if current == nil || f.OriginalGravity == nil || *f.OriginalGravity <= 1 {
+		return nil
+	}
+	attenuation := (*f.OriginalGravity - *current) / (*f.OriginalGravity - 1) * 100
+	return &attenuation
+}
+
+// Abv - The estimated alcohol by volume in percent
+func (f *Fermentation) Abv() *float64 {
+	current := f.CurrentGravity()
+	if current == nil || f.OriginalGravity == nil {
+		return nil
+	}
+	abv := (*f.OriginalGravity - *current) * 131.25
+	return &abv
+}
+
+// Slope - The change in specific gravity per day over the slope window, by least squares, nil until there are two readings
+func (f *Fermentation) Slope() *float64 {
+	readings := f.windowReadings()
+	if len(readings) < 2 {
+		return nil
+	}
+
+	start := readings[0].Time
+	var sumX, sumY, sumXY, sumXX float64
+	for _, reading := range readings {
+		x := reading.Time.Sub(start).Hours() / 24
+		sumX += x
+		sumY += reading.Gravity
+		sumXY += x * reading.Gravity
+		sumXX += x * x
+	}
+	n := float64(len(readings))
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return nil
+	}
+	slope := (n*sumXY - sumX*sumY) / denominator
+	return &slope
+}
+
+// Status - Whether the fermentation is waiting for a gravity, fermenting, stalled or complete
+// It is only stalled or complete once the readings cover the slope window and the slope is stable
+func (f *Fermentation) Status() model.FermentationStatus {
+	if len(f.Readings) == 0 {
+		return model.FermentationStatusWaiting
+	}
+
+	readings := f.windowReadings()
+	covered := f.Readings[len(f.Readings)-1].Time.Sub(f.Readings[0].Time) >= f.window()
+	slope := f.Slope()
+	if !covered || len(readings) < 2 || slope == nil || math.Abs(*slope) > f.StableSlope {
+		return model.FermentationStatusFermenting
+	}
+
+	attenuation := f.ApparentAttenuation()
+	if attenuation != nil && *attenuation >= f.TargetAttenuation {
+		return model.FermentationStatusComplete
+	}
+	return model.FermentationStatusStalled
+}
+
+// updateFermentation records the gravity from the controllers probes and triggers the steps that have been reached
+func (c *TemperatureController) updateFermentation(now func() time.Time) {
+	fermentation := c.Fermentation()
+	if fermentation == nil {
+		return
+	}
+	if now == nil {
+		now = time.Now
+	}
+
+	for _, probe := range c.TempProbeDetails {
+		if probe.Gravity == nil || c.ProbeStatus(probe) != model.ProbeStatusOk {
+			continue
+		}
+		last := len(fermentation.Readings) - 1
+		if last < 0 || now().Sub(fermentation.Readings[last].Time) >= gravityRecordInterval {
+			if err := fermentation.RecordGravity(*probe.Gravity, now); err != nil {
+				log.Warn().Err(err).Msgf("Ignoring the gravity from %v", probe.PhysAddr)
+			}
+		}
+		break
+	}
+	c.triggerFermentationSteps(fermentation, now)
+}
+
+// triggerFermentationSteps applies every step that the attenuation has reached, in order, each step is only applied once
+func (c *TemperatureController) triggerFermentationSteps(fermentation *Fermentation, now func() time.Time) {
+	if now == nil {
+		now = time.Now
+	}
+	attenuation := fermentation.ApparentAttenuation()
+	if attenuation == nil {
+		return
+	}
+
+	for _, step := range fermentation.Steps {
+		if step.Triggered != nil || *attenuation < step.Attenuation {
+			continue
+		}
+		log.Info().Msgf("%v reached %.1f%% attenuation, starting step '%v'", c.Name, *attenuation, step.Name)
+		if len(step.SetPoint) > 0 {
+			if err := c.UpdateSetPoint(step.SetPoint); err != nil {
+				log.Error().Err(err).Msgf("Failed to apply the set point for step '%v'", step.Name)
+			}
+		}
+		if step.Mode != nil {
+			c.setMode(*step.Mode)
+		}
+		triggered := now()
+		step.Triggered = &triggered
+		database.Save(step)
+		database.Save(c)
+		publishEvent(Event{
+			Type:     model.DeviceEventTypeStepComplete,
+			Time:     triggered,
+			Device:   c.Name,
+			DeviceID: fmt.Sprint(c.ID),
+			Message:  fmt.Sprintf("%v reached %.1f%% attenuation, started step '%v'", c.Name, *attenuation, step.Name),
+			Data: map[string]string{
+				"step":        step.Name,
+				"attenuation": fmt.Sprintf("%.1f", *attenuation),
+				"setPoint":    step.SetPoint,
+			},
+		})
+	}
+}
+
+func (f *Fermentation) window() time.Duration {
+	return time.Duration(f.SlopeWindow) * time.Hour
+}
+
+// windowReadings are the readings within the slope window of the latest reading
+func (f *Fermentation) windowReadings() []*GravityReading {
+	if len(f.Readings) == 0 {
+		return nil
+	}
+	cutoff := f.Readings[len(f.Readings)-1].Time.Add(-f.window())
+	i := sort.Search(len(f.Readings), func(i int) bool { return !f.Readings[i].Time.Before(cutoff) })
+	return f.Readings[i:]
+}
+
+// sort orders the readings by time and the steps by attenuation
+func (f *Fermentation) sort() {
+	sort.SliceStable(f.Readings, func(i, j int) bool { return f.Readings[i].Time.Before(f.Readings[j].Time) })
+	sort.SliceStable(f.Steps, func(i, j int) bool { return f.Steps[i].Attenuation < f.Steps[j].Attenuation })
+}
+
+func validGravity(gravity float64) bool {
+	return gravity >= 0.9 && gravity <= 1.2
+}
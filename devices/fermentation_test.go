@@ -0,0 +1,153 @@
+package devices_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/physic"
+)
+
+func TestFermentation(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearControllers()
+	devices.ClearFermentations()
+	t.Cleanup(devices.ClearFermentations)
+
+	controller, err := devices.CreateTemperatureController("Fermenter", &devices.TempProbeDetail{PhysAddr: "remote-fermenter", FriendlyName: "Fermenter"})
+	require.Nil(t, err)
+
+	start := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := func(hours int) func() time.Time {
+		return func() time.Time { return start.Add(time.Duration(hours) * time.Hour) }
+	}
+
+	t.Run("Invalid settings are rejected", func(t *testing.T) {
+		og := 1050.0
+		_, err := controller.StartFermentation(model.FermentationInput{OriginalGravity: &og})
+		require.NotNil(t, err)
+
+		_, err = controller.StartFermentation(model.FermentationInput{Steps: []*model.FermentationStepInput{{Attenuation: 120}}})
+		require.NotNil(t, err)
+		require.Nil(t, controller.Fermentation())
+	})
+
+	t.Run("A new fermentation is waiting for a gravity", func(t *testing.T) {
+		fermentation, err := controller.StartFermentation(model.FermentationInput{})
+		require.Nil(t, err)
+		require.Equal(t, model.FermentationStatusWaiting, fermentation.Status())
+		require.Nil(t, fermentation.CurrentGravity())
+		require.Nil(t, fermentation.ApparentAttenuation())
+	})
+
+	t.Run("The first reading is the original gravity", func(t *testing.T) {
+		fermentation := controller.Fermentation()
+		require.Nil(t, fermentation.RecordGravity(1.050, at(0)))
+		require.Nil(t, fermentation.RecordGravity(1.030, at(24)))
+
+		require.Equal(t, 1.050, *fermentation.OriginalGravity)
+		require.Equal(t, 1.030, *fermentation.CurrentGravity())
+		require.InDelta(t, 40, *fermentation.ApparentAttenuation(), 0.001)
+		require.InDelta(t, 2.625, *fermentation.Abv(), 0.001)
+		require.InDelta(t, -0.020, *fermentation.Slope(), 0.0001)
+		require.Equal(t, model.FermentationStatusFermenting, fermentation.Status())
+	})
+
+	t.Run("A stable gravity below the target attenuation is stalled", func(t *testing.T) {
+		fermentation := controller.Fermentation()
+		require.Nil(t, fermentation.RecordGravity(1.0300, at(48)))
+		require.Nil(t, fermentation.RecordGravity(1.0298, at(72)))
+		require.Equal(t, model.FermentationStatusStalled, fermentation.Status())
+	})
+
+	t.Run("A stable gravity above the target attenuation is complete", func(t *testing.T) {
+		fermentation := controller.Fermentation()
+		require.Nil(t, fermentation.RecordGravity(1.012, at(96)))
+		require.Equal(t, model.FermentationStatusFermenting, fermentation.Status())
+
+		require.Nil(t, fermentation.RecordGravity(1.012, at(120)))
+		require.Nil(t, fermentation.RecordGravity(1.011, at(144)))
+		require.Equal(t, model.FermentationStatusComplete, fermentation.Status())
+	})
+
+	t.Run("Invalid gravities are rejected", func(t *testing.T) {
+		_, err := controller.RecordGravity(12)
+		require.NotNil(t, err)
+	})
+
+	t.Run("Steps trigger once when the attenuation reaches them", func(t *testing.T) {
+		og := 1.060
+		fermentation, err := controller.StartFermentation(model.FermentationInput{
+			OriginalGravity: &og,
+			Steps: []*model.FermentationStepInput{
+				{Name: strPointer("Crash"), Attenuation: 75, SetPoint: strPointer("2C")},
+				{Name: strPointer("Diacetyl rest"), Attenuation: 50, SetPoint: strPointer("20C")},
+			},
+		})
+		require.Nil(t, err)
+		require.Equal(t, "Diacetyl rest", fermentation.Steps[0].Name)
+
+		_, err = controller.RecordGravity(1.040)
+		require.Nil(t, err)
+		require.Nil(t, fermentation.Steps[0].Triggered)
+
+		_, err = controller.RecordGravity(1.028)
+		require.Nil(t, err)
+		require.NotNil(t, fermentation.Steps[0].Triggered)
+		require.Nil(t, fermentation.Steps[1].Triggered)
+		require.Equal(t, "20°C", controller.SetPoint())
+
+		require.Nil(t, controller.UpdateSetPoint("21C"))
+		_, err = controller.RecordGravity(1.027)
+		require.Nil(t, err)
+		require.Equal(t, "21°C", controller.SetPoint())
+	})
+
+	t.Run("Hydrometer probes record the gravity", func(t *testing.T) {
+		og := 1.060
+		_, err := controller.StartFermentation(model.FermentationInput{
+			OriginalGravity: &og,
+			Steps:           []*model.FermentationStepInput{{Name: strPointer("Crash"), Attenuation: 75, SetPoint: strPointer("2C")}},
+		})
+		require.Nil(t, err)
+
+		gravity := 1.010
+		_, err = hardware.UpdateRemoteProbe(hardware.RemoteReading{
+			Source:      hardware.GenericSource,
+			ID:          "fermenter",
+			Temperature: physic.ZeroCelsius + 2*physic.Celsius,
+			Gravity:     &gravity,
+		})
+		require.Nil(t, err)
+
+		controller.UpdateOutput()
+		fermentation := controller.Fermentation()
+		require.Equal(t, 1.010, *fermentation.CurrentGravity())
+		require.NotNil(t, fermentation.Steps[0].Triggered)
+		require.Equal(t, "2°C", controller.SetPoint())
+	})
+
+	t.Run("Fermentations are persisted", func(t *testing.T) {
+		devices.ClearFermentations()
+		fermentation := controller.Fermentation()
+		require.NotNil(t, fermentation)
+		require.Len(t, fermentation.Readings, 1)
+		require.NotNil(t, fermentation.Steps[0].Triggered)
+	})
+
+	t.Run("A fermentation can be ended", func(t *testing.T) {
+		_, err := controller.EndFermentation()
+		require.Nil(t, err)
+		require.Nil(t, controller.Fermentation())
+
+		_, err = controller.EndFermentation()
+		require.NotNil(t, err)
+	})
+}
+
+func strPointer(value string) *string {
+	return &value
+}
@@ -0,0 +1,448 @@
+package devices
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"periph.io/x/periph/conn/gpio"
+)
+
+// DefaultPulsesPerLitre is the calibration of a YF-S201 hall sensor
+const DefaultPulsesPerLitre = 450
+
+// DefaultNoFlowTimeout is the number of seconds without a pulse before a dispense is stopped, when the meter does not set one
+const DefaultNoFlowTimeout = 30
+
+// flowRateWindow is how far back pulses are counted for the flow rate
+const flowRateWindow = 2 * time.Second
+
+// flowEdgeTimeout bounds each wait for a pulse so a stopped meter notices quickly and a stalled dispense is checked
+const flowEdgeTimeout = 200 * time.Millisecond
+
+var flowMeters []*FlowMeter = nil
+
+// FlowMeter counts the pulses from a hall sensor on a GPIO to measure the volume that has flowed through it
+// Volumes are in litres, Pulses is the resettable totalizer and TotalPulses covers the life of the meter
+type FlowMeter struct {
+	gorm.Model
+	database.InstanceScoped
+	Identifier     string
+	FriendlyName   string
+	PulsesPerLitre float64
+	NoFlowTimeout  int64 // Seconds without a pulse before a dispense is stopped
+	Pulses         uint64
+	TotalPulses    uint64
+	PinIn          gpio.PinIn `gorm:"-"`
+	ConnectError   string     `gorm:"-"` // Why the pin could not be watched, empty when it is watched
+	dispense       *FlowDispense
+	pulseTimes     []time.Time
+	lastPulse      time.Time
+	quit           chan struct{}
+	done           chan struct{} // Closed when the goroutine counting the pulses has exited
+	lock           sync.RWMutex
+}
+
+// FlowDispense is a fixed volume run through a flow meter, the switch is turned off when it has flowed
+type FlowDispense struct {
+	Litres   float64
+	Switch   *Switch
+	Started  time.Time
+	Finished *time.Time
+	Error    *string // Why the dispense stopped early
+	meter    *FlowMeter
+	pulses   uint64
+	target   uint64
+}
+
+// AllFlowMeters returns all the flow meters, loading from the Database and watching them if none are loaded
+func AllFlowMeters() []*FlowMeter {
+	if flowMeters == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Flow meters array is nil, checking the database...")
+		database.FetchDatabase().Debug().Find(&flowMeters)
+		for _, meter := range flowMeters {
+			meter.watch()
+		}
+	}
+	return flowMeters
+}
+
+// FindFlowMeterByID - Find a flow meter by id
+func FindFlowMeterByID(id string) *FlowMeter {
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	for _, meter := range AllFlowMeters() {
+		if meter.ID == uint(intID) {
+			return meter
+		}
+	}
+	return nil
+}
+
+// ModifyFlowMeter - Create or update a flow meter and start counting its pulses
+// The meter is saved even if the pin cannot be watched, ConnectError says why
+func ModifyFlowMeter(settings model.FlowMeterInput) (*FlowMeter, error) {
+	meter := &FlowMeter{}
+	if settings.ID != nil {
+		meter = FindFlowMeterByID(*settings.ID)
+		if meter == nil {
+			return nil, fmt.Errorf("no flow meter with id: %v found", *settings.ID)
+		}
+	} else if settings.Name == nil || settings.Gpio == nil {
+		return nil, errors.New("name and gpio are required when creating a flow meter")
+	}
+
+	name, identifier := meter.FriendlyName, meter.Identifier
+	pulsesPerLitre, noFlowTimeout := meter.PulsesPerLitre, meter.NoFlowTimeout
+	if settings.Name != nil {
+		name = strings.TrimSpace(*settings.Name)
+	}
+	if settings.Gpio != nil {
+		identifier = strings.TrimSpace(*settings.Gpio)
+	}
+	if settings.PulsesPerLitre != nil {
+		pulsesPerLitre = *settings.PulsesPerLitre
+	}
+	if settings.NoFlowTimeout != nil {
+		noFlowTimeout = int64(*settings.NoFlowTimeout)
+	}
+
+	if len(name) == 0 || len(identifier) == 0 {
+		return nil, errors.New("a flow meter needs a name and a gpio")
+	}
+	if pulsesPerLitre < 0 {
+		return nil, fmt.Errorf("pulses per litre must not be negative, got %v", pulsesPerLitre)
+	}
+	if noFlowTimeout < 0 {
+		return nil, fmt.Errorf("the no flow timeout must not be negative, got %v", noFlowTimeout)
+	}
+	for _, other := range AllFlowMeters() {
+		if other != meter && strings.EqualFold(other.FriendlyName, name) {
+			return nil, fmt.Errorf("flow meter '%v' already exists", name)
+		}
+	}
+	if !strings.EqualFold(meter.Identifier, identifier) && GpioInUse(identifier) {
+		return nil, fmt.Errorf("GPIO '%v' is already in use", identifier)
+	}
+
+	rewatch := meter.ID == 0 || meter.Identifier != identifier
+	if rewatch {
+		meter.stop()
+	}
+	meter.lock.Lock()
+	if rewatch {
+		meter.PinIn = nil
+	}
+	meter.FriendlyName = name
+	meter.Identifier = identifier
+	meter.PulsesPerLitre = pulsesPerLitre
+	meter.NoFlowTimeout = noFlowTimeout
+	meter.lock.Unlock()
+	if meter.ID == 0 {
+		flowMeters = append(flowMeters, meter)
+	}
+	meter.save()
+	if rewatch {
+		meter.watch()
+	}
+	return meter, nil
+}
+
+// DeleteFlowMeterByID - Stop counting and delete a flow meter, stopping any dispense
+func DeleteFlowMeterByID(id string) (*FlowMeter, error) {
+	meter := FindFlowMeterByID(id)
+	if meter == nil {
+		return nil, fmt.Errorf("no flow meter found with id '%v'", id)
+	}
+
+	meter.CancelDispense()
+	meter.stop()
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Delete(meter)
+	}
+	for i, m := range flowMeters {
+		if m == meter {
+			flowMeters[i] = flowMeters[len(flowMeters)-1]
+			flowMeters = flowMeters[:len(flowMeters)-1]
+			break
+		}
+	}
+	return meter, nil
+}
+
+// ClearFlowMeters stops counting, saves the totals and resets the cached flow meters, any dispense is stopped
+func ClearFlowMeters() {
+	for _, meter := range flowMeters {
+		meter.CancelDispense()
+		meter.stop()
+		meter.save()
+	}
+	flowMeters = nil
+}
+
+// dropFlowMeters stops counting and resets the cached flow meters without saving them, so the database is left as it is
+func dropFlowMeters() {
+	for _, meter := range flowMeters {
+		meter.stop()
+	}
+	flowMeters = nil
+}
+
+// Gpio - Get the GPIO
+func (m *FlowMeter) Gpio() string {
+	return m.Identifier
+}
+
+// Name - Get the Name
+func (m *FlowMeter) Name() string {
+	return m.FriendlyName
+}
+
+// Calibration - The pulses per litre, defaulting to a YF-S201
+func (m *FlowMeter) Calibration() float64 {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.calibration()
+}
+
+// calibration - The pulses per litre while the lock is held
+func (m *FlowMeter) calibration() float64 {
+	if m.PulsesPerLitre <= 0 {
+		return DefaultPulsesPerLitre
+	}
+	return m.PulsesPerLitre
+}
+
+// Volume - The litres counted since the meter was last reset
+func (m *FlowMeter) Volume() float64 {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return float64(m.Pulses) / m.calibration()
+}
+
+// LifetimeVolume - The litres counted since the meter was created
+func (m *FlowMeter) LifetimeVolume() float64 {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return float64(m.TotalPulses) / m.calibration()
+}
+
+// Rate - The current flow in litres per minute
+func (m *FlowMeter) Rate() float64 {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	since := time.Now().Add(-flowRateWindow)
+	count := 0
+	for _, pulse := range m.pulseTimes {
+		if pulse.After(since) {
+			count++
+		}
+	}
+	return float64(count) / m.calibration() / flowRateWindow.Minutes()
+}
+
+// Reset - Zero the resettable totalizer, the lifetime volume is kept
+func (m *FlowMeter) Reset() {
+	m.lock.Lock()
+	m.Pulses = 0
+	m.lock.Unlock()
+	m.save()
+}
+
+// Dispense - Turn on the switch and turn it off again once the litres have flowed
+// The switch is also turned off if the meter sees no flow for NoFlowTimeout seconds, e.g. an empty tank
+func (m *FlowMeter) Dispense(litres float64, s *Switch) (*FlowDispense, error) {
+	if litres <= 0 {
+		return nil, fmt.Errorf("litres must be more than 0, got %v", litres)
+	}
+	if s == nil {
+		return nil, errors.New("a dispense needs a switch")
+	}
+	if len(m.ConnectError) > 0 {
+		return nil, fmt.Errorf("%v is not counting: %v", m.FriendlyName, m.ConnectError)
+	}
+
+	m.lock.Lock()
+	if m.dispense != nil && m.dispense.Finished == nil {
+		m.lock.Unlock()
+		return nil, fmt.Errorf("%v is already dispensing", m.FriendlyName)
+	}
+	now := time.Now()
+	m.dispense = &FlowDispense{
+		Litres:  litres,
+		Switch:  s,
+		Started: now,
+		meter:   m,
+		target:  uint64(litres*m.calibration() + 0.5),
+	}
+	m.lastPulse = now
+	dispense := m.dispense
+	m.lock.Unlock()
+
+	log.Info().Msgf("Dispensing %vL through %v with %v", litres, m.FriendlyName, s.Name())
+	s.On()
+	return dispense, nil
+}
+
+// CancelDispense - Stop a running dispense and turn off its switch
+func (m *FlowMeter) CancelDispense() *FlowDispense {
+	m.finishDispense("cancelled")
+	return m.Dispensing()
+}
+
+// Dispensing - The current or last dispense, nil if there has not been one
+func (m *FlowMeter) Dispensing() *FlowDispense {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.dispense
+}
+
+// Dispensed - The litres that have flowed so far
+func (d *FlowDispense) Dispensed() float64 {
+	d.meter.lock.RLock()
+	defer d.meter.lock.RUnlock()
+	return float64(d.pulses) / d.meter.calibration()
+}
+
+// Running - Returns true until the dispense has flowed or been stopped
+func (d *FlowDispense) Running() bool {
+	d.meter.lock.RLock()
+	defer d.meter.lock.RUnlock()
+	return d.Finished == nil
+}
+
+// watch configures the pin and starts a goroutine that counts the rising edges
+func (m *FlowMeter) watch() {
+	m.ConnectError = ""
+	if m.PinIn == nil {
+		if pin := hardware.Pin(m.Identifier); pin != nil {
+			m.PinIn = pin
+		} else {
+			m.ConnectError = fmt.Sprintf("no pin for %v", m.Identifier)
+			log.Error().Msgf("No Pin for flow meter %v!", m.Identifier)
+			return
+		}
+	}
+	// The pulses are too fast to poll, edge detection is required
+	if err := m.PinIn.In(gpio.PullUp, gpio.RisingEdge); err != nil {
+		m.ConnectError = err.Error()
+		log.Error().Err(err).Msgf("Failed to count pulses on %v", m.Identifier)
+		return
+	}
+
+	pin := m.PinIn
+	m.lock.Lock()
+	quit, done := make(chan struct{}), make(chan struct{})
+	m.quit, m.done = quit, done
+	m.lock.Unlock()
+
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-quit:
+				return
+			default:
+			}
+			if pin.WaitForEdge(flowEdgeTimeout) {
+				m.pulse(time.Now())
+			} else {
+				m.checkFlow(time.Now())
+			}
+		}
+	}()
+}
+
+// stop ends the goroutine counting the pulses and waits for it to exit, so the pin can be changed safely
+func (m *FlowMeter) stop() {
+	m.lock.Lock()
+	quit, done := m.quit, m.done
+	m.quit, m.done = nil, nil
+	m.lock.Unlock()
+	if quit == nil {
+		return
+	}
+	close(quit)
+	<-done
+}
+
+// save holds the write lock, saving sets the ID and timestamps of the meter
+func (m *FlowMeter) save() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	database.Save(m)
+}
+
+// pulse counts one pulse, finishing the dispense when it reaches its volume
+func (m *FlowMeter) pulse(now time.Time) {
+	m.lock.Lock()
+	m.Pulses++
+	m.TotalPulses++
+	m.lastPulse = now
+	since := now.Add(-flowRateWindow)
+	for len(m.pulseTimes) > 0 && !m.pulseTimes[0].After(since) {
+		m.pulseTimes = m.pulseTimes[1:]
+	}
+	m.pulseTimes = append(m.pulseTimes, now)
+	complete := false
+	if m.dispense != nil && m.dispense.Finished == nil {
+		m.dispense.pulses++
+		complete = m.dispense.pulses >= m.dispense.target
+	}
+	m.lock.Unlock()
+
+	if complete {
+		m.finishDispense("")
+	}
+}
+
+// checkFlow stops a dispense that has not seen a pulse for the no flow timeout
+func (m *FlowMeter) checkFlow(now time.Time) {
+	m.lock.RLock()
+	timeout := m.NoFlowTimeout
+	if timeout <= 0 {
+		timeout = DefaultNoFlowTimeout
+	}
+	stalled := m.dispense != nil && m.dispense.Finished == nil && now.Sub(m.lastPulse) >= time.Duration(timeout)*time.Second
+	m.lock.RUnlock()
+
+	if stalled {
+		m.finishDispense(fmt.Sprintf("no flow for %v seconds", timeout))
+	}
+}
+
+// finishDispense turns off the switch of a running dispense, reason is empty when the volume was reached
+func (m *FlowMeter) finishDispense(reason string) {
+	m.lock.Lock()
+	dispense := m.dispense
+	if dispense == nil || dispense.Finished != nil {
+		m.lock.Unlock()
+		return
+	}
+	now := time.Now()
+	dispense.Finished = &now
+	if len(reason) > 0 {
+		dispense.Error = &reason
+	}
+	name := m.FriendlyName
+	m.lock.Unlock()
+
+	dispense.Switch.Off()
+	if len(reason) > 0 {
+		log.Warn().Msgf("Stopped dispensing through %v: %v", name, reason)
+	} else {
+		log.Info().Msgf("Dispensed %vL through %v", dispense.Litres, name)
+	}
+	m.save()
+}
@@ -0,0 +1,147 @@
+package devices_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/gpio/gpioreg"
+	"periph.io/x/periph/conn/gpio/gpiotest"
+)
+
+func TestFlowMeters(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearFlowMeters()
+	t.Cleanup(devices.ClearFlowMeters)
+
+	meterPin := &gpiotest.Pin{N: "FLOW_METER", Num: 201, EdgesChan: make(chan gpio.Level)}
+	pollPin := &gpiotest.Pin{N: "FLOW_POLLED", Num: 202}
+	valvePin := &gpiotest.Pin{N: "FLOW_VALVE", Num: 203}
+	for _, pin := range []*gpiotest.Pin{meterPin, pollPin, valvePin} {
+		require.Nil(t, gpioreg.Register(pin))
+	}
+	valve, err := devices.CreateSwitch("FLOW_VALVE", "Strike Valve")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_, err := devices.DeleteSwitchByID(fmt.Sprint(valve.ID))
+		require.Nil(t, err)
+	})
+
+	pulse := func(count int) {
+		for i := 0; i < count; i++ {
+			meterPin.EdgesChan <- gpio.High
+		}
+	}
+
+	var meter *devices.FlowMeter
+	t.Run("A flow meter can be created", func(t *testing.T) {
+		meter, err = devices.ModifyFlowMeter(model.FlowMeterInput{Name: strPointer("Strike"), Gpio: strPointer("FLOW_METER")})
+		require.Nil(t, err)
+		require.Empty(t, meter.ConnectError)
+		require.Equal(t, float64(devices.DefaultPulsesPerLitre), meter.Calibration())
+		require.True(t, devices.GpioInUse("FLOW_METER"))
+	})
+
+	t.Run("A flow meter needs edge detection", func(t *testing.T) {
+		polled, err := devices.ModifyFlowMeter(model.FlowMeterInput{Name: strPointer("Polled"), Gpio: strPointer("FLOW_POLLED")})
+		require.Nil(t, err)
+		require.NotEmpty(t, polled.ConnectError)
+
+		_, err = polled.Dispense(1, valve)
+		require.NotNil(t, err)
+	})
+
+	t.Run("Pulses are counted as litres", func(t *testing.T) {
+		calibration := 10.0
+		_, err := devices.ModifyFlowMeter(model.FlowMeterInput{ID: strPointer(fmt.Sprint(meter.ID)), PulsesPerLitre: &calibration})
+		require.Nil(t, err)
+
+		pulse(15)
+		require.Eventually(t, func() bool { return meter.Volume() == 1.5 }, time.Second, 10*time.Millisecond)
+		require.Equal(t, 1.5, meter.LifetimeVolume())
+		// 1.5 litres in the last 2 seconds
+		require.InDelta(t, 45, meter.Rate(), 0.001)
+	})
+
+	t.Run("Resetting keeps the lifetime volume", func(t *testing.T) {
+		meter.Reset()
+		require.Equal(t, 0.0, meter.Volume())
+		require.Equal(t, 1.5, meter.LifetimeVolume())
+	})
+
+	t.Run("A dispense turns off the switch when the litres have flowed", func(t *testing.T) {
+		dispense, err := meter.Dispense(2, valve)
+		require.Nil(t, err)
+		require.Equal(t, model.SwitchModeOn, valve.State())
+
+		_, err = meter.Dispense(1, valve)
+		require.Equal(t, "Strike is already dispensing", err.Error())
+
+		pulse(19)
+		require.Eventually(t, func() bool { return dispense.Dispensed() == 1.9 }, time.Second, 10*time.Millisecond)
+		require.True(t, dispense.Running())
+		require.Equal(t, model.SwitchModeOn, valve.State())
+
+		pulse(1)
+		require.Eventually(t, func() bool { return !dispense.Running() }, time.Second, 10*time.Millisecond)
+		require.Nil(t, dispense.Error)
+		require.Equal(t, 2.0, dispense.Dispensed())
+		require.Equal(t, model.SwitchModeOff, valve.State())
+		require.Equal(t, 2.0, meter.Volume())
+	})
+
+	t.Run("A dispense can be cancelled", func(t *testing.T) {
+		_, err := meter.Dispense(5, valve)
+		require.Nil(t, err)
+		require.Equal(t, model.SwitchModeOn, valve.State())
+
+		dispense := meter.CancelDispense()
+		require.Equal(t, "cancelled", *dispense.Error)
+		require.Equal(t, model.SwitchModeOff, valve.State())
+	})
+
+	t.Run("A dispense without flow is stopped", func(t *testing.T) {
+		timeout := 1
+		_, err := devices.ModifyFlowMeter(model.FlowMeterInput{ID: strPointer(fmt.Sprint(meter.ID)), NoFlowTimeout: &timeout})
+		require.Nil(t, err)
+
+		dispense, err := meter.Dispense(5, valve)
+		require.Nil(t, err)
+		require.Eventually(t, func() bool { return !meter.Dispensing().Running() }, 5*time.Second, 50*time.Millisecond)
+		require.Equal(t, "no flow for 1 seconds", *dispense.Error)
+		require.Equal(t, model.SwitchModeOff, valve.State())
+	})
+
+	t.Run("Calibration and totals are persisted", func(t *testing.T) {
+		devices.ClearFlowMeters()
+		reloaded := devices.FindFlowMeterByID(fmt.Sprint(meter.ID))
+		require.NotNil(t, reloaded)
+		require.Equal(t, 10.0, reloaded.PulsesPerLitre)
+		require.Equal(t, 2.0, reloaded.Volume())
+		require.Equal(t, 3.5, reloaded.LifetimeVolume())
+		meter = reloaded
+	})
+
+	t.Run("Resuming reloads the meters without saving over the database", func(t *testing.T) {
+		devices.SuspendTemperatureControllers()
+		require.Equal(t, 2.0, devices.FindFlowMeterByID(fmt.Sprint(meter.ID)).Volume())
+		database.FetchDatabase().Model(&devices.FlowMeter{}).Where("id = ?", meter.ID).Update("pulses", 0)
+		devices.ResumeTemperatureControllers()
+
+		meter = devices.FindFlowMeterByID(fmt.Sprint(meter.ID))
+		require.Equal(t, 0.0, meter.Volume())
+		require.Equal(t, 3.5, meter.LifetimeVolume())
+	})
+
+	t.Run("A flow meter can be deleted", func(t *testing.T) {
+		_, err := devices.DeleteFlowMeterByID(fmt.Sprint(meter.ID))
+		require.Nil(t, err)
+		require.False(t, devices.GpioInUse("FLOW_METER"))
+		require.Nil(t, devices.FindFlowMeterByID(fmt.Sprint(meter.ID)))
+	})
+}
@@ -0,0 +1,434 @@
+package devices
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"periph.io/x/periph/conn/gpio"
+)
+
+// inputPollInterval is how often a pin is read when it does not support edge detection
+const inputPollInterval = 100 * time.Millisecond
+
+// inputEdgeTimeout bounds each wait for an edge so a stopped input notices quickly
+const inputEdgeTimeout = 200 * time.Millisecond
+
+var inpins []*InPin = nil
+
+// InPin represents a stored digital input with a friendly name, e.g. a float switch, door sensor or panel button
+// An input is active when the pin is High, or Low if it is inverted
+type InPin struct {
+	gorm.Model
+	database.InstanceScoped
+	Identifier   string
+	FriendlyName string
+	Pull         model.InputPull
+	Inverted     bool
+	Debounce     int64 // Milliseconds the level has to settle before a change is accepted
+	Action       model.InputAction
+	SwitchID     *uint      // The switch toggled when the input becomes active
+	ControllerID *uint      // The controller held off while the input is inactive
+	PinIn        gpio.PinIn `gorm:"-"`
+	ConnectError string     `gorm:"-"` // Why the pin could not be watched, empty when it is watched
+	active       bool
+	changed      *time.Time
+	quit         chan struct{}
+	done         chan struct{} // Closed when the goroutine watching the pin has exited
+	lock         sync.RWMutex
+}
+
+// AllInPins returns all the inputs, loading from the Database and watching them if none are loaded
+func AllInPins() []*InPin {
+	if inpins == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Inputs array is nil, checking the database...")
+		database.FetchDatabase().Debug().Find(&inpins)
+		for _, input := range inpins {
+			input.watch()
+		}
+	}
+	return inpins
+}
+
+// FindInPinByID - Find an input by id
+func FindInPinByID(id string) *InPin {
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	for _, input := range AllInPins() {
+		if input.ID == uint(intID) {
+			return input
+		}
+	}
+	return nil
+}
+
+// ModifyInPin - Create or update an input and start watching it
+// The input is saved even if the pin cannot be watched, ConnectError says why
+func ModifyInPin(settings model.InPinInput) (*InPin, error) {
+	input := &InPin{}
+	if settings.ID != nil {
+		input = FindInPinByID(*settings.ID)
+		if input == nil {
+			return nil, fmt.Errorf("no input with id: %v found", *settings.ID)
+		}
+	} else if settings.Name == nil || settings.Gpio == nil {
+		return nil, errors.New("name and gpio are required when creating an input")
+	}
+
+	updated := InPin{
+		Model:          input.Model,
+		InstanceScoped: input.InstanceScoped,
+		Identifier:     input.Identifier,
+		FriendlyName:   input.FriendlyName,
+		Pull:           input.Pull,
+		Inverted:       input.Inverted,
+		Debounce:       input.Debounce,
+		Action:         input.Action,
+		SwitchID:       input.SwitchID,
+		ControllerID:   input.ControllerID,
+	}
+	if settings.Name != nil {
+		updated.FriendlyName = strings.TrimSpace(*settings.Name)
+	}
+	if settings.Gpio != nil {
+		updated.Identifier = strings.TrimSpace(*settings.Gpio)
+	}
+	if settings.Pull != nil {
+		updated.Pull = *settings.Pull
+	}
+	if settings.Inverted != nil {
+		updated.Inverted = *settings.Inverted
+	}
+	if settings.Debounce != nil {
+		updated.Debounce = int64(*settings.Debounce)
+	}
+	if settings.Action != nil {
+		updated.Action = *settings.Action
+	}
+	if settings.SwitchID != nil {
+		switchID, err := parseID(*settings.SwitchID)
+		if err != nil {
+			return nil, err
+		}
+		updated.SwitchID = switchID
+	}
+	if settings.ControllerID != nil {
+		controllerID, err := parseID(*settings.ControllerID)
+		if err != nil {
+			return nil, err
+		}
+		updated.ControllerID = controllerID
+	}
+
+	if err := updated.validate(); err != nil {
+		return nil, err
+	}
+	for _, other := range AllInPins() {
+		if other == input {
+			continue
+		}
+		if strings.EqualFold(other.FriendlyName, updated.FriendlyName) {
+			return nil, fmt.Errorf("input '%v' already exists", updated.FriendlyName)
+		}
+	}
+	if !strings.EqualFold(input.Identifier, updated.Identifier) && GpioInUse(updated.Identifier) {
+		return nil, fmt.Errorf("GPIO '%v' is already in use", updated.Identifier)
+	}
+
+	input.stop()
+	input.lock.Lock()
+	input.FriendlyName = updated.FriendlyName
+	input.Identifier = updated.Identifier
+	input.Pull = updated.Pull
+	input.Inverted = updated.Inverted
+	input.Debounce = updated.Debounce
+	input.Action = updated.Action
+	input.SwitchID = updated.SwitchID
+	input.ControllerID = updated.ControllerID
+	input.PinIn = nil
+	input.lock.Unlock()
+	if input.ID == 0 {
+		inpins = append(inpins, input)
+	}
+	database.Save(input)
+	input.watch()
+	return input, nil
+}
+
+// DeleteInPinByID - Stop watching and delete an input
+func DeleteInPinByID(id string) (*InPin, error) {
+	input := FindInPinByID(id)
+	if input == nil {
+		return nil, fmt.Errorf("no input found with id '%v'", id)
+	}
+
+	input.stop()
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Delete(input)
+	}
+	for i, p := range inpins {
+		if p == input {
+			inpins[i] = inpins[len(inpins)-1]
+			inpins = inpins[:len(inpins)-1]
+			break
+		}
+	}
+	return input, nil
+}
+
+// ClearInPins stops watching and resets the cached inputs
+func ClearInPins() {
+	for _, input := range inpins {
+		input.stop()
+	}
+	inpins = nil
+}
+
+// Active - Returns true if the input is active, after debouncing and inversion
+func (ip *InPin) Active() bool {
+	ip.lock.RLock()
+	defer ip.lock.RUnlock()
+	return ip.active
+}
+
+// Changed - When the input last changed state, nil if it has not changed since it was watched
+func (ip *InPin) Changed() *time.Time {
+	ip.lock.RLock()
+	defer ip.lock.RUnlock()
+	return ip.changed
+}
+
+// Gpio - Get the GPIO
+func (ip *InPin) Gpio() string {
+	return ip.Identifier
+}
+
+// Name - Get the Name
+func (ip *InPin) Name() string {
+	return ip.FriendlyName
+}
+
+// Switch - The switch toggled by this input, if any
+func (ip *InPin) Switch() *Switch {
+	if ip.SwitchID == nil {
+		return nil
+	}
+	return FindSwitchByID(strconv.FormatUint(uint64(*ip.SwitchID), 10))
+}
+
+// Controller - The controller held off by this input, if any
+func (ip *InPin) Controller() *TemperatureController {
+	if ip.ControllerID == nil {
+		return nil
+	}
+	return FindTemperatureControllerByID(strconv.FormatUint(uint64(*ip.ControllerID), 10))
+}
+
+// Interlocked - Returns true if an interlock input is inactive, the outputs of the controller are held off until it is active again
+func (c *TemperatureController) Interlocked() bool {
+	for _, input := range AllInPins() {
+		if input.interlocks(c) && !input.Active() {
+			return true
+		}
+	}
+	return false
+}
+
+// interlocks - Returns true if the input is an interlock for the controller
+func (ip *InPin) interlocks(c *TemperatureController) bool {
+	ip.lock.RLock()
+	defer ip.lock.RUnlock()
+	return ip.Action == model.InputActionInterlock && ip.ControllerID != nil && *ip.ControllerID == c.ID
+}
+
+func (ip *InPin) validate() error {
+	if len(ip.FriendlyName) == 0 {
+		return errors.New("an input needs a name")
+	}
+	if len(ip.Identifier) == 0 {
+		return errors.New("an input needs a gpio")
+	}
+	if ip.Pull != "" && !ip.Pull.IsValid() {
+		return fmt.Errorf("unknown pull '%v'", ip.Pull)
+	}
+	if ip.Action != "" && !ip.Action.IsValid() {
+		return fmt.Errorf("unknown action '%v'", ip.Action)
+	}
+	if ip.Debounce < 0 {
+		return fmt.Errorf("debounce must not be negative, got %v", ip.Debounce)
+	}
+	if ip.Action == model.InputActionToggleSwitch && (ip.SwitchID == nil || ip.Switch() == nil) {
+		return errors.New("toggling a switch needs an existing switchId")
+	}
+	if ip.Action == model.InputActionInterlock && (ip.ControllerID == nil || ip.Controller() == nil) {
+		return errors.New("an interlock needs an existing controllerId")
+	}
+	return nil
+}
+
+func (ip *InPin) pull() gpio.Pull {
+	switch ip.Pull {
+	case model.InputPullUp:
+		return gpio.PullUp
+	case model.InputPullDown:
+		return gpio.PullDown
+	case model.InputPullNone:
+		return gpio.Float
+	}
+	return gpio.PullNoChange
+}
+
+// watch configures the pin and starts a goroutine that follows its level
+// Pins without edge detection are polled instead
+func (ip *InPin) watch() {
+	ip.ConnectError = ""
+	if ip.PinIn == nil {
+		if pin := hardware.Pin(ip.Identifier); pin != nil {
+			ip.PinIn = pin
+		} else {
+			ip.ConnectError = fmt.Sprintf("no pin for %v", ip.Identifier)
+			log.Error().Msgf("No Pin for input %v!", ip.Identifier)
+			return
+		}
+	}
+
+	edges := true
+	if err := ip.PinIn.In(ip.pull(), gpio.BothEdges); err != nil {
+		log.Warn().Err(err).Msgf("No edge detection on %v, polling instead", ip.Identifier)
+		edges = false
+		if err := ip.PinIn.In(ip.pull(), gpio.NoEdge); err != nil {
+			ip.ConnectError = err.Error()
+			log.Error().Err(err).Msgf("Failed to configure input %v", ip.Identifier)
+			return
+		}
+	}
+
+	pin, debounce := ip.PinIn, time.Duration(ip.Debounce)*time.Millisecond
+	ip.lock.Lock()
+	ip.active = ip.level()
+	ip.changed = nil
+	quit, done := make(chan struct{}), make(chan struct{})
+	ip.quit, ip.done = quit, done
+	ip.lock.Unlock()
+
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-quit:
+				return
+			default:
+			}
+			if edges {
+				if !pin.WaitForEdge(inputEdgeTimeout) {
+					continue
+				}
+			} else if !sleepUntilQuit(inputPollInterval, quit) {
+				return
+			}
+			if debounce > 0 && !sleepUntilQuit(debounce, quit) {
+				return
+			}
+			select {
+			case <-quit:
+				return
+			default:
+				ip.sample()
+			}
+		}
+	}()
+}
+
+// stop ends the goroutine watching the pin and waits for it to exit, so the settings can be changed safely
+func (ip *InPin) stop() {
+	ip.lock.Lock()
+	quit, done := ip.quit, ip.done
+	ip.quit, ip.done = nil, nil
+	ip.lock.Unlock()
+	if quit == nil {
+		return
+	}
+	close(quit)
+	<-done
+}
+
+// sleepUntilQuit waits for d, returning false if quit is closed first
+func sleepUntilQuit(d time.Duration, quit <-chan struct{}) bool {
+	select {
+	case <-quit:
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+func (ip *InPin) level() bool {
+	return (ip.PinIn.Read() == gpio.High) != ip.Inverted
+}
+
+// sample reads the settled level and runs the action when it changes
+func (ip *InPin) sample() {
+	active := ip.level()
+	ip.lock.Lock()
+	if active == ip.active {
+		ip.lock.Unlock()
+		return
+	}
+	now := time.Now()
+	ip.active = active
+	ip.changed = &now
+	ip.lock.Unlock()
+
+	log.Info().Msgf("Input %v is now %v", ip.FriendlyName, map[bool]string{true: "active", false: "inactive"}[active])
+	switch ip.Action {
+	case model.InputActionToggleSwitch:
+		if !active {
+			return
+		}
+		s := ip.Switch()
+		if s == nil {
+			log.Warn().Msgf("Input %v cannot find switch %v", ip.FriendlyName, *ip.SwitchID)
+			return
+		}
+		if s.State() == model.SwitchModeOn {
+			s.Off()
+		} else {
+			s.On()
+		}
+	case model.InputActionInterlock:
+		if active {
+			return
+		}
+		c := ip.Controller()
+		if c == nil {
+			log.Warn().Msgf("Input %v cannot find controller %v", ip.FriendlyName, *ip.ControllerID)
+			return
+		}
+		log.Warn().Msgf("Input %v is inactive, holding off the outputs for %v", ip.FriendlyName, c.Name)
+		c.OutputControl.HoldOff()
+	}
+}
+
+// parseID - Parse an ID reference, an empty ID clears the reference
+func parseID(id string) (*uint, error) {
+	if len(strings.TrimSpace(id)) == 0 {
+		return nil, nil
+	}
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id '%v'", id)
+	}
+	result := uint(intID)
+	return &result, nil
+}
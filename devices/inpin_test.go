@@ -0,0 +1,170 @@
+package devices_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/gpio/gpioreg"
+	"periph.io/x/periph/conn/gpio/gpiotest"
+)
+
+func TestInPins(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearControllers()
+	devices.ClearInPins()
+	t.Cleanup(devices.ClearInPins)
+
+	buttonPin := &gpiotest.Pin{N: "INPUT_BUTTON", Num: 101, EdgesChan: make(chan gpio.Level, 1)}
+	floatPin := &gpiotest.Pin{N: "INPUT_FLOAT", Num: 102, EdgesChan: make(chan gpio.Level, 1)}
+	doorPin := &gpiotest.Pin{N: "INPUT_DOOR", Num: 103}
+	pumpPin := &gpiotest.Pin{N: "INPUT_PUMP", Num: 104}
+	for _, pin := range []*gpiotest.Pin{buttonPin, floatPin, doorPin, pumpPin} {
+		require.Nil(t, gpioreg.Register(pin))
+	}
+
+	pump, err := devices.CreateSwitch("INPUT_PUMP", "Input Pump")
+	require.Nil(t, err)
+	pumpID := fmt.Sprint(pump.ID)
+	t.Cleanup(func() {
+		_, err := devices.DeleteSwitchByID(pumpID)
+		require.Nil(t, err)
+	})
+
+	t.Run("Name and gpio are required", func(t *testing.T) {
+		_, err := devices.ModifyInPin(model.InPinInput{Name: strPointer("Button")})
+		require.NotNil(t, err)
+	})
+
+	t.Run("A GPIO used by an output cannot be an input", func(t *testing.T) {
+		_, err := devices.ModifyInPin(model.InPinInput{Name: strPointer("Button"), Gpio: strPointer("INPUT_PUMP")})
+		require.Equal(t, "GPIO 'INPUT_PUMP' is already in use", err.Error())
+	})
+
+	t.Run("Toggling a switch needs a switch", func(t *testing.T) {
+		action := model.InputActionToggleSwitch
+		_, err := devices.ModifyInPin(model.InPinInput{Name: strPointer("Button"), Gpio: strPointer("INPUT_BUTTON"), Action: &action})
+		require.NotNil(t, err)
+
+		_, err = devices.ModifyInPin(model.InPinInput{Name: strPointer("Button"), Gpio: strPointer("INPUT_BUTTON"), Action: &action, SwitchID: strPointer("9999")})
+		require.NotNil(t, err)
+	})
+
+	t.Run("A button toggles a switch each time it is pressed", func(t *testing.T) {
+		action := model.InputActionToggleSwitch
+		pull := model.InputPullDown
+		button, err := devices.ModifyInPin(model.InPinInput{
+			Name:     strPointer("Button"),
+			Gpio:     strPointer("INPUT_BUTTON"),
+			Pull:     &pull,
+			Debounce: intPointer(10),
+			Action:   &action,
+			SwitchID: &pumpID,
+		})
+		require.Nil(t, err)
+		require.Empty(t, button.ConnectError)
+		require.Equal(t, gpio.PullDown, buttonPin.P)
+		require.False(t, button.Active())
+		require.True(t, devices.GpioInUse("INPUT_BUTTON"))
+
+		buttonPin.EdgesChan <- gpio.High
+		require.Eventually(t, button.Active, time.Second, 10*time.Millisecond)
+		require.NotNil(t, button.Changed())
+		require.Eventually(t, func() bool { return pump.State() == model.SwitchModeOn }, time.Second, 10*time.Millisecond)
+
+		// Releasing the button does not change the switch
+		buttonPin.EdgesChan <- gpio.Low
+		require.Eventually(t, func() bool { return !button.Active() }, time.Second, 10*time.Millisecond)
+		require.Equal(t, model.SwitchModeOn, pump.State())
+
+		buttonPin.EdgesChan <- gpio.High
+		require.Eventually(t, func() bool { return pump.State() == model.SwitchModeOff }, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("Input names are unique", func(t *testing.T) {
+		_, err := devices.ModifyInPin(model.InPinInput{Name: strPointer("button"), Gpio: strPointer("INPUT_DOOR")})
+		require.Equal(t, "input 'button' already exists", err.Error())
+	})
+
+	t.Run("Pins without edge detection are polled", func(t *testing.T) {
+		door, err := devices.ModifyInPin(model.InPinInput{Name: strPointer("Door"), Gpio: strPointer("INPUT_DOOR")})
+		require.Nil(t, err)
+		require.Empty(t, door.ConnectError)
+		require.False(t, door.Active())
+
+		require.Nil(t, doorPin.Out(gpio.High))
+		require.Eventually(t, door.Active, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("An inactive interlock holds off a controller", func(t *testing.T) {
+		controller, err := devices.CreateTemperatureController("Interlocked HLT", &devices.TempProbeDetail{PhysAddr: "InterlockAddress"})
+		require.Nil(t, err)
+		controller.Mode = "manual"
+		controller.ManualSettings = devices.ManualSettings{DutyCycle: 100, CycleTime: 10, Configured: true}
+		controller.OutputControl = &devices.OutputControl{}
+
+		action := model.InputActionInterlock
+		pull := model.InputPullUp
+		inverted := true
+		float, err := devices.ModifyInPin(model.InPinInput{
+			Name:         strPointer("Low level"),
+			Gpio:         strPointer("INPUT_FLOAT"),
+			Pull:         &pull,
+			Inverted:     &inverted,
+			Action:       &action,
+			ControllerID: strPointer(fmt.Sprint(controller.ID)),
+		})
+		require.Nil(t, err)
+		// Pulled up and inverted, the float switch is open
+		require.False(t, float.Active())
+		require.True(t, controller.Interlocked())
+
+		controller.UpdateOutput()
+		require.Equal(t, int64(0), controller.OutputControl.DutyCycle)
+
+		floatPin.EdgesChan <- gpio.Low
+		require.Eventually(t, float.Active, time.Second, 10*time.Millisecond)
+		require.False(t, controller.Interlocked())
+		controller.UpdateOutput()
+		require.Equal(t, int64(100), controller.OutputControl.DutyCycle)
+
+		require.False(t, controller.OutputControl.HeldOff())
+
+		floatPin.EdgesChan <- gpio.High
+		require.Eventually(t, controller.OutputControl.HeldOff, time.Second, 10*time.Millisecond)
+		require.True(t, controller.Interlocked())
+		controller.UpdateOutput()
+		require.Equal(t, int64(0), controller.OutputControl.DutyCycle)
+	})
+
+	t.Run("Inputs are reloaded from the database", func(t *testing.T) {
+		devices.ClearInPins()
+		require.Len(t, devices.AllInPins(), 3)
+
+		float := devices.FindInPinByID("3")
+		require.NotNil(t, float)
+		require.Equal(t, "Low level", float.Name())
+		require.Equal(t, model.InputPullUp, float.Pull)
+		require.True(t, float.Inverted)
+		require.NotNil(t, float.Controller())
+	})
+
+	t.Run("An input can be deleted and its GPIO reused", func(t *testing.T) {
+		door, err := devices.DeleteInPinByID("2")
+		require.Nil(t, err)
+		require.Equal(t, "Door", door.Name())
+		require.Len(t, devices.AllInPins(), 2)
+		require.False(t, devices.GpioInUse("INPUT_DOOR"))
+
+		_, err = devices.DeleteInPinByID("2")
+		require.NotNil(t, err)
+	})
+}
+
+func intPointer(value int) *int {
+	return &value
+}
@@ -0,0 +1,162 @@
+package devices_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+)
+
+// shellyPlug is a Gen1 Shelly with a single relay
+type shellyPlug struct {
+	sync.Mutex
+	on      bool
+	failing bool
+}
+
+func (s *shellyPlug) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	if s.failing {
+		http.Error(w, "unreachable", http.StatusBadGateway)
+		return
+	}
+	if turn := r.URL.Query().Get("turn"); turn != "" {
+		s.on = turn == "on"
+	}
+	fmt.Fprintf(w, `{"ison": %v}`, s.on)
+}
+
+func (s *shellyPlug) isOn() bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.on
+}
+
+func (s *shellyPlug) fail(failing bool) {
+	s.Lock()
+	defer s.Unlock()
+	s.failing = failing
+}
+
+func TestNetworkOutputs(t *testing.T) {
+	setupTestDb(t)
+	timeout, retries, interval := hardware.NetworkTimeout, hardware.OutputRetries, hardware.OutputPollInterval
+	hardware.NetworkTimeout, hardware.OutputRetries, hardware.OutputPollInterval = 100*time.Millisecond, 0, 100*time.Millisecond
+	t.Cleanup(func() {
+		hardware.NetworkTimeout, hardware.OutputRetries, hardware.OutputPollInterval = timeout, retries, interval
+	})
+
+	fridgePlug := &shellyPlug{}
+	fridgeServer := httptest.NewServer(fridgePlug)
+	t.Cleanup(fridgeServer.Close)
+	heaterPlug := &shellyPlug{}
+	heaterServer := httptest.NewServer(heaterPlug)
+	t.Cleanup(heaterServer.Close)
+
+	t.Run("Invalid network outputs are rejected", func(t *testing.T) {
+		_, err := devices.CreateSwitch("shelly://", "Broken Plug")
+		require.NotNil(t, err)
+		_, err = devices.CreateSwitch("http://relay.local/on", "Broken Relay")
+		require.NotNil(t, err)
+	})
+
+	fridge, err := devices.CreateSwitch("shelly://"+strings.TrimPrefix(fridgeServer.URL, "http://"), "Fridge")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_, err := devices.DeleteSwitchByID(fmt.Sprint(fridge.ID))
+		require.Nil(t, err)
+	})
+
+	t.Run("A switch turns a plug on and off", func(t *testing.T) {
+		require.True(t, fridge.Networked())
+		fridge.On()
+		require.True(t, fridgePlug.isOn())
+		require.Equal(t, model.SwitchModeOn, fridge.State())
+		require.Nil(t, fridge.OutputError())
+
+		fridge.Off()
+		require.False(t, fridgePlug.isOn())
+		require.Equal(t, model.SwitchModeOff, fridge.State())
+	})
+
+	t.Run("A plug cannot be dimmed", func(t *testing.T) {
+		frequency := 100
+		require.NotNil(t, fridge.UpdatePWM(&frequency, nil))
+	})
+
+	t.Run("An unreachable plug is off and reports why", func(t *testing.T) {
+		fridgePlug.fail(true)
+		fridge.On()
+		require.Equal(t, model.SwitchModeOff, fridge.State())
+		require.NotNil(t, fridge.OutputError())
+
+		fridgePlug.fail(false)
+		require.Eventually(t, func() bool {
+			fridge.On()
+			return fridgePlug.isOn()
+		}, time.Second, 20*time.Millisecond)
+		require.Nil(t, fridge.OutputError())
+		fridge.Off()
+	})
+
+	t.Run("Controller outputs cycle plugs instead of running PWM", func(t *testing.T) {
+		outputControl := devices.OutputControl{}
+		require.Nil(t, outputControl.UpdateGpios("Chamber", "shelly://"+strings.TrimPrefix(heaterServer.URL, "http://"), ""))
+		outputControl.CycleTime = 10
+		outputControl.HeatFrequency = 1000
+		outputControl.DutyCycle = 100
+		outputControl.CalculateOutput()
+		require.True(t, heaterPlug.isOn())
+
+		outputControl.DutyCycle = 0
+		outputControl.CalculateOutput()
+		require.False(t, heaterPlug.isOn())
+		require.Nil(t, outputControl.UpdateGpios("Chamber", "", ""))
+	})
+
+	t.Run("A slow plug does not hold up the timers of other switches", func(t *testing.T) {
+		answering, release := make(chan struct{}, 1), make(chan struct{})
+		slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case answering <- struct{}{}:
+			default:
+			}
+			<-release
+			fmt.Fprint(w, `{"ison": true}`)
+		}))
+		t.Cleanup(slowServer.Close)
+		hardware.NetworkTimeout = 5 * time.Second
+		slow, err := devices.CreateSwitch("shelly://"+strings.TrimPrefix(slowServer.URL, "http://"), "Slow Plug")
+		hardware.NetworkTimeout = 100 * time.Millisecond
+		require.Nil(t, err)
+		purge, purgePin := automationSwitch(t, "Slow Purge", 903)
+
+		start := time.Now()
+		switched := make(chan struct{})
+		go func() {
+			slow.OnFor(1, after(start, 0))
+			close(switched)
+		}()
+		<-answering
+
+		require.Nil(t, purge.OnFor(1, after(start, 0)))
+		devices.CheckSwitchTimers(after(start, 1))
+		require.Equal(t, gpio.Low, purgePin.Read())
+		require.NotNil(t, slow.Timer(nil))
+
+		close(release)
+		<-switched
+		_, err = devices.DeleteSwitchByID(fmt.Sprint(slow.ID))
+		require.Nil(t, err)
+	})
+}
@@ -1,14 +1,17 @@
 package devices
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"gorm.io/gorm"
 	"periph.io/x/periph/conn/gpio"
-	"periph.io/x/periph/conn/gpio/gpioreg"
 )
 
 var outpins []*OutPin = nil
@@ -22,16 +25,30 @@ type OutPin struct {
 	PinIO        gpio.PinIO `gorm:"-"`
 	onTime       *time.Time
 	offTime      *time.Time
+	duty         int64         // PWM duty in percent, 0 when the pin is on/off
+	frequency    int64         // PWM frequency in Hz
+	softPWM      chan struct{} // Stops the software PWM goroutine
+	softPWMDone  chan struct{}
+	failed       bool   // The last attempt to set the pin failed, a safety fault is only published for the first failure
+	fault        string // A safety fault to publish once the lock is released
+	// lock guards the pin, its times and the PWM, they are set from the control loops, inputs, timers, scenes and the APIs
+	lock sync.Mutex
 }
 
 func (op *OutPin) off() bool {
 	if op == nil {
 		return false
 	}
+	op.lock.Lock()
+	defer op.unlock()
+	return op.offLocked()
+}
 
+// offLocked turns the pin off while the lock is held
+func (op *OutPin) offLocked() bool {
 	if op.PinIO == nil {
 		log.Warn().Msgf("Resetting off %v", op.Identifier)
-		err := op.reset()
+		err := op.resetLocked()
 		if err != nil {
 			return false
 		}
@@ -42,13 +59,17 @@ func (op *OutPin) off() bool {
 		return false
 	}
 
-	if op.offTime != nil && op.PinIO.Read() == gpio.Low {
+	pwmRunning := op.stopPWM()
+	if !pwmRunning && op.offTime != nil && op.PinIO.Read() == gpio.Low {
 		return false
 	}
 
 	if err := op.PinIO.Out(gpio.Low); err != nil {
-		log.Fatal().Err(err).Msgf("Failed to set %v to Low (off)", op.FriendlyName)
+		logOutError(err).Msgf("Failed to set %v to Low (off)", op.FriendlyName)
+		op.outputFailed(err)
+		return false
 	}
+	op.failed = false
 	curTime := time.Now()
 	op.offTime = &curTime
 	op.onTime = nil
@@ -59,10 +80,16 @@ func (op *OutPin) on() bool {
 	if op == nil {
 		return false
 	}
+	op.lock.Lock()
+	defer op.unlock()
+	return op.onLocked()
+}
 
+// onLocked turns the pin on while the lock is held
+func (op *OutPin) onLocked() bool {
 	if op.PinIO == nil {
 		log.Warn().Msgf("Rsetting on %v", op.Identifier)
-		err := op.reset()
+		err := op.resetLocked()
 		if err != nil {
 			return false
 		}
@@ -73,18 +100,23 @@ func (op *OutPin) on() bool {
 		return false
 	}
 
-	if op.onTime != nil && op.PinIO.Read() == gpio.High {
+	pwmRunning := op.stopPWM()
+	if !pwmRunning && op.onTime != nil && op.PinIO.Read() == gpio.High {
 		return false
 	}
 
 	if err := op.PinIO.Out(gpio.High); err != nil {
-		log.Fatal().Err(err).Msgf("Failed to set %v to High (on)", op.FriendlyName)
+		logOutError(err).Msgf("Failed to set %v to High (on)", op.FriendlyName)
+		op.outputFailed(err)
+		return false
 	}
+	op.failed = false
 
 	if op.PinIO.Read() != gpio.High {
 		log.Warn().Msg("Failed to turn pin on! resetting and trying again")
 		if err := op.PinIO.Out(gpio.High); err != nil {
-			log.Fatal().Err(err).Msgf("Failed to set %v to High (on)", op.FriendlyName)
+			log.Error().Err(err).Msgf("Failed to set %v to High (on)", op.FriendlyName)
+			return false
 		}
 		if op.PinIO.Read() != gpio.High {
 			log.Warn().Msg("Failed to turn pin on!")
@@ -97,25 +129,53 @@ func (op *OutPin) on() bool {
 }
 
 func (op *OutPin) reset() error {
+	if op == nil {
+		return nil
+	}
+	op.lock.Lock()
+	defer op.unlock()
+	return op.resetLocked()
+}
+
+// resetLocked looks up the pin and turns it off while the lock is held
+func (op *OutPin) resetLocked() error {
 	if len(strings.TrimSpace(op.Identifier)) == 0 {
-		if op != nil {
-			op.off()
-		}
+		op.offLocked()
 		return nil
 	}
 
 	if op.PinIO == nil {
-		op.PinIO = gpioreg.ByName(op.Identifier)
+		op.PinIO = hardware.Pin(op.Identifier)
 		if op.PinIO == nil {
 			log.Error().Msgf("No Pin for %v!\n", op.Identifier)
 			return fmt.Errorf("no pin for %v", op.Identifier)
 		}
 	}
 	log.Warn().Msgf("Reset %v", op.Identifier)
-	op.off()
+	op.offLocked()
 	return nil
 }
 
+// unlock releases the lock, then publishes any safety fault found while it was held
+func (op *OutPin) unlock() {
+	fault := op.fault
+	op.fault = ""
+	op.lock.Unlock()
+	if len(fault) > 0 {
+		publishFault(op.FriendlyName, op.Identifier, fault)
+	}
+}
+
+// changedAt - When the pin was last turned on and off, only one of them is set
+func (op *OutPin) changedAt() (onTime *time.Time, offTime *time.Time) {
+	if op == nil {
+		return nil, nil
+	}
+	op.lock.Lock()
+	defer op.lock.Unlock()
+	return op.onTime, op.offTime
+}
+
 func (op *OutPin) update(identifier string) error {
 	if len(strings.TrimSpace(identifier)) == 0 {
 		err := op.reset()
@@ -135,8 +195,13 @@ func (op *OutPin) update(identifier string) error {
 		if GpioInUse(identifier) {
 			return fmt.Errorf("gpio %v is already in use", identifier)
 		}
+		if err := validateOutput(identifier); err != nil {
+			return err
+		}
+		op.lock.Lock()
 		op.PinIO = nil
 		op.Identifier = identifier
+		op.lock.Unlock()
 
 		err = op.reset()
 		if err != nil {
@@ -146,13 +211,23 @@ func (op *OutPin) update(identifier string) error {
 	return nil
 }
 
-// GpioInUse - Returns true if the GPIO specified is in use already
+// GpioInUse - Returns true if the GPIO specified is in use already, as an output, an input or a flow meter
 func GpioInUse(identifier string) bool {
 	for _, outpin := range outpins {
 		if strings.EqualFold(outpin.Identifier, identifier) {
 			return true
 		}
 	}
+	for _, inpin := range inpins {
+		if strings.EqualFold(inpin.Identifier, identifier) {
+			return true
+		}
+	}
+	for _, meter := range flowMeters {
+		if strings.EqualFold(meter.Identifier, identifier) {
+			return true
+		}
+	}
 	return false
 }
 
@@ -180,10 +255,12 @@ func (op *OutPin) Read() *gpio.Level {
 	if op == nil {
 		return nil
 	}
+	op.lock.Lock()
+	defer op.unlock()
 
 	if op.PinIO == nil {
 		log.Warn().Msgf("Resetting off %v", op.Identifier)
-		err := op.reset()
+		err := op.resetLocked()
 		if err != nil {
 			return nil
 		}
@@ -202,7 +279,59 @@ func createOutpin(identifier string, friendlyName string) (*OutPin, error) {
 	if GpioInUse(identifier) {
 		return nil, fmt.Errorf("GPIO '%v' is already in use", identifier)
 	}
+	if err := validateOutput(identifier); err != nil {
+		return nil, err
+	}
 	newPin := &OutPin{Identifier: identifier, FriendlyName: friendlyName}
 	outpins = append(outpins, newPin)
 	return newPin, nil
 }
+
+// Networked - Returns true if the output is a smart plug or relay on the network rather than a GPIO
+func (op *OutPin) Networked() bool {
+	return op != nil && hardware.IsNetworkOutput(op.Identifier)
+}
+
+// External - Returns true if the output is on another device, on the network or a serial port, rather than a GPIO
+func (op *OutPin) External() bool {
+	return op != nil && hardware.IsExternalOutput(op.Identifier)
+}
+
+// Error - Why the output could not be set or read back, empty when it is working
+func (op *OutPin) Error() string {
+	if op == nil {
+		return ""
+	}
+	op.lock.Lock()
+	defer op.lock.Unlock()
+	if pin, ok := op.PinIO.(*hardware.DriverPin); ok && pin.Err() != nil {
+		return pin.Err().Error()
+	}
+	return ""
+}
+
+// validateOutput checks an output on another device, any other identifier is looked up when the pin is used
+func validateOutput(identifier string) error {
+	if !hardware.IsExternalOutput(identifier) {
+		return nil
+	}
+	_, err := hardware.NewOutputDriver(identifier)
+	return err
+}
+
+// outputFailed publishes a safety fault the first time the pin cannot be set, once the lock is released
+func (op *OutPin) outputFailed(err error) {
+	if op.failed {
+		return
+	}
+	op.failed = true
+	op.fault = fmt.Sprintf("the output could not be set: %v", err)
+}
+
+// logOutError logs a failure to set a pin, an unreachable output on another device is only logged when it is first found to be unreachable
+func logOutError(err error) *zerolog.Event {
+	if errors.Is(err, hardware.ErrUnreachable) {
+		return log.Debug().Err(err)
+	}
+	return log.Error().Err(err)
+}
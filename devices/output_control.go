@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -22,10 +23,14 @@ var CancelFunc = cancelFunc
 // OutputControl is a basic struct to handle heating outputs with a duty cyclke
 type OutputControl struct {
 	gorm.Model
-	HeatOutput *OutPin
-	CoolOutput *OutPin
-	DutyCycle  int64 `gorm:"-"`
-	CycleTime  int64 `gorm:"-"`
+	HeatOutput    *OutPin
+	CoolOutput    *OutPin
+	DutyCycle     int64      `gorm:"-"`
+	CycleTime     int64      `gorm:"-"`
+	HeatFrequency int64      `gorm:"-"` // PWM frequency in Hz for the heat output, 0 cycles it on and off
+	CoolFrequency int64      `gorm:"-"` // PWM frequency in Hz for the cool output, 0 cycles it on and off
+	heldOff       bool       // An interlock is holding both outputs off
+	heldOffLock   sync.Mutex // heldOff is set by the input and controller goroutines
 }
 
 // RegisterGpios - Register the outpins with the master list
@@ -114,8 +119,54 @@ func (o *OutputControl) UpdateGpios(parentName string, heatGpio string, coolGpio
 	return nil
 }
 
+// HoldOff - Hold both outputs off until the controller next updates, it is safe to call from any goroutine, e.g. an interlock input
+func (o *OutputControl) HoldOff() {
+	o.hold(true)
+}
+
+// HeldOff - Returns true while an interlock is holding the outputs off
+func (o *OutputControl) HeldOff() bool {
+	if o == nil {
+		return false
+	}
+	o.heldOffLock.Lock()
+	defer o.heldOffLock.Unlock()
+	return o.heldOff
+}
+
+func (o *OutputControl) hold(held bool) {
+	if o == nil {
+		return
+	}
+	o.heldOffLock.Lock()
+	defer o.heldOffLock.Unlock()
+	o.heldOff = held
+}
+
 // CalculateOutput - Turn on and off the output pin for this output control depending on the duty cycle
+// Outputs with a PWM frequency run at the duty cycle instead of being cycled on and off
+// Outputs on other devices cannot run PWM, so they are always cycled
+// Both outputs are off while an interlock holds them off, whatever the duty cycle
 func (o *OutputControl) CalculateOutput() {
+	if o.HeldOff() {
+		o.HeatOutput.off()
+		o.CoolOutput.off()
+		return
+	}
+	if o.DutyCycle > 0 && o.HeatFrequency > 0 && !o.HeatOutput.External() {
+		o.CoolOutput.off()
+		if err := o.HeatOutput.pwm(o.DutyCycle, o.HeatFrequency); err != nil {
+			log.Error().Err(err).Msgf("Failed to set the PWM for %v", o.HeatOutput.FriendlyName)
+		}
+		return
+	}
+	if o.DutyCycle < 0 && o.CoolFrequency > 0 && !o.CoolOutput.External() {
+		o.HeatOutput.off()
+		if err := o.CoolOutput.pwm(-o.DutyCycle, o.CoolFrequency); err != nil {
+			log.Error().Err(err).Msgf("Failed to set the PWM for %v", o.CoolOutput.FriendlyName)
+		}
+		return
+	}
 	cycleSeconds := math.Abs(float64(o.CycleTime*o.DutyCycle) / 100)
 	if cycleSeconds == 0 {
 		o.HeatOutput.off()
@@ -132,16 +183,16 @@ func (o *OutputControl) CalculateOutput() {
 		}
 	} else if o.DutyCycle > 0 {
 		o.CoolOutput.off()
-		if o.HeatOutput.onTime != nil {
+		if onTime, offTime := o.HeatOutput.changedAt(); onTime != nil {
 			// it's on, do we need to turn it off?
-			changedAt := time.Since(*o.HeatOutput.onTime)
+			changedAt := time.Since(*onTime)
 			if changedAt.Seconds() > float64(cycleSeconds) {
 				log.Info().Msgf("Heat output (%v) turning off after %v seconds", o.HeatOutput.FriendlyName, changedAt.Seconds())
 				o.HeatOutput.off()
 			}
-		} else if o.HeatOutput.offTime != nil {
+		} else if offTime != nil {
 			// it's off, do we need to turn it on?
-			changedAt := time.Since(*o.HeatOutput.offTime)
+			changedAt := time.Since(*offTime)
 			offSeconds := float64(o.CycleTime) - cycleSeconds
 			if changedAt.Seconds() >= offSeconds {
 				log.Info().Msgf("Heat output (%v) turning on after %v seconds", o.HeatOutput.FriendlyName, changedAt.Seconds())
@@ -154,16 +205,16 @@ func (o *OutputControl) CalculateOutput() {
 	} else if o.DutyCycle < 0 {
 		o.HeatOutput.off()
 
-		if o.CoolOutput.onTime != nil {
+		if onTime, offTime := o.CoolOutput.changedAt(); onTime != nil {
 			// it's on, do we need to turn it off?
-			changedAt := time.Since(*o.CoolOutput.onTime)
+			changedAt := time.Since(*onTime)
 			if changedAt.Seconds() > float64(cycleSeconds) {
 				log.Info().Msgf("Cool output (%v) turning off after %v seconds\n", o.CoolOutput.FriendlyName, changedAt.Seconds())
 				o.CoolOutput.off()
 			}
-		} else if o.CoolOutput.offTime != nil {
+		} else if offTime != nil {
 			// it's off, do we need to turn it on?
-			changedAt := time.Since(*o.CoolOutput.offTime)
+			changedAt := time.Since(*offTime)
 			offSeconds := float64(o.CycleTime) - cycleSeconds
 			if changedAt.Seconds() >= offSeconds {
 				log.Info().Msgf("Cool output (%v) turning on after %v seconds\n", o.CoolOutput.FriendlyName, changedAt.Seconds())
@@ -0,0 +1,225 @@
+package devices
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"periph.io/x/periph/conn/physic"
+)
+
+var probeSettings []*ProbeSettings = nil
+
+// ProbeSettings is the persisted registry entry for a physical probe, independent of any temperature controller
+type ProbeSettings struct {
+	gorm.Model
+	database.InstanceScoped
+	PhysAddr   string `gorm:"index"`
+	Name       string
+	Location   string
+	Notes      string
+	SensorType string
+	Enabled    bool
+	Resolution int                 // The resolution in bits to read the probe at, 0 for the hardware default
+	LowLimit   *physic.Temperature // A probeOutOfRange event is published when the reading goes below this
+	HighLimit  *physic.Temperature // A probeOutOfRange event is published when the reading goes above this
+}
+
+// AllProbeSettings returns every registered probe, loading from the Database if none are loaded
+func AllProbeSettings() []*ProbeSettings {
+	if probeSettings == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Probe settings array is nil, checking the database...")
+		database.FetchDatabase().Debug().Find(&probeSettings)
+		for _, settings := range probeSettings {
+			if err := hardware.SetResolution(settings.PhysAddr, settings.Resolution); err != nil {
+				log.Error().Err(err).Msgf("Invalid resolution for %v", settings.PhysAddr)
+			}
+		}
+	}
+	return probeSettings
+}
+
+// FindProbeSettings returns the registry entry for a physical address, or nil if the probe is not registered
+func FindProbeSettings(physAddr string) *ProbeSettings {
+	for _, settings := range AllProbeSettings() {
+		if settings.PhysAddr == physAddr {
+			return settings
+		}
+	}
+	return nil
+}
+
+// ProbeEnabled returns false only when a probe has been registered and disabled
+func ProbeEnabled(physAddr string) bool {
+	settings := FindProbeSettings(physAddr)
+	return settings == nil || settings.Enabled
+}
+
+// ProbeName returns the registered name of a probe, falling back to the physical address
+func ProbeName(physAddr string) string {
+	settings := FindProbeSettings(physAddr)
+	if settings == nil || len(strings.TrimSpace(settings.Name)) == 0 {
+		return physAddr
+	}
+	return settings.Name
+}
+
+// TemperatureProbeModel joins the live probe with its registry entry, either may be missing, for the GraphQL and REST APIs
+func TemperatureProbeModel(physAddr string) *model.TemperatureProbe {
+	address := physAddr
+	name := ProbeName(physAddr)
+	probe := model.TemperatureProbe{PhysAddr: &address, Name: &name, Enabled: true}
+
+	if settings := FindProbeSettings(physAddr); settings != nil {
+		probe.Location = &settings.Location
+		probe.Notes = &settings.Notes
+		probe.SensorType = &settings.SensorType
+		probe.Enabled = settings.Enabled
+		probe.LowLimit = temperatureString(settings.LowLimit)
+		probe.HighLimit = temperatureString(settings.HighLimit)
+	}
+
+	if device := hardware.GetTemperature(physAddr); device != nil {
+		reading := device.Reading()
+		updated := device.Updated
+		probe.Reading = &reading
+		probe.Updated = &updated
+		probe.Connected = device.Connected
+		resolution := device.Resolution()
+		latency := float64(device.ReadLatency) / float64(time.Millisecond)
+		reads := int(device.Reads)
+		readErrors := int(device.ReadErrors)
+		probe.Resolution = &resolution
+		probe.ReadLatency = &latency
+		probe.Reads = &reads
+		probe.ReadErrors = &readErrors
+		probe.ColdJunction = temperatureString(device.ColdJunction)
+		probe.Gravity = device.Gravity
+	}
+	return &probe
+}
+
+// UpdateProbeSettings - Create or update the registry entry for a probe, renaming it on any controller it is assigned to
+func UpdateProbeSettings(newSettings model.ProbeSettingsInput) (*ProbeSettings, error) {
+	if len(strings.TrimSpace(newSettings.PhysAddr)) == 0 {
+		return nil, fmt.Errorf("a physical address is required to update a probe")
+	}
+
+	lowLimit, err := parseLimit(newSettings.LowLimit)
+	if err != nil {
+		return nil, err
+	}
+	highLimit, err := parseLimit(newSettings.HighLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := FindProbeSettings(newSettings.PhysAddr)
+	if newSettings.LowLimit == nil && settings != nil {
+		lowLimit = settings.LowLimit
+	}
+	if newSettings.HighLimit == nil && settings != nil {
+		highLimit = settings.HighLimit
+	}
+	if lowLimit != nil && highLimit != nil && *lowLimit >= *highLimit {
+		return nil, fmt.Errorf("the low limit %v must be below the high limit %v", lowLimit, highLimit)
+	}
+
+	if newSettings.Name != nil {
+		for _, other := range probeSettings {
+			if other != settings && len(strings.TrimSpace(*newSettings.Name)) > 0 && strings.EqualFold(other.Name, *newSettings.Name) {
+				return nil, fmt.Errorf("probe '%v' already exists", *newSettings.Name)
+			}
+		}
+	}
+
+	if newSettings.Resolution != nil {
+		if err := hardware.SetResolution(newSettings.PhysAddr, *newSettings.Resolution); err != nil {
+			return nil, err
+		}
+	}
+
+	if settings == nil {
+		settings = &ProbeSettings{PhysAddr: newSettings.PhysAddr, Enabled: true}
+		probeSettings = append(probeSettings, settings)
+	}
+
+	if newSettings.Name != nil {
+		settings.Name = *newSettings.Name
+	}
+	if newSettings.Location != nil {
+		settings.Location = *newSettings.Location
+	}
+	if newSettings.Notes != nil {
+		settings.Notes = *newSettings.Notes
+	}
+	if newSettings.SensorType != nil {
+		settings.SensorType = *newSettings.SensorType
+	}
+	if newSettings.Enabled != nil {
+		settings.Enabled = *newSettings.Enabled
+	}
+	if newSettings.Resolution != nil {
+		settings.Resolution = *newSettings.Resolution
+	}
+	settings.LowLimit, settings.HighLimit = lowLimit, highLimit
+	settings.Save()
+
+	if detail := FindTempProbeDetail(settings.PhysAddr); detail != nil {
+		detail.FriendlyName = ProbeName(settings.PhysAddr)
+		database.Save(detail)
+	}
+	return settings, nil
+}
+
+// parseLimit reads a probe limit such as 24C, an empty limit removes it
+func parseLimit(limit *string) (*physic.Temperature, error) {
+	if limit == nil || len(strings.TrimSpace(*limit)) == 0 {
+		return nil, nil
+	}
+	temperature := physic.Temperature(0)
+	if err := temperature.Set(strings.ToUpper(*limit)); err != nil {
+		return nil, fmt.Errorf("invalid limit '%v': %v", *limit, err)
+	}
+	return &temperature, nil
+}
+
+// DeleteProbeSettings - Remove the registry entry for a probe, it keeps working but loses its name and metadata
+func DeleteProbeSettings(physAddr string) (*ProbeSettings, error) {
+	settings := FindProbeSettings(physAddr)
+	if settings == nil {
+		return nil, fmt.Errorf("no probe registered with address '%v'", physAddr)
+	}
+
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Delete(settings)
+	}
+	for i, s := range probeSettings {
+		if s == settings {
+			probeSettings[i] = probeSettings[len(probeSettings)-1]
+			probeSettings = probeSettings[:len(probeSettings)-1]
+			break
+		}
+	}
+
+	if detail := FindTempProbeDetail(physAddr); detail != nil {
+		detail.FriendlyName = physAddr
+		database.Save(detail)
+	}
+	return settings, nil
+}
+
+// ClearProbeSettings reset the cached probe registry
+func ClearProbeSettings() {
+	probeSettings = nil
+}
+
+// Save - Helper to save this object
+func (p *ProbeSettings) Save() {
+	database.Save(p)
+}
@@ -0,0 +1,104 @@
+package devices_test
+
+import (
+	"testing"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeSettings(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearControllers()
+	devices.ClearProbeSettings()
+	t.Cleanup(devices.ClearProbeSettings)
+
+	name := "Fermenter 1 Bottom"
+	location := "Conical 1"
+
+	t.Run("Unregistered probes are enabled and named after their address", func(t *testing.T) {
+		require.Nil(t, devices.FindProbeSettings("RegistryAddress"))
+		require.True(t, devices.ProbeEnabled("RegistryAddress"))
+		require.Equal(t, "RegistryAddress", devices.ProbeName("RegistryAddress"))
+	})
+
+	t.Run("A physical address is required", func(t *testing.T) {
+		_, err := devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: " ", Name: &name})
+		require.NotNil(t, err)
+	})
+
+	t.Run("An unassigned probe can be named", func(t *testing.T) {
+		settings, err := devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: "RegistryAddress", Name: &name, Location: &location})
+		require.Nil(t, err)
+		require.Equal(t, name, settings.Name)
+		require.Equal(t, location, settings.Location)
+		require.True(t, settings.Enabled)
+		require.Equal(t, name, devices.ProbeName("RegistryAddress"))
+	})
+
+	t.Run("The probe model joins the registry entry without a live probe", func(t *testing.T) {
+		probe := devices.TemperatureProbeModel("RegistryAddress")
+		require.Equal(t, name, *probe.Name)
+		require.Equal(t, location, *probe.Location)
+		require.True(t, probe.Enabled)
+		require.False(t, probe.Connected)
+		require.Nil(t, probe.Reading)
+	})
+
+	t.Run("The registry is persisted", func(t *testing.T) {
+		devices.ClearProbeSettings()
+		settings := devices.FindProbeSettings("RegistryAddress")
+		require.NotNil(t, settings)
+		require.Equal(t, name, settings.Name)
+	})
+
+	t.Run("The resolution is persisted and applied to the hardware", func(t *testing.T) {
+		resolution := 12
+		_, err := devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: "RegistryAddress", Resolution: &resolution})
+		require.Nil(t, err)
+
+		devices.ClearProbeSettings()
+		require.Nil(t, hardware.SetResolution("RegistryAddress", 0))
+		require.Equal(t, 12, devices.FindProbeSettings("RegistryAddress").Resolution)
+		require.Equal(t, 12, (&hardware.TemperatureProbe{PhysAddr: "RegistryAddress"}).Resolution())
+
+		invalid := 16
+		_, err = devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: "RegistryAddress", Resolution: &invalid})
+		require.NotNil(t, err)
+	})
+
+	t.Run("Two probes cannot have the same name", func(t *testing.T) {
+		_, err := devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: "OtherAddress", Name: &name})
+		require.NotNil(t, err)
+		require.Nil(t, devices.FindProbeSettings("OtherAddress"))
+	})
+
+	t.Run("Renaming a probe renames it on its controller", func(t *testing.T) {
+		controller, err := devices.CreateTemperatureController("Registry", &devices.TempProbeDetail{PhysAddr: "RegistryAddress", FriendlyName: "RegistryAddress"})
+		require.Nil(t, err)
+
+		newName := "Fermenter 1 Top"
+		_, err = devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: "RegistryAddress", Name: &newName})
+		require.Nil(t, err)
+		require.Equal(t, newName, controller.TempProbeDetails[0].FriendlyName)
+	})
+
+	t.Run("A probe can be disabled", func(t *testing.T) {
+		enabled := false
+		_, err := devices.UpdateProbeSettings(model.ProbeSettingsInput{PhysAddr: "RegistryAddress", Enabled: &enabled})
+		require.Nil(t, err)
+		require.False(t, devices.ProbeEnabled("RegistryAddress"))
+	})
+
+	t.Run("Forgetting a probe removes it from the registry", func(t *testing.T) {
+		_, err := devices.DeleteProbeSettings("RegistryAddress")
+		require.Nil(t, err)
+		require.Nil(t, devices.FindProbeSettings("RegistryAddress"))
+		require.Equal(t, "RegistryAddress", devices.ProbeName("RegistryAddress"))
+
+		_, err = devices.DeleteProbeSettings("RegistryAddress")
+		require.NotNil(t, err)
+	})
+}
@@ -0,0 +1,146 @@
+package devices
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/physic"
+)
+
+// MaxPWMFrequency is the highest PWM frequency that can be configured, in Hz
+const MaxPWMFrequency = 100000
+
+// minSoftwarePWMPeriod is the shortest period software PWM runs at, higher frequencies are slowed down to it
+const minSoftwarePWMPeriod = 10 * time.Millisecond
+
+// validatePWM - Check a PWM frequency in Hz and a duty cycle in percent
+func validatePWM(frequency int64, duty int64) error {
+	if frequency < 0 || frequency > MaxPWMFrequency {
+		return fmt.Errorf("frequency must be between 0 and %vHz, got %v", MaxPWMFrequency, frequency)
+	}
+	if duty < 0 || duty > 100 {
+		return fmt.Errorf("duty must be between 0 and 100%%, got %v", duty)
+	}
+	return nil
+}
+
+// Duty - The duty cycle in percent the pin is running PWM at, 0 when it is on/off
+func (op *OutPin) Duty() int64 {
+	if op == nil {
+		return 0
+	}
+	op.lock.Lock()
+	defer op.lock.Unlock()
+	return op.duty
+}
+
+// SoftwarePWM - Returns true if the PWM is timed in software because the pin has no hardware PWM
+func (op *OutPin) SoftwarePWM() bool {
+	if op == nil {
+		return false
+	}
+	op.lock.Lock()
+	defer op.lock.Unlock()
+	return op.softPWM != nil
+}
+
+// pwm runs the pin at a duty cycle (in percent) and frequency (in Hz)
+// Hardware PWM is used when the pin supports it, otherwise the pin is toggled in software
+// 0 and 100% turn the pin off and on
+func (op *OutPin) pwm(duty int64, frequency int64) error {
+	if op == nil {
+		return nil
+	}
+	if err := validatePWM(frequency, duty); err != nil {
+		return err
+	}
+	if op.External() && duty > 0 && duty < 100 {
+		return fmt.Errorf("%v is an output on another device, it cannot run PWM", op.Identifier)
+	}
+	op.lock.Lock()
+	defer op.unlock()
+	if duty == 0 || frequency == 0 {
+		op.offLocked()
+		return nil
+	}
+	if duty == 100 {
+		op.onLocked()
+		return nil
+	}
+	if op.duty == duty && op.frequency == frequency {
+		return nil
+	}
+
+	if op.PinIO == nil {
+		if err := op.resetLocked(); err != nil {
+			return err
+		}
+	}
+	op.stopPWM()
+
+	f := physic.Frequency(frequency) * physic.Hertz
+	if err := op.PinIO.PWM(gpio.Duty(int64(gpio.DutyMax)*duty/100), f); err != nil {
+		log.Warn().Err(err).Msgf("No hardware PWM on %v, using software PWM", op.Identifier)
+		op.softwarePWM(duty, f.Period())
+	}
+
+	curTime := time.Now()
+	op.offTime = nil
+	op.onTime = &curTime
+	op.duty = duty
+	op.frequency = frequency
+	return nil
+}
+
+// softwarePWM toggles the pin from a goroutine until stopPWM is called, the lock is held
+func (op *OutPin) softwarePWM(duty int64, period time.Duration) {
+	if period < minSoftwarePWMPeriod {
+		log.Warn().Msgf("Software PWM on %v is limited to %v", op.Identifier, time.Second/minSoftwarePWMPeriod)
+		period = minSoftwarePWMPeriod
+	}
+	onFor := period * time.Duration(duty) / 100
+	quit := make(chan struct{})
+	done := make(chan struct{})
+	op.softPWM = quit
+	op.softPWMDone = done
+
+	pin, identifier := op.PinIO, op.Identifier
+	go func() {
+		defer close(done)
+		for {
+			if err := pin.Out(gpio.High); err != nil {
+				log.Error().Err(err).Msgf("Software PWM failed to set %v High", identifier)
+			}
+			select {
+			case <-quit:
+				return
+			case <-time.After(onFor):
+			}
+			if err := pin.Out(gpio.Low); err != nil {
+				log.Error().Err(err).Msgf("Software PWM failed to set %v Low", identifier)
+			}
+			select {
+			case <-quit:
+				return
+			case <-time.After(period - onFor):
+			}
+		}
+	}()
+}
+
+// stopPWM stops any PWM on the pin, returning true if it was running, the level of the pin is left to the caller
+// The lock is held, the software PWM goroutine does not take it
+func (op *OutPin) stopPWM() bool {
+	if op.softPWM != nil {
+		close(op.softPWM)
+		<-op.softPWMDone
+		op.softPWM = nil
+		op.softPWMDone = nil
+	}
+	running := op.duty != 0
+	op.duty = 0
+	op.frequency = 0
+	return running
+}
@@ -0,0 +1,164 @@
+package devices_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/gpio/gpioreg"
+	"periph.io/x/periph/conn/gpio/gpiotest"
+	"periph.io/x/periph/conn/physic"
+)
+
+// noPWMPin is a pin without hardware PWM
+type noPWMPin struct {
+	*gpiotest.Pin
+	levels chan gpio.Level
+}
+
+func (p *noPWMPin) PWM(duty gpio.Duty, f physic.Frequency) error {
+	return errors.New("no hardware PWM")
+}
+
+func (p *noPWMPin) Out(l gpio.Level) error {
+	select {
+	case p.levels <- l:
+	default:
+	}
+	return p.Pin.Out(l)
+}
+
+func TestPWM(t *testing.T) {
+	setupTestDb(t)
+
+	fanPin := &gpiotest.Pin{N: "PWM_FAN", Num: 301}
+	pumpPin := &noPWMPin{Pin: &gpiotest.Pin{N: "PWM_PUMP", Num: 302}, levels: make(chan gpio.Level, 1)}
+	require.Nil(t, gpioreg.Register(fanPin))
+	require.Nil(t, gpioreg.Register(pumpPin))
+
+	fan, err := devices.CreateSwitch("PWM_FAN", "Chamber Fan")
+	require.Nil(t, err)
+	pump, err := devices.CreateSwitch("PWM_PUMP", "DC Pump")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_, err := devices.DeleteSwitchByID(fmt.Sprint(fan.ID))
+		require.Nil(t, err)
+		_, err = devices.DeleteSwitchByID(fmt.Sprint(pump.ID))
+		require.Nil(t, err)
+	})
+
+	t.Run("Invalid PWM settings are rejected", func(t *testing.T) {
+		frequency, duty := 25000, 101
+		require.NotNil(t, fan.UpdatePWM(&frequency, &duty))
+		frequency = -1
+		require.NotNil(t, fan.UpdatePWM(&frequency, nil))
+		require.Equal(t, int64(0), fan.Frequency)
+	})
+
+	t.Run("A dimmer uses hardware PWM at its duty", func(t *testing.T) {
+		frequency, duty := 25000, 40
+		require.Nil(t, fan.UpdatePWM(&frequency, &duty))
+		require.Equal(t, model.SwitchModeOff, fan.State())
+
+		fan.On()
+		require.Equal(t, model.SwitchModeOn, fan.State())
+		require.False(t, fan.SoftwarePWM())
+		require.Equal(t, gpio.Duty(int64(gpio.DutyMax)*40/100), fanPin.D)
+		require.Equal(t, 25*physic.KiloHertz, fanPin.F)
+	})
+
+	t.Run("A dimmer that is on changes duty straight away", func(t *testing.T) {
+		duty := 75
+		require.Nil(t, fan.UpdatePWM(nil, &duty))
+		require.Equal(t, gpio.Duty(int64(gpio.DutyMax)*75/100), fanPin.D)
+		require.Equal(t, int64(75), fan.Output.Duty())
+	})
+
+	t.Run("Turning a dimmer off stops the PWM", func(t *testing.T) {
+		fan.Off()
+		require.Equal(t, model.SwitchModeOff, fan.State())
+		require.Equal(t, int64(0), fan.Output.Duty())
+		require.Equal(t, gpio.Low, fanPin.Read())
+	})
+
+	t.Run("Pins without hardware PWM are toggled in software", func(t *testing.T) {
+		frequency, duty := 50, 50
+		require.Nil(t, pump.UpdatePWM(&frequency, &duty))
+		pump.On()
+		require.True(t, pump.SoftwarePWM())
+		require.Equal(t, model.SwitchModeOn, pump.State())
+
+		seen := map[gpio.Level]bool{}
+		require.Eventually(t, func() bool {
+			seen[<-pumpPin.levels] = true
+			return seen[gpio.High] && seen[gpio.Low]
+		}, time.Second, time.Millisecond)
+
+		pump.Off()
+		require.False(t, pump.SoftwarePWM())
+		require.Equal(t, gpio.Low, pumpPin.Read())
+	})
+
+	t.Run("Full duty turns the pin on", func(t *testing.T) {
+		duty := 100
+		require.Nil(t, pump.UpdatePWM(nil, &duty))
+		pump.On()
+		require.False(t, pump.SoftwarePWM())
+		require.Equal(t, gpio.High, pumpPin.Read())
+		pump.Off()
+	})
+
+	t.Run("A dimmer without a duty runs at full power and a duty of 0 is off", func(t *testing.T) {
+		fan.Duty = nil
+		require.Equal(t, int64(100), fan.DimmerDuty())
+		fan.On()
+		require.Equal(t, gpio.High, fanPin.Read())
+
+		duty := 0
+		_, err := devices.ModifySwitch(model.SwitchSettingsInput{ID: strPointer(fmt.Sprint(fan.ID)), Duty: &duty})
+		require.Nil(t, err)
+		require.Equal(t, gpio.Low, fanPin.Read())
+		fan.On()
+		require.Equal(t, gpio.Low, fanPin.Read())
+		require.Equal(t, model.SwitchModeOff, fan.State())
+
+		stored := devices.Switch{}
+		require.Nil(t, database.FetchDatabase().First(&stored, fan.ID).Error)
+		require.Equal(t, int64(0), stored.DimmerDuty())
+	})
+
+	t.Run("Output control runs PWM outputs at the duty cycle", func(t *testing.T) {
+		heatPin := gpiotest.Pin{N: "PWM_HEAT", Num: 303}
+		coolPin := gpiotest.Pin{N: "PWM_COOL", Num: 304}
+		outputControl := devices.OutputControl{
+			HeatOutput:    &devices.OutPin{Identifier: "PWM_HEAT", FriendlyName: "PWM_HEAT", PinIO: &heatPin},
+			CoolOutput:    &devices.OutPin{Identifier: "PWM_COOL", FriendlyName: "PWM_COOL", PinIO: &coolPin},
+			CycleTime:     10,
+			HeatFrequency: 1000,
+			CoolFrequency: 25000,
+		}
+
+		outputControl.DutyCycle = 30
+		outputControl.CalculateOutput()
+		require.Equal(t, gpio.Duty(int64(gpio.DutyMax)*30/100), heatPin.D)
+		require.Equal(t, physic.KiloHertz, heatPin.F)
+		require.Equal(t, int64(30), outputControl.HeatOutput.Duty())
+
+		outputControl.DutyCycle = -60
+		outputControl.CalculateOutput()
+		require.Equal(t, int64(0), outputControl.HeatOutput.Duty())
+		require.Equal(t, gpio.Low, heatPin.Read())
+		require.Equal(t, gpio.Duty(int64(gpio.DutyMax)*60/100), coolPin.D)
+
+		outputControl.DutyCycle = 0
+		outputControl.CalculateOutput()
+		require.Equal(t, int64(0), outputControl.CoolOutput.Duty())
+		require.Equal(t, gpio.Low, coolPin.Read())
+	})
+}
@@ -0,0 +1,436 @@
+package devices
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SceneCheckInterval is how often WatchScenes runs the steps that are waiting for their delays
+var SceneCheckInterval = 100 * time.Millisecond
+
+var scenes []*Scene = nil
+var sceneLock sync.Mutex
+
+// Scene is a set of switch states that are applied together, e.g. Recirculate mash = pump 1 on, valve A open, valve B closed
+// Interlocks -> Conditions that have to match before the scene is applied, and before each step that waits for a delay
+type Scene struct {
+	gorm.Model
+	database.InstanceScoped
+	Name        string
+	Steps       []*SceneStep           `gorm:"ForeignKey:SceneID"`
+	Interlocks  []*AutomationCondition `gorm:"ForeignKey:SceneID"`
+	LastApplied *time.Time
+	LastResult  string       // What the steps did
+	LastError   string       // Why the scene was not applied, or stopped
+	pending     []*SceneStep `gorm:"-"` // The steps waiting for their delays
+	nextStepAt  time.Time    `gorm:"-"`
+}
+
+// SceneStep turns a switch, or every switch in a group, on or off, in order of Position
+type SceneStep struct {
+	gorm.Model
+	SceneID       uint `gorm:"index"`
+	Position      int64
+	SwitchID      *uint
+	SwitchGroupID *uint
+	State         model.SwitchMode
+	DelaySeconds  float64 // How long to wait after the previous step
+}
+
+// AllScenes returns all the scenes, loading from the Database if none are loaded
+func AllScenes() []*Scene {
+	sceneLock.Lock()
+	defer sceneLock.Unlock()
+	return loadScenes()
+}
+
+func loadScenes() []*Scene {
+	if scenes == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Scenes array is nil, checking the database...")
+		database.FetchDatabase().Debug().Preload(clause.Associations).Find(&scenes)
+		for _, scene := range scenes {
+			scene.sort()
+		}
+	}
+	return scenes
+}
+
+// FindSceneByID - Find a scene by id
+func FindSceneByID(id string) *Scene {
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	for _, scene := range AllScenes() {
+		if scene.ID == uint(intID) {
+			return scene
+		}
+	}
+	return nil
+}
+
+// ModifyScene - Create or update a scene, the steps and interlocks that are given replace the existing ones
+// Steps of the scene that are waiting for their delays are not run
+func ModifyScene(settings model.SceneInput) (*Scene, error) {
+	scene := &Scene{}
+	if settings.ID != nil {
+		scene = FindSceneByID(*settings.ID)
+		if scene == nil {
+			return nil, fmt.Errorf("no scene with id: %v found", *settings.ID)
+		}
+	} else if settings.Name == nil || len(settings.Steps) == 0 {
+		return nil, fmt.Errorf("name and steps are required when creating a scene")
+	}
+
+	updated := *scene
+	if settings.Name != nil {
+		updated.Name = strings.TrimSpace(*settings.Name)
+	}
+	if settings.Steps != nil {
+		if len(settings.Steps) == 0 {
+			return nil, fmt.Errorf("a scene needs at least one step")
+		}
+		updated.Steps = []*SceneStep{}
+		for i, stepSettings := range settings.Steps {
+			step, err := newSceneStep(*stepSettings, int64(i))
+			if err != nil {
+				return nil, err
+			}
+			updated.Steps = append(updated.Steps, step)
+		}
+	}
+	if settings.Interlocks != nil {
+		updated.Interlocks = []*AutomationCondition{}
+		for _, interlockSettings := range settings.Interlocks {
+			interlock, err := newAutomationCondition(*interlockSettings, false)
+			if err != nil {
+				return nil, err
+			}
+			updated.Interlocks = append(updated.Interlocks, interlock)
+		}
+	}
+
+	if len(updated.Name) == 0 {
+		return nil, fmt.Errorf("a scene needs a name")
+	}
+	for _, other := range AllScenes() {
+		if other != scene && strings.EqualFold(other.Name, updated.Name) {
+			return nil, fmt.Errorf("scene '%v' already exists", updated.Name)
+		}
+	}
+
+	sceneLock.Lock()
+	defer sceneLock.Unlock()
+	if database.FetchDatabase() != nil {
+		for _, step := range scene.Steps {
+			if !containsSceneStep(updated.Steps, step) {
+				database.FetchDatabase().Debug().Delete(step)
+			}
+		}
+		for _, interlock := range scene.Interlocks {
+			if !containsCondition(updated.Interlocks, interlock) {
+				database.FetchDatabase().Debug().Delete(interlock)
+			}
+		}
+	}
+	*scene = updated
+	scene.pending = nil
+	if scene.ID == 0 {
+		scenes = append(scenes, scene)
+	}
+	database.Save(scene)
+	return scene, nil
+}
+
+// newSceneStep validates the settings for a step
+func newSceneStep(settings model.SceneStepInput, position int64) (*SceneStep, error) {
+	step := &SceneStep{Position: position, State: settings.State}
+	if !settings.State.IsValid() {
+		return nil, fmt.Errorf("%v is not a valid switch state", settings.State)
+	}
+	if settings.DelaySeconds != nil {
+		if *settings.DelaySeconds < 0 {
+			return nil, fmt.Errorf("a step cannot wait for %v seconds", *settings.DelaySeconds)
+		}
+		step.DelaySeconds = *settings.DelaySeconds
+	}
+	if (settings.SwitchID == nil) == (settings.GroupID == nil) {
+		return nil, fmt.Errorf("a step needs a switch or a switch group")
+	}
+	if settings.SwitchID != nil {
+		s := FindSwitchByID(*settings.SwitchID)
+		if s == nil {
+			return nil, fmt.Errorf("no switch with id: %v found", *settings.SwitchID)
+		}
+		step.SwitchID = &s.ID
+		return step, nil
+	}
+	group := FindSwitchGroupByID(*settings.GroupID)
+	if group == nil {
+		return nil, fmt.Errorf("no switch group with id: %v found", *settings.GroupID)
+	}
+	step.SwitchGroupID = &group.ID
+	return step, nil
+}
+
+// DeleteSceneByID - Delete a scene, steps that are waiting for their delays are not run
+func DeleteSceneByID(id string) (*Scene, error) {
+	scene := FindSceneByID(id)
+	if scene == nil {
+		return nil, fmt.Errorf("no scene found with id '%v'", id)
+	}
+
+	sceneLock.Lock()
+	defer sceneLock.Unlock()
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Select(clause.Associations).Delete(scene)
+	}
+	scene.pending = nil
+	for i, s := range scenes {
+		if s == scene {
+			scenes[i] = scenes[len(scenes)-1]
+			scenes = scenes[:len(scenes)-1]
+			break
+		}
+	}
+	return scene, nil
+}
+
+// ClearScenes reset the cached scenes and switch groups
+func ClearScenes() {
+	sceneLock.Lock()
+	defer sceneLock.Unlock()
+	scenes = nil
+	switchGroups = nil
+}
+
+// Apply - Check the interlocks and switches of every step, then apply the steps that do not wait and queue the rest
+// Nothing is switched when an interlock does not match, other scenes waiting to switch the same switches are stopped
+func (s *Scene) Apply(now func() time.Time) error {
+	if now == nil {
+		now = time.Now
+	}
+	at := now()
+
+	sceneLock.Lock()
+	defer sceneLock.Unlock()
+	s.pending = nil
+	if err := s.ready(at, s.Steps); err != nil {
+		s.LastError = err.Error()
+		database.Save(s)
+		return err
+	}
+
+	switchIDs := sceneSwitchIDs(s.Steps)
+	for _, other := range loadScenes() {
+		if other != s && len(other.pending) > 0 && sharesSwitch(sceneSwitchIDs(other.pending), switchIDs) {
+			log.Info().Msgf("Scene %v stopped %v", s.Name, other.Name)
+			other.pending = nil
+			other.LastError = fmt.Sprintf("Stopped by %v", s.Name)
+			database.Save(other)
+		}
+	}
+
+	s.LastApplied = &at
+	s.LastResult, s.LastError = "", ""
+	s.pending = append([]*SceneStep{}, s.Steps...)
+	s.nextStepAt = at.Add(secondsDuration(s.pending[0].DelaySeconds))
+	s.advance(at)
+	database.Save(s)
+	return nil
+}
+
+// Applying - True while steps are waiting for their delays
+func (s *Scene) Applying() bool {
+	sceneLock.Lock()
+	defer sceneLock.Unlock()
+	return len(s.pending) > 0
+}
+
+// WatchScenes - Run the steps that are waiting for their delays every SceneCheckInterval until quit is closed
+func WatchScenes(quit <-chan struct{}) {
+	ticker := time.NewTicker(SceneCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			CheckScenes(nil)
+		}
+	}
+}
+
+// CheckScenes - Run the steps of the scenes being applied that have waited for their delays
+func CheckScenes(now func() time.Time) {
+	if !startCheck() {
+		return
+	}
+	defer finishCheck()
+	if now == nil {
+		now = time.Now
+	}
+	at := now()
+
+	sceneLock.Lock()
+	defer sceneLock.Unlock()
+	for _, scene := range loadScenes() {
+		if len(scene.pending) > 0 && !at.Before(scene.nextStepAt) {
+			scene.advance(at)
+			database.Save(scene)
+		}
+	}
+}
+
+// ready checks that the switches of the steps exist and that every interlock matches, while the scene lock is held
+func (s *Scene) ready(at time.Time, steps []*SceneStep) error {
+	for _, step := range steps {
+		if _, err := step.switches(); err != nil {
+			return err
+		}
+	}
+	failed := []string{}
+	for _, interlock := range s.Interlocks {
+		if matched, description := interlock.check(at, at, nil); !matched {
+			failed = append(failed, description)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("interlock not met: %v", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// advance applies the pending steps that are due, the interlocks are checked again before a step that waited
+func (s *Scene) advance(at time.Time) {
+	for len(s.pending) > 0 && !at.Before(s.nextStepAt) {
+		step := s.pending[0]
+		if step.DelaySeconds > 0 {
+			if err := s.ready(at, s.pending); err != nil {
+				log.Warn().Err(err).Msgf("Scene %v stopped", s.Name)
+				s.pending = nil
+				s.LastError = fmt.Sprintf("Stopped before step %v, %v", step.Position+1, err)
+				return
+			}
+		}
+
+		description, err := step.apply()
+		if err != nil {
+			log.Error().Err(err).Msgf("Scene %v step %v failed", s.Name, step.Position+1)
+			s.LastError = joinResult(s.LastError, err.Error())
+		} else {
+			s.LastResult = joinResult(s.LastResult, description)
+		}
+		s.pending = s.pending[1:]
+		if len(s.pending) > 0 {
+			s.nextStepAt = at.Add(secondsDuration(s.pending[0].DelaySeconds))
+		}
+	}
+	if len(s.pending) == 0 {
+		log.Info().Msgf("Scene %v applied: %v", s.Name, s.LastResult)
+	}
+}
+
+func (s *Scene) sort() {
+	sort.SliceStable(s.Steps, func(i, j int) bool { return s.Steps[i].Position < s.Steps[j].Position })
+	sort.SliceStable(s.Interlocks, func(i, j int) bool { return s.Interlocks[i].ID < s.Interlocks[j].ID })
+}
+
+// Switch - The switch the step turns on or off, nil for a group
+func (step *SceneStep) Switch() *Switch {
+	if step.SwitchID == nil {
+		return nil
+	}
+	return FindSwitchByID(fmt.Sprint(*step.SwitchID))
+}
+
+// Group - The switch group the step turns on or off, nil for a switch
+func (step *SceneStep) Group() *SwitchGroup {
+	if step.SwitchGroupID == nil {
+		return nil
+	}
+	return FindSwitchGroupByID(fmt.Sprint(*step.SwitchGroupID))
+}
+
+// switches finds the switches of the step while the scene lock is held
+func (step *SceneStep) switches() ([]*Switch, error) {
+	if step.SwitchID != nil {
+		s := FindSwitchByID(fmt.Sprint(*step.SwitchID))
+		if s == nil {
+			return nil, fmt.Errorf("switch %v no longer exists", *step.SwitchID)
+		}
+		return []*Switch{s}, nil
+	}
+	group := findSwitchGroup(fmt.Sprint(*step.SwitchGroupID))
+	if group == nil {
+		return nil, fmt.Errorf("switch group %v no longer exists", *step.SwitchGroupID)
+	}
+	return group.Switches(), nil
+}
+
+// apply the step while the scene lock is held, describing what it did
+func (step *SceneStep) apply() (string, error) {
+	switches, err := step.switches()
+	if err != nil {
+		return "", err
+	}
+	names := []string{}
+	for _, s := range switches {
+		if err := s.SetState(step.State); err != nil {
+			return "", err
+		}
+		names = append(names, s.Name())
+	}
+	if step.SwitchGroupID != nil {
+		return fmt.Sprintf("Turned %v %v (%v)", step.State, findSwitchGroup(fmt.Sprint(*step.SwitchGroupID)).Name, strings.Join(names, ", ")), nil
+	}
+	return fmt.Sprintf("Turned %v %v", step.State, strings.Join(names, ", ")), nil
+}
+
+// sceneSwitchIDs are the IDs of the switches the steps turn on or off, while the scene lock is held
+func sceneSwitchIDs(steps []*SceneStep) map[uint]bool {
+	ids := map[uint]bool{}
+	for _, step := range steps {
+		switches, _ := step.switches()
+		for _, s := range switches {
+			ids[s.ID] = true
+		}
+	}
+	return ids
+}
+
+func sharesSwitch(ids map[uint]bool, others map[uint]bool) bool {
+	for id := range ids {
+		if others[id] {
+			return true
+		}
+	}
+	return false
+}
+
+func joinResult(result string, description string) string {
+	if len(result) == 0 {
+		return description
+	}
+	return result + ", " + description
+}
+
+func containsSceneStep(steps []*SceneStep, step *SceneStep) bool {
+	for _, s := range steps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
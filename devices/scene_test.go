@@ -0,0 +1,182 @@
+package devices_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+)
+
+func TestScenes(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearScenes()
+	t.Cleanup(devices.ClearScenes)
+
+	pump, pumpPin := automationSwitch(t, "Pump 1", 1001)
+	valveA, valveAPin := automationSwitch(t, "Valve A", 1002)
+	valveB, valveBPin := automationSwitch(t, "Valve B", 1003)
+	heater, _ := automationSwitch(t, "Heater", 1004)
+	pumpID, valveAID, valveBID, heaterID := fmt.Sprint(pump.ID), fmt.Sprint(valveA.ID), fmt.Sprint(valveB.ID), fmt.Sprint(heater.ID)
+	on, off := model.SwitchModeOn, model.SwitchModeOff
+	delay := 5.0
+
+	t.Run("Invalid groups and scenes are rejected", func(t *testing.T) {
+		_, err := devices.ModifySwitchGroup(model.SwitchGroupInput{Name: strPointer("Valves")})
+		require.Equal(t, "name and switches are required when creating a switch group", err.Error())
+		_, err = devices.ModifySwitchGroup(model.SwitchGroupInput{Name: strPointer("Valves"), SwitchIds: []string{valveAID, "999"}})
+		require.Equal(t, "no switch with id: 999 found", err.Error())
+		_, err = devices.ModifySwitchGroup(model.SwitchGroupInput{Name: strPointer("Valves"), SwitchIds: []string{valveAID, valveAID}})
+		require.Equal(t, "Valve A is in the group more than once", err.Error())
+		require.Empty(t, devices.AllSwitchGroups())
+
+		_, err = devices.ModifyScene(model.SceneInput{Name: strPointer("Mash")})
+		require.Equal(t, "name and steps are required when creating a scene", err.Error())
+		_, err = devices.ModifyScene(model.SceneInput{Name: strPointer("Mash"), Steps: []*model.SceneStepInput{{State: on}}})
+		require.Equal(t, "a step needs a switch or a switch group", err.Error())
+		_, err = devices.ModifyScene(model.SceneInput{Name: strPointer("Mash"), Steps: []*model.SceneStepInput{{SwitchID: &pumpID, GroupID: strPointer("1"), State: on}}})
+		require.Equal(t, "a step needs a switch or a switch group", err.Error())
+		negative := -1.0
+		_, err = devices.ModifyScene(model.SceneInput{Name: strPointer("Mash"), Steps: []*model.SceneStepInput{{SwitchID: &pumpID, State: on, DelaySeconds: &negative}}})
+		require.Equal(t, "a step cannot wait for -1 seconds", err.Error())
+		_, err = devices.ModifyScene(model.SceneInput{Name: strPointer("Mash"), Steps: []*model.SceneStepInput{{GroupID: strPointer("999"), State: on}}})
+		require.Equal(t, "no switch group with id: 999 found", err.Error())
+		toggled := model.DeviceEventTypeSwitchToggled
+		_, err = devices.ModifyScene(model.SceneInput{
+			Name:       strPointer("Mash"),
+			Steps:      []*model.SceneStepInput{{SwitchID: &pumpID, State: on}},
+			Interlocks: []*model.AutomationConditionInput{{Kind: model.AutomationConditionKindEvent, Event: &toggled}},
+		})
+		require.Equal(t, "only the trigger can be an event", err.Error())
+		require.Empty(t, devices.AllScenes())
+	})
+
+	valves, err := devices.ModifySwitchGroup(model.SwitchGroupInput{Name: strPointer("Valves"), SwitchIds: []string{valveAID, valveBID}})
+	require.Nil(t, err)
+	require.Equal(t, []*devices.Switch{valveA, valveB}, valves.Switches())
+	valvesID := fmt.Sprint(valves.ID)
+
+	recirculate, err := devices.ModifyScene(model.SceneInput{
+		Name: strPointer("Recirculate mash"),
+		Steps: []*model.SceneStepInput{
+			{SwitchID: &valveAID, State: on},
+			{SwitchID: &valveBID, State: off},
+			{SwitchID: &pumpID, State: on, DelaySeconds: &delay},
+		},
+		Interlocks: []*model.AutomationConditionInput{{Kind: model.AutomationConditionKindSwitchOff, SwitchID: &heaterID}},
+	})
+	require.Nil(t, err)
+
+	t.Run("Switch groups turn their switches on and off together", func(t *testing.T) {
+		require.Nil(t, valves.SetState(on))
+		require.Equal(t, gpio.High, valveAPin.Read())
+		require.Equal(t, gpio.High, valveBPin.Read())
+		require.Equal(t, "turbo is not a valid switch state", valves.SetState("turbo").Error())
+
+		_, err := devices.ModifySwitchGroup(model.SwitchGroupInput{Name: strPointer("valves"), SwitchIds: []string{pumpID}})
+		require.Equal(t, "switch group 'valves' already exists", err.Error())
+	})
+
+	t.Run("Scenes apply their steps after the delays", func(t *testing.T) {
+		start := time.Now()
+		require.Nil(t, recirculate.Apply(after(start, 0)))
+		require.Equal(t, gpio.High, valveAPin.Read())
+		require.Equal(t, gpio.Low, valveBPin.Read())
+		require.Equal(t, gpio.Low, pumpPin.Read())
+		require.True(t, recirculate.Applying())
+		require.Equal(t, "Turned on Valve A, Turned off Valve B", recirculate.LastResult)
+
+		devices.CheckScenes(after(start, 4))
+		require.Equal(t, gpio.Low, pumpPin.Read())
+		devices.CheckScenes(after(start, 5))
+		require.Equal(t, gpio.High, pumpPin.Read())
+		require.False(t, recirculate.Applying())
+		require.Equal(t, "Turned on Valve A, Turned off Valve B, Turned on Pump 1", recirculate.LastResult)
+		require.Empty(t, recirculate.LastError)
+		require.Equal(t, start, *recirculate.LastApplied)
+	})
+
+	pump.Off()
+
+	t.Run("Nothing is switched when an interlock does not match", func(t *testing.T) {
+		valves.SetState(on)
+		heater.On()
+		err := recirculate.Apply(nil)
+		require.Equal(t, "interlock not met: Heater is on", err.Error())
+		require.Equal(t, "interlock not met: Heater is on", recirculate.LastError)
+		require.Equal(t, gpio.High, valveBPin.Read())
+		require.False(t, recirculate.Applying())
+	})
+
+	t.Run("Interlocks are checked again before a step that waited", func(t *testing.T) {
+		heater.Off()
+		start := time.Now()
+		require.Nil(t, recirculate.Apply(after(start, 0)))
+		require.Equal(t, gpio.Low, valveBPin.Read())
+
+		heater.On()
+		devices.CheckScenes(after(start, 5))
+		require.Equal(t, gpio.Low, pumpPin.Read())
+		require.False(t, recirculate.Applying())
+		require.Equal(t, "Stopped before step 3, interlock not met: Heater is on", recirculate.LastError)
+		heater.Off()
+	})
+
+	t.Run("Applying a scene stops scenes waiting to switch the same switches", func(t *testing.T) {
+		drain, err := devices.ModifyScene(model.SceneInput{
+			Name:  strPointer("Drain"),
+			Steps: []*model.SceneStepInput{{GroupID: &valvesID, State: off}, {SwitchID: &pumpID, State: off}},
+		})
+		require.Nil(t, err)
+
+		start := time.Now()
+		require.Nil(t, recirculate.Apply(after(start, 0)))
+		require.Nil(t, drain.Apply(after(start, 1)))
+		require.Equal(t, "Turned off Valves (Valve A, Valve B), Turned off Pump 1", drain.LastResult)
+		require.False(t, recirculate.Applying())
+		require.Equal(t, "Stopped by Drain", recirculate.LastError)
+
+		devices.CheckScenes(after(start, 5))
+		require.Equal(t, gpio.Low, pumpPin.Read())
+
+		_, err = devices.DeleteSwitchGroupByID(valvesID)
+		require.Equal(t, "switch group 'Valves' is used by 'Drain', it cannot be deleted", err.Error())
+		_, err = devices.DeleteSceneByID(fmt.Sprint(drain.ID))
+		require.Nil(t, err)
+	})
+
+	t.Run("Scenes are loaded from the database", func(t *testing.T) {
+		devices.ClearScenes()
+		loaded := devices.FindSceneByID(fmt.Sprint(recirculate.ID))
+		require.Len(t, loaded.Steps, 3)
+		require.Equal(t, pump, loaded.Steps[2].Switch())
+		require.Equal(t, 5.0, loaded.Steps[2].DelaySeconds)
+		require.Len(t, loaded.Interlocks, 1)
+		require.Equal(t, heater, loaded.Interlocks[0].Switch())
+		require.Equal(t, "Stopped by Drain", loaded.LastError)
+		require.Equal(t, []*devices.Switch{valveA, valveB}, devices.FindSwitchGroupByID(valvesID).Switches())
+
+		_, err := devices.DeleteSwitchGroupByID(valvesID)
+		require.Nil(t, err)
+		require.Empty(t, devices.AllSwitchGroups())
+	})
+
+	t.Run("Scenes do not switch while the controllers are suspended", func(t *testing.T) {
+		loaded := devices.FindSceneByID(fmt.Sprint(recirculate.ID))
+		start := time.Now()
+		require.Nil(t, loaded.Apply(after(start, 0)))
+
+		devices.SuspendTemperatureControllers()
+		devices.CheckScenes(after(start, 5))
+		require.Equal(t, gpio.Low, pumpPin.Read())
+		require.True(t, loaded.Applying())
+
+		devices.ResumeTemperatureControllers()
+		require.False(t, devices.TemperatureControllersSuspended())
+		devices.CheckScenes(after(start, 6))
+		require.Equal(t, gpio.Low, pumpPin.Read())
+	})
+}
@@ -0,0 +1,293 @@
+package devices
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/system"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// How late a run can be before it counts as missed, e.g. while Elsinore was not running
+const missedRunGrace = time.Minute
+
+// ScheduleCheckInterval is how often WatchSchedules checks the schedules
+var ScheduleCheckInterval = time.Second
+
+var schedules []*Schedule = nil
+var scheduleLock sync.Mutex
+
+// Schedule runs its actions at the times of the cron expression, or once At, in the timezone of the system settings
+// Checked -> When the schedule last ran, skipped a missed run or was changed, the next run is the first time after it
+// MissedRun -> What to do when the next run is more than a minute late, such as after a restart
+type Schedule struct {
+	gorm.Model
+	database.InstanceScoped
+	Name       string
+	Enabled    bool
+	Cron       string
+	At         *time.Time
+	MissedRun  model.MissedRunPolicy
+	Actions    []*AutomationAction `gorm:"ForeignKey:ScheduleID"`
+	Checked    time.Time
+	LastRun    *time.Time
+	LastResult string // What the actions did, or that a missed run was skipped
+	LastError  string // The actions that failed
+}
+
+// AllSchedules returns all the schedules, loading from the Database if none are loaded
+func AllSchedules() []*Schedule {
+	scheduleLock.Lock()
+	defer scheduleLock.Unlock()
+	return loadSchedules()
+}
+
+func loadSchedules() []*Schedule {
+	if schedules == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Schedules array is nil, checking the database...")
+		database.FetchDatabase().Debug().Preload(clause.Associations).Find(&schedules)
+		for _, schedule := range schedules {
+			sort.SliceStable(schedule.Actions, func(i, j int) bool { return schedule.Actions[i].Position < schedule.Actions[j].Position })
+		}
+	}
+	return schedules
+}
+
+// FindScheduleByID - Find a schedule by id
+func FindScheduleByID(id string) *Schedule {
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	for _, schedule := range AllSchedules() {
+		if schedule.ID == uint(intID) {
+			return schedule
+		}
+	}
+	return nil
+}
+
+// ModifySchedule - Create or update a schedule, the actions that are given replace the existing ones
+// A changed schedule next runs at the first time after now, runs it missed before the change are not made up
+func ModifySchedule(settings model.ScheduleInput, now func() time.Time) (*Schedule, error) {
+	if now == nil {
+		now = time.Now
+	}
+	schedule := &Schedule{Enabled: true, MissedRun: model.MissedRunPolicySkip}
+	if settings.ID != nil {
+		schedule = FindScheduleByID(*settings.ID)
+		if schedule == nil {
+			return nil, fmt.Errorf("no schedule with id: %v found", *settings.ID)
+		}
+	} else if settings.Name == nil || len(settings.Actions) == 0 || (settings.Cron == nil && settings.At == nil) {
+		return nil, fmt.Errorf("name, actions and a cron expression or time are required when creating a schedule")
+	}
+
+	updated := *schedule
+	if settings.Name != nil {
+		updated.Name = strings.TrimSpace(*settings.Name)
+	}
+	if settings.Enabled != nil {
+		updated.Enabled = *settings.Enabled
+	}
+	if settings.MissedRun != nil {
+		if !settings.MissedRun.IsValid() {
+			return nil, fmt.Errorf("%v is not a missed run policy", *settings.MissedRun)
+		}
+		updated.MissedRun = *settings.MissedRun
+	}
+	if settings.Cron != nil && settings.At != nil {
+		return nil, fmt.Errorf("a schedule runs with a cron expression or at a time, not both")
+	}
+	if settings.Cron != nil {
+		updated.Cron = strings.Join(strings.Fields(*settings.Cron), " ")
+		if _, err := ParseCron(updated.Cron); err != nil {
+			return nil, err
+		}
+		updated.At = nil
+	}
+	if settings.At != nil {
+		if !settings.At.After(now()) {
+			return nil, fmt.Errorf("%v is in the past", settings.At.Format(time.RFC3339))
+		}
+		at := *settings.At
+		updated.At = &at
+		updated.Cron = ""
+	}
+	if settings.Actions != nil {
+		if len(settings.Actions) == 0 {
+			return nil, fmt.Errorf("a schedule needs at least one action")
+		}
+		updated.Actions = []*AutomationAction{}
+		for i, actionSettings := range settings.Actions {
+			action, err := newAutomationAction(*actionSettings, int64(i))
+			if err != nil {
+				return nil, err
+			}
+			updated.Actions = append(updated.Actions, action)
+		}
+	}
+
+	if len(updated.Name) == 0 {
+		return nil, fmt.Errorf("a schedule needs a name")
+	}
+	for _, other := range AllSchedules() {
+		if other != schedule && strings.EqualFold(other.Name, updated.Name) {
+			return nil, fmt.Errorf("schedule '%v' already exists", updated.Name)
+		}
+	}
+
+	scheduleLock.Lock()
+	defer scheduleLock.Unlock()
+	if database.FetchDatabase() != nil {
+		for _, action := range schedule.Actions {
+			if !containsAction(updated.Actions, action) {
+				database.FetchDatabase().Debug().Delete(action)
+			}
+		}
+	}
+	*schedule = updated
+	schedule.Checked = now()
+	if schedule.ID == 0 {
+		schedules = append(schedules, schedule)
+	}
+	database.Save(schedule)
+	return schedule, nil
+}
+
+// DeleteScheduleByID - Delete a schedule
+func DeleteScheduleByID(id string) (*Schedule, error) {
+	schedule := FindScheduleByID(id)
+	if schedule == nil {
+		return nil, fmt.Errorf("no schedule found with id '%v'", id)
+	}
+
+	scheduleLock.Lock()
+	defer scheduleLock.Unlock()
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Select(clause.Associations).Delete(schedule)
+	}
+	for i, s := range schedules {
+		if s == schedule {
+			schedules[i] = schedules[len(schedules)-1]
+			schedules = schedules[:len(schedules)-1]
+			break
+		}
+	}
+	return schedule, nil
+}
+
+// ClearSchedules reset the cached schedules
+func ClearSchedules() {
+	scheduleLock.Lock()
+	defer scheduleLock.Unlock()
+	schedules = nil
+}
+
+// NextRun - When the schedule runs next, nil when it is disabled or a one-shot schedule has run
+func (s *Schedule) NextRun() *time.Time {
+	scheduleLock.Lock()
+	defer scheduleLock.Unlock()
+	return s.nextRun()
+}
+
+func (s *Schedule) nextRun() *time.Time {
+	if !s.Enabled {
+		return nil
+	}
+	if s.At != nil {
+		if !s.At.After(s.Checked) {
+			return nil
+		}
+		at := s.At.In(system.CurrentSettings().Location())
+		return &at
+	}
+	cron, err := ParseCron(s.Cron)
+	if err != nil {
+		log.Error().Err(err).Msgf("Schedule %v cannot run", s.Name)
+		return nil
+	}
+	next := cron.Next(s.Checked.In(system.CurrentSettings().Location()))
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
+
+// Run - Run the actions now, the schedule still runs when it is next due
+func (s *Schedule) Run(now func() time.Time) *Schedule {
+	if now == nil {
+		now = time.Now
+	}
+	scheduleLock.Lock()
+	defer scheduleLock.Unlock()
+	s.run(now())
+	database.Save(s)
+	return s
+}
+
+// WatchSchedules - Check the schedules every ScheduleCheckInterval until quit is closed
+func WatchSchedules(quit <-chan struct{}) {
+	ticker := time.NewTicker(ScheduleCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			CheckSchedules(nil)
+		}
+	}
+}
+
+// CheckSchedules - Run the schedules that are due, a run more than a minute late was missed and is skipped unless the schedule runs missed runs once
+// The schedules are saved after they run, so runs missed while Elsinore was not running are found when it starts
+func CheckSchedules(now func() time.Time) {
+	if !startCheck() {
+		return
+	}
+	defer finishCheck()
+	if now == nil {
+		now = time.Now
+	}
+	at := now()
+
+	scheduleLock.Lock()
+	defer scheduleLock.Unlock()
+	for _, schedule := range loadSchedules() {
+		next := schedule.nextRun()
+		if next == nil || at.Before(*next) {
+			continue
+		}
+		if at.Sub(*next) > missedRunGrace && schedule.MissedRun != model.MissedRunPolicyRunOnce {
+			log.Warn().Msgf("Schedule %v missed the run at %v, skipping it", schedule.Name, next.Format(time.RFC3339))
+			schedule.LastResult = fmt.Sprintf("Skipped the run at %v", next.Format(time.RFC3339))
+			schedule.LastError = ""
+		} else {
+			schedule.run(at)
+		}
+		schedule.Checked = at
+		database.Save(schedule)
+	}
+}
+
+// run the actions in order, recording what they did
+func (s *Schedule) run(at time.Time) {
+	trace := &AutomationTrace{Time: at, Matched: true, Actions: []string{}, Errors: []string{}}
+	for _, action := range s.Actions {
+		trace.record(action.run())
+	}
+	s.LastRun = &at
+	s.LastResult = strings.Join(trace.Actions, ", ")
+	s.LastError = strings.Join(trace.Errors, ", ")
+	log.Info().Msgf("Schedule %v ran: %v", s.Name, s.LastResult)
+}
@@ -0,0 +1,194 @@
+package devices_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/system"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+)
+
+func TestCronExpressions(t *testing.T) {
+	// A Friday
+	friday := time.Date(2021, 6, 4, 12, 30, 0, 0, time.UTC)
+	next := func(expression string, after time.Time) time.Time {
+		cron, err := devices.ParseCron(expression)
+		require.Nil(t, err)
+		return cron.Next(after)
+	}
+
+	require.Equal(t, time.Date(2021, 6, 5, 6, 0, 0, 0, time.UTC), next("0 6 * * SAT", friday))
+	require.Equal(t, time.Date(2021, 6, 4, 12, 31, 0, 0, time.UTC), next("* * * * *", friday))
+	require.Equal(t, time.Date(2021, 6, 4, 12, 45, 0, 0, time.UTC), next("*/15 9-17 * * mon-fri", friday))
+	require.Equal(t, time.Date(2021, 6, 7, 9, 0, 0, 0, time.UTC), next("*/15 9-17 * * mon-fri", friday.Add(6*time.Hour)))
+	require.Equal(t, time.Date(2021, 6, 5, 0, 0, 0, 0, time.UTC), next("@daily", friday))
+	require.Equal(t, time.Date(2021, 6, 6, 0, 0, 0, 0, time.UTC), next("0 0 * * 7", friday))
+	require.Equal(t, time.Date(2021, 12, 1, 8, 5, 0, 0, time.UTC), next("5 8 1 DEC *", friday))
+	// Either the first of the month or a Monday
+	require.Equal(t, time.Date(2021, 6, 7, 0, 0, 0, 0, time.UTC), next("0 0 1 * MON", friday))
+	require.Equal(t, time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC), next("0 0 1 * MON", time.Date(2021, 6, 28, 1, 0, 0, 0, time.UTC)))
+	require.True(t, next("0 0 30 2 *", friday).IsZero())
+
+	toronto, err := time.LoadLocation("America/Toronto")
+	require.Nil(t, err)
+	require.Equal(t, time.Date(2021, 6, 5, 10, 0, 0, 0, time.UTC), next("0 6 * * SAT", friday.In(toronto)).UTC())
+
+	for expression, message := range map[string]string{
+		"0 6 * *":       "'0 6 * *' is not a cron expression, it needs five fields: minute hour day-of-month month day-of-week",
+		"60 6 * * *":    "'60 6 * * *' is not a cron expression, '60' is not a minute, it must be from 0 to 59",
+		"0 6 * * FUN":   "'0 6 * * FUN' is not a cron expression, 'FUN' is not a day of week, it must be from 0 to 7",
+		"*/0 * * * *":   "'*/0 * * * *' is not a cron expression, '0' is not a step for the minute",
+		"0 17-9 * * *":  "'0 17-9 * * *' is not a cron expression, 17-9 is not a range for the hour",
+		"0 0 0 * *":     "'0 0 0 * *' is not a cron expression, '0' is not a day of month, it must be from 1 to 31",
+		"@fortnightly":  "'@fortnightly' is not a cron expression, it needs five fields: minute hour day-of-month month day-of-week",
+		"0 6 * * SAT,":  "'0 6 * * SAT,' is not a cron expression, '' is not a day of week, it must be from 0 to 7",
+		"0 6 * 1-13 * ": "'0 6 * 1-13 * ' is not a cron expression, '13' is not a month, it must be from 1 to 12",
+	} {
+		_, err := devices.ParseCron(expression)
+		require.NotNil(t, err, expression)
+		require.Equal(t, message, err.Error())
+	}
+}
+
+func TestSchedules(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearControllers()
+	devices.ClearSchedules()
+	t.Cleanup(devices.ClearSchedules)
+	system.CurrentSettings().Timezone = "America/Toronto"
+	t.Cleanup(func() { system.CurrentSettings().Timezone = "" })
+
+	hlt, err := devices.CreateTemperatureController("HLT", &devices.TempProbeDetail{PhysAddr: "schedule-hlt"})
+	require.Nil(t, err)
+	pump, pumpPin := automationSwitch(t, "HLT Pump", 801)
+	hltID := fmt.Sprint(hlt.ID)
+	pumpID := fmt.Sprint(pump.ID)
+	auto := model.ControllerMode("auto")
+	heatTheHLT := []*model.AutomationActionInput{
+		{Kind: model.AutomationActionKindControllerSetPoint, ControllerID: &hltID, SetPoint: strPointer("76C")},
+		{Kind: model.AutomationActionKindControllerMode, ControllerID: &hltID, Mode: &auto},
+		{Kind: model.AutomationActionKindSwitchOn, SwitchID: &pumpID},
+	}
+	// Friday 12:00 in Toronto
+	friday := time.Date(2021, 6, 4, 16, 0, 0, 0, time.UTC)
+	saturday := time.Date(2021, 6, 5, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Invalid schedules are rejected", func(t *testing.T) {
+		_, err := devices.ModifySchedule(model.ScheduleInput{Name: strPointer("Heat"), Actions: heatTheHLT}, after(friday, 0))
+		require.Equal(t, "name, actions and a cron expression or time are required when creating a schedule", err.Error())
+		_, err = devices.ModifySchedule(model.ScheduleInput{Name: strPointer("Heat"), Cron: strPointer("0 6 * * SAT"), At: &saturday, Actions: heatTheHLT}, after(friday, 0))
+		require.Equal(t, "a schedule runs with a cron expression or at a time, not both", err.Error())
+		_, err = devices.ModifySchedule(model.ScheduleInput{Name: strPointer("Heat"), Cron: strPointer("0 6 * *"), Actions: heatTheHLT}, after(friday, 0))
+		require.Equal(t, "'0 6 * *' is not a cron expression, it needs five fields: minute hour day-of-month month day-of-week", err.Error())
+		_, err = devices.ModifySchedule(model.ScheduleInput{Name: strPointer("Heat"), At: &friday, Actions: heatTheHLT}, after(friday, 60))
+		require.Equal(t, "2021-06-04T16:00:00Z is in the past", err.Error())
+		_, err = devices.ModifySchedule(model.ScheduleInput{Name: strPointer(" "), Cron: strPointer("@daily"), Actions: heatTheHLT}, after(friday, 0))
+		require.Equal(t, "a schedule needs a name", err.Error())
+		_, err = devices.ModifySchedule(model.ScheduleInput{Name: strPointer("Heat"), Cron: strPointer("@daily"), Actions: []*model.AutomationActionInput{{Kind: model.AutomationActionKindSwitchOff}}}, after(friday, 0))
+		require.Equal(t, "a switchOff action needs a switch", err.Error())
+		require.Empty(t, devices.AllSchedules())
+	})
+
+	heat, err := devices.ModifySchedule(model.ScheduleInput{Name: strPointer("Heat the HLT"), Cron: strPointer(" 0  6 * * SAT"), Actions: heatTheHLT}, after(friday, 0))
+	require.Nil(t, err)
+	require.Equal(t, "0 6 * * SAT", heat.Cron)
+	require.Equal(t, model.MissedRunPolicySkip, heat.MissedRun)
+	require.True(t, heat.Enabled)
+
+	t.Run("Schedules run in the timezone of the system settings", func(t *testing.T) {
+		require.Equal(t, saturday, heat.NextRun().UTC())
+		require.Equal(t, "America/Toronto", heat.NextRun().Location().String())
+
+		devices.CheckSchedules(after(saturday, -1))
+		require.Nil(t, heat.LastRun)
+		require.NotEqual(t, auto, hlt.Mode)
+
+		devices.CheckSchedules(after(saturday, 1))
+		require.Equal(t, saturday.Add(time.Second), *heat.LastRun)
+		require.Equal(t, "Set HLT to 76°C, Changed HLT to auto, Turned on HLT Pump", heat.LastResult)
+		require.Empty(t, heat.LastError)
+		require.Equal(t, "76°C", hlt.SetPoint())
+		require.Equal(t, auto, hlt.Mode)
+		require.Equal(t, gpio.High, pumpPin.Read())
+		require.Equal(t, saturday.AddDate(0, 0, 7), heat.NextRun().UTC())
+	})
+
+	off := model.ControllerMode("off")
+	err = hlt.ApplySettings(model.TemperatureControllerSettingsInput{ID: hltID, Mode: &off})
+	require.Nil(t, err)
+	pump.Off()
+
+	t.Run("Runs missed while not running are skipped", func(t *testing.T) {
+		devices.ClearSchedules()
+		restarted := saturday.AddDate(0, 0, 7).Add(2 * time.Hour)
+		devices.CheckSchedules(after(restarted, 0))
+		heat = devices.FindScheduleByID(fmt.Sprint(heat.ID))
+		require.Len(t, heat.Actions, 3)
+		require.Equal(t, saturday.Add(time.Second), heat.LastRun.UTC())
+		require.Equal(t, "Skipped the run at 2021-06-12T06:00:00-04:00", heat.LastResult)
+		require.Equal(t, off, hlt.Mode)
+		require.Equal(t, saturday.AddDate(0, 0, 14), heat.NextRun().UTC())
+	})
+
+	t.Run("Runs missed while not running can run once", func(t *testing.T) {
+		runOnce := model.MissedRunPolicyRunOnce
+		_, err := devices.ModifySchedule(model.ScheduleInput{ID: strPointer(fmt.Sprint(heat.ID)), MissedRun: &runOnce}, after(friday, 0))
+		require.Nil(t, err)
+
+		devices.ClearSchedules()
+		restarted := saturday.AddDate(0, 0, 14)
+		devices.CheckSchedules(after(restarted, 0))
+		heat = devices.FindScheduleByID(fmt.Sprint(heat.ID))
+		require.Equal(t, restarted, heat.LastRun.UTC())
+		require.Equal(t, auto, hlt.Mode)
+		require.Equal(t, gpio.High, pumpPin.Read())
+
+		// Only once for all the missed runs
+		pump.Off()
+		devices.CheckSchedules(after(restarted, 60))
+		require.Equal(t, gpio.Low, pumpPin.Read())
+		require.Equal(t, restarted.AddDate(0, 0, 7), heat.NextRun().UTC())
+	})
+
+	t.Run("One-shot schedules run once", func(t *testing.T) {
+		at := saturday.AddDate(0, 0, 20)
+		once, err := devices.ModifySchedule(model.ScheduleInput{
+			Name:    strPointer("Pump once"),
+			At:      &at,
+			Actions: []*model.AutomationActionInput{{Kind: model.AutomationActionKindSwitchOn, SwitchID: &pumpID}},
+		}, after(saturday, 0))
+		require.Nil(t, err)
+		require.Equal(t, at, once.NextRun().UTC())
+
+		devices.CheckSchedules(after(at, 0))
+		require.Equal(t, "Turned on HLT Pump", once.LastResult)
+		require.Nil(t, once.NextRun())
+
+		_, err = devices.ModifySchedule(model.ScheduleInput{ID: strPointer(fmt.Sprint(once.ID)), Name: strPointer("heat the hlt")}, after(at, 0))
+		require.Equal(t, "schedule 'heat the hlt' already exists", err.Error())
+		_, err = devices.DeleteScheduleByID(fmt.Sprint(once.ID))
+		require.Nil(t, err)
+	})
+
+	t.Run("Disabled schedules do not run", func(t *testing.T) {
+		disabled := false
+		_, err := devices.ModifySchedule(model.ScheduleInput{ID: strPointer(fmt.Sprint(heat.ID)), Enabled: &disabled}, after(friday, 0))
+		require.Nil(t, err)
+		require.Nil(t, heat.NextRun())
+
+		pump.Off()
+		heat.Run(after(friday, 0))
+		require.Equal(t, gpio.High, pumpPin.Read())
+		require.Equal(t, friday, *heat.LastRun)
+
+		_, err = devices.DeleteScheduleByID(fmt.Sprint(heat.ID))
+		require.Nil(t, err)
+		require.Empty(t, devices.AllSchedules())
+		_, err = devices.DeleteScheduleByID(fmt.Sprint(heat.ID))
+		require.Equal(t, fmt.Sprintf("no schedule found with id '%v'", heat.ID), err.Error())
+	})
+}
@@ -0,0 +1,54 @@
+//go:build linux
+// +build linux
+
+package devices_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/dougedey/elsinore/hardware/serialtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerialOutputs(t *testing.T) {
+	setupTestDb(t)
+	pty, err := serialtest.OpenPty()
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		hardware.CloseSerialPorts()
+		pty.Close()
+	})
+	module := serialtest.NewModbusSlave(2, 8)
+	pty.ServeModbus(module)
+
+	t.Run("Invalid serial relays are rejected", func(t *testing.T) {
+		_, err := devices.CreateSwitch(fmt.Sprintf("modbus:%v:0:coil1", pty.Path), "Broken Relay")
+		require.NotNil(t, err)
+	})
+
+	t.Run("A switch turns a Modbus coil on and off", func(t *testing.T) {
+		pump, err := devices.CreateSwitch(fmt.Sprintf("modbus:%v:2:coil3", pty.Path), "Panel Pump")
+		require.Nil(t, err)
+		t.Cleanup(func() {
+			_, err := devices.DeleteSwitchByID(fmt.Sprint(pump.ID))
+			require.Nil(t, err)
+		})
+		require.False(t, pump.Networked())
+
+		pump.On()
+		require.True(t, module.Coil(3))
+		require.Equal(t, model.SwitchModeOn, pump.State())
+		require.Nil(t, pump.OutputError())
+
+		frequency := 100
+		require.NotNil(t, pump.UpdatePWM(&frequency, nil))
+
+		pump.Off()
+		require.False(t, module.Coil(3))
+		require.Equal(t, model.SwitchModeOff, pump.State())
+	})
+}
@@ -0,0 +1,183 @@
+package devices
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+var spiProbes []*SPIProbe = nil
+
+// SPIProbe is a temperature chip on an SPI port, it is read as a probe alongside the 1-Wire probes
+type SPIProbe struct {
+	gorm.Model
+	database.InstanceScoped
+	Driver            model.SPIDriver
+	Bus               int
+	ChipSelect        int
+	Wires             int
+	ReferenceResistor float64
+	NominalResistance float64
+	Filter50Hz        bool
+	ConnectError      string `gorm:"-"` // Why the chip could not be connected, empty when it is connected
+}
+
+// AllSPIProbes returns all the SPI probes, loading from the Database and connecting them if none are loaded
+func AllSPIProbes() []*SPIProbe {
+	if spiProbes == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("SPI probes array is nil, checking the database...")
+		database.FetchDatabase().Debug().Find(&spiProbes)
+		for _, probe := range spiProbes {
+			probe.connect()
+		}
+	}
+	return spiProbes
+}
+
+// FindSPIProbeByID - Find an SPI probe by id
+func FindSPIProbeByID(id string) *SPIProbe {
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	for _, probe := range AllSPIProbes() {
+		if probe.ID == uint(intID) {
+			return probe
+		}
+	}
+	return nil
+}
+
+// ModifySPIProbe - Create or update an SPI probe and (re)connect it
+// The probe is saved even if the chip cannot be connected, ConnectError says why
+func ModifySPIProbe(settings model.SPIProbeInput) (*SPIProbe, error) {
+	probe := &SPIProbe{}
+	if settings.ID != nil {
+		probe = FindSPIProbeByID(*settings.ID)
+		if probe == nil {
+			return nil, fmt.Errorf("no SPI probe with id: %v found", *settings.ID)
+		}
+	} else if settings.Driver == nil || settings.Bus == nil || settings.ChipSelect == nil {
+		return nil, fmt.Errorf("driver, bus and chipSelect are required when creating an SPI probe")
+	}
+
+	updated := *probe
+	if settings.Driver != nil {
+		updated.Driver = *settings.Driver
+	}
+	if settings.Bus != nil {
+		updated.Bus = *settings.Bus
+	}
+	if settings.ChipSelect != nil {
+		updated.ChipSelect = *settings.ChipSelect
+	}
+	if settings.Wires != nil {
+		updated.Wires = *settings.Wires
+	}
+	if settings.ReferenceResistor != nil {
+		updated.ReferenceResistor = *settings.ReferenceResistor
+	}
+	if settings.NominalResistance != nil {
+		updated.NominalResistance = *settings.NominalResistance
+	}
+	if settings.Filter50Hz != nil {
+		updated.Filter50Hz = *settings.Filter50Hz
+	}
+
+	if err := updated.validate(); err != nil {
+		return nil, err
+	}
+	for _, other := range AllSPIProbes() {
+		if other != probe && other.Bus == updated.Bus && other.ChipSelect == updated.ChipSelect {
+			return nil, fmt.Errorf("SPI%v.%v is already in use by %v", updated.Bus, updated.ChipSelect, other.PhysAddr())
+		}
+	}
+
+	if probe.ID != 0 {
+		hardware.RemoveSPIProbe(probe.PhysAddr())
+	}
+	*probe = updated
+	if probe.ID == 0 {
+		spiProbes = append(spiProbes, probe)
+	}
+	database.Save(probe)
+	probe.connect()
+	return probe, nil
+}
+
+// DeleteSPIProbeByID - Disconnect and delete an SPI probe
+func DeleteSPIProbeByID(id string) (*SPIProbe, error) {
+	probe := FindSPIProbeByID(id)
+	if probe == nil {
+		return nil, fmt.Errorf("no SPI probe found with id '%v'", id)
+	}
+
+	hardware.RemoveSPIProbe(probe.PhysAddr())
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Delete(probe)
+	}
+	for i, p := range spiProbes {
+		if p == probe {
+			spiProbes[i] = spiProbes[len(spiProbes)-1]
+			spiProbes = spiProbes[:len(spiProbes)-1]
+			break
+		}
+	}
+	return probe, nil
+}
+
+// ClearSPIProbes disconnects and resets the cached SPI probes
+func ClearSPIProbes() {
+	for _, probe := range spiProbes {
+		hardware.RemoveSPIProbe(probe.PhysAddr())
+	}
+	spiProbes = nil
+}
+
+// PhysAddr - The physical address of the probe for this chip
+func (p *SPIProbe) PhysAddr() string {
+	return p.config().PhysAddr()
+}
+
+func (p *SPIProbe) config() hardware.SPIProbeConfig {
+	return hardware.SPIProbeConfig{
+		Driver:            string(p.Driver),
+		Bus:               p.Bus,
+		ChipSelect:        p.ChipSelect,
+		Wires:             p.Wires,
+		ReferenceResistor: p.ReferenceResistor,
+		NominalResistance: p.NominalResistance,
+		Filter50Hz:        p.Filter50Hz,
+	}
+}
+
+func (p *SPIProbe) validate() error {
+	if !p.Driver.IsValid() {
+		return fmt.Errorf("unknown SPI driver '%v'", p.Driver)
+	}
+	if p.Bus < 0 || p.ChipSelect < 0 {
+		return errors.New("bus and chipSelect must not be negative")
+	}
+	if p.Driver == model.SPIDriverMax31865 && p.Wires != 0 && (p.Wires < 2 || p.Wires > 4) {
+		return fmt.Errorf("an RTD has 2, 3 or 4 wires, got %v", p.Wires)
+	}
+	if p.ReferenceResistor < 0 || p.NominalResistance < 0 {
+		return errors.New("resistances must not be negative")
+	}
+	return nil
+}
+
+func (p *SPIProbe) connect() {
+	p.ConnectError = ""
+	if _, err := hardware.AddSPIProbe(p.config()); err != nil {
+		log.Error().Err(err).Msgf("Failed to connect %v", p.PhysAddr())
+		p.ConnectError = err.Error()
+	}
+}
@@ -0,0 +1,73 @@
+package devices_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSPIProbes(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearSPIProbes()
+	t.Cleanup(devices.ClearSPIProbes)
+
+	driver := model.SPIDriverMax31865
+	bus := 0
+	chipSelect := 1
+	wires := 3
+
+	t.Run("The driver, bus and chip select are required", func(t *testing.T) {
+		_, err := devices.ModifySPIProbe(model.SPIProbeInput{Driver: &driver, Bus: &bus})
+		require.NotNil(t, err)
+	})
+
+	t.Run("Invalid wiring is rejected", func(t *testing.T) {
+		invalid := 5
+		_, err := devices.ModifySPIProbe(model.SPIProbeInput{Driver: &driver, Bus: &bus, ChipSelect: &chipSelect, Wires: &invalid})
+		require.NotNil(t, err)
+		require.Empty(t, devices.AllSPIProbes())
+	})
+
+	t.Run("A probe is saved even when the port cannot be opened", func(t *testing.T) {
+		probe, err := devices.ModifySPIProbe(model.SPIProbeInput{Driver: &driver, Bus: &bus, ChipSelect: &chipSelect, Wires: &wires})
+		require.Nil(t, err)
+		require.Equal(t, "max31865-spi0.1", probe.PhysAddr())
+		require.NotEmpty(t, probe.ConnectError)
+
+		devices.ClearSPIProbes()
+		require.Len(t, devices.AllSPIProbes(), 1)
+		require.Equal(t, 3, devices.AllSPIProbes()[0].Wires)
+	})
+
+	t.Run("A chip select can only be used once", func(t *testing.T) {
+		_, err := devices.ModifySPIProbe(model.SPIProbeInput{Driver: &driver, Bus: &bus, ChipSelect: &chipSelect})
+		require.NotNil(t, err)
+	})
+
+	t.Run("Thermocouple amplifiers use their own chip select", func(t *testing.T) {
+		thermocouple := model.SPIDriverMax31855
+		otherChipSelect := 0
+		probe, err := devices.ModifySPIProbe(model.SPIProbeInput{Driver: &thermocouple, Bus: &bus, ChipSelect: &otherChipSelect})
+		require.Nil(t, err)
+		require.Equal(t, "max31855-spi0.0", probe.PhysAddr())
+
+		_, err = devices.DeleteSPIProbeByID(fmt.Sprint(probe.ID))
+		require.Nil(t, err)
+	})
+
+	t.Run("A probe can be updated and deleted", func(t *testing.T) {
+		id := fmt.Sprint(devices.AllSPIProbes()[0].ID)
+		nominal := 1000.0
+		probe, err := devices.ModifySPIProbe(model.SPIProbeInput{ID: &id, NominalResistance: &nominal})
+		require.Nil(t, err)
+		require.Equal(t, 1000.0, probe.NominalResistance)
+		require.Equal(t, 3, probe.Wires)
+
+		_, err = devices.DeleteSPIProbeByID(id)
+		require.Nil(t, err)
+		require.Nil(t, devices.FindSPIProbeByID(id))
+	})
+}
@@ -107,12 +107,88 @@ func CreateSwitch(identifier string, friendlyName string) (*Switch, error) {
 	return &newSwitch, nil
 }
 
+// ModifySwitch - Create a switch, or update the name, GPIO, PWM settings or state of an existing one
+func ModifySwitch(settings model.SwitchSettingsInput) (*Switch, error) {
+	if settings.State != nil && !settings.State.IsValid() {
+		return nil, fmt.Errorf("%v is not a valid switch state", *settings.State)
+	}
+	if settings.MaxOnSeconds != nil && *settings.MaxOnSeconds < 0 {
+		return nil, fmt.Errorf("the maximum on time cannot be negative, got %v", *settings.MaxOnSeconds)
+	}
+
+	var curSwitch *Switch
+	if settings.ID == nil {
+		errors := []string{}
+		if settings.Gpio == nil || len(strings.TrimSpace(*settings.Gpio)) == 0 {
+			errors = append(errors, "GPIO is required when creating a new switch")
+		} else if GpioInUse(*settings.Gpio) {
+			errors = append(errors, fmt.Sprintf("GPIO '%v' is already in use", *settings.Gpio))
+		}
+		if settings.Name == nil || len(strings.TrimSpace(*settings.Name)) == 0 {
+			errors = append(errors, "Name is required when creating a new switch")
+		}
+		if len(errors) > 0 {
+			return nil, fmt.Errorf(strings.Join(errors, "\n"))
+		}
+		newSwitch, err := CreateSwitch(*settings.Gpio, *settings.Name)
+		if err != nil {
+			return nil, err
+		}
+		curSwitch = newSwitch
+	} else {
+		curSwitch = FindSwitchByID(*settings.ID)
+		if curSwitch == nil {
+			return nil, fmt.Errorf("no switch with id: %v found", *settings.ID)
+		}
+	}
+
+	if settings.Name != nil {
+		curSwitch.Output.FriendlyName = *settings.Name
+	}
+
+	if settings.Gpio != nil {
+		err := curSwitch.UpdateIdentifier(*settings.Gpio)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if settings.MaxOnSeconds != nil {
+		curSwitch.MaxOnSeconds = int64(*settings.MaxOnSeconds)
+	}
+
+	if settings.Frequency != nil || settings.Duty != nil {
+		err := curSwitch.UpdatePWM(settings.Frequency, settings.Duty)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if settings.State != nil {
+		log.Info().Msgf("Setting %v to %v", curSwitch.Output.Identifier, *settings.State)
+		if *settings.State == model.SwitchModeOn {
+			curSwitch.On()
+		}
+		if *settings.State == model.SwitchModeOff {
+			curSwitch.Off()
+		}
+	}
+	curSwitch.Save()
+	return curSwitch, nil
+}
+
 // Switch - Represents a thin wrapper around an OutPin to define a switch
 type Switch struct {
 	gorm.Model
-	OutputID uint
-	Output   *OutPin `gorm:"ForeignKey:OutputID"`
-	Inverted bool
+	database.InstanceScoped
+	OutputID     uint
+	Output       *OutPin `gorm:"ForeignKey:OutputID"`
+	Inverted     bool
+	Frequency    int64         // PWM frequency in Hz, a switch with a frequency is a dimmer
+	Duty         *int64        // PWM duty in percent that a dimmer runs at when it is on, 0 is off and nil is full power
+	MaxOnSeconds int64         // The longest the switch stays on before CheckSwitchTimers turns it off, 0 for no limit
+	timer        *switchTimer  `gorm:"-"`
+	changing     chan struct{} // Holds a value while the output is changed, so a timer and On or Off cannot interleave
 }
 
 // Reset - Turn off the switch if configured
@@ -124,10 +200,84 @@ func (s *Switch) Reset() {
 	s.Off()
 }
 
-// On - Switch on the output pin, if it's inverted, the pin goes to off
+// On - Switch on the output pin, if it's inverted, the pin goes to off, stopping any timer or pulse
+// A dimmer runs the pin with PWM at its duty instead
 func (s *Switch) On() {
+	s.lockOutput()
+	s.setTimer(nil)
+	wasOn := s.turnOn()
+	s.unlockOutput()
+	s.publishChange(wasOn)
+}
+
+// Off - Switch off the output pin, if it's inverted, the pin goes to on, stopping any timer or pulse
+func (s *Switch) Off() {
+	s.lockOutput()
+	s.setTimer(nil)
+	wasOn := s.turnOff()
+	s.unlockOutput()
+	s.publishChange(wasOn)
+}
+
+func (s *Switch) on() {
+	s.lockOutput()
+	wasOn := s.turnOn()
+	s.unlockOutput()
+	s.publishChange(wasOn)
+}
+
+func (s *Switch) off() {
+	s.lockOutput()
+	wasOn := s.turnOff()
+	s.unlockOutput()
+	s.publishChange(wasOn)
+}
+
+// outputLock returns the channel that is held while the output is changed, creating it for a switch loaded from the database
+func (s *Switch) outputLock() chan struct{} {
+	switchTimerLock.Lock()
+	defer switchTimerLock.Unlock()
+	if s.changing == nil {
+		s.changing = make(chan struct{}, 1)
+	}
+	return s.changing
+}
+
+// lockOutput waits until no one else is changing the output
+func (s *Switch) lockOutput() {
+	s.outputLock() <- struct{}{}
+}
+
+// tryLockOutput returns false straight away when someone else is changing the output
+func (s *Switch) tryLockOutput() bool {
+	select {
+	case s.outputLock() <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Switch) unlockOutput() {
+	<-s.outputLock()
+}
+
+// turnOn sets the output without publishing the change, returning whether the switch was on before
+func (s *Switch) turnOn() bool {
 	if s.Output == nil {
-		return
+		return false
+	}
+	wasOn := s.switchedOn()
+
+	if s.Frequency > 0 {
+		duty := s.DimmerDuty()
+		if s.Inverted {
+			duty = 100 - duty
+		}
+		if err := s.Output.pwm(duty, s.Frequency); err != nil {
+			log.Error().Err(err).Msgf("Failed to dim %v", s.Output.FriendlyName)
+		}
+		return wasOn
 	}
 
 	if s.Inverted {
@@ -135,19 +285,67 @@ func (s *Switch) On() {
 	} else {
 		s.Output.on()
 	}
+	return wasOn
 }
 
-// Off - Switch off the output pin, if it's inverted, the pin goes to on
-func (s *Switch) Off() {
+// turnOff sets the output without publishing the change, returning whether the switch was on before
+func (s *Switch) turnOff() bool {
 	if s.Output == nil {
-		return
+		return false
 	}
+	wasOn := s.switchedOn()
 
 	if s.Inverted {
 		s.Output.on()
 	} else {
 		s.Output.off()
 	}
+	return wasOn
+}
+
+// SetState - Turn the switch on or off, stopping any timer or pulse
+func (s *Switch) SetState(mode model.SwitchMode) error {
+	if !mode.IsValid() {
+		return fmt.Errorf("%v is not a valid switch state", mode)
+	}
+	if mode == model.SwitchModeOn {
+		s.On()
+	} else {
+		s.Off()
+	}
+	return nil
+}
+
+// switchedOn - Whether the switch was last set on, false before it has been set
+func (s *Switch) switchedOn() bool {
+	if s.Output.Duty() > 0 {
+		return true
+	}
+	onTime, offTime := s.Output.changedAt()
+	if s.Inverted {
+		return offTime != nil
+	}
+	return onTime != nil
+}
+
+// publishChange publishes a switchToggled event when the switch is no longer in the state it was
+func (s *Switch) publishChange(wasOn bool) {
+	if s.Output == nil {
+		return
+	}
+	if on := s.switchedOn(); on != wasOn {
+		state := model.SwitchModeOff
+		if on {
+			state = model.SwitchModeOn
+		}
+		publishEvent(Event{
+			Type:     model.DeviceEventTypeSwitchToggled,
+			Device:   s.Name(),
+			DeviceID: fmt.Sprint(s.ID),
+			Message:  fmt.Sprintf("%v turned %v", s.Name(), state),
+			Data:     map[string]string{"state": string(state)},
+		})
+	}
 }
 
 // Gpio - Get the GPIO
@@ -162,6 +360,9 @@ func (s *Switch) Name() string {
 
 // State - Returns on if this switch is on
 func (s *Switch) State() model.SwitchMode {
+	if s.Output.Duty() > 0 {
+		return model.SwitchModeOn
+	}
 	log.Info().Msgf("Reading Pin State %v", s.Output.Read())
 	if *s.Output.Read() == s.onState() {
 		return model.SwitchModeOn
@@ -176,6 +377,62 @@ func (s *Switch) onState() gpio.Level {
 	return gpio.High
 }
 
+// UpdatePWM - Change the frequency (Hz) and duty (percent) of a dimmer, a frequency of 0 makes it a plain on/off switch
+// A dimmer that is on runs at the new settings straight away
+func (s *Switch) UpdatePWM(frequency *int, duty *int) error {
+	newFrequency, newDuty := s.Frequency, s.Duty
+	if frequency != nil {
+		newFrequency = int64(*frequency)
+	}
+	if duty != nil {
+		value := int64(*duty)
+		newDuty = &value
+	}
+	if err := validatePWM(newFrequency, dimmerDuty(newDuty)); err != nil {
+		return err
+	}
+	if newFrequency > 0 && s.Output.External() {
+		return fmt.Errorf("%v is an output on another device, it cannot be dimmed", s.Output.Identifier)
+	}
+
+	on := s.State() == model.SwitchModeOn
+	s.Frequency, s.Duty = newFrequency, newDuty
+	if on {
+		s.on()
+	}
+	return nil
+}
+
+// SoftwarePWM - Returns true if the dimmer is timed in software because the pin has no hardware PWM
+func (s *Switch) SoftwarePWM() bool {
+	return s.Output.SoftwarePWM()
+}
+
+// Networked - Returns true if the switch is a smart plug or relay on the network
+func (s *Switch) Networked() bool {
+	return s.Output.Networked()
+}
+
+// OutputError - Why the output could not be set or read back, nil when it is working
+func (s *Switch) OutputError() *string {
+	if err := s.Output.Error(); err != "" {
+		return &err
+	}
+	return nil
+}
+
+// DimmerDuty - The PWM duty in percent that a dimmer runs at when it is on, 100 when it has not been set
+func (s *Switch) DimmerDuty() int64 {
+	return dimmerDuty(s.Duty)
+}
+
+func dimmerDuty(duty *int64) int64 {
+	if duty == nil {
+		return 100
+	}
+	return *duty
+}
+
 // Save - Helper to save this object
 func (s *Switch) Save() {
 	database.Save(s)
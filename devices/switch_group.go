@@ -0,0 +1,179 @@
+package devices
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var switchGroups []*SwitchGroup = nil
+
+// SwitchGroup is a named set of switches that are turned on and off together
+type SwitchGroup struct {
+	gorm.Model
+	database.InstanceScoped
+	Name    string
+	Members []*SwitchGroupMember `gorm:"ForeignKey:SwitchGroupID"`
+}
+
+// SwitchGroupMember is a switch in a group
+type SwitchGroupMember struct {
+	gorm.Model
+	SwitchGroupID uint `gorm:"index"`
+	SwitchID      uint
+}
+
+// AllSwitchGroups returns all the switch groups, loading from the Database if none are loaded
+func AllSwitchGroups() []*SwitchGroup {
+	sceneLock.Lock()
+	defer sceneLock.Unlock()
+	return loadSwitchGroups()
+}
+
+func loadSwitchGroups() []*SwitchGroup {
+	if switchGroups == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Switch groups array is nil, checking the database...")
+		database.FetchDatabase().Debug().Preload(clause.Associations).Find(&switchGroups)
+	}
+	return switchGroups
+}
+
+// FindSwitchGroupByID - Find a switch group by id
+func FindSwitchGroupByID(id string) *SwitchGroup {
+	sceneLock.Lock()
+	defer sceneLock.Unlock()
+	return findSwitchGroup(id)
+}
+
+// findSwitchGroup finds a group while the scene lock is held
+func findSwitchGroup(id string) *SwitchGroup {
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	for _, group := range loadSwitchGroups() {
+		if group.ID == uint(intID) {
+			return group
+		}
+	}
+	return nil
+}
+
+// ModifySwitchGroup - Create or update a switch group, the switches that are given replace the existing ones
+func ModifySwitchGroup(settings model.SwitchGroupInput) (*SwitchGroup, error) {
+	group := &SwitchGroup{}
+	if settings.ID != nil {
+		group = FindSwitchGroupByID(*settings.ID)
+		if group == nil {
+			return nil, fmt.Errorf("no switch group with id: %v found", *settings.ID)
+		}
+	} else if settings.Name == nil || len(settings.SwitchIds) == 0 {
+		return nil, fmt.Errorf("name and switches are required when creating a switch group")
+	}
+
+	updated := *group
+	if settings.Name != nil {
+		updated.Name = strings.TrimSpace(*settings.Name)
+	}
+	if settings.SwitchIds != nil {
+		if len(settings.SwitchIds) == 0 {
+			return nil, fmt.Errorf("a switch group needs at least one switch")
+		}
+		updated.Members = []*SwitchGroupMember{}
+		for _, switchID := range settings.SwitchIds {
+			s := FindSwitchByID(switchID)
+			if s == nil {
+				return nil, fmt.Errorf("no switch with id: %v found", switchID)
+			}
+			for _, member := range updated.Members {
+				if member.SwitchID == s.ID {
+					return nil, fmt.Errorf("%v is in the group more than once", s.Name())
+				}
+			}
+			updated.Members = append(updated.Members, &SwitchGroupMember{SwitchID: s.ID})
+		}
+	}
+
+	if len(updated.Name) == 0 {
+		return nil, fmt.Errorf("a switch group needs a name")
+	}
+	for _, other := range AllSwitchGroups() {
+		if other != group && strings.EqualFold(other.Name, updated.Name) {
+			return nil, fmt.Errorf("switch group '%v' already exists", updated.Name)
+		}
+	}
+
+	sceneLock.Lock()
+	defer sceneLock.Unlock()
+	if database.FetchDatabase() != nil && settings.SwitchIds != nil {
+		for _, member := range group.Members {
+			database.FetchDatabase().Debug().Delete(member)
+		}
+	}
+	*group = updated
+	if group.ID == 0 {
+		switchGroups = append(switchGroups, group)
+	}
+	database.Save(group)
+	return group, nil
+}
+
+// DeleteSwitchGroupByID - Delete a switch group, it cannot be deleted while a scene uses it
+func DeleteSwitchGroupByID(id string) (*SwitchGroup, error) {
+	group := FindSwitchGroupByID(id)
+	if group == nil {
+		return nil, fmt.Errorf("no switch group found with id '%v'", id)
+	}
+	for _, scene := range AllScenes() {
+		for _, step := range scene.Steps {
+			if step.SwitchGroupID != nil && *step.SwitchGroupID == group.ID {
+				return nil, fmt.Errorf("switch group '%v' is used by '%v', it cannot be deleted", group.Name, scene.Name)
+			}
+		}
+	}
+
+	sceneLock.Lock()
+	defer sceneLock.Unlock()
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Select(clause.Associations).Delete(group)
+	}
+	for i, g := range switchGroups {
+		if g == group {
+			switchGroups[i] = switchGroups[len(switchGroups)-1]
+			switchGroups = switchGroups[:len(switchGroups)-1]
+			break
+		}
+	}
+	return group, nil
+}
+
+// Switches - The switches in the group, switches that have been deleted are left out
+func (g *SwitchGroup) Switches() []*Switch {
+	found := []*Switch{}
+	for _, member := range g.Members {
+		if s := FindSwitchByID(fmt.Sprint(member.SwitchID)); s != nil {
+			found = append(found, s)
+		}
+	}
+	return found
+}
+
+// SetState - Turn every switch in the group on or off
+func (g *SwitchGroup) SetState(mode model.SwitchMode) error {
+	if !mode.IsValid() {
+		return fmt.Errorf("%v is not a valid switch state", mode)
+	}
+	for _, s := range g.Switches() {
+		if err := s.SetState(mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package devices
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/rs/zerolog/log"
+)
+
+// SwitchTimerInterval is how often WatchSwitchTimers checks the timers, pulses and maximum on times
+var SwitchTimerInterval = 100 * time.Millisecond
+
+// switchTimerLock guards the timers, it is never held while an output is changed so a slow output only holds up its own switch
+var switchTimerLock sync.Mutex
+
+// switchTimer turns a switch off after a time, or pulses it on and off
+// cycles -> The pulses left including the current one, 0 until it is stopped
+type switchTimer struct {
+	kind     model.SwitchTimerKind
+	on       time.Duration
+	off      time.Duration
+	cycles   int
+	pulseOn  bool
+	changeAt time.Time // When the switch is next turned on or off
+}
+
+// SwitchTimer is the timer or pulse running on a switch when it was looked at
+type SwitchTimer struct {
+	Kind             model.SwitchTimerKind
+	OnSeconds        float64
+	OffSeconds       float64
+	On               bool
+	CyclesLeft       *int
+	RemainingSeconds float64
+}
+
+// OnFor - Turn the switch on, then off after seconds, e.g. a CO2 purge
+func (s *Switch) OnFor(seconds float64, now func() time.Time) error {
+	if seconds <= 0 {
+		return fmt.Errorf("a switch has to be on for more than 0 seconds, got %v", seconds)
+	}
+	if now == nil {
+		now = time.Now
+	}
+	on := secondsDuration(seconds)
+	s.startTimer(&switchTimer{kind: model.SwitchTimerKindOnFor, on: on, pulseOn: true, changeAt: now().Add(on)})
+	return nil
+}
+
+// Pulse - Turn the switch on for onSeconds then off for offSeconds, cycles times or forever when cycles is 0, e.g. a dosing pump
+func (s *Switch) Pulse(onSeconds float64, offSeconds float64, cycles int, now func() time.Time) error {
+	if onSeconds <= 0 || offSeconds <= 0 {
+		return fmt.Errorf("a pulse has to be on and off for more than 0 seconds, got %v and %v", onSeconds, offSeconds)
+	}
+	if cycles < 0 {
+		return fmt.Errorf("a pulse cannot run %v times", cycles)
+	}
+	if now == nil {
+		now = time.Now
+	}
+	on := secondsDuration(onSeconds)
+	s.startTimer(&switchTimer{kind: model.SwitchTimerKindPulse, on: on, off: secondsDuration(offSeconds), cycles: cycles, pulseOn: true, changeAt: now().Add(on)})
+	return nil
+}
+
+func (s *Switch) startTimer(timer *switchTimer) {
+	s.lockOutput()
+	s.setTimer(timer)
+	wasOn := s.turnOn()
+	s.unlockOutput()
+	s.publishChange(wasOn)
+	log.Info().Msgf("%v is on for %v", s.Name(), timer.on)
+}
+
+func (s *Switch) setTimer(timer *switchTimer) {
+	switchTimerLock.Lock()
+	s.timer = timer
+	switchTimerLock.Unlock()
+}
+
+// Timer - The timer or pulse running on the switch at now, nil when there is none
+func (s *Switch) Timer(now func() time.Time) *SwitchTimer {
+	if now == nil {
+		now = time.Now
+	}
+	switchTimerLock.Lock()
+	defer switchTimerLock.Unlock()
+	if s.timer == nil {
+		return nil
+	}
+	timer := &SwitchTimer{
+		Kind:             s.timer.kind,
+		OnSeconds:        s.timer.on.Seconds(),
+		OffSeconds:       s.timer.off.Seconds(),
+		On:               s.timer.pulseOn,
+		RemainingSeconds: remainingSeconds(s.timer.changeAt, now()),
+	}
+	if s.timer.kind == model.SwitchTimerKindPulse && s.timer.cycles > 0 {
+		cycles := s.timer.cycles
+		timer.CyclesLeft = &cycles
+	}
+	return timer
+}
+
+// AutoOffSeconds - Seconds from now until MaxOnSeconds turns the switch off, nil when it is off or has no maximum
+func (s *Switch) AutoOffSeconds(now func() time.Time) *float64 {
+	if now == nil {
+		now = time.Now
+	}
+	since := s.onSince()
+	if s.MaxOnSeconds <= 0 || since == nil {
+		return nil
+	}
+	remaining := remainingSeconds(since.Add(time.Duration(s.MaxOnSeconds)*time.Second), now())
+	return &remaining
+}
+
+// onSince - When the switch was turned on, nil when it is off
+func (s *Switch) onSince() *time.Time {
+	if s.Output == nil || !s.switchedOn() {
+		return nil
+	}
+	onTime, offTime := s.Output.changedAt()
+	if s.Output.Duty() > 0 || !s.Inverted {
+		return onTime
+	}
+	return offTime
+}
+
+// WatchSwitchTimers - Check the switch timers every SwitchTimerInterval until quit is closed
+func WatchSwitchTimers(quit <-chan struct{}) {
+	ticker := time.NewTicker(SwitchTimerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			go CheckSwitchTimers(nil)
+		}
+	}
+}
+
+// CheckSwitchTimers - Turn switches on and off for their timers and pulses, and off when they have been on for longer than MaxOnSeconds
+// Reaching the maximum on time stops the timer or pulse as well
+// A switch whose output is still being changed is skipped until the next check, so an output that is slow to answer only holds up its own switch
+func CheckSwitchTimers(now func() time.Time) {
+	if !startCheck() {
+		return
+	}
+	defer finishCheck()
+	if now == nil {
+		now = time.Now
+	}
+	for _, s := range AllSwitches() {
+		if s.tryLockOutput() {
+			s.checkTimer(now())
+		}
+	}
+}
+
+// checkTimer switches s for its timer and maximum on time at, the output must be locked and is unlocked before the change is published
+func (s *Switch) checkTimer(at time.Time) {
+	if since := s.onSince(); s.MaxOnSeconds > 0 && since != nil && at.Sub(*since) >= time.Duration(s.MaxOnSeconds)*time.Second {
+		log.Warn().Msgf("%v has been on for more than %v seconds, turning it off", s.Name(), s.MaxOnSeconds)
+		s.setTimer(nil)
+		wasOn := s.turnOff()
+		s.unlockOutput()
+		s.publishChange(wasOn)
+		return
+	}
+
+	switchTimerLock.Lock()
+	timer := s.timer
+	if timer == nil || at.Before(timer.changeAt) {
+		switchTimerLock.Unlock()
+		s.unlockOutput()
+		return
+	}
+	turnOn := false
+	switch {
+	case timer.kind == model.SwitchTimerKindOnFor:
+		s.timer = nil
+	case timer.pulseOn:
+		timer.pulseOn = false
+		timer.changeAt = at.Add(timer.off)
+		if timer.cycles > 0 {
+			timer.cycles--
+			if timer.cycles == 0 {
+				s.timer = nil
+			}
+		}
+	default:
+		timer.pulseOn = true
+		timer.changeAt = at.Add(timer.on)
+		turnOn = true
+	}
+	switchTimerLock.Unlock()
+
+	var wasOn bool
+	if turnOn {
+		wasOn = s.turnOn()
+	} else {
+		wasOn = s.turnOff()
+	}
+	s.unlockOutput()
+	s.publishChange(wasOn)
+}
+
+func secondsDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// remainingSeconds from now until at, rounded to a tenth of a second and never negative
+func remainingSeconds(at time.Time, now time.Time) float64 {
+	remaining := at.Sub(now).Round(100 * time.Millisecond).Seconds()
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
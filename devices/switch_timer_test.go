@@ -0,0 +1,130 @@
+package devices_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/gpio/gpioreg"
+	"periph.io/x/periph/conn/gpio/gpiotest"
+)
+
+func TestSwitchTimers(t *testing.T) {
+	setupTestDb(t)
+	purge, purgePin := automationSwitch(t, "CO2 Purge", 901)
+	doser, doserPin := automationSwitch(t, "Dosing Pump", 902)
+	require.Nil(t, gpioreg.Register(&gpiotest.Pin{N: "TIMER_904", Num: 904}))
+
+	t.Run("Invalid timers are rejected", func(t *testing.T) {
+		require.Equal(t, "a switch has to be on for more than 0 seconds, got 0", purge.OnFor(0, nil).Error())
+		require.Equal(t, "a pulse has to be on and off for more than 0 seconds, got 1 and 0", doser.Pulse(1, 0, 0, nil).Error())
+		require.Equal(t, "a pulse cannot run -1 times", doser.Pulse(1, 1, -1, nil).Error())
+		maxOn := -1
+		_, err := devices.ModifySwitch(model.SwitchSettingsInput{ID: strPointer(fmt.Sprint(purge.ID)), MaxOnSeconds: &maxOn})
+		require.Equal(t, "the maximum on time cannot be negative, got -1", err.Error())
+		require.Nil(t, purge.Timer(nil))
+
+		turbo := model.SwitchMode("turbo")
+		_, err = devices.ModifySwitch(model.SwitchSettingsInput{Gpio: strPointer("TIMER_904"), Name: strPointer("Half Configured"), State: &turbo})
+		require.Equal(t, "turbo is not a valid switch state", err.Error())
+		_, err = devices.ModifySwitch(model.SwitchSettingsInput{Gpio: strPointer("TIMER_904"), Name: strPointer("Half Configured"), MaxOnSeconds: &maxOn})
+		require.Equal(t, "the maximum on time cannot be negative, got -1", err.Error())
+		require.False(t, devices.GpioInUse("TIMER_904"))
+		for _, s := range devices.AllSwitches() {
+			require.NotEqual(t, "Half Configured", s.Name())
+		}
+		require.Equal(t, gpio.Low, purgePin.Read())
+	})
+
+	t.Run("A switch can be on for a time", func(t *testing.T) {
+		start := time.Now()
+		require.Nil(t, purge.OnFor(30, after(start, 0)))
+		require.Equal(t, gpio.High, purgePin.Read())
+		timer := purge.Timer(after(start, 10))
+		require.Equal(t, model.SwitchTimerKindOnFor, timer.Kind)
+		require.Equal(t, 30.0, timer.OnSeconds)
+		require.True(t, timer.On)
+		require.Nil(t, timer.CyclesLeft)
+		require.Equal(t, 20.0, timer.RemainingSeconds)
+
+		devices.CheckSwitchTimers(after(start, 29))
+		require.Equal(t, gpio.High, purgePin.Read())
+		devices.CheckSwitchTimers(after(start, 30))
+		require.Equal(t, gpio.Low, purgePin.Read())
+		require.Nil(t, purge.Timer(nil))
+	})
+
+	t.Run("Turning a switch on or off stops the timer", func(t *testing.T) {
+		start := time.Now()
+		require.Nil(t, purge.OnFor(10, after(start, 0)))
+		purge.On()
+		require.Nil(t, purge.Timer(nil))
+		devices.CheckSwitchTimers(after(start, 10))
+		require.Equal(t, gpio.High, purgePin.Read())
+		purge.Off()
+	})
+
+	t.Run("A switch can pulse a number of times", func(t *testing.T) {
+		start := time.Now()
+		require.Nil(t, doser.Pulse(5, 10, 2, after(start, 0)))
+		require.Equal(t, gpio.High, doserPin.Read())
+		require.Equal(t, 2, *doser.Timer(nil).CyclesLeft)
+
+		devices.CheckSwitchTimers(after(start, 5))
+		require.Equal(t, gpio.Low, doserPin.Read())
+		timer := doser.Timer(after(start, 8))
+		require.False(t, timer.On)
+		require.Equal(t, 7.0, timer.RemainingSeconds)
+		require.Equal(t, 1, *timer.CyclesLeft)
+		require.Equal(t, 10.0, timer.OffSeconds)
+
+		devices.CheckSwitchTimers(after(start, 14))
+		require.Equal(t, gpio.Low, doserPin.Read())
+		devices.CheckSwitchTimers(after(start, 15))
+		require.Equal(t, gpio.High, doserPin.Read())
+		devices.CheckSwitchTimers(after(start, 20))
+		require.Equal(t, gpio.Low, doserPin.Read())
+		require.Nil(t, doser.Timer(nil))
+		devices.CheckSwitchTimers(after(start, 30))
+		require.Equal(t, gpio.Low, doserPin.Read())
+	})
+
+	t.Run("A switch can pulse until it is stopped", func(t *testing.T) {
+		start := time.Now()
+		require.Nil(t, doser.Pulse(1, 1, 0, after(start, 0)))
+		for i := 1; i <= 10; i++ {
+			devices.CheckSwitchTimers(after(start, i))
+		}
+		require.Equal(t, gpio.High, doserPin.Read())
+		require.Nil(t, doser.Timer(nil).CyclesLeft)
+		doser.Off()
+		require.Nil(t, doser.Timer(nil))
+	})
+
+	t.Run("A switch is turned off after its maximum on time", func(t *testing.T) {
+		maxOn := 60
+		_, err := devices.ModifySwitch(model.SwitchSettingsInput{ID: strPointer(fmt.Sprint(purge.ID)), MaxOnSeconds: &maxOn})
+		require.Nil(t, err)
+		require.Equal(t, int64(60), devices.FindSwitchByID(fmt.Sprint(purge.ID)).MaxOnSeconds)
+		require.Nil(t, purge.AutoOffSeconds(nil))
+
+		start := time.Now()
+		purge.On()
+		require.InDelta(t, 50, *purge.AutoOffSeconds(after(start, 10)), 1)
+		devices.CheckSwitchTimers(after(start, 50))
+		require.Equal(t, gpio.High, purgePin.Read())
+		devices.CheckSwitchTimers(after(start, 61))
+		require.Equal(t, gpio.Low, purgePin.Read())
+		require.Nil(t, purge.AutoOffSeconds(nil))
+
+		// It stops a pulse that would keep it on for longer
+		require.Nil(t, purge.Pulse(120, 10, 0, after(start, 0)))
+		devices.CheckSwitchTimers(after(start, 61))
+		require.Equal(t, gpio.Low, purgePin.Read())
+		require.Nil(t, purge.Timer(nil))
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dougedey/elsinore/database"
@@ -18,6 +19,12 @@ import (
 
 var controllers []*TemperatureController = nil
 
+// suspended and checksRunning are guarded by suspendLock, suspendIdle is signalled when the last running check finishes
+var suspended = false
+var checksRunning = 0
+var suspendLock sync.Mutex
+var suspendIdle = sync.NewCond(&suspendLock)
+
 // TempProbeDetail is the persisted model for TemperatureProbe
 // this is to simplify loading data so that TemperatureProbe represents the physical state and this represents the cached state
 type TempProbeDetail struct {
@@ -25,13 +32,25 @@ type TempProbeDetail struct {
 	TemperatureControllerID uint
 	PhysAddr                string
 	FriendlyName            string
-	ReadingRaw              physic.Temperature `gorm:"-"`
+	ReadingRaw              physic.Temperature `gorm:"-"` // Calibrated reading
+	UncalibratedRaw         physic.Temperature `gorm:"-"` // Reading as reported by the probe
 	Updated                 time.Time
+	Fault                   string   `gorm:"-"` // The last error reported by the probe, empty when it is healthy
+	Gravity                 *float64 `gorm:"-"` // The specific gravity from a hydrometer probe, nil for other probes
+	Weight                  float64  // Used by the weighted aggregation, unset is 1
+	Priority                int64    // Used by the primary aggregation, the lowest priority usable probe is used
+	CalibrationMode         model.CalibrationMode
+	CalibrationOffset       physic.Temperature
+	LowRawReading           *physic.Temperature // Probe reading at the low calibration point (e.g. an ice bath)
+	LowReference            *physic.Temperature // Actual temperature of the low calibration point
+	HighRawReading          *physic.Temperature // Probe reading at the high calibration point (e.g. boiling water)
+	HighReference           *physic.Temperature // Actual temperature of the high calibration point
 }
 
 // TemperatureController defines a mapping of temperature probes to their control settings
 type TemperatureController struct {
 	gorm.Model
+	database.InstanceScoped
 	Name             string
 	LastReadings     []physic.Temperature `gorm:"-"`
 	TempProbeDetails []*TempProbeDetail
@@ -46,14 +65,18 @@ type TemperatureController struct {
 	DutyCycle               int64
 	CalculatedDuty          int64
 	SetPointRaw             *physic.Temperature
-	PreviousCalculationTime time.Time      `gorm:"-"`
-	TotalDiff               float64        `gorm:"-"` // Always in Fahrenheit (internal calculation)
-	integralError           float64        `gorm:"-"`
-	derivativeFactor        float64        `gorm:"-"`
-	prevDiff                float64        `gorm:"-"` // Always in Fahrenheit (internal calculaiton)
-	OutputControl           *OutputControl `gorm:"-"`
-	Running                 bool           `gorm:"-"`
-	quitOutputControl       chan struct{}  `gorm:"-"`
+	AggregationMode         model.AggregationMode // How the probe readings are combined
+	StaleAfter              int64                 // Seconds without an update before a probe is ignored
+	ContributingProbes      []string              `gorm:"-"`
+	PreviousCalculationTime time.Time             `gorm:"-"`
+	TotalDiff               float64               `gorm:"-"` // Always in Fahrenheit (internal calculation)
+	integralError           float64               `gorm:"-"`
+	derivativeFactor        float64               `gorm:"-"`
+	prevDiff                float64               `gorm:"-"` // Always in Fahrenheit (internal calculaiton)
+	OutputControl           *OutputControl        `gorm:"-"`
+	Running                 bool                  `gorm:"-"`
+	quitOutputControl       chan struct{}         `gorm:"-"`
+	interlocked             bool                  `gorm:"-"` // Whether an interlock held the outputs off on the last update
 }
 
 // PidSettings define the actual values for heating/cooling as persisted
@@ -68,6 +91,7 @@ type PidSettings struct {
 	TemperatureControllerID   uint
 	TemperatureControllerType string
 	Gpio                      string
+	Frequency                 int64 // PWM frequency in Hz, the output runs at the duty cycle instead of cycling on and off
 }
 
 // HysteriaSettings are used for Hysteria mode
@@ -176,6 +200,10 @@ func DeleteTemperatureControllerByID(id string) []*string {
 		return nil
 	}
 
+	if _, err := controller.EndFermentation(); err == nil {
+		log.Info().Msgf("Ended the fermentation for %v", controller.Name)
+	}
+
 	probeList := []*string{}
 	for _, t := range controller.TempProbeDetails {
 		probeList = append(probeList, &t.PhysAddr)
@@ -199,6 +227,83 @@ func ClearControllers() {
 	controllers = nil
 }
 
+// SuspendTemperatureControllers - Stop every running controller, turn off their outputs and switches, and stop them being restarted until ResumeTemperatureControllers is called
+// The alerts, automations, schedules, scenes, switch timers and probe checks are skipped until then too, a check that is running is waited for
+func SuspendTemperatureControllers() {
+	suspendLock.Lock()
+	suspended = true
+	for checksRunning > 0 {
+		suspendIdle.Wait()
+	}
+	suspendLock.Unlock()
+
+	for _, controller := range controllers {
+		controller.Stop()
+	}
+	ClearFlowMeters()
+	ShutdownAllSwitches()
+	ClearInPins()
+}
+
+// ResumeTemperatureControllers - Reload every device from the database, e.g. after a restore, then allow the controllers to run again
+func ResumeTemperatureControllers() {
+	controllers = nil
+	switches = nil
+	outpins = nil
+	probeSettings = nil
+	fermentations = nil
+	ClearWebhooks()
+	ClearAlerts()
+	ClearAutomations()
+	ClearSchedules()
+	ClearScenes()
+	ClearExpanders()
+	AllExpanders()
+	ClearSPIProbes()
+	AllSPIProbes()
+	ClearInPins()
+	AllInPins()
+	dropFlowMeters()
+	AllFlowMeters()
+
+	suspendLock.Lock()
+	suspended = false
+	suspendLock.Unlock()
+}
+
+// TemperatureControllersSuspended - Returns true when controllers should not be started
+func TemperatureControllersSuspended() bool {
+	suspendLock.Lock()
+	defer suspendLock.Unlock()
+	return suspended
+}
+
+// startCheck returns false while the controllers are suspended, otherwise finishCheck must be called when the check is done
+func startCheck() bool {
+	suspendLock.Lock()
+	defer suspendLock.Unlock()
+	if suspended {
+		return false
+	}
+	checksRunning++
+	return true
+}
+
+func finishCheck() {
+	suspendLock.Lock()
+	defer suspendLock.Unlock()
+	checksRunning--
+	if checksRunning == 0 {
+		suspendIdle.Broadcast()
+	}
+}
+
+// Stop - Stop the control loop for this controller and turn off its outputs
+func (c *TemperatureController) Stop() {
+	c.Running = false
+	c.OutputControl.Reset()
+}
+
 // CreateTemperatureController Create a new PID controller for the Temperature probe
 // name -> The name of the PID Controller
 // probe -> The probe to associate with the controller
@@ -228,6 +333,20 @@ func CreateTemperatureController(name string, probe *TempProbeDetail) (*Temperat
 	return controller, nil
 }
 
+// AssignProbe - Add the probe at address to the controller called name, creating the controller if it does not exist
+func AssignProbe(name string, address string) (*TemperatureController, error) {
+	probe := hardware.GetTemperature(address)
+	if probe == nil {
+		return nil, fmt.Errorf("could not find a probe for %v", address)
+	}
+	if !ProbeEnabled(probe.PhysAddr) {
+		return nil, fmt.Errorf("probe %v is disabled", address)
+	}
+	probeDetails := TempProbeDetail{FriendlyName: ProbeName(probe.PhysAddr), PhysAddr: probe.PhysAddr}
+	probeDetails.UpdateReading()
+	return CreateTemperatureController(name, &probeDetails)
+}
+
 // RemoveProbe removes a temperature probe from this controller
 func (c *TemperatureController) RemoveProbe(physAddr string) error {
 	for i, probe := range c.TempProbeDetails {
@@ -253,10 +372,40 @@ func (c *TemperatureController) UpdateOutput() {
 	for _, t := range c.TempProbeDetails {
 		t.UpdateReading()
 	}
-	averageTemp := c.AverageTemperature()
-	c.LastReadings = append(c.LastReadings, averageTemp)
+	averageTemp, contributing, err := c.AggregateTemperature()
+	c.ContributingProbes = contributing
+	if err != nil {
+		log.Warn().Err(err).Msgf("Cannot read the temperature for %v", c.Name)
+	} else {
+		c.LastReadings = append(c.LastReadings, averageTemp)
+	}
+	c.updateFermentation(nil)
+	if c.OutputControl != nil {
+		c.OutputControl.HeatFrequency = c.HeatSettings.Frequency
+		c.OutputControl.CoolFrequency = c.CoolSettings.Frequency
+	}
+	interlocked := c.Interlocked()
+	if interlocked && !c.interlocked {
+		publishFault(c.Name, fmt.Sprint(c.ID), "an interlock is holding the outputs off")
+	}
+	c.interlocked = interlocked
+	c.OutputControl.hold(interlocked)
+	if interlocked {
+		// An inactive interlock input holds the outputs off whatever the mode
+		if c.OutputControl != nil {
+			c.OutputControl.DutyCycle = 0
+		}
+		return
+	}
 	switch c.Mode {
 	case "auto":
+		if err != nil {
+			// Without a temperature there is nothing safe to control on
+			if c.OutputControl != nil {
+				c.OutputControl.DutyCycle = 0
+			}
+			return
+		}
 		c.CalculatedDuty = c.Calculate(averageTemp, nil)
 		if c.OutputControl == nil {
 			return
@@ -308,7 +457,9 @@ func (c *TemperatureController) RunControl() {
 			if !c.Running {
 				ticker.Stop()
 				log.Info().Msgf("Stopping %v", c.Name)
-				c.quitOutputControl <- struct{}{}
+				if c.OutputControl != nil {
+					c.quitOutputControl <- struct{}{}
+				}
 				return
 			}
 			c.UpdateOutput()
@@ -319,14 +470,15 @@ func (c *TemperatureController) RunControl() {
 	}
 }
 
-// AverageTemperature Calculate the average temperature for a temperature controller over all the probes
+// AverageTemperature Calculate the temperature for a temperature controller over the usable probes, see AggregateTemperature
+// Returns 0 when there are no usable probes
 func (c *TemperatureController) AverageTemperature() physic.Temperature {
-	var totalTemp int64
-	for _, probe := range c.TempProbeDetails {
-		totalTemp += (int64)(probe.ReadingRaw)
+	temperature, _, err := c.AggregateTemperature()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Cannot calculate the average temperature for %v", c.Name)
+		return 0
 	}
-
-	return (physic.Temperature)(totalTemp / (int64)(len(c.TempProbeDetails)))
+	return temperature
 }
 
 // Calculate does the calculation for the probe
@@ -431,13 +583,30 @@ func (c *TemperatureController) ApplySettings(newSettings model.TemperatureContr
 		return err
 	}
 
-	log.Logger.Info().Msgf("Name is %v", *newSettings.Name)
 	if newSettings.Name != nil {
+		log.Logger.Info().Msgf("Name is %v", *newSettings.Name)
 		c.Name = *newSettings.Name
 	}
 
 	if newSettings.Mode != nil {
-		c.Mode = *newSettings.Mode
+		if !newSettings.Mode.IsValid() {
+			return fmt.Errorf("%v is not a valid controller mode", *newSettings.Mode)
+		}
+		c.setMode(*newSettings.Mode)
+	}
+
+	if newSettings.Aggregation != nil {
+		if !newSettings.Aggregation.IsValid() {
+			return fmt.Errorf("%v is not a valid aggregation mode", *newSettings.Aggregation)
+		}
+		c.AggregationMode = *newSettings.Aggregation
+	}
+
+	if newSettings.StaleAfter != nil {
+		if *newSettings.StaleAfter < 0 {
+			return fmt.Errorf("staleAfter must not be negative, got %v", *newSettings.StaleAfter)
+		}
+		c.StaleAfter = int64(*newSettings.StaleAfter)
 	}
 
 	if newSettings.SetPoint != nil {
@@ -508,6 +677,12 @@ func (s *PidSettings) ApplySettings(newSettings *model.PidSettingsInput) error {
 	if newSettings.Gpio != nil {
 		s.Gpio = *newSettings.Gpio
 	}
+	if newSettings.Frequency != nil {
+		if err := validatePWM(int64(*newSettings.Frequency), 0); err != nil {
+			return err
+		}
+		s.Frequency = int64(*newSettings.Frequency)
+	}
 	return nil
 }
 
@@ -516,6 +691,12 @@ func (s *ManualSettings) ApplySettings(newSettings *model.ManualSettingsInput) e
 	if newSettings == nil {
 		return nil
 	}
+	if newSettings.DutyCycle != nil && (*newSettings.DutyCycle < -100 || *newSettings.DutyCycle > 100) {
+		return fmt.Errorf("the manual duty cycle must be between -100 and 100, got %v", *newSettings.DutyCycle)
+	}
+	if newSettings.CycleTime != nil && *newSettings.CycleTime < 0 {
+		return fmt.Errorf("the manual cycle time must not be negative, got %v", *newSettings.CycleTime)
+	}
 	if newSettings.Configured != nil {
 		s.Configured = *newSettings.Configured
 	}
@@ -554,9 +735,13 @@ func (h *HysteriaSettings) ApplySettings(newSettings *model.HysteriaSettingsInpu
 	return nil
 }
 
-// UpdateTemperature Set the temperature on the Temperature Probe from a string
+// UpdateTemperature Set the temperature on the Temperature Probe from a string, it counts as an update
 func (t *TempProbeDetail) UpdateTemperature(newTemp string) error {
-	return t.ReadingRaw.Set(newTemp)
+	if err := t.ReadingRaw.Set(newTemp); err != nil {
+		return err
+	}
+	t.Updated = time.Now()
+	return nil
 }
 
 // Reading The current temperature reading for the probe
@@ -564,12 +749,23 @@ func (t *TempProbeDetail) Reading() string {
 	return t.ReadingRaw.String()
 }
 
-// UpdateReading -  Update the reading from the associated probe
+// UpdateReading -  Update the reading from the associated probe, applying the calibration
 func (t *TempProbeDetail) UpdateReading() {
-	err := t.ReadingRaw.Set(hardware.GetTemperature(t.PhysAddr).Reading())
+	probe := hardware.GetTemperature(t.PhysAddr)
+	err := t.UncalibratedRaw.Set(probe.Reading())
 	if err != nil {
 		log.Printf("Failed to update %v temperature details: %v", t.PhysAddr, err)
+		return
 	}
+	t.ReadingRaw = t.Calibrate(t.UncalibratedRaw)
+	t.Updated = probe.Updated
+	t.Fault = probe.Fault
+	t.Gravity = probe.Gravity
+}
+
+// RawReading The current temperature reading for the probe before calibration
+func (t *TempProbeDetail) RawReading() string {
+	return t.UncalibratedRaw.String()
 }
 
 func (c *TemperatureController) loadController() {
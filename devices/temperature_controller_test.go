@@ -14,14 +14,32 @@ import (
 	"periph.io/x/periph/conn/physic"
 )
 
+// setupTestDb uses a local SQLite database, or the database in ELSINORE_TEST_DSN when it is set (e.g. a local PostgreSQL)
 func setupTestDb(t *testing.T) {
 	dbName := "test"
+	testDSN := os.Getenv("ELSINORE_TEST_DSN")
+	if testDSN != "" {
+		dbName = testDSN
+	}
 	database.InitDatabase(&dbName,
 		&devices.TempProbeDetail{}, &devices.PidSettings{}, &devices.HysteriaSettings{},
 		&devices.ManualSettings{}, &devices.TemperatureController{}, &devices.Switch{},
+		&devices.ProbeSettings{}, &devices.SPIProbe{}, &devices.InPin{}, &devices.FlowMeter{},
+		&devices.Expander{},
+		&devices.Fermentation{}, &devices.FermentationStep{}, &devices.GravityReading{},
+		&devices.Webhook{}, &devices.WebhookDelivery{}, &devices.AlertRule{}, &devices.AlertChannel{},
+		&devices.AutomationRule{}, &devices.AutomationCondition{}, &devices.AutomationAction{},
+		&devices.Schedule{}, &devices.SwitchGroup{}, &devices.SwitchGroupMember{}, &devices.Scene{}, &devices.SceneStep{},
 	)
 
 	t.Cleanup(func() {
+		if testDSN != "" {
+			if e := database.DropTables(); e != nil {
+				t.Fatal(e)
+			}
+			database.Close()
+			return
+		}
 		database.Close()
 		e := os.Remove("test.db")
 		if e != nil {
@@ -275,6 +293,12 @@ func TestTemperatureControllerUpdateOutput(t *testing.T) {
 		}
 	})
 
+	t.Run("The probes used for the temperature are recorded", func(t *testing.T) {
+		if !temperatureController.Contributing("ARealAddress") {
+			t.Fatalf("Expected ARealAddress to contribute, got %v", temperatureController.ContributingProbes)
+		}
+	})
+
 	t.Run("When auto mode and no output control, do nothing", func(t *testing.T) {
 		temperatureController.Mode = "auto"
 		temperatureController.UpdateOutput()
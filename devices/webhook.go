@@ -0,0 +1,395 @@
+package devices
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/system"
+	"github.com/rs/zerolog/log"
+	"gorm.io/gorm"
+)
+
+// The number of deliveries kept in the log for each webhook
+const webhookDeliveryHistory = 100
+
+// WebhookAttempts is how many times a payload is POSTed before the delivery fails
+var WebhookAttempts = 5
+
+// WebhookBackoff is the wait before the first retry, it doubles for every retry after that
+var WebhookBackoff = 2 * time.Second
+
+// WebhookTimeout is how long a URL has to respond to a POST
+var WebhookTimeout = 10 * time.Second
+
+var webhooks []*Webhook = nil
+var webhookLock sync.Mutex
+
+// Webhook is a URL that device events are POSTed to as JSON, signed with the secret
+type Webhook struct {
+	gorm.Model
+	database.InstanceScoped
+	Name       string
+	URL        string
+	EventTypes string // The events that are sent, comma separated
+	Secret     string // The key for the HMAC-SHA256 signature of the payload
+	Enabled    bool
+}
+
+// WebhookDelivery is an event sent to a webhook, updated after every attempt
+type WebhookDelivery struct {
+	gorm.Model
+	WebhookID    uint `gorm:"index"`
+	Event        model.DeviceEventType
+	Payload      string
+	Status       model.WebhookDeliveryStatus
+	Attempts     int
+	ResponseCode *int   // The HTTP status of the last attempt, nil when there was no response
+	Error        string // Why the last attempt failed
+}
+
+// webhookPayload is the JSON body of a delivery, text is the message so it can be sent straight to a Slack incoming webhook
+type webhookPayload struct {
+	Event    model.DeviceEventType `json:"event"`
+	Time     time.Time             `json:"time"`
+	Brewery  string                `json:"brewery"`
+	Device   string                `json:"device"`
+	DeviceID string                `json:"deviceId"`
+	Text     string                `json:"text"`
+	Data     map[string]string     `json:"data"`
+}
+
+// AllWebhooks returns all the webhooks, loading from the Database if none are loaded
+func AllWebhooks() []*Webhook {
+	webhookLock.Lock()
+	defer webhookLock.Unlock()
+	return loadWebhooks()
+}
+
+func loadWebhooks() []*Webhook {
+	if webhooks == nil && database.FetchDatabase() != nil {
+		log.Info().Msg("Webhooks array is nil, checking the database...")
+		database.FetchDatabase().Debug().Find(&webhooks)
+	}
+	return webhooks
+}
+
+// FindWebhookByID - Find a webhook by id
+func FindWebhookByID(id string) *Webhook {
+	intID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil
+	}
+
+	for _, webhook := range AllWebhooks() {
+		if webhook.ID == uint(intID) {
+			return webhook
+		}
+	}
+	return nil
+}
+
+// ModifyWebhook - Create or update a webhook, a secret is generated when one is not given
+func ModifyWebhook(settings model.WebhookInput) (*Webhook, error) {
+	webhook := &Webhook{Enabled: true}
+	if settings.ID != nil {
+		webhook = FindWebhookByID(*settings.ID)
+		if webhook == nil {
+			return nil, fmt.Errorf("no webhook with id: %v found", *settings.ID)
+		}
+	} else if settings.Name == nil || settings.URL == nil || settings.Events == nil {
+		return nil, fmt.Errorf("name, url and events are required when creating a webhook")
+	}
+
+	name, address, events := webhook.Name, webhook.URL, webhook.Events()
+	if settings.Name != nil {
+		name = strings.TrimSpace(*settings.Name)
+	}
+	if settings.URL != nil {
+		address = strings.TrimSpace(*settings.URL)
+	}
+	if settings.Events != nil {
+		events = settings.Events
+	}
+
+	if len(name) == 0 {
+		return nil, fmt.Errorf("a webhook needs a name")
+	}
+	if parsed, err := url.Parse(address); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || len(parsed.Host) == 0 {
+		return nil, fmt.Errorf("'%v' is not an http or https URL", address)
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("a webhook needs at least one event")
+	}
+	eventTypes := []string{}
+	for _, event := range events {
+		if !event.IsValid() {
+			return nil, fmt.Errorf("%v is not a device event", event)
+		}
+		eventTypes = append(eventTypes, string(event))
+	}
+	for _, other := range AllWebhooks() {
+		if other != webhook && strings.EqualFold(other.Name, name) {
+			return nil, fmt.Errorf("webhook '%v' already exists", name)
+		}
+	}
+
+	webhookLock.Lock()
+	defer webhookLock.Unlock()
+	webhook.Name, webhook.URL, webhook.EventTypes = name, address, strings.Join(eventTypes, ",")
+	if settings.Secret != nil {
+		webhook.Secret = strings.TrimSpace(*settings.Secret)
+	}
+	if len(webhook.Secret) == 0 {
+		webhook.Secret = generateSecret()
+	}
+	if settings.Enabled != nil {
+		webhook.Enabled = *settings.Enabled
+	}
+	if webhook.ID == 0 {
+		webhooks = append(webhooks, webhook)
+	}
+	database.Save(webhook)
+	return webhook, nil
+}
+
+// DeleteWebhookByID - Delete a webhook and its delivery log
+func DeleteWebhookByID(id string) (*Webhook, error) {
+	webhook := FindWebhookByID(id)
+	if webhook == nil {
+		return nil, fmt.Errorf("no webhook found with id '%v'", id)
+	}
+
+	webhookLock.Lock()
+	defer webhookLock.Unlock()
+	if database.FetchDatabase() != nil {
+		database.FetchDatabase().Debug().Unscoped().Where("webhook_id = ?", webhook.ID).Delete(&WebhookDelivery{})
+		database.FetchDatabase().Debug().Delete(webhook)
+	}
+	for i, w := range webhooks {
+		if w == webhook {
+			webhooks[i] = webhooks[len(webhooks)-1]
+			webhooks = webhooks[:len(webhooks)-1]
+			break
+		}
+	}
+	return webhook, nil
+}
+
+// ClearWebhooks reset the cached webhooks
+func ClearWebhooks() {
+	webhookLock.Lock()
+	defer webhookLock.Unlock()
+	webhooks = nil
+}
+
+// WebhookDeliveries - The most recent deliveries, newest first, for a webhook or every webhook when webhookID is 0
+func WebhookDeliveries(webhookID uint, limit int) []*WebhookDelivery {
+	deliveries := []*WebhookDelivery{}
+	if database.FetchDatabase() == nil {
+		return deliveries
+	}
+	query := database.FetchDatabase().Order("id desc")
+	if webhookID != 0 {
+		query = query.Where("webhook_id = ?", webhookID)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	query.Find(&deliveries)
+	return deliveries
+}
+
+// Events - The events that are sent to this webhook
+func (w *Webhook) Events() []model.DeviceEventType {
+	events := []model.DeviceEventType{}
+	for _, event := range strings.Split(w.EventTypes, ",") {
+		if len(event) > 0 {
+			events = append(events, model.DeviceEventType(event))
+		}
+	}
+	return events
+}
+
+// Subscribes - Returns true if this webhook is sent events of this type
+func (w *Webhook) Subscribes(eventType model.DeviceEventType) bool {
+	for _, event := range w.Events() {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Deliveries - The most recent deliveries to this webhook, newest first, every delivery in the log when limit is nil
+func (w *Webhook) Deliveries(limit *int) []*WebhookDelivery {
+	if limit == nil {
+		return WebhookDeliveries(w.ID, 0)
+	}
+	return WebhookDeliveries(w.ID, *limit)
+}
+
+// Test - Send a test event now, whatever events the webhook subscribes to
+// The first attempt is made before returning, any retries carry on in the background
+func (w *Webhook) Test() (*WebhookDelivery, error) {
+	event := Event{
+		Type:     model.DeviceEventTypeSwitchToggled,
+		Time:     time.Now(),
+		Device:   "Test",
+		DeviceID: "test",
+		Message:  fmt.Sprintf("A test of the %v webhook", w.Name),
+		Data:     map[string]string{"test": "true"},
+	}
+	delivery, body, err := w.newDelivery(event)
+	if err != nil {
+		return nil, err
+	}
+	done := w.attempt(delivery, body)
+	result := *delivery
+	if !done {
+		go w.retry(delivery, body)
+	}
+	return &result, nil
+}
+
+// sendWebhooks queues the event for every enabled webhook that subscribes to it
+func sendWebhooks(event Event) {
+	webhookLock.Lock()
+	subscribed := []*Webhook{}
+	for _, webhook := range loadWebhooks() {
+		if webhook.Enabled && webhook.Subscribes(event.Type) {
+			subscribed = append(subscribed, webhook)
+		}
+	}
+	webhookLock.Unlock()
+
+	for _, webhook := range subscribed {
+		delivery, body, err := webhook.newDelivery(event)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed to queue %v for the %v webhook", event.Type, webhook.Name)
+			continue
+		}
+		go func(webhook *Webhook) {
+			if !webhook.attempt(delivery, body) {
+				webhook.retry(delivery, body)
+			}
+		}(webhook)
+	}
+}
+
+// newDelivery saves a pending delivery of the event, dropping the oldest deliveries from the log
+func (w *Webhook) newDelivery(event Event) (*WebhookDelivery, []byte, error) {
+	body, err := json.Marshal(webhookPayload{
+		Event:    event.Type,
+		Time:     event.Time,
+		Brewery:  system.CurrentSettings().BreweryName,
+		Device:   event.Device,
+		DeviceID: event.DeviceID,
+		Text:     event.Message,
+		Data:     event.Data,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delivery := &WebhookDelivery{WebhookID: w.ID, Event: event.Type, Payload: string(body), Status: model.WebhookDeliveryStatusPending}
+	if db := database.FetchDatabase(); db != nil {
+		db.Create(delivery)
+		var oldest []uint
+		db.Model(&WebhookDelivery{}).Where("webhook_id = ?", w.ID).Order("id desc").Offset(webhookDeliveryHistory).Limit(1).Pluck("id", &oldest)
+		if len(oldest) > 0 {
+			db.Unscoped().Where("webhook_id = ? AND id <= ?", w.ID, oldest[0]).Delete(&WebhookDelivery{})
+		}
+	}
+	return delivery, body, nil
+}
+
+// retry makes the remaining attempts, backing off between them, until the delivery is delivered or fails
+func (w *Webhook) retry(delivery *WebhookDelivery, body []byte) {
+	backoff := WebhookBackoff * time.Duration(1<<uint(delivery.Attempts-1))
+	for delivery.Status == model.WebhookDeliveryStatusPending {
+		select {
+		case <-Context.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if FindWebhookByID(fmt.Sprint(w.ID)) == nil {
+			return
+		}
+		w.attempt(delivery, body)
+		backoff *= 2
+	}
+}
+
+// attempt POSTs the payload once, returning true when there is nothing more to try
+// Anything but a 2xx is retried, except client errors that will not change such as a 404
+func (w *Webhook) attempt(delivery *WebhookDelivery, body []byte) bool {
+	delivery.Attempts++
+	delivery.ResponseCode = nil
+	delivery.Error = ""
+	retry := false
+
+	request, err := http.NewRequestWithContext(Context, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err == nil {
+		request.Header.Set("Content-Type", "application/json")
+		request.Header.Set("User-Agent", "Elsinore")
+		request.Header.Set("X-Elsinore-Event", string(delivery.Event))
+		request.Header.Set("X-Elsinore-Delivery", fmt.Sprint(delivery.ID))
+		request.Header.Set("X-Elsinore-Signature", Sign(w.Secret, body))
+
+		var response *http.Response
+		response, err = (&http.Client{Timeout: WebhookTimeout}).Do(request)
+		if err == nil {
+			response.Body.Close()
+			code := response.StatusCode
+			delivery.ResponseCode = &code
+			if code < 200 || code > 299 {
+				err = fmt.Errorf("%v responded %v", w.URL, response.Status)
+				retry = code >= 500 || code == http.StatusRequestTimeout || code == http.StatusTooManyRequests
+			}
+		} else {
+			retry = true
+		}
+	}
+
+	switch {
+	case err == nil:
+		delivery.Status = model.WebhookDeliveryStatusDelivered
+	case retry && delivery.Attempts < WebhookAttempts:
+		delivery.Error = err.Error()
+		log.Warn().Err(err).Msgf("Failed to send %v to the %v webhook, attempt %v of %v", delivery.Event, w.Name, delivery.Attempts, WebhookAttempts)
+	default:
+		delivery.Error = err.Error()
+		delivery.Status = model.WebhookDeliveryStatusFailed
+		log.Error().Err(err).Msgf("Failed to send %v to the %v webhook", delivery.Event, w.Name)
+	}
+	database.Save(delivery)
+	return delivery.Status != model.WebhookDeliveryStatusPending
+}
+
+// Sign - The X-Elsinore-Signature header for a payload, sha256= and the hex HMAC-SHA256 of the body keyed with the secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func generateSecret() string {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Error().Err(err).Msg("Failed to generate a webhook secret")
+	}
+	return hex.EncodeToString(secret)
+}
@@ -0,0 +1,199 @@
+package devices_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio/gpioreg"
+	"periph.io/x/periph/conn/gpio/gpiotest"
+)
+
+type webhookRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// webhookReceiver - A server that records every request, responding with the queued statuses and then 200
+func webhookReceiver(t *testing.T) (*httptest.Server, chan webhookRequest, chan int) {
+	requests := make(chan webhookRequest, 10)
+	statuses := make(chan int, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- webhookRequest{header: r.Header, body: body}
+		select {
+		case status := <-statuses:
+			w.WriteHeader(status)
+		default:
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, requests, statuses
+}
+
+func nextRequest(t *testing.T, requests chan webhookRequest) webhookRequest {
+	select {
+	case request := <-requests:
+		return request
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "the webhook was not called")
+	}
+	return webhookRequest{}
+}
+
+// lastDelivery - Wait for the newest delivery to the webhook to finish
+func lastDelivery(t *testing.T, webhook *devices.Webhook) *devices.WebhookDelivery {
+	var delivery *devices.WebhookDelivery
+	require.Eventually(t, func() bool {
+		deliveries := devices.WebhookDeliveries(webhook.ID, 1)
+		if len(deliveries) == 0 {
+			return false
+		}
+		delivery = deliveries[0]
+		return delivery.Status != model.WebhookDeliveryStatusPending
+	}, 5*time.Second, 10*time.Millisecond)
+	return delivery
+}
+
+func TestWebhooks(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearWebhooks()
+	t.Cleanup(devices.ClearWebhooks)
+	backoff := devices.WebhookBackoff
+	devices.WebhookBackoff = 10 * time.Millisecond
+	t.Cleanup(func() { devices.WebhookBackoff = backoff })
+
+	server, requests, statuses := webhookReceiver(t)
+	pin := &gpiotest.Pin{N: "WEBHOOK_HEATER", Num: 602}
+	require.Nil(t, gpioreg.Register(pin))
+	heater, err := devices.CreateSwitch("WEBHOOK_HEATER", "HLT Heater")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_, err := devices.DeleteSwitchByID(fmt.Sprint(heater.ID))
+		require.Nil(t, err)
+	})
+
+	t.Run("Invalid webhooks are rejected", func(t *testing.T) {
+		events := []model.DeviceEventType{model.DeviceEventTypeSwitchToggled}
+		_, err := devices.ModifyWebhook(model.WebhookInput{Name: strPointer("Slack"), URL: strPointer(server.URL)})
+		require.NotNil(t, err)
+		_, err = devices.ModifyWebhook(model.WebhookInput{Name: strPointer("Slack"), URL: strPointer("ftp://example.com"), Events: events})
+		require.Equal(t, "'ftp://example.com' is not an http or https URL", err.Error())
+		_, err = devices.ModifyWebhook(model.WebhookInput{Name: strPointer("Slack"), URL: strPointer(server.URL), Events: []model.DeviceEventType{}})
+		require.Equal(t, "a webhook needs at least one event", err.Error())
+		_, err = devices.ModifyWebhook(model.WebhookInput{Name: strPointer("Slack"), URL: strPointer(server.URL), Events: []model.DeviceEventType{"boiled"}})
+		require.Equal(t, "boiled is not a device event", err.Error())
+		require.Empty(t, devices.AllWebhooks())
+	})
+
+	webhook, err := devices.ModifyWebhook(model.WebhookInput{
+		Name:   strPointer("n8n"),
+		URL:    strPointer(server.URL),
+		Events: []model.DeviceEventType{model.DeviceEventTypeSwitchToggled, model.DeviceEventTypeSafetyFault},
+	})
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_, err := devices.DeleteWebhookByID(fmt.Sprint(webhook.ID))
+		require.Nil(t, err)
+	})
+
+	t.Run("A secret is generated", func(t *testing.T) {
+		require.Len(t, webhook.Secret, 64)
+		require.True(t, webhook.Enabled)
+		require.Equal(t, []model.DeviceEventType{model.DeviceEventTypeSwitchToggled, model.DeviceEventTypeSafetyFault}, webhook.Events())
+	})
+
+	t.Run("Subscribed events are POSTed signed", func(t *testing.T) {
+		heater.On()
+		request := nextRequest(t, requests)
+		require.Equal(t, "application/json", request.header.Get("Content-Type"))
+		require.Equal(t, "switchToggled", request.header.Get("X-Elsinore-Event"))
+		require.Equal(t, devices.Sign(webhook.Secret, request.body), request.header.Get("X-Elsinore-Signature"))
+
+		payload := struct {
+			Event    string
+			Device   string
+			DeviceID string
+			Text     string
+			Data     map[string]string
+		}{}
+		require.Nil(t, json.Unmarshal(request.body, &payload))
+		require.Equal(t, "switchToggled", payload.Event)
+		require.Equal(t, "HLT Heater", payload.Device)
+		require.Equal(t, fmt.Sprint(heater.ID), payload.DeviceID)
+		require.Equal(t, "HLT Heater turned on", payload.Text)
+		require.Equal(t, "on", payload.Data["state"])
+
+		delivery := lastDelivery(t, webhook)
+		require.Equal(t, model.WebhookDeliveryStatusDelivered, delivery.Status)
+		require.Equal(t, 1, delivery.Attempts)
+		require.Equal(t, http.StatusOK, *delivery.ResponseCode)
+		require.Equal(t, string(request.body), delivery.Payload)
+		require.Equal(t, request.header.Get("X-Elsinore-Delivery"), fmt.Sprint(delivery.ID))
+	})
+
+	t.Run("Failed deliveries are retried", func(t *testing.T) {
+		statuses <- http.StatusBadGateway
+		statuses <- http.StatusTooManyRequests
+		heater.Off()
+		first := nextRequest(t, requests)
+		require.Equal(t, first.body, nextRequest(t, requests).body)
+		require.Equal(t, first.body, nextRequest(t, requests).body)
+
+		delivery := lastDelivery(t, webhook)
+		require.Equal(t, model.WebhookDeliveryStatusDelivered, delivery.Status)
+		require.Equal(t, 3, delivery.Attempts)
+	})
+
+	t.Run("Rejected deliveries are not retried", func(t *testing.T) {
+		statuses <- http.StatusNotFound
+		heater.On()
+		nextRequest(t, requests)
+
+		delivery := lastDelivery(t, webhook)
+		require.Equal(t, model.WebhookDeliveryStatusFailed, delivery.Status)
+		require.Equal(t, 1, delivery.Attempts)
+		require.Equal(t, http.StatusNotFound, *delivery.ResponseCode)
+		require.Contains(t, delivery.Error, "404 Not Found")
+	})
+
+	t.Run("Deliveries fail after the last attempt", func(t *testing.T) {
+		for i := 0; i < devices.WebhookAttempts; i++ {
+			statuses <- http.StatusInternalServerError
+		}
+		heater.Off()
+		for i := 0; i < devices.WebhookAttempts; i++ {
+			nextRequest(t, requests)
+		}
+
+		delivery := lastDelivery(t, webhook)
+		require.Equal(t, model.WebhookDeliveryStatusFailed, delivery.Status)
+		require.Equal(t, devices.WebhookAttempts, delivery.Attempts)
+		require.Len(t, webhook.Deliveries(nil), 4)
+	})
+
+	t.Run("Only subscribed events are sent to enabled webhooks", func(t *testing.T) {
+		_, err := devices.ModifyWebhook(model.WebhookInput{ID: strPointer(fmt.Sprint(webhook.ID)), Events: []model.DeviceEventType{model.DeviceEventTypeSafetyFault}})
+		require.Nil(t, err)
+		heater.On()
+
+		delivery, err := webhook.Test()
+		require.Nil(t, err)
+		require.Equal(t, model.WebhookDeliveryStatusDelivered, delivery.Status)
+		require.Contains(t, string(nextRequest(t, requests).body), "A test of the n8n webhook")
+
+		disabled := false
+		_, err = devices.ModifyWebhook(model.WebhookInput{ID: strPointer(fmt.Sprint(webhook.ID)), Enabled: &disabled, Events: []model.DeviceEventType{model.DeviceEventTypeSwitchToggled}})
+		require.Nil(t, err)
+		heater.Off()
+		require.Len(t, webhook.Deliveries(nil), 5)
+		require.Empty(t, requests)
+	})
+}
@@ -9,10 +9,12 @@ require (
 	github.com/kr/pretty v0.2.1 // indirect
 	github.com/rs/cors v1.7.0
 	github.com/rs/zerolog v1.22.0
-	github.com/stretchr/testify v1.4.0
+	github.com/stretchr/testify v1.5.1
 	github.com/vektah/gqlparser/v2 v2.1.0
 	github.com/ztrue/shutdown v0.1.1
+	gorm.io/driver/mysql v1.0.6
+	gorm.io/driver/postgres v1.0.8
 	gorm.io/driver/sqlite v1.1.4
-	gorm.io/gorm v1.21.7
+	gorm.io/gorm v1.21.9
 	periph.io/x/periph v3.6.7+incompatible
 )
@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/dougedey/elsinore/modbus"
+	"periph.io/x/periph/conn/physic"
+)
+
+func toBackupModel(backup *database.BackupFile) *model.Backup {
+	return &model.Backup{Name: backup.Name, Size: int(backup.Size), Created: backup.Created}
+}
+
+var modbusTables = map[modbus.Table]model.ModbusTable{
+	modbus.Coils:            model.ModbusTableCoil,
+	modbus.DiscreteInputs:   model.ModbusTableDiscreteInput,
+	modbus.InputRegisters:   model.ModbusTableInputRegister,
+	modbus.HoldingRegisters: model.ModbusTableHoldingRegister,
+}
+
+func toModbusRegisterModel(register *modbus.Register) *model.ModbusRegister {
+	return &model.ModbusRegister{
+		Table:       modbusTables[register.Table],
+		Address:     int(register.Address),
+		Name:        register.Name,
+		Description: register.Description,
+		Writable:    register.Writable(),
+		Value:       int(register.Read()),
+	}
+}
+
+func toTempProbeDetailsModel(controller *devices.TemperatureController, tempProbe *devices.TempProbeDetail) *model.TempProbeDetails {
+	reading := tempProbe.Reading()
+	rawReading := tempProbe.RawReading()
+	calibration := model.ProbeCalibration{
+		Mode:           tempProbe.CalibrationMode,
+		LowRawReading:  temperatureString(tempProbe.LowRawReading),
+		LowReference:   temperatureString(tempProbe.LowReference),
+		HighRawReading: temperatureString(tempProbe.HighRawReading),
+		HighReference:  temperatureString(tempProbe.HighReference),
+	}
+	if !calibration.Mode.IsValid() {
+		calibration.Mode = model.CalibrationModeNone
+	}
+	if calibration.Mode == model.CalibrationModeOffset {
+		offset := tempProbe.Offset()
+		calibration.Offset = &offset
+	}
+
+	priority := int(tempProbe.Priority)
+	probeDetails := model.TempProbeDetails{
+		ID:          fmt.Sprint(tempProbe.ID),
+		PhysAddr:    &tempProbe.PhysAddr,
+		Reading:     &reading,
+		RawReading:  &rawReading,
+		Name:        &tempProbe.FriendlyName,
+		Updated:     &tempProbe.Updated,
+		Calibration: &calibration,
+		Weight:      &tempProbe.Weight,
+		Priority:    &priority,
+		Gravity:     tempProbe.Gravity,
+	}
+	if len(tempProbe.Fault) > 0 {
+		probeDetails.Fault = &tempProbe.Fault
+	}
+	if controller != nil {
+		status := controller.ProbeStatus(tempProbe)
+		contributing := controller.Contributing(tempProbe.PhysAddr)
+		probeDetails.Status = &status
+		probeDetails.Contributing = &contributing
+	}
+	return &probeDetails
+}
+
+func temperatureString(temperature *physic.Temperature) *string {
+	if temperature == nil {
+		return nil
+	}
+	value := temperature.String()
+	return &value
+}
+
+func toProbeScanModel(scan *hardware.ProbeScan) *model.ProbeScan {
+	result := model.ProbeScan{Discovered: []*model.TemperatureProbe{}, Lost: []*model.TemperatureProbe{}}
+	for _, physAddr := range scan.Discovered {
+		result.Discovered = append(result.Discovered, devices.TemperatureProbeModel(physAddr))
+	}
+	for _, physAddr := range scan.Lost {
+		result.Lost = append(result.Lost, devices.TemperatureProbeModel(physAddr))
+	}
+	return &result
+}
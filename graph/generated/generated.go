@@ -38,24 +38,190 @@ type Config struct {
 }
 
 type ResolverRoot interface {
+	AlertChannel() AlertChannelResolver
+	AlertRule() AlertRuleResolver
+	AutomationRule() AutomationRuleResolver
+	Expander() ExpanderResolver
+	Fermentation() FermentationResolver
+	FlowMeter() FlowMeterResolver
 	HysteriaSettings() HysteriaSettingsResolver
+	InPin() InPinResolver
 	ManualSettings() ManualSettingsResolver
 	Mutation() MutationResolver
 	PidSettings() PidSettingsResolver
 	Query() QueryResolver
+	SPIProbe() SPIProbeResolver
+	Scene() SceneResolver
+	Schedule() ScheduleResolver
 	Switch() SwitchResolver
+	SwitchGroup() SwitchGroupResolver
 	TemperatureController() TemperatureControllerResolver
+	Webhook() WebhookResolver
+	WebhookDelivery() WebhookDeliveryResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AlertChannel struct {
+		Enabled   func(childComplexity int) int
+		From      func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Kind      func(childComplexity int) int
+		LastError func(childComplexity int) int
+		Name      func(childComplexity int) int
+		To        func(childComplexity int) int
+		URL       func(childComplexity int) int
+		Username  func(childComplexity int) int
+	}
+
+	AlertRule struct {
+		Acknowledged   func(childComplexity int) int
+		Channels       func(childComplexity int) int
+		Condition      func(childComplexity int) int
+		Controller     func(childComplexity int) int
+		Duration       func(childComplexity int) int
+		Enabled        func(childComplexity int) int
+		Hysteresis     func(childComplexity int) int
+		ID             func(childComplexity int) int
+		Name           func(childComplexity int) int
+		Probe          func(childComplexity int) int
+		RepeatInterval func(childComplexity int) int
+		SilencedUntil  func(childComplexity int) int
+		Since          func(childComplexity int) int
+		State          func(childComplexity int) int
+		Threshold      func(childComplexity int) int
+		Value          func(childComplexity int) int
+	}
+
+	AutomationAction struct {
+		Controller func(childComplexity int) int
+		Kind       func(childComplexity int) int
+		Mode       func(childComplexity int) int
+		SetPoint   func(childComplexity int) int
+		Steps      func(childComplexity int) int
+		Switch     func(childComplexity int) int
+	}
+
+	AutomationCheck struct {
+		Description func(childComplexity int) int
+		Matched     func(childComplexity int) int
+	}
+
+	AutomationCondition struct {
+		Controller func(childComplexity int) int
+		DeviceID   func(childComplexity int) int
+		Event      func(childComplexity int) int
+		Hysteresis func(childComplexity int) int
+		Kind       func(childComplexity int) int
+		Probe      func(childComplexity int) int
+		Switch     func(childComplexity int) int
+		Threshold  func(childComplexity int) int
+		Time       func(childComplexity int) int
+		Until      func(childComplexity int) int
+	}
+
+	AutomationRule struct {
+		Actions    func(childComplexity int) int
+		Active     func(childComplexity int) int
+		Conditions func(childComplexity int) int
+		Enabled    func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Revert     func(childComplexity int) int
+		Trace      func(childComplexity int, limit *int) int
+		Trigger    func(childComplexity int) int
+	}
+
+	AutomationTrace struct {
+		Actions func(childComplexity int) int
+		Checks  func(childComplexity int) int
+		Errors  func(childComplexity int) int
+		Matched func(childComplexity int) int
+		Time    func(childComplexity int) int
+	}
+
+	Backup struct {
+		Created func(childComplexity int) int
+		Name    func(childComplexity int) int
+		Size    func(childComplexity int) int
+	}
+
 	DeleteTemperatureControllerReturnType struct {
 		ID                func(childComplexity int) int
 		TemperatureProbes func(childComplexity int) int
 	}
 
+	DeviceEvent struct {
+		Device   func(childComplexity int) int
+		DeviceID func(childComplexity int) int
+		Message  func(childComplexity int) int
+		Time     func(childComplexity int) int
+		Type     func(childComplexity int) int
+	}
+
+	Expander struct {
+		Address      func(childComplexity int) int
+		Bus          func(childComplexity int) int
+		Chip         func(childComplexity int) int
+		ConnectError func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Name         func(childComplexity int) int
+		Pins         func(childComplexity int) int
+	}
+
+	Fermentation struct {
+		Abv                 func(childComplexity int) int
+		ApparentAttenuation func(childComplexity int) int
+		CurrentGravity      func(childComplexity int) int
+		ID                  func(childComplexity int) int
+		OriginalGravity     func(childComplexity int) int
+		Readings            func(childComplexity int) int
+		Slope               func(childComplexity int) int
+		SlopeWindow         func(childComplexity int) int
+		StableSlope         func(childComplexity int) int
+		Started             func(childComplexity int) int
+		Status              func(childComplexity int) int
+		Steps               func(childComplexity int) int
+		TargetAttenuation   func(childComplexity int) int
+	}
+
+	FermentationStep struct {
+		Attenuation func(childComplexity int) int
+		Mode        func(childComplexity int) int
+		Name        func(childComplexity int) int
+		SetPoint    func(childComplexity int) int
+		Triggered   func(childComplexity int) int
+	}
+
+	FlowDispense struct {
+		Dispensed func(childComplexity int) int
+		Error     func(childComplexity int) int
+		Finished  func(childComplexity int) int
+		Litres    func(childComplexity int) int
+		Started   func(childComplexity int) int
+		Switch    func(childComplexity int) int
+	}
+
+	FlowMeter struct {
+		ConnectError   func(childComplexity int) int
+		Dispensing     func(childComplexity int) int
+		Gpio           func(childComplexity int) int
+		ID             func(childComplexity int) int
+		LifetimeVolume func(childComplexity int) int
+		Name           func(childComplexity int) int
+		NoFlowTimeout  func(childComplexity int) int
+		PulsesPerLitre func(childComplexity int) int
+		Rate           func(childComplexity int) int
+		Volume         func(childComplexity int) int
+	}
+
+	GravityReading struct {
+		Gravity func(childComplexity int) int
+		Time    func(childComplexity int) int
+	}
+
 	HysteriaSettings struct {
 		Configured func(childComplexity int) int
 		ID         func(childComplexity int) int
@@ -64,6 +230,21 @@ type ComplexityRoot struct {
 		MinTime    func(childComplexity int) int
 	}
 
+	InPin struct {
+		Action       func(childComplexity int) int
+		Active       func(childComplexity int) int
+		Changed      func(childComplexity int) int
+		ConnectError func(childComplexity int) int
+		Controller   func(childComplexity int) int
+		Debounce     func(childComplexity int) int
+		Gpio         func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Inverted     func(childComplexity int) int
+		Name         func(childComplexity int) int
+		Pull         func(childComplexity int) int
+		Switch       func(childComplexity int) int
+	}
+
 	ManualSettings struct {
 		Configured func(childComplexity int) int
 		CycleTime  func(childComplexity int) int
@@ -71,14 +252,68 @@ type ComplexityRoot struct {
 		ID         func(childComplexity int) int
 	}
 
+	ModbusRegister struct {
+		Address     func(childComplexity int) int
+		Description func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Table       func(childComplexity int) int
+		Value       func(childComplexity int) int
+		Writable    func(childComplexity int) int
+	}
+
 	Mutation struct {
+		AcknowledgeAlert                     func(childComplexity int, id string) int
+		ApplyScene                           func(childComplexity int, id string) int
 		AssignProbe                          func(childComplexity int, name string, address string) int
+		CalibrateProbe                       func(childComplexity int, address string, point model.CalibrationPoint, reference *string) int
+		CancelDispense                       func(childComplexity int, flowMeterID string) int
+		CreateBackup                         func(childComplexity int) int
+		DeleteAlertChannel                   func(childComplexity int, id string) int
+		DeleteAlertRule                      func(childComplexity int, id string) int
+		DeleteAutomationRule                 func(childComplexity int, id string) int
+		DeleteExpander                       func(childComplexity int, id string) int
+		DeleteFlowMeter                      func(childComplexity int, id string) int
+		DeleteInPin                          func(childComplexity int, id string) int
+		DeleteSPIProbe                       func(childComplexity int, id string) int
+		DeleteScene                          func(childComplexity int, id string) int
+		DeleteSchedule                       func(childComplexity int, id string) int
 		DeleteSwitch                         func(childComplexity int, id string) int
+		DeleteSwitchGroup                    func(childComplexity int, id string) int
 		DeleteTemperatureController          func(childComplexity int, id string) int
+		DeleteWebhook                        func(childComplexity int, id string) int
+		Dispense                             func(childComplexity int, flowMeterID string, litres float64, switchID string) int
+		EndFermentation                      func(childComplexity int, controllerID string) int
+		ForgetProbe                          func(childComplexity int, address string) int
+		ModifyAlertChannel                   func(childComplexity int, alertChannel model.AlertChannelInput) int
+		ModifyAlertRule                      func(childComplexity int, alertRule model.AlertRuleInput) int
+		ModifyAutomationRule                 func(childComplexity int, automationRule model.AutomationRuleInput) int
+		ModifyExpander                       func(childComplexity int, expander model.ExpanderInput) int
+		ModifyFlowMeter                      func(childComplexity int, flowMeter model.FlowMeterInput) int
+		ModifyInPin                          func(childComplexity int, inPin model.InPinInput) int
+		ModifySPIProbe                       func(childComplexity int, spiProbe model.SPIProbeInput) int
+		ModifyScene                          func(childComplexity int, scene model.SceneInput) int
+		ModifySchedule                       func(childComplexity int, schedule model.ScheduleInput) int
 		ModifySwitch                         func(childComplexity int, switchSettings model.SwitchSettingsInput) int
+		ModifySwitchGroup                    func(childComplexity int, switchGroup model.SwitchGroupInput) int
+		ModifyWebhook                        func(childComplexity int, webhook model.WebhookInput) int
+		PulseSwitch                          func(childComplexity int, id string, onSeconds float64, offSeconds float64, cycles *int) int
+		RecordGravity                        func(childComplexity int, controllerID string, gravity float64) int
 		RemoveProbeFromTemperatureController func(childComplexity int, address string) int
+		RescanProbes                         func(childComplexity int) int
+		ResetFlowMeter                       func(childComplexity int, id string) int
+		ResetProbeCalibration                func(childComplexity int, address string) int
+		RestoreBackup                        func(childComplexity int, name string) int
+		RunSchedule                          func(childComplexity int, id string) int
+		SilenceAlert                         func(childComplexity int, id string, minutes int) int
+		StartFermentation                    func(childComplexity int, fermentation model.FermentationInput) int
+		SwitchOnFor                          func(childComplexity int, id string, seconds float64) int
+		TestAlertChannel                     func(childComplexity int, id string) int
+		TestWebhook                          func(childComplexity int, id string) int
 		ToggleSwitch                         func(childComplexity int, id string, mode model.SwitchMode) int
+		ToggleSwitchGroup                    func(childComplexity int, id string, mode model.SwitchMode) int
+		UpdateProbe                          func(childComplexity int, probeSettings model.ProbeSettingsInput) int
 		UpdateSettings                       func(childComplexity int, settings model.SettingsInput) int
+		UpdateTempProbeDetails               func(childComplexity int, details model.TempProbeDetailsInput) int
 		UpdateTemperatureController          func(childComplexity int, controllerSettings model.TemperatureControllerSettingsInput) int
 	}
 
@@ -87,65 +322,245 @@ type ComplexityRoot struct {
 		CycleTime    func(childComplexity int) int
 		Delay        func(childComplexity int) int
 		Derivative   func(childComplexity int) int
+		Frequency    func(childComplexity int) int
 		Gpio         func(childComplexity int) int
 		ID           func(childComplexity int) int
 		Integral     func(childComplexity int) int
 		Proportional func(childComplexity int) int
 	}
 
+	ProbeCalibration struct {
+		HighRawReading func(childComplexity int) int
+		HighReference  func(childComplexity int) int
+		LowRawReading  func(childComplexity int) int
+		LowReference   func(childComplexity int) int
+		Mode           func(childComplexity int) int
+		Offset         func(childComplexity int) int
+	}
+
+	ProbeEvent struct {
+		PhysAddr func(childComplexity int) int
+		Time     func(childComplexity int) int
+		Type     func(childComplexity int) int
+	}
+
+	ProbeScan struct {
+		Discovered func(childComplexity int) int
+		Lost       func(childComplexity int) int
+	}
+
 	Query struct {
+		AlertChannels          func(childComplexity int) int
+		AlertRules             func(childComplexity int) int
+		AutomationRules        func(childComplexity int) int
+		Backups                func(childComplexity int) int
+		DeviceEvents           func(childComplexity int) int
+		EvaluateAutomationRule func(childComplexity int, id string) int
+		Expanders              func(childComplexity int) int
 		FetchProbes            func(childComplexity int, addresses []*string) int
+		FlowMeters             func(childComplexity int) int
+		InPins                 func(childComplexity int) int
+		ModbusRegisters        func(childComplexity int) int
 		Probe                  func(childComplexity int, address *string) int
+		ProbeEvents            func(childComplexity int) int
 		ProbeList              func(childComplexity int, available *bool) int
+		RegisteredProbes       func(childComplexity int) int
+		Scenes                 func(childComplexity int) int
+		Schedules              func(childComplexity int) int
 		Settings               func(childComplexity int) int
+		SpiProbes              func(childComplexity int) int
+		SwitchGroups           func(childComplexity int) int
 		Switches               func(childComplexity int) int
 		TemperatureControllers func(childComplexity int, name *string) int
+		WebhookDeliveries      func(childComplexity int, webhookID *string, limit *int) int
+		Webhooks               func(childComplexity int) int
+	}
+
+	SPIProbe struct {
+		Bus               func(childComplexity int) int
+		ChipSelect        func(childComplexity int) int
+		ConnectError      func(childComplexity int) int
+		Driver            func(childComplexity int) int
+		Filter50Hz        func(childComplexity int) int
+		ID                func(childComplexity int) int
+		NominalResistance func(childComplexity int) int
+		PhysAddr          func(childComplexity int) int
+		Probe             func(childComplexity int) int
+		ReferenceResistor func(childComplexity int) int
+		Wires             func(childComplexity int) int
+	}
+
+	Scene struct {
+		Applying    func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Interlocks  func(childComplexity int) int
+		LastApplied func(childComplexity int) int
+		LastError   func(childComplexity int) int
+		LastResult  func(childComplexity int) int
+		Name        func(childComplexity int) int
+		Steps       func(childComplexity int) int
+	}
+
+	SceneStep struct {
+		DelaySeconds func(childComplexity int) int
+		Group        func(childComplexity int) int
+		State        func(childComplexity int) int
+		Switch       func(childComplexity int) int
+	}
+
+	Schedule struct {
+		Actions    func(childComplexity int) int
+		At         func(childComplexity int) int
+		Cron       func(childComplexity int) int
+		Enabled    func(childComplexity int) int
+		ID         func(childComplexity int) int
+		LastError  func(childComplexity int) int
+		LastResult func(childComplexity int) int
+		LastRun    func(childComplexity int) int
+		MissedRun  func(childComplexity int) int
+		Name       func(childComplexity int) int
+		NextRun    func(childComplexity int) int
 	}
 
 	Settings struct {
 		BreweryName func(childComplexity int) int
+		Timezone    func(childComplexity int) int
 	}
 
 	Switch struct {
-		Gpio  func(childComplexity int) int
-		ID    func(childComplexity int) int
-		Name  func(childComplexity int) int
-		State func(childComplexity int) int
+		AutoOffSeconds func(childComplexity int) int
+		DimmerDuty     func(childComplexity int) int
+		Frequency      func(childComplexity int) int
+		Gpio           func(childComplexity int) int
+		ID             func(childComplexity int) int
+		MaxOnSeconds   func(childComplexity int) int
+		Name           func(childComplexity int) int
+		Networked      func(childComplexity int) int
+		OutputError    func(childComplexity int) int
+		SoftwarePWM    func(childComplexity int) int
+		State          func(childComplexity int) int
+		Timer          func(childComplexity int) int
+	}
+
+	SwitchGroup struct {
+		ID       func(childComplexity int) int
+		Name     func(childComplexity int) int
+		Switches func(childComplexity int) int
+	}
+
+	SwitchTimer struct {
+		CyclesLeft       func(childComplexity int) int
+		Kind             func(childComplexity int) int
+		OffSeconds       func(childComplexity int) int
+		On               func(childComplexity int) int
+		OnSeconds        func(childComplexity int) int
+		RemainingSeconds func(childComplexity int) int
 	}
 
 	TempProbeDetails struct {
-		ID       func(childComplexity int) int
-		Name     func(childComplexity int) int
-		PhysAddr func(childComplexity int) int
-		Reading  func(childComplexity int) int
-		Updated  func(childComplexity int) int
+		Calibration  func(childComplexity int) int
+		Contributing func(childComplexity int) int
+		Fault        func(childComplexity int) int
+		Gravity      func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Name         func(childComplexity int) int
+		PhysAddr     func(childComplexity int) int
+		Priority     func(childComplexity int) int
+		RawReading   func(childComplexity int) int
+		Reading      func(childComplexity int) int
+		Status       func(childComplexity int) int
+		Updated      func(childComplexity int) int
+		Weight       func(childComplexity int) int
 	}
 
 	TemperatureController struct {
+		Aggregation             func(childComplexity int) int
 		CalculatedDuty          func(childComplexity int) int
+		ContributingProbes      func(childComplexity int) int
 		CoolSettings            func(childComplexity int) int
 		DutyCycle               func(childComplexity int) int
+		Fermentation            func(childComplexity int) int
 		HeatSettings            func(childComplexity int) int
 		HysteriaSettings        func(childComplexity int) int
 		ID                      func(childComplexity int) int
+		Interlocked             func(childComplexity int) int
 		ManualSettings          func(childComplexity int) int
 		Mode                    func(childComplexity int) int
 		Name                    func(childComplexity int) int
 		PreviousCalculationTime func(childComplexity int) int
 		SetPoint                func(childComplexity int) int
+		StaleAfter              func(childComplexity int) int
 		TempProbeDetails        func(childComplexity int) int
 	}
 
 	TemperatureProbe struct {
-		PhysAddr func(childComplexity int) int
-		Reading  func(childComplexity int) int
-		Updated  func(childComplexity int) int
+		ColdJunction func(childComplexity int) int
+		Connected    func(childComplexity int) int
+		Enabled      func(childComplexity int) int
+		Gravity      func(childComplexity int) int
+		HighLimit    func(childComplexity int) int
+		Location     func(childComplexity int) int
+		LowLimit     func(childComplexity int) int
+		Name         func(childComplexity int) int
+		Notes        func(childComplexity int) int
+		PhysAddr     func(childComplexity int) int
+		ReadErrors   func(childComplexity int) int
+		ReadLatency  func(childComplexity int) int
+		Reading      func(childComplexity int) int
+		Reads        func(childComplexity int) int
+		Resolution   func(childComplexity int) int
+		SensorType   func(childComplexity int) int
+		Updated      func(childComplexity int) int
+	}
+
+	Webhook struct {
+		Deliveries func(childComplexity int, limit *int) int
+		Enabled    func(childComplexity int) int
+		Events     func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Secret     func(childComplexity int) int
+		URL        func(childComplexity int) int
+	}
+
+	WebhookDelivery struct {
+		Attempts     func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		Error        func(childComplexity int) int
+		Event        func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Payload      func(childComplexity int) int
+		ResponseCode func(childComplexity int) int
+		Status       func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+		WebhookID    func(childComplexity int) int
 	}
 }
 
+type AlertChannelResolver interface {
+	ID(ctx context.Context, obj *devices.AlertChannel) (string, error)
+}
+type AlertRuleResolver interface {
+	ID(ctx context.Context, obj *devices.AlertRule) (string, error)
+}
+type AutomationRuleResolver interface {
+	ID(ctx context.Context, obj *devices.AutomationRule) (string, error)
+}
+type ExpanderResolver interface {
+	ID(ctx context.Context, obj *devices.Expander) (string, error)
+}
+type FermentationResolver interface {
+	ID(ctx context.Context, obj *devices.Fermentation) (string, error)
+}
+type FlowMeterResolver interface {
+	ID(ctx context.Context, obj *devices.FlowMeter) (string, error)
+}
 type HysteriaSettingsResolver interface {
 	ID(ctx context.Context, obj *devices.HysteriaSettings) (string, error)
 }
+type InPinResolver interface {
+	ID(ctx context.Context, obj *devices.InPin) (string, error)
+}
 type ManualSettingsResolver interface {
 	ID(ctx context.Context, obj *devices.ManualSettings) (string, error)
 }
@@ -158,6 +573,51 @@ type MutationResolver interface {
 	ModifySwitch(ctx context.Context, switchSettings model.SwitchSettingsInput) (*devices.Switch, error)
 	DeleteSwitch(ctx context.Context, id string) (*devices.Switch, error)
 	ToggleSwitch(ctx context.Context, id string, mode model.SwitchMode) (*devices.Switch, error)
+	SwitchOnFor(ctx context.Context, id string, seconds float64) (*devices.Switch, error)
+	PulseSwitch(ctx context.Context, id string, onSeconds float64, offSeconds float64, cycles *int) (*devices.Switch, error)
+	CalibrateProbe(ctx context.Context, address string, point model.CalibrationPoint, reference *string) (*model.TempProbeDetails, error)
+	ResetProbeCalibration(ctx context.Context, address string) (*model.TempProbeDetails, error)
+	UpdateTempProbeDetails(ctx context.Context, details model.TempProbeDetailsInput) (*model.TempProbeDetails, error)
+	UpdateProbe(ctx context.Context, probeSettings model.ProbeSettingsInput) (*model.TemperatureProbe, error)
+	ForgetProbe(ctx context.Context, address string) (*model.TemperatureProbe, error)
+	RescanProbes(ctx context.Context) (*model.ProbeScan, error)
+	ModifySPIProbe(ctx context.Context, spiProbe model.SPIProbeInput) (*devices.SPIProbe, error)
+	DeleteSPIProbe(ctx context.Context, id string) (*devices.SPIProbe, error)
+	ModifyExpander(ctx context.Context, expander model.ExpanderInput) (*devices.Expander, error)
+	DeleteExpander(ctx context.Context, id string) (*devices.Expander, error)
+	ModifyWebhook(ctx context.Context, webhook model.WebhookInput) (*devices.Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) (*devices.Webhook, error)
+	TestWebhook(ctx context.Context, id string) (*devices.WebhookDelivery, error)
+	ModifyAlertRule(ctx context.Context, alertRule model.AlertRuleInput) (*devices.AlertRule, error)
+	DeleteAlertRule(ctx context.Context, id string) (*devices.AlertRule, error)
+	AcknowledgeAlert(ctx context.Context, id string) (*devices.AlertRule, error)
+	SilenceAlert(ctx context.Context, id string, minutes int) (*devices.AlertRule, error)
+	ModifyAlertChannel(ctx context.Context, alertChannel model.AlertChannelInput) (*devices.AlertChannel, error)
+	DeleteAlertChannel(ctx context.Context, id string) (*devices.AlertChannel, error)
+	TestAlertChannel(ctx context.Context, id string) (*devices.AlertChannel, error)
+	ModifyAutomationRule(ctx context.Context, automationRule model.AutomationRuleInput) (*devices.AutomationRule, error)
+	DeleteAutomationRule(ctx context.Context, id string) (*devices.AutomationRule, error)
+	ModifySchedule(ctx context.Context, schedule model.ScheduleInput) (*devices.Schedule, error)
+	DeleteSchedule(ctx context.Context, id string) (*devices.Schedule, error)
+	RunSchedule(ctx context.Context, id string) (*devices.Schedule, error)
+	ModifySwitchGroup(ctx context.Context, switchGroup model.SwitchGroupInput) (*devices.SwitchGroup, error)
+	DeleteSwitchGroup(ctx context.Context, id string) (*devices.SwitchGroup, error)
+	ToggleSwitchGroup(ctx context.Context, id string, mode model.SwitchMode) (*devices.SwitchGroup, error)
+	ModifyScene(ctx context.Context, scene model.SceneInput) (*devices.Scene, error)
+	DeleteScene(ctx context.Context, id string) (*devices.Scene, error)
+	ApplyScene(ctx context.Context, id string) (*devices.Scene, error)
+	ModifyInPin(ctx context.Context, inPin model.InPinInput) (*devices.InPin, error)
+	DeleteInPin(ctx context.Context, id string) (*devices.InPin, error)
+	ModifyFlowMeter(ctx context.Context, flowMeter model.FlowMeterInput) (*devices.FlowMeter, error)
+	DeleteFlowMeter(ctx context.Context, id string) (*devices.FlowMeter, error)
+	ResetFlowMeter(ctx context.Context, id string) (*devices.FlowMeter, error)
+	Dispense(ctx context.Context, flowMeterID string, litres float64, switchID string) (*devices.FlowMeter, error)
+	CancelDispense(ctx context.Context, flowMeterID string) (*devices.FlowMeter, error)
+	StartFermentation(ctx context.Context, fermentation model.FermentationInput) (*devices.Fermentation, error)
+	RecordGravity(ctx context.Context, controllerID string, gravity float64) (*devices.Fermentation, error)
+	EndFermentation(ctx context.Context, controllerID string) (*devices.Fermentation, error)
+	CreateBackup(ctx context.Context) (*model.Backup, error)
+	RestoreBackup(ctx context.Context, name string) (*model.Backup, error)
 }
 type PidSettingsResolver interface {
 	ID(ctx context.Context, obj *devices.PidSettings) (string, error)
@@ -166,18 +626,60 @@ type QueryResolver interface {
 	Probe(ctx context.Context, address *string) (*model.TemperatureProbe, error)
 	ProbeList(ctx context.Context, available *bool) ([]*model.TemperatureProbe, error)
 	FetchProbes(ctx context.Context, addresses []*string) ([]*model.TemperatureProbe, error)
+	RegisteredProbes(ctx context.Context) ([]*model.TemperatureProbe, error)
+	SpiProbes(ctx context.Context) ([]*devices.SPIProbe, error)
+	ProbeEvents(ctx context.Context) ([]*model.ProbeEvent, error)
+	DeviceEvents(ctx context.Context) ([]*model.DeviceEvent, error)
+	Webhooks(ctx context.Context) ([]*devices.Webhook, error)
+	WebhookDeliveries(ctx context.Context, webhookID *string, limit *int) ([]*devices.WebhookDelivery, error)
+	AlertRules(ctx context.Context) ([]*devices.AlertRule, error)
+	AlertChannels(ctx context.Context) ([]*devices.AlertChannel, error)
+	AutomationRules(ctx context.Context) ([]*devices.AutomationRule, error)
+	EvaluateAutomationRule(ctx context.Context, id string) (*devices.AutomationTrace, error)
+	Schedules(ctx context.Context) ([]*devices.Schedule, error)
+	SwitchGroups(ctx context.Context) ([]*devices.SwitchGroup, error)
+	Scenes(ctx context.Context) ([]*devices.Scene, error)
+	Expanders(ctx context.Context) ([]*devices.Expander, error)
+	InPins(ctx context.Context) ([]*devices.InPin, error)
+	FlowMeters(ctx context.Context) ([]*devices.FlowMeter, error)
 	TemperatureControllers(ctx context.Context, name *string) ([]*devices.TemperatureController, error)
 	Settings(ctx context.Context) (*system.Settings, error)
 	Switches(ctx context.Context) ([]*devices.Switch, error)
+	Backups(ctx context.Context) ([]*model.Backup, error)
+	ModbusRegisters(ctx context.Context) ([]*model.ModbusRegister, error)
+}
+type SPIProbeResolver interface {
+	ID(ctx context.Context, obj *devices.SPIProbe) (string, error)
+
+	Probe(ctx context.Context, obj *devices.SPIProbe) (*model.TemperatureProbe, error)
+}
+type SceneResolver interface {
+	ID(ctx context.Context, obj *devices.Scene) (string, error)
+}
+type ScheduleResolver interface {
+	ID(ctx context.Context, obj *devices.Schedule) (string, error)
 }
 type SwitchResolver interface {
 	ID(ctx context.Context, obj *devices.Switch) (string, error)
+
+	AutoOffSeconds(ctx context.Context, obj *devices.Switch) (*float64, error)
+	Timer(ctx context.Context, obj *devices.Switch) (*devices.SwitchTimer, error)
+}
+type SwitchGroupResolver interface {
+	ID(ctx context.Context, obj *devices.SwitchGroup) (string, error)
 }
 type TemperatureControllerResolver interface {
 	ID(ctx context.Context, obj *devices.TemperatureController) (string, error)
 
 	TempProbeDetails(ctx context.Context, obj *devices.TemperatureController) ([]*model.TempProbeDetails, error)
 }
+type WebhookResolver interface {
+	ID(ctx context.Context, obj *devices.Webhook) (string, error)
+}
+type WebhookDeliveryResolver interface {
+	ID(ctx context.Context, obj *devices.WebhookDelivery) (string, error)
+	WebhookID(ctx context.Context, obj *devices.WebhookDelivery) (string, error)
+}
 
 type executableSchema struct {
 	resolvers  ResolverRoot
@@ -194,5374 +696,25377 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
-	case "DeleteTemperatureControllerReturnType.id":
-		if e.complexity.DeleteTemperatureControllerReturnType.ID == nil {
+	case "AlertChannel.enabled":
+		if e.complexity.AlertChannel.Enabled == nil {
 			break
 		}
 
-		return e.complexity.DeleteTemperatureControllerReturnType.ID(childComplexity), true
+		return e.complexity.AlertChannel.Enabled(childComplexity), true
 
-	case "DeleteTemperatureControllerReturnType.temperatureProbes":
-		if e.complexity.DeleteTemperatureControllerReturnType.TemperatureProbes == nil {
+	case "AlertChannel.from":
+		if e.complexity.AlertChannel.From == nil {
 			break
 		}
 
-		return e.complexity.DeleteTemperatureControllerReturnType.TemperatureProbes(childComplexity), true
+		return e.complexity.AlertChannel.From(childComplexity), true
 
-	case "HysteriaSettings.configured":
-		if e.complexity.HysteriaSettings.Configured == nil {
+	case "AlertChannel.id":
+		if e.complexity.AlertChannel.ID == nil {
 			break
 		}
 
-		return e.complexity.HysteriaSettings.Configured(childComplexity), true
+		return e.complexity.AlertChannel.ID(childComplexity), true
 
-	case "HysteriaSettings.id":
-		if e.complexity.HysteriaSettings.ID == nil {
+	case "AlertChannel.kind":
+		if e.complexity.AlertChannel.Kind == nil {
 			break
 		}
 
-		return e.complexity.HysteriaSettings.ID(childComplexity), true
+		return e.complexity.AlertChannel.Kind(childComplexity), true
 
-	case "HysteriaSettings.maxTemp":
-		if e.complexity.HysteriaSettings.MaxTemp == nil {
+	case "AlertChannel.lastError":
+		if e.complexity.AlertChannel.LastError == nil {
 			break
 		}
 
-		return e.complexity.HysteriaSettings.MaxTemp(childComplexity), true
+		return e.complexity.AlertChannel.LastError(childComplexity), true
 
-	case "HysteriaSettings.minTemp":
-		if e.complexity.HysteriaSettings.MinTemp == nil {
+	case "AlertChannel.name":
+		if e.complexity.AlertChannel.Name == nil {
 			break
 		}
 
-		return e.complexity.HysteriaSettings.MinTemp(childComplexity), true
+		return e.complexity.AlertChannel.Name(childComplexity), true
 
-	case "HysteriaSettings.minTime":
-		if e.complexity.HysteriaSettings.MinTime == nil {
+	case "AlertChannel.to":
+		if e.complexity.AlertChannel.To == nil {
 			break
 		}
 
-		return e.complexity.HysteriaSettings.MinTime(childComplexity), true
+		return e.complexity.AlertChannel.To(childComplexity), true
 
-	case "ManualSettings.configured":
-		if e.complexity.ManualSettings.Configured == nil {
+	case "AlertChannel.url":
+		if e.complexity.AlertChannel.URL == nil {
 			break
 		}
 
-		return e.complexity.ManualSettings.Configured(childComplexity), true
+		return e.complexity.AlertChannel.URL(childComplexity), true
 
-	case "ManualSettings.cycleTime":
-		if e.complexity.ManualSettings.CycleTime == nil {
+	case "AlertChannel.username":
+		if e.complexity.AlertChannel.Username == nil {
 			break
 		}
 
-		return e.complexity.ManualSettings.CycleTime(childComplexity), true
+		return e.complexity.AlertChannel.Username(childComplexity), true
 
-	case "ManualSettings.dutyCycle":
-		if e.complexity.ManualSettings.DutyCycle == nil {
+	case "AlertRule.acknowledged":
+		if e.complexity.AlertRule.Acknowledged == nil {
 			break
 		}
 
-		return e.complexity.ManualSettings.DutyCycle(childComplexity), true
+		return e.complexity.AlertRule.Acknowledged(childComplexity), true
 
-	case "ManualSettings.id":
-		if e.complexity.ManualSettings.ID == nil {
+	case "AlertRule.channels":
+		if e.complexity.AlertRule.Channels == nil {
 			break
 		}
 
-		return e.complexity.ManualSettings.ID(childComplexity), true
+		return e.complexity.AlertRule.Channels(childComplexity), true
 
-	case "Mutation.assignProbe":
-		if e.complexity.Mutation.AssignProbe == nil {
+	case "AlertRule.condition":
+		if e.complexity.AlertRule.Condition == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_assignProbe_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AlertRule.Condition(childComplexity), true
+
+	case "AlertRule.controller":
+		if e.complexity.AlertRule.Controller == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AssignProbe(childComplexity, args["name"].(string), args["address"].(string)), true
+		return e.complexity.AlertRule.Controller(childComplexity), true
 
-	case "Mutation.deleteSwitch":
-		if e.complexity.Mutation.DeleteSwitch == nil {
+	case "AlertRule.duration":
+		if e.complexity.AlertRule.Duration == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteSwitch_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AlertRule.Duration(childComplexity), true
+
+	case "AlertRule.enabled":
+		if e.complexity.AlertRule.Enabled == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DeleteSwitch(childComplexity, args["id"].(string)), true
+		return e.complexity.AlertRule.Enabled(childComplexity), true
 
-	case "Mutation.deleteTemperatureController":
-		if e.complexity.Mutation.DeleteTemperatureController == nil {
+	case "AlertRule.hysteresis":
+		if e.complexity.AlertRule.Hysteresis == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteTemperatureController_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AlertRule.Hysteresis(childComplexity), true
+
+	case "AlertRule.id":
+		if e.complexity.AlertRule.ID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DeleteTemperatureController(childComplexity, args["id"].(string)), true
+		return e.complexity.AlertRule.ID(childComplexity), true
 
-	case "Mutation.modifySwitch":
-		if e.complexity.Mutation.ModifySwitch == nil {
+	case "AlertRule.name":
+		if e.complexity.AlertRule.Name == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_modifySwitch_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AlertRule.Name(childComplexity), true
+
+	case "AlertRule.probe":
+		if e.complexity.AlertRule.Probe == nil {
+			break
 		}
 
-		return e.complexity.Mutation.ModifySwitch(childComplexity, args["switchSettings"].(model.SwitchSettingsInput)), true
+		return e.complexity.AlertRule.Probe(childComplexity), true
 
-	case "Mutation.removeProbeFromTemperatureController":
-		if e.complexity.Mutation.RemoveProbeFromTemperatureController == nil {
+	case "AlertRule.repeatInterval":
+		if e.complexity.AlertRule.RepeatInterval == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_removeProbeFromTemperatureController_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AlertRule.RepeatInterval(childComplexity), true
+
+	case "AlertRule.silencedUntil":
+		if e.complexity.AlertRule.SilencedUntil == nil {
+			break
 		}
 
-		return e.complexity.Mutation.RemoveProbeFromTemperatureController(childComplexity, args["address"].(string)), true
+		return e.complexity.AlertRule.SilencedUntil(childComplexity), true
 
-	case "Mutation.toggleSwitch":
-		if e.complexity.Mutation.ToggleSwitch == nil {
+	case "AlertRule.since":
+		if e.complexity.AlertRule.Since == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_toggleSwitch_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AlertRule.Since(childComplexity), true
+
+	case "AlertRule.state":
+		if e.complexity.AlertRule.State == nil {
+			break
 		}
 
-		return e.complexity.Mutation.ToggleSwitch(childComplexity, args["id"].(string), args["mode"].(model.SwitchMode)), true
+		return e.complexity.AlertRule.State(childComplexity), true
 
-	case "Mutation.updateSettings":
-		if e.complexity.Mutation.UpdateSettings == nil {
+	case "AlertRule.threshold":
+		if e.complexity.AlertRule.Threshold == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateSettings_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AlertRule.Threshold(childComplexity), true
+
+	case "AlertRule.value":
+		if e.complexity.AlertRule.Value == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateSettings(childComplexity, args["settings"].(model.SettingsInput)), true
+		return e.complexity.AlertRule.Value(childComplexity), true
 
-	case "Mutation.updateTemperatureController":
-		if e.complexity.Mutation.UpdateTemperatureController == nil {
+	case "AutomationAction.controller":
+		if e.complexity.AutomationAction.Controller == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateTemperatureController_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AutomationAction.Controller(childComplexity), true
+
+	case "AutomationAction.kind":
+		if e.complexity.AutomationAction.Kind == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateTemperatureController(childComplexity, args["controllerSettings"].(model.TemperatureControllerSettingsInput)), true
+		return e.complexity.AutomationAction.Kind(childComplexity), true
 
-	case "PidSettings.configured":
-		if e.complexity.PidSettings.Configured == nil {
+	case "AutomationAction.mode":
+		if e.complexity.AutomationAction.Mode == nil {
 			break
 		}
 
-		return e.complexity.PidSettings.Configured(childComplexity), true
+		return e.complexity.AutomationAction.Mode(childComplexity), true
 
-	case "PidSettings.cycleTime":
-		if e.complexity.PidSettings.CycleTime == nil {
+	case "AutomationAction.setPoint":
+		if e.complexity.AutomationAction.SetPoint == nil {
 			break
 		}
 
-		return e.complexity.PidSettings.CycleTime(childComplexity), true
+		return e.complexity.AutomationAction.SetPoint(childComplexity), true
 
-	case "PidSettings.delay":
-		if e.complexity.PidSettings.Delay == nil {
+	case "AutomationAction.steps":
+		if e.complexity.AutomationAction.Steps == nil {
 			break
 		}
 
-		return e.complexity.PidSettings.Delay(childComplexity), true
+		return e.complexity.AutomationAction.Steps(childComplexity), true
 
-	case "PidSettings.derivative":
-		if e.complexity.PidSettings.Derivative == nil {
+	case "AutomationAction.switch":
+		if e.complexity.AutomationAction.Switch == nil {
 			break
 		}
 
-		return e.complexity.PidSettings.Derivative(childComplexity), true
+		return e.complexity.AutomationAction.Switch(childComplexity), true
 
-	case "PidSettings.gpio":
-		if e.complexity.PidSettings.Gpio == nil {
+	case "AutomationCheck.description":
+		if e.complexity.AutomationCheck.Description == nil {
 			break
 		}
 
-		return e.complexity.PidSettings.Gpio(childComplexity), true
+		return e.complexity.AutomationCheck.Description(childComplexity), true
 
-	case "PidSettings.id":
-		if e.complexity.PidSettings.ID == nil {
+	case "AutomationCheck.matched":
+		if e.complexity.AutomationCheck.Matched == nil {
 			break
 		}
 
-		return e.complexity.PidSettings.ID(childComplexity), true
+		return e.complexity.AutomationCheck.Matched(childComplexity), true
 
-	case "PidSettings.integral":
-		if e.complexity.PidSettings.Integral == nil {
+	case "AutomationCondition.controller":
+		if e.complexity.AutomationCondition.Controller == nil {
 			break
 		}
 
-		return e.complexity.PidSettings.Integral(childComplexity), true
+		return e.complexity.AutomationCondition.Controller(childComplexity), true
 
-	case "PidSettings.proportional":
-		if e.complexity.PidSettings.Proportional == nil {
+	case "AutomationCondition.deviceId":
+		if e.complexity.AutomationCondition.DeviceID == nil {
 			break
 		}
 
-		return e.complexity.PidSettings.Proportional(childComplexity), true
+		return e.complexity.AutomationCondition.DeviceID(childComplexity), true
 
-	case "Query.fetchProbes":
-		if e.complexity.Query.FetchProbes == nil {
+	case "AutomationCondition.event":
+		if e.complexity.AutomationCondition.Event == nil {
 			break
 		}
 
-		args, err := ec.field_Query_fetchProbes_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AutomationCondition.Event(childComplexity), true
+
+	case "AutomationCondition.hysteresis":
+		if e.complexity.AutomationCondition.Hysteresis == nil {
+			break
 		}
 
-		return e.complexity.Query.FetchProbes(childComplexity, args["addresses"].([]*string)), true
+		return e.complexity.AutomationCondition.Hysteresis(childComplexity), true
 
-	case "Query.probe":
-		if e.complexity.Query.Probe == nil {
+	case "AutomationCondition.kind":
+		if e.complexity.AutomationCondition.Kind == nil {
 			break
 		}
 
-		args, err := ec.field_Query_probe_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AutomationCondition.Kind(childComplexity), true
+
+	case "AutomationCondition.probe":
+		if e.complexity.AutomationCondition.Probe == nil {
+			break
 		}
 
-		return e.complexity.Query.Probe(childComplexity, args["address"].(*string)), true
+		return e.complexity.AutomationCondition.Probe(childComplexity), true
 
-	case "Query.probeList":
-		if e.complexity.Query.ProbeList == nil {
+	case "AutomationCondition.switch":
+		if e.complexity.AutomationCondition.Switch == nil {
 			break
 		}
 
-		args, err := ec.field_Query_probeList_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AutomationCondition.Switch(childComplexity), true
+
+	case "AutomationCondition.threshold":
+		if e.complexity.AutomationCondition.Threshold == nil {
+			break
 		}
 
-		return e.complexity.Query.ProbeList(childComplexity, args["available"].(*bool)), true
+		return e.complexity.AutomationCondition.Threshold(childComplexity), true
 
-	case "Query.settings":
-		if e.complexity.Query.Settings == nil {
+	case "AutomationCondition.time":
+		if e.complexity.AutomationCondition.Time == nil {
 			break
 		}
 
-		return e.complexity.Query.Settings(childComplexity), true
+		return e.complexity.AutomationCondition.Time(childComplexity), true
 
-	case "Query.switches":
-		if e.complexity.Query.Switches == nil {
+	case "AutomationCondition.until":
+		if e.complexity.AutomationCondition.Until == nil {
 			break
 		}
 
-		return e.complexity.Query.Switches(childComplexity), true
+		return e.complexity.AutomationCondition.Until(childComplexity), true
 
-	case "Query.temperatureControllers":
-		if e.complexity.Query.TemperatureControllers == nil {
+	case "AutomationRule.actions":
+		if e.complexity.AutomationRule.Actions == nil {
 			break
 		}
 
-		args, err := ec.field_Query_temperatureControllers_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.AutomationRule.Actions(childComplexity), true
+
+	case "AutomationRule.active":
+		if e.complexity.AutomationRule.Active == nil {
+			break
 		}
 
-		return e.complexity.Query.TemperatureControllers(childComplexity, args["name"].(*string)), true
+		return e.complexity.AutomationRule.Active(childComplexity), true
 
-	case "Settings.breweryName":
-		if e.complexity.Settings.BreweryName == nil {
+	case "AutomationRule.conditions":
+		if e.complexity.AutomationRule.Conditions == nil {
 			break
 		}
 
-		return e.complexity.Settings.BreweryName(childComplexity), true
+		return e.complexity.AutomationRule.Conditions(childComplexity), true
 
-	case "Switch.gpio":
-		if e.complexity.Switch.Gpio == nil {
+	case "AutomationRule.enabled":
+		if e.complexity.AutomationRule.Enabled == nil {
 			break
 		}
 
-		return e.complexity.Switch.Gpio(childComplexity), true
+		return e.complexity.AutomationRule.Enabled(childComplexity), true
 
-	case "Switch.id":
-		if e.complexity.Switch.ID == nil {
+	case "AutomationRule.id":
+		if e.complexity.AutomationRule.ID == nil {
 			break
 		}
 
-		return e.complexity.Switch.ID(childComplexity), true
+		return e.complexity.AutomationRule.ID(childComplexity), true
 
-	case "Switch.name":
-		if e.complexity.Switch.Name == nil {
+	case "AutomationRule.name":
+		if e.complexity.AutomationRule.Name == nil {
 			break
 		}
 
-		return e.complexity.Switch.Name(childComplexity), true
+		return e.complexity.AutomationRule.Name(childComplexity), true
 
-	case "Switch.state":
-		if e.complexity.Switch.State == nil {
+	case "AutomationRule.revert":
+		if e.complexity.AutomationRule.Revert == nil {
 			break
 		}
 
-		return e.complexity.Switch.State(childComplexity), true
+		return e.complexity.AutomationRule.Revert(childComplexity), true
 
-	case "TempProbeDetails.id":
-		if e.complexity.TempProbeDetails.ID == nil {
+	case "AutomationRule.trace":
+		if e.complexity.AutomationRule.Trace == nil {
 			break
 		}
 
-		return e.complexity.TempProbeDetails.ID(childComplexity), true
+		args, err := ec.field_AutomationRule_trace_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "TempProbeDetails.name":
-		if e.complexity.TempProbeDetails.Name == nil {
+		return e.complexity.AutomationRule.Trace(childComplexity, args["limit"].(*int)), true
+
+	case "AutomationRule.trigger":
+		if e.complexity.AutomationRule.Trigger == nil {
 			break
 		}
 
-		return e.complexity.TempProbeDetails.Name(childComplexity), true
+		return e.complexity.AutomationRule.Trigger(childComplexity), true
 
-	case "TempProbeDetails.physAddr":
-		if e.complexity.TempProbeDetails.PhysAddr == nil {
+	case "AutomationTrace.actions":
+		if e.complexity.AutomationTrace.Actions == nil {
 			break
 		}
 
-		return e.complexity.TempProbeDetails.PhysAddr(childComplexity), true
+		return e.complexity.AutomationTrace.Actions(childComplexity), true
 
-	case "TempProbeDetails.reading":
-		if e.complexity.TempProbeDetails.Reading == nil {
+	case "AutomationTrace.checks":
+		if e.complexity.AutomationTrace.Checks == nil {
 			break
 		}
 
-		return e.complexity.TempProbeDetails.Reading(childComplexity), true
+		return e.complexity.AutomationTrace.Checks(childComplexity), true
 
-	case "TempProbeDetails.updated":
-		if e.complexity.TempProbeDetails.Updated == nil {
+	case "AutomationTrace.errors":
+		if e.complexity.AutomationTrace.Errors == nil {
 			break
 		}
 
-		return e.complexity.TempProbeDetails.Updated(childComplexity), true
+		return e.complexity.AutomationTrace.Errors(childComplexity), true
 
-	case "TemperatureController.calculatedDuty":
-		if e.complexity.TemperatureController.CalculatedDuty == nil {
+	case "AutomationTrace.matched":
+		if e.complexity.AutomationTrace.Matched == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.CalculatedDuty(childComplexity), true
+		return e.complexity.AutomationTrace.Matched(childComplexity), true
 
-	case "TemperatureController.coolSettings":
-		if e.complexity.TemperatureController.CoolSettings == nil {
+	case "AutomationTrace.time":
+		if e.complexity.AutomationTrace.Time == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.CoolSettings(childComplexity), true
+		return e.complexity.AutomationTrace.Time(childComplexity), true
 
-	case "TemperatureController.dutyCycle":
-		if e.complexity.TemperatureController.DutyCycle == nil {
+	case "Backup.created":
+		if e.complexity.Backup.Created == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.DutyCycle(childComplexity), true
+		return e.complexity.Backup.Created(childComplexity), true
 
-	case "TemperatureController.heatSettings":
-		if e.complexity.TemperatureController.HeatSettings == nil {
+	case "Backup.name":
+		if e.complexity.Backup.Name == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.HeatSettings(childComplexity), true
+		return e.complexity.Backup.Name(childComplexity), true
 
-	case "TemperatureController.hysteriaSettings":
-		if e.complexity.TemperatureController.HysteriaSettings == nil {
+	case "Backup.size":
+		if e.complexity.Backup.Size == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.HysteriaSettings(childComplexity), true
+		return e.complexity.Backup.Size(childComplexity), true
 
-	case "TemperatureController.id":
-		if e.complexity.TemperatureController.ID == nil {
+	case "DeleteTemperatureControllerReturnType.id":
+		if e.complexity.DeleteTemperatureControllerReturnType.ID == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.ID(childComplexity), true
+		return e.complexity.DeleteTemperatureControllerReturnType.ID(childComplexity), true
 
-	case "TemperatureController.manualSettings":
-		if e.complexity.TemperatureController.ManualSettings == nil {
+	case "DeleteTemperatureControllerReturnType.temperatureProbes":
+		if e.complexity.DeleteTemperatureControllerReturnType.TemperatureProbes == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.ManualSettings(childComplexity), true
+		return e.complexity.DeleteTemperatureControllerReturnType.TemperatureProbes(childComplexity), true
 
-	case "TemperatureController.mode":
-		if e.complexity.TemperatureController.Mode == nil {
+	case "DeviceEvent.device":
+		if e.complexity.DeviceEvent.Device == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.Mode(childComplexity), true
+		return e.complexity.DeviceEvent.Device(childComplexity), true
 
-	case "TemperatureController.name":
-		if e.complexity.TemperatureController.Name == nil {
+	case "DeviceEvent.deviceId":
+		if e.complexity.DeviceEvent.DeviceID == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.Name(childComplexity), true
+		return e.complexity.DeviceEvent.DeviceID(childComplexity), true
 
-	case "TemperatureController.previousCalculationTime":
-		if e.complexity.TemperatureController.PreviousCalculationTime == nil {
+	case "DeviceEvent.message":
+		if e.complexity.DeviceEvent.Message == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.PreviousCalculationTime(childComplexity), true
+		return e.complexity.DeviceEvent.Message(childComplexity), true
 
-	case "TemperatureController.setPoint":
-		if e.complexity.TemperatureController.SetPoint == nil {
+	case "DeviceEvent.time":
+		if e.complexity.DeviceEvent.Time == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.SetPoint(childComplexity), true
+		return e.complexity.DeviceEvent.Time(childComplexity), true
 
-	case "TemperatureController.tempProbeDetails":
-		if e.complexity.TemperatureController.TempProbeDetails == nil {
+	case "DeviceEvent.type":
+		if e.complexity.DeviceEvent.Type == nil {
 			break
 		}
 
-		return e.complexity.TemperatureController.TempProbeDetails(childComplexity), true
+		return e.complexity.DeviceEvent.Type(childComplexity), true
 
-	case "TemperatureProbe.physAddr":
-		if e.complexity.TemperatureProbe.PhysAddr == nil {
+	case "Expander.address":
+		if e.complexity.Expander.Address == nil {
 			break
 		}
 
-		return e.complexity.TemperatureProbe.PhysAddr(childComplexity), true
+		return e.complexity.Expander.Address(childComplexity), true
 
-	case "TemperatureProbe.reading":
-		if e.complexity.TemperatureProbe.Reading == nil {
+	case "Expander.bus":
+		if e.complexity.Expander.Bus == nil {
 			break
 		}
 
-		return e.complexity.TemperatureProbe.Reading(childComplexity), true
+		return e.complexity.Expander.Bus(childComplexity), true
 
-	case "TemperatureProbe.updated":
-		if e.complexity.TemperatureProbe.Updated == nil {
+	case "Expander.chip":
+		if e.complexity.Expander.Chip == nil {
 			break
 		}
 
-		return e.complexity.TemperatureProbe.Updated(childComplexity), true
-
-	}
-	return 0, false
-}
+		return e.complexity.Expander.Chip(childComplexity), true
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e}
-	first := true
+	case "Expander.connectError":
+		if e.complexity.Expander.ConnectError == nil {
+			break
+		}
 
-	switch rc.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			data := ec._Query(ctx, rc.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
+		return e.complexity.Expander.ConnectError(childComplexity), true
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+	case "Expander.id":
+		if e.complexity.Expander.ID == nil {
+			break
 		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.Expander.ID(childComplexity), true
+
+	case "Expander.name":
+		if e.complexity.Expander.Name == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.Expander.Name(childComplexity), true
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-}
+	case "Expander.pins":
+		if e.complexity.Expander.Pins == nil {
+			break
+		}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(parsedSchema), nil
-}
+		return e.complexity.Expander.Pins(childComplexity), true
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
-}
+	case "Fermentation.abv":
+		if e.complexity.Fermentation.Abv == nil {
+			break
+		}
 
-var sources = []*ast.Source{
-	{Name: "graph/schema.graphqls", Input: `"""The temperature controller mode"""
-enum ControllerMode {
-  """Use the manual settings"""
-  manual
+		return e.complexity.Fermentation.Abv(childComplexity), true
 
-  """This controller is off"""
-  off
+	case "Fermentation.apparentAttenuation":
+		if e.complexity.Fermentation.ApparentAttenuation == nil {
+			break
+		}
 
-  """Use the PID settings"""
-  auto
+		return e.complexity.Fermentation.ApparentAttenuation(childComplexity), true
 
-  """Use the hysteria settings"""
-  hysteria
-}
+	case "Fermentation.currentGravity":
+		if e.complexity.Fermentation.CurrentGravity == nil {
+			break
+		}
 
-enum SwitchMode {
-  on
-  off
-}
+		return e.complexity.Fermentation.CurrentGravity(childComplexity), true
 
-scalar Time
+	case "Fermentation.id":
+		if e.complexity.Fermentation.ID == nil {
+			break
+		}
 
-"""The settings for hysteria mode"""
-type HysteriaSettings {
-  """Indicates if these settings have been configured yet."""
-  configured: Boolean
+		return e.complexity.Fermentation.ID(childComplexity), true
 
-  """The ID of an object"""
-  id: ID!
+	case "Fermentation.originalGravity":
+		if e.complexity.Fermentation.OriginalGravity == nil {
+			break
+		}
 
-  """When this temperature is hit, turn on the cooling output"""
-  maxTemp: String
+		return e.complexity.Fermentation.OriginalGravity(childComplexity), true
 
-  """When this temperature is hit, turn on the heating output"""
-  minTemp: String
+	case "Fermentation.readings":
+		if e.complexity.Fermentation.Readings == nil {
+			break
+		}
 
-  """The minimum amount of time to turn the outputs on for."""
-  minTime: Int
-}
+		return e.complexity.Fermentation.Readings(childComplexity), true
 
-"""The manual settings for this controller"""
-type ManualSettings {
-  """Indicates if these settings have been configured yet."""
-  configured: Boolean
+	case "Fermentation.slope":
+		if e.complexity.Fermentation.Slope == nil {
+			break
+		}
 
-  """The time for one duty cycle in seconds"""
-  cycleTime: Int
+		return e.complexity.Fermentation.Slope(childComplexity), true
 
-  """The manual duty cycle percentage for this controller"""
-  dutyCycle: Int
+	case "Fermentation.slopeWindow":
+		if e.complexity.Fermentation.SlopeWindow == nil {
+			break
+		}
 
-  """The ID of an object"""
-  id: ID!
-}
+		return e.complexity.Fermentation.SlopeWindow(childComplexity), true
 
-type Mutation {
-  assignProbe(name: String!, address: String!): TemperatureController
-  removeProbeFromTemperatureController(address: String!): TemperatureController
-  updateTemperatureController(controllerSettings: TemperatureControllerSettingsInput!): TemperatureController
-  deleteTemperatureController(id: ID!): DeleteTemperatureControllerReturnType
-  
-  """Update the current system settings"""
-  updateSettings(settings: SettingsInput!): Settings
+	case "Fermentation.stableSlope":
+		if e.complexity.Fermentation.StableSlope == nil {
+			break
+		}
 
-  """
-  Create or update a switch
-  """
-  modifySwitch(switchSettings: SwitchSettingsInput!): Switch
-  """
-  Delete a switch
-  """
-  deleteSwitch(id: ID!): Switch
-  """
-  Enable or disable a switch
-  """
-  toggleSwitch(id: ID!, mode: SwitchMode!): Switch
-}
+		return e.complexity.Fermentation.StableSlope(childComplexity), true
 
-"""The settings for heating or cooling on a temperature controller"""
-type PidSettings {
-  """Indicates if these settings have been configured yet"""
-  configured: Boolean
+	case "Fermentation.started":
+		if e.complexity.Fermentation.Started == nil {
+			break
+		}
 
-  """The automatic cycle time in seconds"""
-  cycleTime: Int
+		return e.complexity.Fermentation.Started(childComplexity), true
 
-  """The minimum delay between turning an output on and off in seconds"""
-  delay: Int
+	case "Fermentation.status":
+		if e.complexity.Fermentation.Status == nil {
+			break
+		}
 
-  """The derivative calculation value"""
-  derivative: Float
+		return e.complexity.Fermentation.Status(childComplexity), true
 
-  """The ID of an object"""
-  id: ID!
+	case "Fermentation.steps":
+		if e.complexity.Fermentation.Steps == nil {
+			break
+		}
 
-  """The integral calculation value"""
-  integral: Float
+		return e.complexity.Fermentation.Steps(childComplexity), true
 
-  """The proportional calculation value"""
-  proportional: Float
+	case "Fermentation.targetAttenuation":
+		if e.complexity.Fermentation.TargetAttenuation == nil {
+			break
+		}
 
-  """The GPIO"""
-  gpio: String
-}
+		return e.complexity.Fermentation.TargetAttenuation(childComplexity), true
 
-"""Used to configure a controller"""
-input TemperatureControllerSettingsInput {
-  """The controller Id"""
-  id: ID!
+	case "FermentationStep.attenuation":
+		if e.complexity.FermentationStep.Attenuation == nil {
+			break
+		}
 
-  """The name of the controller."""
-  name: String
+		return e.complexity.FermentationStep.Attenuation(childComplexity), true
 
-  """The new mode for the controller"""
-  mode: ControllerMode
+	case "FermentationStep.mode":
+		if e.complexity.FermentationStep.Mode == nil {
+			break
+		}
 
-  """The PID Settings for the cooling output"""
-  coolSettings: PidSettingsInput
+		return e.complexity.FermentationStep.Mode(childComplexity), true
 
-  """The PID settings for the heating output"""
-  heatSettings: PidSettingsInput
+	case "FermentationStep.name":
+		if e.complexity.FermentationStep.Name == nil {
+			break
+		}
 
-  """The hysteria settings for controlling this temperature controller"""
-  hysteriaSettings: HysteriaSettingsInput
+		return e.complexity.FermentationStep.Name(childComplexity), true
 
-  """The manual settings for this temperature controller"""
-  manualSettings: ManualSettingsInput
+	case "FermentationStep.setPoint":
+		if e.complexity.FermentationStep.SetPoint == nil {
+			break
+		}
 
-  """The target for auto mode"""
-  setPoint: String
-}
+		return e.complexity.FermentationStep.SetPoint(childComplexity), true
 
-type Query {
-  probe(address: String): TemperatureProbe
+	case "FermentationStep.triggered":
+		if e.complexity.FermentationStep.Triggered == nil {
+			break
+		}
 
-  """Get the list of probes"""
-  probeList(available: Boolean): [TemperatureProbe]
+		return e.complexity.FermentationStep.Triggered(childComplexity), true
 
-  """Get a specific list of probes"""
-  fetchProbes(addresses: [String]): [TemperatureProbe]
+	case "FlowDispense.dispensed":
+		if e.complexity.FlowDispense.Dispensed == nil {
+			break
+		}
 
-  """Fetch all the temperature controllers, or a subset by name"""
-  temperatureControllers(name: String): [TemperatureController]
+		return e.complexity.FlowDispense.Dispensed(childComplexity), true
 
-  """Fetch the current System Settings"""
-  settings: Settings
+	case "FlowDispense.error":
+		if e.complexity.FlowDispense.Error == nil {
+			break
+		}
 
-  """Fetch switches that are configured"""
-  switches: [Switch]
-}
+		return e.complexity.FlowDispense.Error(childComplexity), true
 
-type TemperatureController {
-  """
-  The PID calculated duty cycle, this can be overriden by the ManualDuty in manual mode
-  """
-  calculatedDuty: Int
+	case "FlowDispense.finished":
+		if e.complexity.FlowDispense.Finished == nil {
+			break
+		}
 
-  """The cooling settings for this controller"""
-  coolSettings: PidSettings
+		return e.complexity.FlowDispense.Finished(childComplexity), true
 
-  """The percentage of time this controller is on"""
-  dutyCycle: Int
+	case "FlowDispense.litres":
+		if e.complexity.FlowDispense.Litres == nil {
+			break
+		}
 
-  """The heating settings for this controller"""
-  heatSettings: PidSettings
+		return e.complexity.FlowDispense.Litres(childComplexity), true
 
-  """The hysteria mode settings for this controller"""
-  hysteriaSettings: HysteriaSettings
+	case "FlowDispense.started":
+		if e.complexity.FlowDispense.Started == nil {
+			break
+		}
 
-  """The ID of an object"""
-  id: ID!
+		return e.complexity.FlowDispense.Started(childComplexity), true
 
-  """The manual settings for this controller"""
-  manualSettings: ManualSettings
+	case "FlowDispense.switch":
+		if e.complexity.FlowDispense.Switch == nil {
+			break
+		}
 
-  """The controller mode"""
-  mode: ControllerMode
+		return e.complexity.FlowDispense.Switch(childComplexity), true
 
-  """The assigned name of this controller"""
-  name: String
+	case "FlowMeter.connectError":
+		if e.complexity.FlowMeter.ConnectError == nil {
+			break
+		}
 
-  """The last time that the duty cycle was calculated"""
-  previousCalculationTime: Time
+		return e.complexity.FlowMeter.ConnectError(childComplexity), true
 
-  """The target temperature when in auto mode"""
-  setPoint: String
+	case "FlowMeter.dispensing":
+		if e.complexity.FlowMeter.Dispensing == nil {
+			break
+		}
 
-  """The probes assigned to this controller"""
-  tempProbeDetails: [TempProbeDetails]
-}
+		return e.complexity.FlowMeter.Dispensing(childComplexity), true
 
-"""A device that reads a temperature and is assigned to a temperature controller"""
-type TempProbeDetails {
-  """The ID of an object"""
-  id: ID!
+	case "FlowMeter.gpio":
+		if e.complexity.FlowMeter.Gpio == nil {
+			break
+		}
 
-  """The physical address of this probe"""
-  physAddr: String
+		return e.complexity.FlowMeter.Gpio(childComplexity), true
 
-  """The value of the reading"""
-  reading: String
+	case "FlowMeter.id":
+		if e.complexity.FlowMeter.ID == nil {
+			break
+		}
 
-  """The friendly name of this probe"""
-  name: String
+		return e.complexity.FlowMeter.ID(childComplexity), true
 
-  """The time that this reading was updated"""
-  updated: Time
-}
+	case "FlowMeter.lifetimeVolume":
+		if e.complexity.FlowMeter.LifetimeVolume == nil {
+			break
+		}
 
-"""A device that reads a temperature"""
-type TemperatureProbe {
-  """The physical address of this probe"""
-  physAddr: String
+		return e.complexity.FlowMeter.LifetimeVolume(childComplexity), true
 
-  """The value of the reading"""
-  reading: String
+	case "FlowMeter.name":
+		if e.complexity.FlowMeter.Name == nil {
+			break
+		}
 
-  """The time that this reading was updated"""
-  updated: Time
-}
+		return e.complexity.FlowMeter.Name(childComplexity), true
 
+	case "FlowMeter.noFlowTimeout":
+		if e.complexity.FlowMeter.NoFlowTimeout == nil {
+			break
+		}
 
-"""The new settings for hysteria mode"""
-input HysteriaSettingsInput {
-  """Indicates if these settings have been configured yet"""
-  configured: Boolean
+		return e.complexity.FlowMeter.NoFlowTimeout(childComplexity), true
 
-  """When this temperature is hit, turn on the cooling output"""
-  maxTemp: String
+	case "FlowMeter.pulsesPerLitre":
+		if e.complexity.FlowMeter.PulsesPerLitre == nil {
+			break
+		}
 
-  """When this temperature is hit, turn on the heating output"""
-  minTemp: String
+		return e.complexity.FlowMeter.PulsesPerLitre(childComplexity), true
 
-  """The minimum amount of time to turn the outputs on for."""
-  minTime: Int
-}
+	case "FlowMeter.rate":
+		if e.complexity.FlowMeter.Rate == nil {
+			break
+		}
 
-"""The new manual settings for this controller"""
-input ManualSettingsInput {
-  """Indicates if these settings have been configured yet"""
-  configured: Boolean
+		return e.complexity.FlowMeter.Rate(childComplexity), true
 
-  """The time for one duty cycle in seconds"""
-  cycleTime: Int
+	case "FlowMeter.volume":
+		if e.complexity.FlowMeter.Volume == nil {
+			break
+		}
 
-  """The manual duty cycle percentage for this controller"""
-  dutyCycle: Int
-}
+		return e.complexity.FlowMeter.Volume(childComplexity), true
 
-"""The settings for heating or cooling on a temperature controller"""
-input PidSettingsInput {
-  """Indicates if these settings have been configured yet"""
-  configured: Boolean
+	case "GravityReading.gravity":
+		if e.complexity.GravityReading.Gravity == nil {
+			break
+		}
 
-  """The automatic cycle time in seconds"""
-  cycleTime: Int
+		return e.complexity.GravityReading.Gravity(childComplexity), true
 
-  """The minimum delay between turning an output on and off in seconds"""
-  delay: Int
+	case "GravityReading.time":
+		if e.complexity.GravityReading.Time == nil {
+			break
+		}
 
-  """The derivative calculation value"""
-  derivative: Float
+		return e.complexity.GravityReading.Time(childComplexity), true
 
-  """The integral calculation value"""
-  integral: Float
+	case "HysteriaSettings.configured":
+		if e.complexity.HysteriaSettings.Configured == nil {
+			break
+		}
 
-  """The proportional calculation value"""
-  proportional: Float
+		return e.complexity.HysteriaSettings.Configured(childComplexity), true
 
-  """The friendly name of the GPIO Value"""
-  gpio: String
-}
+	case "HysteriaSettings.id":
+		if e.complexity.HysteriaSettings.ID == nil {
+			break
+		}
 
-"""The deleted controller"""
-type DeleteTemperatureControllerReturnType {
-  """The ID of the deleted Controller"""
-  id: ID!
-  """Temperatures Probes that were associated with this controller"""
-  temperatureProbes: [String]
-}
+		return e.complexity.HysteriaSettings.ID(childComplexity), true
 
-"""The overall settings for this brewery"""
-type Settings {
-  """The current brewery name"""
-  breweryName: String!
-}
+	case "HysteriaSettings.maxTemp":
+		if e.complexity.HysteriaSettings.MaxTemp == nil {
+			break
+		}
 
-"""The new settings for this brewery"""
-input SettingsInput {
-  """The new brewery name (blank for no change)"""
-  breweryName: String
-}
+		return e.complexity.HysteriaSettings.MaxTemp(childComplexity), true
 
-type Switch {
-  """The ID of the switch"""
-  id: ID!
-  """The GPIO for the pin"""
-  gpio: String!
-  """The name of the switch"""
-  name: String!
-  """The state of the switch"""
-  state: SwitchMode!
-}
+	case "HysteriaSettings.minTemp":
+		if e.complexity.HysteriaSettings.MinTemp == nil {
+			break
+		}
 
-input SwitchSettingsInput {
-  """
-  The Id of the switch, if no ID, create a new switch
-  """
-  id: ID
-  """
-  The new Name for the switch (required during switch creation)
-  """
-  name: String
-  """
-  The new GPIO for the switch (required during switch creation)
-  """
-  gpio: String
-  """
-  The new state for the switch
-  """
-  state: SwitchMode
-}`, BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+		return e.complexity.HysteriaSettings.MinTemp(childComplexity), true
 
-// endregion ************************** generated!.gotpl **************************
+	case "HysteriaSettings.minTime":
+		if e.complexity.HysteriaSettings.MinTime == nil {
+			break
+		}
 
-// region    ***************************** args.gotpl *****************************
+		return e.complexity.HysteriaSettings.MinTime(childComplexity), true
 
-func (ec *executionContext) field_Mutation_assignProbe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+	case "InPin.action":
+		if e.complexity.InPin.Action == nil {
+			break
 		}
-	}
-	args["name"] = arg0
-	var arg1 string
-	if tmp, ok := rawArgs["address"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
-		arg1, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.InPin.Action(childComplexity), true
+
+	case "InPin.active":
+		if e.complexity.InPin.Active == nil {
+			break
 		}
-	}
-	args["address"] = arg1
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_deleteSwitch_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.InPin.Active(childComplexity), true
+
+	case "InPin.changed":
+		if e.complexity.InPin.Changed == nil {
+			break
 		}
-	}
-	args["id"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_deleteTemperatureController_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.InPin.Changed(childComplexity), true
+
+	case "InPin.connectError":
+		if e.complexity.InPin.ConnectError == nil {
+			break
 		}
-	}
-	args["id"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_modifySwitch_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 model.SwitchSettingsInput
-	if tmp, ok := rawArgs["switchSettings"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("switchSettings"))
-		arg0, err = ec.unmarshalNSwitchSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchSettingsInput(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.InPin.ConnectError(childComplexity), true
+
+	case "InPin.controller":
+		if e.complexity.InPin.Controller == nil {
+			break
 		}
-	}
-	args["switchSettings"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_removeProbeFromTemperatureController_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["address"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.InPin.Controller(childComplexity), true
+
+	case "InPin.debounce":
+		if e.complexity.InPin.Debounce == nil {
+			break
 		}
-	}
-	args["address"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_toggleSwitch_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNID2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.InPin.Debounce(childComplexity), true
+
+	case "InPin.gpio":
+		if e.complexity.InPin.Gpio == nil {
+			break
 		}
-	}
-	args["id"] = arg0
-	var arg1 model.SwitchMode
-	if tmp, ok := rawArgs["mode"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mode"))
-		arg1, err = ec.unmarshalNSwitchMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx, tmp)
-		if err != nil {
-			return nil, err
+
+		return e.complexity.InPin.Gpio(childComplexity), true
+
+	case "InPin.id":
+		if e.complexity.InPin.ID == nil {
+			break
 		}
-	}
-	args["mode"] = arg1
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_updateSettings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 model.SettingsInput
-	if tmp, ok := rawArgs["settings"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("settings"))
-		arg0, err = ec.unmarshalNSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSettingsInput(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.InPin.ID(childComplexity), true
+
+	case "InPin.inverted":
+		if e.complexity.InPin.Inverted == nil {
+			break
 		}
-	}
-	args["settings"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Mutation_updateTemperatureController_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 model.TemperatureControllerSettingsInput
-	if tmp, ok := rawArgs["controllerSettings"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("controllerSettings"))
-		arg0, err = ec.unmarshalNTemperatureControllerSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureControllerSettingsInput(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.InPin.Inverted(childComplexity), true
+
+	case "InPin.name":
+		if e.complexity.InPin.Name == nil {
+			break
 		}
-	}
-	args["controllerSettings"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.InPin.Name(childComplexity), true
+
+	case "InPin.pull":
+		if e.complexity.InPin.Pull == nil {
+			break
 		}
-	}
-	args["name"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_fetchProbes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 []*string
-	if tmp, ok := rawArgs["addresses"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("addresses"))
-		arg0, err = ec.unmarshalOString2ᚕᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.InPin.Pull(childComplexity), true
+
+	case "InPin.switch":
+		if e.complexity.InPin.Switch == nil {
+			break
 		}
-	}
-	args["addresses"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_probeList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *bool
-	if tmp, ok := rawArgs["available"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("available"))
-		arg0, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.InPin.Switch(childComplexity), true
+
+	case "ManualSettings.configured":
+		if e.complexity.ManualSettings.Configured == nil {
+			break
 		}
-	}
-	args["available"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_probe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *string
-	if tmp, ok := rawArgs["address"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
-		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.ManualSettings.Configured(childComplexity), true
+
+	case "ManualSettings.cycleTime":
+		if e.complexity.ManualSettings.CycleTime == nil {
+			break
 		}
-	}
-	args["address"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field_Query_temperatureControllers_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 *string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.ManualSettings.CycleTime(childComplexity), true
+
+	case "ManualSettings.dutyCycle":
+		if e.complexity.ManualSettings.DutyCycle == nil {
+			break
 		}
-	}
-	args["name"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.ManualSettings.DutyCycle(childComplexity), true
+
+	case "ManualSettings.id":
+		if e.complexity.ManualSettings.ID == nil {
+			break
 		}
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
-		if err != nil {
-			return nil, err
+		return e.complexity.ManualSettings.ID(childComplexity), true
+
+	case "ModbusRegister.address":
+		if e.complexity.ModbusRegister.Address == nil {
+			break
 		}
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
 
-// endregion ***************************** args.gotpl *****************************
+		return e.complexity.ModbusRegister.Address(childComplexity), true
 
-// region    ************************** directives.gotpl **************************
+	case "ModbusRegister.description":
+		if e.complexity.ModbusRegister.Description == nil {
+			break
+		}
 
-// endregion ************************** directives.gotpl **************************
+		return e.complexity.ModbusRegister.Description(childComplexity), true
 
-// region    **************************** field.gotpl *****************************
+	case "ModbusRegister.name":
+		if e.complexity.ModbusRegister.Name == nil {
+			break
+		}
 
-func (ec *executionContext) _DeleteTemperatureControllerReturnType_id(ctx context.Context, field graphql.CollectedField, obj *model.DeleteTemperatureControllerReturnType) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ModbusRegister.Name(childComplexity), true
+
+	case "ModbusRegister.table":
+		if e.complexity.ModbusRegister.Table == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "DeleteTemperatureControllerReturnType",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		return e.complexity.ModbusRegister.Table(childComplexity), true
+
+	case "ModbusRegister.value":
+		if e.complexity.ModbusRegister.Value == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _DeleteTemperatureControllerReturnType_temperatureProbes(ctx context.Context, field graphql.CollectedField, obj *model.DeleteTemperatureControllerReturnType) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.ModbusRegister.Value(childComplexity), true
+
+	case "ModbusRegister.writable":
+		if e.complexity.ModbusRegister.Writable == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "DeleteTemperatureControllerReturnType",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.TemperatureProbes, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*string)
-	fc.Result = res
-	return ec.marshalOString2ᚕᚖstring(ctx, field.Selections, res)
-}
+		return e.complexity.ModbusRegister.Writable(childComplexity), true
 
-func (ec *executionContext) _HysteriaSettings_configured(ctx context.Context, field graphql.CollectedField, obj *devices.HysteriaSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Mutation.acknowledgeAlert":
+		if e.complexity.Mutation.AcknowledgeAlert == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "HysteriaSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Configured, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _HysteriaSettings_id(ctx context.Context, field graphql.CollectedField, obj *devices.HysteriaSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Mutation_acknowledgeAlert_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "HysteriaSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.HysteriaSettings().ID(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		return e.complexity.Mutation.AcknowledgeAlert(childComplexity, args["id"].(string)), true
+
+	case "Mutation.applyScene":
+		if e.complexity.Mutation.ApplyScene == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _HysteriaSettings_maxTemp(ctx context.Context, field graphql.CollectedField, obj *devices.HysteriaSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Mutation_applyScene_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "HysteriaSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.MaxTemp(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
+		return e.complexity.Mutation.ApplyScene(childComplexity, args["id"].(string)), true
 
-func (ec *executionContext) _HysteriaSettings_minTemp(ctx context.Context, field graphql.CollectedField, obj *devices.HysteriaSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Mutation.assignProbe":
+		if e.complexity.Mutation.AssignProbe == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "HysteriaSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.MinTemp(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_assignProbe_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _HysteriaSettings_minTime(ctx context.Context, field graphql.CollectedField, obj *devices.HysteriaSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.AssignProbe(childComplexity, args["name"].(string), args["address"].(string)), true
+
+	case "Mutation.calibrateProbe":
+		if e.complexity.Mutation.CalibrateProbe == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "HysteriaSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.MinTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalOInt2int64(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_calibrateProbe_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _ManualSettings_configured(ctx context.Context, field graphql.CollectedField, obj *devices.ManualSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.CalibrateProbe(childComplexity, args["address"].(string), args["point"].(model.CalibrationPoint), args["reference"].(*string)), true
+
+	case "Mutation.cancelDispense":
+		if e.complexity.Mutation.CancelDispense == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "ManualSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Configured, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_cancelDispense_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _ManualSettings_cycleTime(ctx context.Context, field graphql.CollectedField, obj *devices.ManualSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.CancelDispense(childComplexity, args["flowMeterId"].(string)), true
+
+	case "Mutation.createBackup":
+		if e.complexity.Mutation.CreateBackup == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "ManualSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CycleTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalOInt2int64(ctx, field.Selections, res)
-}
+		return e.complexity.Mutation.CreateBackup(childComplexity), true
 
-func (ec *executionContext) _ManualSettings_dutyCycle(ctx context.Context, field graphql.CollectedField, obj *devices.ManualSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Mutation.deleteAlertChannel":
+		if e.complexity.Mutation.DeleteAlertChannel == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "ManualSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DutyCycle, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalOInt2int64(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteAlertChannel_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _ManualSettings_id(ctx context.Context, field graphql.CollectedField, obj *devices.ManualSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteAlertChannel(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteAlertRule":
+		if e.complexity.Mutation.DeleteAlertRule == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "ManualSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.ManualSettings().ID(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		args, err := ec.field_Mutation_deleteAlertRule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Mutation_assignProbe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteAlertRule(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteAutomationRule":
+		if e.complexity.Mutation.DeleteAutomationRule == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_assignProbe_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().AssignProbe(rctx, args["name"].(string), args["address"].(string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*devices.TemperatureController)
-	fc.Result = res
-	return ec.marshalOTemperatureController2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteAutomationRule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Mutation_removeProbeFromTemperatureController(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteAutomationRule(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteExpander":
+		if e.complexity.Mutation.DeleteExpander == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_removeProbeFromTemperatureController_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RemoveProbeFromTemperatureController(rctx, args["address"].(string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*devices.TemperatureController)
-	fc.Result = res
-	return ec.marshalOTemperatureController2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteExpander_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Mutation_updateTemperatureController(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteExpander(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteFlowMeter":
+		if e.complexity.Mutation.DeleteFlowMeter == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_updateTemperatureController_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateTemperatureController(rctx, args["controllerSettings"].(model.TemperatureControllerSettingsInput))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*devices.TemperatureController)
-	fc.Result = res
-	return ec.marshalOTemperatureController2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteFlowMeter_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Mutation_deleteTemperatureController(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteFlowMeter(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteInPin":
+		if e.complexity.Mutation.DeleteInPin == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_deleteTemperatureController_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteTemperatureController(rctx, args["id"].(string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.DeleteTemperatureControllerReturnType)
-	fc.Result = res
-	return ec.marshalODeleteTemperatureControllerReturnType2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeleteTemperatureControllerReturnType(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteInPin_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Mutation_updateSettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteInPin(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteSPIProbe":
+		if e.complexity.Mutation.DeleteSPIProbe == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_updateSettings_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdateSettings(rctx, args["settings"].(model.SettingsInput))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*system.Settings)
-	fc.Result = res
-	return ec.marshalOSettings2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋsystemᚐSettings(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteSPIProbe_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Mutation_modifySwitch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteSPIProbe(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteScene":
+		if e.complexity.Mutation.DeleteScene == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_modifySwitch_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ModifySwitch(rctx, args["switchSettings"].(model.SwitchSettingsInput))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*devices.Switch)
-	fc.Result = res
-	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteScene_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Mutation_deleteSwitch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteScene(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteSchedule":
+		if e.complexity.Mutation.DeleteSchedule == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_deleteSwitch_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteSwitch(rctx, args["id"].(string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*devices.Switch)
-	fc.Result = res
-	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteSchedule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Mutation_toggleSwitch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteSchedule(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteSwitch":
+		if e.complexity.Mutation.DeleteSwitch == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Mutation",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_toggleSwitch_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ToggleSwitch(rctx, args["id"].(string), args["mode"].(model.SwitchMode))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*devices.Switch)
-	fc.Result = res
-	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteSwitch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _PidSettings_configured(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteSwitch(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteSwitchGroup":
+		if e.complexity.Mutation.DeleteSwitchGroup == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "PidSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Configured, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteSwitchGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _PidSettings_cycleTime(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteSwitchGroup(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteTemperatureController":
+		if e.complexity.Mutation.DeleteTemperatureController == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "PidSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CycleTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalOInt2int64(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteTemperatureController_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _PidSettings_delay(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteTemperatureController(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteWebhook":
+		if e.complexity.Mutation.DeleteWebhook == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "PidSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Delay, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalOInt2int64(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_deleteWebhook_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _PidSettings_derivative(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.DeleteWebhook(childComplexity, args["id"].(string)), true
+
+	case "Mutation.dispense":
+		if e.complexity.Mutation.Dispense == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "PidSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Derivative, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(float64)
-	fc.Result = res
-	return ec.marshalOFloat2float64(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_dispense_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _PidSettings_id(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.Dispense(childComplexity, args["flowMeterId"].(string), args["litres"].(float64), args["switchId"].(string)), true
+
+	case "Mutation.endFermentation":
+		if e.complexity.Mutation.EndFermentation == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "PidSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.PidSettings().ID(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		args, err := ec.field_Mutation_endFermentation_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _PidSettings_integral(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.EndFermentation(childComplexity, args["controllerId"].(string)), true
+
+	case "Mutation.forgetProbe":
+		if e.complexity.Mutation.ForgetProbe == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "PidSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Integral, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(float64)
-	fc.Result = res
-	return ec.marshalOFloat2float64(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_forgetProbe_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _PidSettings_proportional(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ForgetProbe(childComplexity, args["address"].(string)), true
+
+	case "Mutation.modifyAlertChannel":
+		if e.complexity.Mutation.ModifyAlertChannel == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "PidSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Proportional, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(float64)
-	fc.Result = res
-	return ec.marshalOFloat2float64(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_modifyAlertChannel_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _PidSettings_gpio(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ModifyAlertChannel(childComplexity, args["alertChannel"].(model.AlertChannelInput)), true
+
+	case "Mutation.modifyAlertRule":
+		if e.complexity.Mutation.ModifyAlertRule == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "PidSettings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Gpio, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_modifyAlertRule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Query_probe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ModifyAlertRule(childComplexity, args["alertRule"].(model.AlertRuleInput)), true
+
+	case "Mutation.modifyAutomationRule":
+		if e.complexity.Mutation.ModifyAutomationRule == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_probe_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Probe(rctx, args["address"].(*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*model.TemperatureProbe)
-	fc.Result = res
-	return ec.marshalOTemperatureProbe2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_modifyAutomationRule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Query_probeList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ModifyAutomationRule(childComplexity, args["automationRule"].(model.AutomationRuleInput)), true
+
+	case "Mutation.modifyExpander":
+		if e.complexity.Mutation.ModifyExpander == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_probeList_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ProbeList(rctx, args["available"].(*bool))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*model.TemperatureProbe)
-	fc.Result = res
-	return ec.marshalOTemperatureProbe2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_modifyExpander_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Query_fetchProbes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ModifyExpander(childComplexity, args["expander"].(model.ExpanderInput)), true
+
+	case "Mutation.modifyFlowMeter":
+		if e.complexity.Mutation.ModifyFlowMeter == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_fetchProbes_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().FetchProbes(rctx, args["addresses"].([]*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*model.TemperatureProbe)
-	fc.Result = res
-	return ec.marshalOTemperatureProbe2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) _Query_temperatureControllers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Mutation_modifyFlowMeter_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_temperatureControllers_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TemperatureControllers(rctx, args["name"].(*string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*devices.TemperatureController)
-	fc.Result = res
-	return ec.marshalOTemperatureController2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
-}
+		return e.complexity.Mutation.ModifyFlowMeter(childComplexity, args["flowMeter"].(model.FlowMeterInput)), true
 
-func (ec *executionContext) _Query_settings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Mutation.modifyInPin":
+		if e.complexity.Mutation.ModifyInPin == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Settings(rctx)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*system.Settings)
-	fc.Result = res
-	return ec.marshalOSettings2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋsystemᚐSettings(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_modifyInPin_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Query_switches(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ModifyInPin(childComplexity, args["inPin"].(model.InPinInput)), true
+
+	case "Mutation.modifySPIProbe":
+		if e.complexity.Mutation.ModifySPIProbe == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Switches(rctx)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]*devices.Switch)
-	fc.Result = res
-	return ec.marshalOSwitch2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_modifySPIProbe_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ModifySPIProbe(childComplexity, args["spiProbe"].(model.SPIProbeInput)), true
+
+	case "Mutation.modifyScene":
+		if e.complexity.Mutation.ModifyScene == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_modifyScene_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ModifyScene(childComplexity, args["scene"].(model.SceneInput)), true
+
+	case "Mutation.modifySchedule":
+		if e.complexity.Mutation.ModifySchedule == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Schema)
-	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_modifySchedule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _Settings_breweryName(ctx context.Context, field graphql.CollectedField, obj *system.Settings) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ModifySchedule(childComplexity, args["schedule"].(model.ScheduleInput)), true
+
+	case "Mutation.modifySwitch":
+		if e.complexity.Mutation.ModifySwitch == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Settings",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.BreweryName, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		args, err := ec.field_Mutation_modifySwitch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Switch_id(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ModifySwitch(childComplexity, args["switchSettings"].(model.SwitchSettingsInput)), true
+
+	case "Mutation.modifySwitchGroup":
+		if e.complexity.Mutation.ModifySwitchGroup == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Switch",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Switch().ID(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		args, err := ec.field_Mutation_modifySwitchGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Switch_gpio(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Switch",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
+		return e.complexity.Mutation.ModifySwitchGroup(childComplexity, args["switchGroup"].(model.SwitchGroupInput)), true
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Gpio(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	case "Mutation.modifyWebhook":
+		if e.complexity.Mutation.ModifyWebhook == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Switch_name(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Mutation_modifyWebhook_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Switch",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		return e.complexity.Mutation.ModifyWebhook(childComplexity, args["webhook"].(model.WebhookInput)), true
+
+	case "Mutation.pulseSwitch":
+		if e.complexity.Mutation.PulseSwitch == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _Switch_state(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Mutation_pulseSwitch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "Switch",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.State(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		return e.complexity.Mutation.PulseSwitch(childComplexity, args["id"].(string), args["onSeconds"].(float64), args["offSeconds"].(float64), args["cycles"].(*int)), true
+
+	case "Mutation.recordGravity":
+		if e.complexity.Mutation.RecordGravity == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(model.SwitchMode)
-	fc.Result = res
-	return ec.marshalNSwitchMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _TempProbeDetails_id(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Mutation_recordGravity_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TempProbeDetails",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		return e.complexity.Mutation.RecordGravity(childComplexity, args["controllerId"].(string), args["gravity"].(float64)), true
+
+	case "Mutation.removeProbeFromTemperatureController":
+		if e.complexity.Mutation.RemoveProbeFromTemperatureController == nil {
+			break
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _TempProbeDetails_physAddr(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		args, err := ec.field_Mutation_removeProbeFromTemperatureController_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TempProbeDetails",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PhysAddr, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
+		return e.complexity.Mutation.RemoveProbeFromTemperatureController(childComplexity, args["address"].(string)), true
 
-func (ec *executionContext) _TempProbeDetails_reading(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Mutation.rescanProbes":
+		if e.complexity.Mutation.RescanProbes == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TempProbeDetails",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Reading, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
+		return e.complexity.Mutation.RescanProbes(childComplexity), true
 
-func (ec *executionContext) _TempProbeDetails_name(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	case "Mutation.resetFlowMeter":
+		if e.complexity.Mutation.ResetFlowMeter == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TempProbeDetails",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_resetFlowMeter_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _TempProbeDetails_updated(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ResetFlowMeter(childComplexity, args["id"].(string)), true
+
+	case "Mutation.resetProbeCalibration":
+		if e.complexity.Mutation.ResetProbeCalibration == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TempProbeDetails",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Updated, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*time.Time)
-	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_resetProbeCalibration_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _TemperatureController_calculatedDuty(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.ResetProbeCalibration(childComplexity, args["address"].(string)), true
+
+	case "Mutation.restoreBackup":
+		if e.complexity.Mutation.RestoreBackup == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CalculatedDuty, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalOInt2int64(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_restoreBackup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _TemperatureController_coolSettings(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.RestoreBackup(childComplexity, args["name"].(string)), true
+
+	case "Mutation.runSchedule":
+		if e.complexity.Mutation.RunSchedule == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.CoolSettings, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(devices.PidSettings)
-	fc.Result = res
-	return ec.marshalOPidSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐPidSettings(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_runSchedule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _TemperatureController_dutyCycle(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.RunSchedule(childComplexity, args["id"].(string)), true
+
+	case "Mutation.silenceAlert":
+		if e.complexity.Mutation.SilenceAlert == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DutyCycle, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(int64)
-	fc.Result = res
-	return ec.marshalOInt2int64(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_silenceAlert_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _TemperatureController_heatSettings(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.SilenceAlert(childComplexity, args["id"].(string), args["minutes"].(int)), true
+
+	case "Mutation.startFermentation":
+		if e.complexity.Mutation.StartFermentation == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.HeatSettings, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(devices.PidSettings)
-	fc.Result = res
-	return ec.marshalOPidSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐPidSettings(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_startFermentation_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _TemperatureController_hysteriaSettings(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.StartFermentation(childComplexity, args["fermentation"].(model.FermentationInput)), true
+
+	case "Mutation.switchOnFor":
+		if e.complexity.Mutation.SwitchOnFor == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.HysteriaSettings, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(devices.HysteriaSettings)
-	fc.Result = res
-	return ec.marshalOHysteriaSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐHysteriaSettings(ctx, field.Selections, res)
-}
+		args, err := ec.field_Mutation_switchOnFor_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _TemperatureController_id(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.SwitchOnFor(childComplexity, args["id"].(string), args["seconds"].(float64)), true
+
+	case "Mutation.testAlertChannel":
+		if e.complexity.Mutation.TestAlertChannel == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.TemperatureController().ID(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+		args, err := ec.field_Mutation_testAlertChannel_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
-}
 
-func (ec *executionContext) _TemperatureController_manualSettings(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		return e.complexity.Mutation.TestAlertChannel(childComplexity, args["id"].(string)), true
+
+	case "Mutation.testWebhook":
+		if e.complexity.Mutation.TestWebhook == nil {
+			break
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ManualSettings, nil
+		args, err := ec.field_Mutation_testWebhook_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TestWebhook(childComplexity, args["id"].(string)), true
+
+	case "Mutation.toggleSwitch":
+		if e.complexity.Mutation.ToggleSwitch == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_toggleSwitch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ToggleSwitch(childComplexity, args["id"].(string), args["mode"].(model.SwitchMode)), true
+
+	case "Mutation.toggleSwitchGroup":
+		if e.complexity.Mutation.ToggleSwitchGroup == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_toggleSwitchGroup_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ToggleSwitchGroup(childComplexity, args["id"].(string), args["mode"].(model.SwitchMode)), true
+
+	case "Mutation.updateProbe":
+		if e.complexity.Mutation.UpdateProbe == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateProbe_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateProbe(childComplexity, args["probeSettings"].(model.ProbeSettingsInput)), true
+
+	case "Mutation.updateSettings":
+		if e.complexity.Mutation.UpdateSettings == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateSettings_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateSettings(childComplexity, args["settings"].(model.SettingsInput)), true
+
+	case "Mutation.updateTempProbeDetails":
+		if e.complexity.Mutation.UpdateTempProbeDetails == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateTempProbeDetails_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateTempProbeDetails(childComplexity, args["details"].(model.TempProbeDetailsInput)), true
+
+	case "Mutation.updateTemperatureController":
+		if e.complexity.Mutation.UpdateTemperatureController == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateTemperatureController_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateTemperatureController(childComplexity, args["controllerSettings"].(model.TemperatureControllerSettingsInput)), true
+
+	case "PidSettings.configured":
+		if e.complexity.PidSettings.Configured == nil {
+			break
+		}
+
+		return e.complexity.PidSettings.Configured(childComplexity), true
+
+	case "PidSettings.cycleTime":
+		if e.complexity.PidSettings.CycleTime == nil {
+			break
+		}
+
+		return e.complexity.PidSettings.CycleTime(childComplexity), true
+
+	case "PidSettings.delay":
+		if e.complexity.PidSettings.Delay == nil {
+			break
+		}
+
+		return e.complexity.PidSettings.Delay(childComplexity), true
+
+	case "PidSettings.derivative":
+		if e.complexity.PidSettings.Derivative == nil {
+			break
+		}
+
+		return e.complexity.PidSettings.Derivative(childComplexity), true
+
+	case "PidSettings.frequency":
+		if e.complexity.PidSettings.Frequency == nil {
+			break
+		}
+
+		return e.complexity.PidSettings.Frequency(childComplexity), true
+
+	case "PidSettings.gpio":
+		if e.complexity.PidSettings.Gpio == nil {
+			break
+		}
+
+		return e.complexity.PidSettings.Gpio(childComplexity), true
+
+	case "PidSettings.id":
+		if e.complexity.PidSettings.ID == nil {
+			break
+		}
+
+		return e.complexity.PidSettings.ID(childComplexity), true
+
+	case "PidSettings.integral":
+		if e.complexity.PidSettings.Integral == nil {
+			break
+		}
+
+		return e.complexity.PidSettings.Integral(childComplexity), true
+
+	case "PidSettings.proportional":
+		if e.complexity.PidSettings.Proportional == nil {
+			break
+		}
+
+		return e.complexity.PidSettings.Proportional(childComplexity), true
+
+	case "ProbeCalibration.highRawReading":
+		if e.complexity.ProbeCalibration.HighRawReading == nil {
+			break
+		}
+
+		return e.complexity.ProbeCalibration.HighRawReading(childComplexity), true
+
+	case "ProbeCalibration.highReference":
+		if e.complexity.ProbeCalibration.HighReference == nil {
+			break
+		}
+
+		return e.complexity.ProbeCalibration.HighReference(childComplexity), true
+
+	case "ProbeCalibration.lowRawReading":
+		if e.complexity.ProbeCalibration.LowRawReading == nil {
+			break
+		}
+
+		return e.complexity.ProbeCalibration.LowRawReading(childComplexity), true
+
+	case "ProbeCalibration.lowReference":
+		if e.complexity.ProbeCalibration.LowReference == nil {
+			break
+		}
+
+		return e.complexity.ProbeCalibration.LowReference(childComplexity), true
+
+	case "ProbeCalibration.mode":
+		if e.complexity.ProbeCalibration.Mode == nil {
+			break
+		}
+
+		return e.complexity.ProbeCalibration.Mode(childComplexity), true
+
+	case "ProbeCalibration.offset":
+		if e.complexity.ProbeCalibration.Offset == nil {
+			break
+		}
+
+		return e.complexity.ProbeCalibration.Offset(childComplexity), true
+
+	case "ProbeEvent.physAddr":
+		if e.complexity.ProbeEvent.PhysAddr == nil {
+			break
+		}
+
+		return e.complexity.ProbeEvent.PhysAddr(childComplexity), true
+
+	case "ProbeEvent.time":
+		if e.complexity.ProbeEvent.Time == nil {
+			break
+		}
+
+		return e.complexity.ProbeEvent.Time(childComplexity), true
+
+	case "ProbeEvent.type":
+		if e.complexity.ProbeEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.ProbeEvent.Type(childComplexity), true
+
+	case "ProbeScan.discovered":
+		if e.complexity.ProbeScan.Discovered == nil {
+			break
+		}
+
+		return e.complexity.ProbeScan.Discovered(childComplexity), true
+
+	case "ProbeScan.lost":
+		if e.complexity.ProbeScan.Lost == nil {
+			break
+		}
+
+		return e.complexity.ProbeScan.Lost(childComplexity), true
+
+	case "Query.alertChannels":
+		if e.complexity.Query.AlertChannels == nil {
+			break
+		}
+
+		return e.complexity.Query.AlertChannels(childComplexity), true
+
+	case "Query.alertRules":
+		if e.complexity.Query.AlertRules == nil {
+			break
+		}
+
+		return e.complexity.Query.AlertRules(childComplexity), true
+
+	case "Query.automationRules":
+		if e.complexity.Query.AutomationRules == nil {
+			break
+		}
+
+		return e.complexity.Query.AutomationRules(childComplexity), true
+
+	case "Query.backups":
+		if e.complexity.Query.Backups == nil {
+			break
+		}
+
+		return e.complexity.Query.Backups(childComplexity), true
+
+	case "Query.deviceEvents":
+		if e.complexity.Query.DeviceEvents == nil {
+			break
+		}
+
+		return e.complexity.Query.DeviceEvents(childComplexity), true
+
+	case "Query.evaluateAutomationRule":
+		if e.complexity.Query.EvaluateAutomationRule == nil {
+			break
+		}
+
+		args, err := ec.field_Query_evaluateAutomationRule_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.EvaluateAutomationRule(childComplexity, args["id"].(string)), true
+
+	case "Query.expanders":
+		if e.complexity.Query.Expanders == nil {
+			break
+		}
+
+		return e.complexity.Query.Expanders(childComplexity), true
+
+	case "Query.fetchProbes":
+		if e.complexity.Query.FetchProbes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_fetchProbes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FetchProbes(childComplexity, args["addresses"].([]*string)), true
+
+	case "Query.flowMeters":
+		if e.complexity.Query.FlowMeters == nil {
+			break
+		}
+
+		return e.complexity.Query.FlowMeters(childComplexity), true
+
+	case "Query.inPins":
+		if e.complexity.Query.InPins == nil {
+			break
+		}
+
+		return e.complexity.Query.InPins(childComplexity), true
+
+	case "Query.modbusRegisters":
+		if e.complexity.Query.ModbusRegisters == nil {
+			break
+		}
+
+		return e.complexity.Query.ModbusRegisters(childComplexity), true
+
+	case "Query.probe":
+		if e.complexity.Query.Probe == nil {
+			break
+		}
+
+		args, err := ec.field_Query_probe_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Probe(childComplexity, args["address"].(*string)), true
+
+	case "Query.probeEvents":
+		if e.complexity.Query.ProbeEvents == nil {
+			break
+		}
+
+		return e.complexity.Query.ProbeEvents(childComplexity), true
+
+	case "Query.probeList":
+		if e.complexity.Query.ProbeList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_probeList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ProbeList(childComplexity, args["available"].(*bool)), true
+
+	case "Query.registeredProbes":
+		if e.complexity.Query.RegisteredProbes == nil {
+			break
+		}
+
+		return e.complexity.Query.RegisteredProbes(childComplexity), true
+
+	case "Query.scenes":
+		if e.complexity.Query.Scenes == nil {
+			break
+		}
+
+		return e.complexity.Query.Scenes(childComplexity), true
+
+	case "Query.schedules":
+		if e.complexity.Query.Schedules == nil {
+			break
+		}
+
+		return e.complexity.Query.Schedules(childComplexity), true
+
+	case "Query.settings":
+		if e.complexity.Query.Settings == nil {
+			break
+		}
+
+		return e.complexity.Query.Settings(childComplexity), true
+
+	case "Query.spiProbes":
+		if e.complexity.Query.SpiProbes == nil {
+			break
+		}
+
+		return e.complexity.Query.SpiProbes(childComplexity), true
+
+	case "Query.switchGroups":
+		if e.complexity.Query.SwitchGroups == nil {
+			break
+		}
+
+		return e.complexity.Query.SwitchGroups(childComplexity), true
+
+	case "Query.switches":
+		if e.complexity.Query.Switches == nil {
+			break
+		}
+
+		return e.complexity.Query.Switches(childComplexity), true
+
+	case "Query.temperatureControllers":
+		if e.complexity.Query.TemperatureControllers == nil {
+			break
+		}
+
+		args, err := ec.field_Query_temperatureControllers_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TemperatureControllers(childComplexity, args["name"].(*string)), true
+
+	case "Query.webhookDeliveries":
+		if e.complexity.Query.WebhookDeliveries == nil {
+			break
+		}
+
+		args, err := ec.field_Query_webhookDeliveries_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.WebhookDeliveries(childComplexity, args["webhookId"].(*string), args["limit"].(*int)), true
+
+	case "Query.webhooks":
+		if e.complexity.Query.Webhooks == nil {
+			break
+		}
+
+		return e.complexity.Query.Webhooks(childComplexity), true
+
+	case "SPIProbe.bus":
+		if e.complexity.SPIProbe.Bus == nil {
+			break
+		}
+
+		return e.complexity.SPIProbe.Bus(childComplexity), true
+
+	case "SPIProbe.chipSelect":
+		if e.complexity.SPIProbe.ChipSelect == nil {
+			break
+		}
+
+		return e.complexity.SPIProbe.ChipSelect(childComplexity), true
+
+	case "SPIProbe.connectError":
+		if e.complexity.SPIProbe.ConnectError == nil {
+			break
+		}
+
+		return e.complexity.SPIProbe.ConnectError(childComplexity), true
+
+	case "SPIProbe.driver":
+		if e.complexity.SPIProbe.Driver == nil {
+			break
+		}
+
+		return e.complexity.SPIProbe.Driver(childComplexity), true
+
+	case "SPIProbe.filter50Hz":
+		if e.complexity.SPIProbe.Filter50Hz == nil {
+			break
+		}
+
+		return e.complexity.SPIProbe.Filter50Hz(childComplexity), true
+
+	case "SPIProbe.id":
+		if e.complexity.SPIProbe.ID == nil {
+			break
+		}
+
+		return e.complexity.SPIProbe.ID(childComplexity), true
+
+	case "SPIProbe.nominalResistance":
+		if e.complexity.SPIProbe.NominalResistance == nil {
+			break
+		}
+
+		return e.complexity.SPIProbe.NominalResistance(childComplexity), true
+
+	case "SPIProbe.physAddr":
+		if e.complexity.SPIProbe.PhysAddr == nil {
+			break
+		}
+
+		return e.complexity.SPIProbe.PhysAddr(childComplexity), true
+
+	case "SPIProbe.probe":
+		if e.complexity.SPIProbe.Probe == nil {
+			break
+		}
+
+		return e.complexity.SPIProbe.Probe(childComplexity), true
+
+	case "SPIProbe.referenceResistor":
+		if e.complexity.SPIProbe.ReferenceResistor == nil {
+			break
+		}
+
+		return e.complexity.SPIProbe.ReferenceResistor(childComplexity), true
+
+	case "SPIProbe.wires":
+		if e.complexity.SPIProbe.Wires == nil {
+			break
+		}
+
+		return e.complexity.SPIProbe.Wires(childComplexity), true
+
+	case "Scene.applying":
+		if e.complexity.Scene.Applying == nil {
+			break
+		}
+
+		return e.complexity.Scene.Applying(childComplexity), true
+
+	case "Scene.id":
+		if e.complexity.Scene.ID == nil {
+			break
+		}
+
+		return e.complexity.Scene.ID(childComplexity), true
+
+	case "Scene.interlocks":
+		if e.complexity.Scene.Interlocks == nil {
+			break
+		}
+
+		return e.complexity.Scene.Interlocks(childComplexity), true
+
+	case "Scene.lastApplied":
+		if e.complexity.Scene.LastApplied == nil {
+			break
+		}
+
+		return e.complexity.Scene.LastApplied(childComplexity), true
+
+	case "Scene.lastError":
+		if e.complexity.Scene.LastError == nil {
+			break
+		}
+
+		return e.complexity.Scene.LastError(childComplexity), true
+
+	case "Scene.lastResult":
+		if e.complexity.Scene.LastResult == nil {
+			break
+		}
+
+		return e.complexity.Scene.LastResult(childComplexity), true
+
+	case "Scene.name":
+		if e.complexity.Scene.Name == nil {
+			break
+		}
+
+		return e.complexity.Scene.Name(childComplexity), true
+
+	case "Scene.steps":
+		if e.complexity.Scene.Steps == nil {
+			break
+		}
+
+		return e.complexity.Scene.Steps(childComplexity), true
+
+	case "SceneStep.delaySeconds":
+		if e.complexity.SceneStep.DelaySeconds == nil {
+			break
+		}
+
+		return e.complexity.SceneStep.DelaySeconds(childComplexity), true
+
+	case "SceneStep.group":
+		if e.complexity.SceneStep.Group == nil {
+			break
+		}
+
+		return e.complexity.SceneStep.Group(childComplexity), true
+
+	case "SceneStep.state":
+		if e.complexity.SceneStep.State == nil {
+			break
+		}
+
+		return e.complexity.SceneStep.State(childComplexity), true
+
+	case "SceneStep.switch":
+		if e.complexity.SceneStep.Switch == nil {
+			break
+		}
+
+		return e.complexity.SceneStep.Switch(childComplexity), true
+
+	case "Schedule.actions":
+		if e.complexity.Schedule.Actions == nil {
+			break
+		}
+
+		return e.complexity.Schedule.Actions(childComplexity), true
+
+	case "Schedule.at":
+		if e.complexity.Schedule.At == nil {
+			break
+		}
+
+		return e.complexity.Schedule.At(childComplexity), true
+
+	case "Schedule.cron":
+		if e.complexity.Schedule.Cron == nil {
+			break
+		}
+
+		return e.complexity.Schedule.Cron(childComplexity), true
+
+	case "Schedule.enabled":
+		if e.complexity.Schedule.Enabled == nil {
+			break
+		}
+
+		return e.complexity.Schedule.Enabled(childComplexity), true
+
+	case "Schedule.id":
+		if e.complexity.Schedule.ID == nil {
+			break
+		}
+
+		return e.complexity.Schedule.ID(childComplexity), true
+
+	case "Schedule.lastError":
+		if e.complexity.Schedule.LastError == nil {
+			break
+		}
+
+		return e.complexity.Schedule.LastError(childComplexity), true
+
+	case "Schedule.lastResult":
+		if e.complexity.Schedule.LastResult == nil {
+			break
+		}
+
+		return e.complexity.Schedule.LastResult(childComplexity), true
+
+	case "Schedule.lastRun":
+		if e.complexity.Schedule.LastRun == nil {
+			break
+		}
+
+		return e.complexity.Schedule.LastRun(childComplexity), true
+
+	case "Schedule.missedRun":
+		if e.complexity.Schedule.MissedRun == nil {
+			break
+		}
+
+		return e.complexity.Schedule.MissedRun(childComplexity), true
+
+	case "Schedule.name":
+		if e.complexity.Schedule.Name == nil {
+			break
+		}
+
+		return e.complexity.Schedule.Name(childComplexity), true
+
+	case "Schedule.nextRun":
+		if e.complexity.Schedule.NextRun == nil {
+			break
+		}
+
+		return e.complexity.Schedule.NextRun(childComplexity), true
+
+	case "Settings.breweryName":
+		if e.complexity.Settings.BreweryName == nil {
+			break
+		}
+
+		return e.complexity.Settings.BreweryName(childComplexity), true
+
+	case "Settings.timezone":
+		if e.complexity.Settings.Timezone == nil {
+			break
+		}
+
+		return e.complexity.Settings.Timezone(childComplexity), true
+
+	case "Switch.autoOffSeconds":
+		if e.complexity.Switch.AutoOffSeconds == nil {
+			break
+		}
+
+		return e.complexity.Switch.AutoOffSeconds(childComplexity), true
+
+	case "Switch.duty":
+		if e.complexity.Switch.DimmerDuty == nil {
+			break
+		}
+
+		return e.complexity.Switch.DimmerDuty(childComplexity), true
+
+	case "Switch.frequency":
+		if e.complexity.Switch.Frequency == nil {
+			break
+		}
+
+		return e.complexity.Switch.Frequency(childComplexity), true
+
+	case "Switch.gpio":
+		if e.complexity.Switch.Gpio == nil {
+			break
+		}
+
+		return e.complexity.Switch.Gpio(childComplexity), true
+
+	case "Switch.id":
+		if e.complexity.Switch.ID == nil {
+			break
+		}
+
+		return e.complexity.Switch.ID(childComplexity), true
+
+	case "Switch.maxOnSeconds":
+		if e.complexity.Switch.MaxOnSeconds == nil {
+			break
+		}
+
+		return e.complexity.Switch.MaxOnSeconds(childComplexity), true
+
+	case "Switch.name":
+		if e.complexity.Switch.Name == nil {
+			break
+		}
+
+		return e.complexity.Switch.Name(childComplexity), true
+
+	case "Switch.networked":
+		if e.complexity.Switch.Networked == nil {
+			break
+		}
+
+		return e.complexity.Switch.Networked(childComplexity), true
+
+	case "Switch.outputError":
+		if e.complexity.Switch.OutputError == nil {
+			break
+		}
+
+		return e.complexity.Switch.OutputError(childComplexity), true
+
+	case "Switch.softwarePWM":
+		if e.complexity.Switch.SoftwarePWM == nil {
+			break
+		}
+
+		return e.complexity.Switch.SoftwarePWM(childComplexity), true
+
+	case "Switch.state":
+		if e.complexity.Switch.State == nil {
+			break
+		}
+
+		return e.complexity.Switch.State(childComplexity), true
+
+	case "Switch.timer":
+		if e.complexity.Switch.Timer == nil {
+			break
+		}
+
+		return e.complexity.Switch.Timer(childComplexity), true
+
+	case "SwitchGroup.id":
+		if e.complexity.SwitchGroup.ID == nil {
+			break
+		}
+
+		return e.complexity.SwitchGroup.ID(childComplexity), true
+
+	case "SwitchGroup.name":
+		if e.complexity.SwitchGroup.Name == nil {
+			break
+		}
+
+		return e.complexity.SwitchGroup.Name(childComplexity), true
+
+	case "SwitchGroup.switches":
+		if e.complexity.SwitchGroup.Switches == nil {
+			break
+		}
+
+		return e.complexity.SwitchGroup.Switches(childComplexity), true
+
+	case "SwitchTimer.cyclesLeft":
+		if e.complexity.SwitchTimer.CyclesLeft == nil {
+			break
+		}
+
+		return e.complexity.SwitchTimer.CyclesLeft(childComplexity), true
+
+	case "SwitchTimer.kind":
+		if e.complexity.SwitchTimer.Kind == nil {
+			break
+		}
+
+		return e.complexity.SwitchTimer.Kind(childComplexity), true
+
+	case "SwitchTimer.offSeconds":
+		if e.complexity.SwitchTimer.OffSeconds == nil {
+			break
+		}
+
+		return e.complexity.SwitchTimer.OffSeconds(childComplexity), true
+
+	case "SwitchTimer.on":
+		if e.complexity.SwitchTimer.On == nil {
+			break
+		}
+
+		return e.complexity.SwitchTimer.On(childComplexity), true
+
+	case "SwitchTimer.onSeconds":
+		if e.complexity.SwitchTimer.OnSeconds == nil {
+			break
+		}
+
+		return e.complexity.SwitchTimer.OnSeconds(childComplexity), true
+
+	case "SwitchTimer.remainingSeconds":
+		if e.complexity.SwitchTimer.RemainingSeconds == nil {
+			break
+		}
+
+		return e.complexity.SwitchTimer.RemainingSeconds(childComplexity), true
+
+	case "TempProbeDetails.calibration":
+		if e.complexity.TempProbeDetails.Calibration == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.Calibration(childComplexity), true
+
+	case "TempProbeDetails.contributing":
+		if e.complexity.TempProbeDetails.Contributing == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.Contributing(childComplexity), true
+
+	case "TempProbeDetails.fault":
+		if e.complexity.TempProbeDetails.Fault == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.Fault(childComplexity), true
+
+	case "TempProbeDetails.gravity":
+		if e.complexity.TempProbeDetails.Gravity == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.Gravity(childComplexity), true
+
+	case "TempProbeDetails.id":
+		if e.complexity.TempProbeDetails.ID == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.ID(childComplexity), true
+
+	case "TempProbeDetails.name":
+		if e.complexity.TempProbeDetails.Name == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.Name(childComplexity), true
+
+	case "TempProbeDetails.physAddr":
+		if e.complexity.TempProbeDetails.PhysAddr == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.PhysAddr(childComplexity), true
+
+	case "TempProbeDetails.priority":
+		if e.complexity.TempProbeDetails.Priority == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.Priority(childComplexity), true
+
+	case "TempProbeDetails.rawReading":
+		if e.complexity.TempProbeDetails.RawReading == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.RawReading(childComplexity), true
+
+	case "TempProbeDetails.reading":
+		if e.complexity.TempProbeDetails.Reading == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.Reading(childComplexity), true
+
+	case "TempProbeDetails.status":
+		if e.complexity.TempProbeDetails.Status == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.Status(childComplexity), true
+
+	case "TempProbeDetails.updated":
+		if e.complexity.TempProbeDetails.Updated == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.Updated(childComplexity), true
+
+	case "TempProbeDetails.weight":
+		if e.complexity.TempProbeDetails.Weight == nil {
+			break
+		}
+
+		return e.complexity.TempProbeDetails.Weight(childComplexity), true
+
+	case "TemperatureController.aggregation":
+		if e.complexity.TemperatureController.Aggregation == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.Aggregation(childComplexity), true
+
+	case "TemperatureController.calculatedDuty":
+		if e.complexity.TemperatureController.CalculatedDuty == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.CalculatedDuty(childComplexity), true
+
+	case "TemperatureController.contributingProbes":
+		if e.complexity.TemperatureController.ContributingProbes == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.ContributingProbes(childComplexity), true
+
+	case "TemperatureController.coolSettings":
+		if e.complexity.TemperatureController.CoolSettings == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.CoolSettings(childComplexity), true
+
+	case "TemperatureController.dutyCycle":
+		if e.complexity.TemperatureController.DutyCycle == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.DutyCycle(childComplexity), true
+
+	case "TemperatureController.fermentation":
+		if e.complexity.TemperatureController.Fermentation == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.Fermentation(childComplexity), true
+
+	case "TemperatureController.heatSettings":
+		if e.complexity.TemperatureController.HeatSettings == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.HeatSettings(childComplexity), true
+
+	case "TemperatureController.hysteriaSettings":
+		if e.complexity.TemperatureController.HysteriaSettings == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.HysteriaSettings(childComplexity), true
+
+	case "TemperatureController.id":
+		if e.complexity.TemperatureController.ID == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.ID(childComplexity), true
+
+	case "TemperatureController.interlocked":
+		if e.complexity.TemperatureController.Interlocked == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.Interlocked(childComplexity), true
+
+	case "TemperatureController.manualSettings":
+		if e.complexity.TemperatureController.ManualSettings == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.ManualSettings(childComplexity), true
+
+	case "TemperatureController.mode":
+		if e.complexity.TemperatureController.Mode == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.Mode(childComplexity), true
+
+	case "TemperatureController.name":
+		if e.complexity.TemperatureController.Name == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.Name(childComplexity), true
+
+	case "TemperatureController.previousCalculationTime":
+		if e.complexity.TemperatureController.PreviousCalculationTime == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.PreviousCalculationTime(childComplexity), true
+
+	case "TemperatureController.setPoint":
+		if e.complexity.TemperatureController.SetPoint == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.SetPoint(childComplexity), true
+
+	case "TemperatureController.staleAfter":
+		if e.complexity.TemperatureController.StaleAfter == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.StaleAfter(childComplexity), true
+
+	case "TemperatureController.tempProbeDetails":
+		if e.complexity.TemperatureController.TempProbeDetails == nil {
+			break
+		}
+
+		return e.complexity.TemperatureController.TempProbeDetails(childComplexity), true
+
+	case "TemperatureProbe.coldJunction":
+		if e.complexity.TemperatureProbe.ColdJunction == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.ColdJunction(childComplexity), true
+
+	case "TemperatureProbe.connected":
+		if e.complexity.TemperatureProbe.Connected == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.Connected(childComplexity), true
+
+	case "TemperatureProbe.enabled":
+		if e.complexity.TemperatureProbe.Enabled == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.Enabled(childComplexity), true
+
+	case "TemperatureProbe.gravity":
+		if e.complexity.TemperatureProbe.Gravity == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.Gravity(childComplexity), true
+
+	case "TemperatureProbe.highLimit":
+		if e.complexity.TemperatureProbe.HighLimit == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.HighLimit(childComplexity), true
+
+	case "TemperatureProbe.location":
+		if e.complexity.TemperatureProbe.Location == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.Location(childComplexity), true
+
+	case "TemperatureProbe.lowLimit":
+		if e.complexity.TemperatureProbe.LowLimit == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.LowLimit(childComplexity), true
+
+	case "TemperatureProbe.name":
+		if e.complexity.TemperatureProbe.Name == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.Name(childComplexity), true
+
+	case "TemperatureProbe.notes":
+		if e.complexity.TemperatureProbe.Notes == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.Notes(childComplexity), true
+
+	case "TemperatureProbe.physAddr":
+		if e.complexity.TemperatureProbe.PhysAddr == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.PhysAddr(childComplexity), true
+
+	case "TemperatureProbe.readErrors":
+		if e.complexity.TemperatureProbe.ReadErrors == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.ReadErrors(childComplexity), true
+
+	case "TemperatureProbe.readLatency":
+		if e.complexity.TemperatureProbe.ReadLatency == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.ReadLatency(childComplexity), true
+
+	case "TemperatureProbe.reading":
+		if e.complexity.TemperatureProbe.Reading == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.Reading(childComplexity), true
+
+	case "TemperatureProbe.reads":
+		if e.complexity.TemperatureProbe.Reads == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.Reads(childComplexity), true
+
+	case "TemperatureProbe.resolution":
+		if e.complexity.TemperatureProbe.Resolution == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.Resolution(childComplexity), true
+
+	case "TemperatureProbe.sensorType":
+		if e.complexity.TemperatureProbe.SensorType == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.SensorType(childComplexity), true
+
+	case "TemperatureProbe.updated":
+		if e.complexity.TemperatureProbe.Updated == nil {
+			break
+		}
+
+		return e.complexity.TemperatureProbe.Updated(childComplexity), true
+
+	case "Webhook.deliveries":
+		if e.complexity.Webhook.Deliveries == nil {
+			break
+		}
+
+		args, err := ec.field_Webhook_deliveries_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Webhook.Deliveries(childComplexity, args["limit"].(*int)), true
+
+	case "Webhook.enabled":
+		if e.complexity.Webhook.Enabled == nil {
+			break
+		}
+
+		return e.complexity.Webhook.Enabled(childComplexity), true
+
+	case "Webhook.events":
+		if e.complexity.Webhook.Events == nil {
+			break
+		}
+
+		return e.complexity.Webhook.Events(childComplexity), true
+
+	case "Webhook.id":
+		if e.complexity.Webhook.ID == nil {
+			break
+		}
+
+		return e.complexity.Webhook.ID(childComplexity), true
+
+	case "Webhook.name":
+		if e.complexity.Webhook.Name == nil {
+			break
+		}
+
+		return e.complexity.Webhook.Name(childComplexity), true
+
+	case "Webhook.secret":
+		if e.complexity.Webhook.Secret == nil {
+			break
+		}
+
+		return e.complexity.Webhook.Secret(childComplexity), true
+
+	case "Webhook.url":
+		if e.complexity.Webhook.URL == nil {
+			break
+		}
+
+		return e.complexity.Webhook.URL(childComplexity), true
+
+	case "WebhookDelivery.attempts":
+		if e.complexity.WebhookDelivery.Attempts == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Attempts(childComplexity), true
+
+	case "WebhookDelivery.createdAt":
+		if e.complexity.WebhookDelivery.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.CreatedAt(childComplexity), true
+
+	case "WebhookDelivery.error":
+		if e.complexity.WebhookDelivery.Error == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Error(childComplexity), true
+
+	case "WebhookDelivery.event":
+		if e.complexity.WebhookDelivery.Event == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Event(childComplexity), true
+
+	case "WebhookDelivery.id":
+		if e.complexity.WebhookDelivery.ID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.ID(childComplexity), true
+
+	case "WebhookDelivery.payload":
+		if e.complexity.WebhookDelivery.Payload == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Payload(childComplexity), true
+
+	case "WebhookDelivery.responseCode":
+		if e.complexity.WebhookDelivery.ResponseCode == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.ResponseCode(childComplexity), true
+
+	case "WebhookDelivery.status":
+		if e.complexity.WebhookDelivery.Status == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.Status(childComplexity), true
+
+	case "WebhookDelivery.updatedAt":
+		if e.complexity.WebhookDelivery.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.UpdatedAt(childComplexity), true
+
+	case "WebhookDelivery.webhookId":
+		if e.complexity.WebhookDelivery.WebhookID == nil {
+			break
+		}
+
+		return e.complexity.WebhookDelivery.WebhookID(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	ec := executionContext{rc, e}
+	first := true
+
+	switch rc.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			data := ec._Query(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(parsedSchema), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(parsedSchema, parsedSchema.Types[name]), nil
+}
+
+var sources = []*ast.Source{
+	{Name: "graph/schema.graphqls", Input: `"""The temperature controller mode"""
+enum ControllerMode {
+  """Use the manual settings"""
+  manual
+
+  """This controller is off"""
+  off
+
+  """Use the PID settings"""
+  auto
+
+  """Use the hysteria settings"""
+  hysteria
+}
+
+"""How the readings of the probes on a temperature controller are combined"""
+enum AggregationMode {
+  """The average of the usable probes"""
+  mean
+
+  """The middle reading of the usable probes"""
+  median
+
+  """The lowest reading of the usable probes"""
+  min
+
+  """The highest reading of the usable probes"""
+  max
+
+  """The average of the usable probes, weighted by each probes weight"""
+  weighted
+
+  """The usable probe with the lowest priority, falling back to the next when it is unusable"""
+  primary
+}
+
+"""Whether a probe can be used by its temperature controller"""
+enum ProbeStatus {
+  """The probe is being used"""
+  ok
+
+  """The probe has not been updated recently, or has no reading yet"""
+  stale
+
+  """The probe reported an error"""
+  faulted
+
+  """The probe has been disabled"""
+  disabled
+}
+
+"""How the readings of a probe are corrected"""
+enum ProbeEventType {
+  """A probe was found on the bus, for the first time or after being lost"""
+  discovered
+
+  """A probe is no longer found on the bus"""
+  lost
+}
+
+enum SPIDriver {
+  """MAX31865 PT100/PT1000 RTD converter"""
+  max31865
+
+  """MAX31855 K-type thermocouple converter, reports the cold junction temperature and open/short faults"""
+  max31855
+
+  """MAX6675 K-type thermocouple converter, reports open faults"""
+  max6675
+}
+
+enum FermentationStatus {
+  """No gravity has been read yet"""
+  waiting
+
+  """The gravity is still dropping, or there are not enough readings to tell"""
+  fermenting
+
+  """The gravity is stable but the attenuation is below the target"""
+  stalled
+
+  """The gravity is stable and the attenuation reached the target"""
+  complete
+}
+
+enum ExpanderChip {
+  """16 pins, A0-A7 and B0-B7, at 0x20-0x27"""
+  mcp23017
+
+  """8 pins, P0-P7, at 0x20-0x27 or 0x38-0x3f for a PCF8574A"""
+  pcf8574
+}
+
+enum InputPull {
+  """The pin floats, the input has its own pull resistor"""
+  none
+
+  """The pin is pulled High, e.g. a switch to ground"""
+  up
+
+  """The pin is pulled Low, e.g. a switch to 3.3V"""
+  down
+}
+
+enum InputAction {
+  """The input is only watched"""
+  none
+
+  """Toggle a switch each time the input becomes active, e.g. a panel button for a pump"""
+  toggleSwitch
+
+  """Hold off a controller's outputs while the input is inactive, e.g. a low level float switch for an element"""
+  interlock
+}
+
+enum CalibrationMode {
+  """Readings are used as they are"""
+  none
+
+  """A fixed offset is added to every reading"""
+  offset
+
+  """Readings are linearly corrected between a low and a high reference point"""
+  twoPoint
+}
+
+"""A reference point captured while calibrating a probe"""
+enum CalibrationPoint {
+  """The difference between the reading and the reference is used as an offset"""
+  offset
+
+  """The low point of a two point calibration, defaults to an ice bath at 0°C"""
+  low
+
+  """The high point of a two point calibration, defaults to boiling water at 100°C"""
+  high
+}
+
+enum SwitchMode {
+  on
+  off
+}
+
+"""A timed operation running on a switch"""
+enum SwitchTimerKind {
+  """The switch is on, then turned off"""
+  onFor
+
+  """The switch is turned on and off over and over"""
+  pulse
+}
+
+"""Something that happened to a device, webhooks are sent for the types they subscribe to"""
+enum DeviceEventType {
+  """A probe reading went outside its low or high limit"""
+  probeOutOfRange
+
+  """A temperature controller changed mode"""
+  controllerMode
+
+  """A fermentation reached the attenuation of a step and the step was applied"""
+  stepComplete
+
+  """A switch was turned on or off"""
+  switchToggled
+
+  """An interlock held a controller off, a probe faulted or an output could not be set"""
+  safetyFault
+}
+
+"""What an alert rule watches for"""
+enum AlertCondition {
+  """The temperature is above the threshold"""
+  above
+
+  """The temperature is below the threshold"""
+  below
+
+  """The probe has not been updated for the duration"""
+  stale
+}
+
+"""What an automation trigger or condition checks"""
+enum AutomationConditionKind {
+  """The temperature of the probe or controller is above the threshold"""
+  temperatureAbove
+
+  """The temperature of the probe or controller is below the threshold"""
+  temperatureBelow
+
+  """The switch is on"""
+  switchOn
+
+  """The switch is off"""
+  switchOff
+
+  """The controller, or any controller when there is none, is calling for heat"""
+  heating
+
+  """The controller, or any controller when there is none, is calling for cooling"""
+  cooling
+
+  """The time of day passes time, or is between time and until"""
+  timeOfDay
+
+  """A device event was published, only for triggers"""
+  event
+}
+
+"""What an automation does when it matches"""
+enum AutomationActionKind {
+  """Turn the switch on"""
+  switchOn
+
+  """Turn the switch off"""
+  switchOff
+
+  """Change the mode of the controller"""
+  controllerMode
+
+  """Change the set point of the controller"""
+  controllerSetPoint
+
+  """Start a fermentation on the controller with the steps"""
+  startFermentation
+}
+
+"""What a schedule does about a run that was missed, e.g. while Elsinore was not running"""
+enum MissedRunPolicy {
+  """Wait for the next run"""
+  skip
+
+  """Run once as soon as possible, however many runs were missed"""
+  runOnce
+}
+
+"""Whether an alert rule is firing"""
+enum AlertState {
+  """The condition is not met"""
+  ok
+
+  """The condition is met but has not held for the duration yet"""
+  pending
+
+  """The condition has held for the duration, the channels have been notified"""
+  firing
+}
+
+"""How an alert channel notifies"""
+enum AlertChannelKind {
+  """Email through an SMTP server, url is smtp://host:port (STARTTLS when offered) or smtps://host:port"""
+  email
+
+  """A push to an ntfy topic, url is the topic, e.g. https://ntfy.sh/my-brewery, token is an optional access token"""
+  ntfy
+
+  """A push to a Gotify server, url is the server, token is the application token"""
+  gotify
+
+  """A JSON POST, token is an optional secret to sign it with like webhooks"""
+  webhook
+}
+
+"""How far a webhook delivery has got"""
+enum WebhookDeliveryStatus {
+  """The delivery is being attempted or is waiting to be retried"""
+  pending
+
+  """The URL responded with a 2xx status"""
+  delivered
+
+  """Every attempt failed, or the URL rejected the delivery"""
+  failed
+}
+
+scalar Time
+
+"""The settings for hysteria mode"""
+type HysteriaSettings {
+  """Indicates if these settings have been configured yet."""
+  configured: Boolean
+
+  """The ID of an object"""
+  id: ID!
+
+  """When this temperature is hit, turn on the cooling output"""
+  maxTemp: String
+
+  """When this temperature is hit, turn on the heating output"""
+  minTemp: String
+
+  """The minimum amount of time to turn the outputs on for."""
+  minTime: Int
+}
+
+"""The manual settings for this controller"""
+type ManualSettings {
+  """Indicates if these settings have been configured yet."""
+  configured: Boolean
+
+  """The time for one duty cycle in seconds"""
+  cycleTime: Int
+
+  """The manual duty cycle percentage for this controller"""
+  dutyCycle: Int
+
+  """The ID of an object"""
+  id: ID!
+}
+
+type Mutation {
+  assignProbe(name: String!, address: String!): TemperatureController
+  removeProbeFromTemperatureController(address: String!): TemperatureController
+  updateTemperatureController(controllerSettings: TemperatureControllerSettingsInput!): TemperatureController
+  deleteTemperatureController(id: ID!): DeleteTemperatureControllerReturnType
+  
+  """Update the current system settings"""
+  updateSettings(settings: SettingsInput!): Settings
+
+  """
+  Create or update a switch
+  """
+  modifySwitch(switchSettings: SwitchSettingsInput!): Switch
+  """
+  Delete a switch
+  """
+  deleteSwitch(id: ID!): Switch
+  """
+  Enable or disable a switch
+  """
+  toggleSwitch(id: ID!, mode: SwitchMode!): Switch
+  """
+  Turn a switch on, then off after seconds, turning it on or off stops the timer
+  """
+  switchOnFor(id: ID!, seconds: Float!): Switch
+  """
+  Turn a switch on for onSeconds then off for offSeconds, cycles times or until it is turned on or off
+  """
+  pulseSwitch(id: ID!, onSeconds: Float!, offSeconds: Float!, cycles: Int): Switch
+
+  """
+  Capture the current raw reading of an assigned probe as a calibration point,
+  reference is the actual temperature of the probe (e.g. "0C" in an ice bath)
+  """
+  calibrateProbe(address: String!, point: CalibrationPoint!, reference: String): TempProbeDetails
+  """
+  Remove the calibration from an assigned probe
+  """
+  resetProbeCalibration(address: String!): TempProbeDetails
+
+  """
+  Update how an assigned probe is used by its temperature controller
+  """
+  updateTempProbeDetails(details: TempProbeDetailsInput!): TempProbeDetails
+
+  """
+  Name and describe a probe, it does not need to be connected or assigned to a controller
+  """
+  updateProbe(probeSettings: ProbeSettingsInput!): TemperatureProbe
+  """
+  Remove the name and description of a probe
+  """
+  forgetProbe(address: String!): TemperatureProbe
+  """
+  Search the 1-Wire bus for probes that have been plugged in or removed
+  """
+  rescanProbes: ProbeScan
+
+  """
+  Create or update a temperature chip on an SPI port, it is saved even if it cannot be connected
+  """
+  modifySPIProbe(spiProbe: SPIProbeInput!): SPIProbe
+  """
+  Disconnect and delete a temperature chip on an SPI port
+  """
+  deleteSPIProbe(id: ID!): SPIProbe
+
+  """
+  Create or update a GPIO expander on an I2C bus, it is saved even if it cannot be connected
+  Changing the chip, bus or address reconnects it, which is refused while any of its pins are in use
+  """
+  modifyExpander(expander: ExpanderInput!): Expander
+  """
+  Disconnect and delete a GPIO expander, none of its pins can be in use
+  """
+  deleteExpander(id: ID!): Expander
+
+  """
+  Create or update a webhook, a secret is generated when one is not given
+  """
+  modifyWebhook(webhook: WebhookInput!): Webhook
+  """
+  Delete a webhook and its delivery log
+  """
+  deleteWebhook(id: ID!): Webhook
+  """
+  Send a test event to a webhook now, whatever events it subscribes to
+  """
+  testWebhook(id: ID!): WebhookDelivery
+
+  """
+  Create or update an alert rule
+  """
+  modifyAlertRule(alertRule: AlertRuleInput!): AlertRule
+  """
+  Delete an alert rule
+  """
+  deleteAlertRule(id: ID!): AlertRule
+  """
+  Acknowledge a firing alert, it is not repeated until it has resolved and fired again
+  """
+  acknowledgeAlert(id: ID!): AlertRule
+  """
+  Stop an alert rule notifying for a number of minutes, 0 to notify again now
+  """
+  silenceAlert(id: ID!, minutes: Int!): AlertRule
+  """
+  Create or update an alert channel
+  """
+  modifyAlertChannel(alertChannel: AlertChannelInput!): AlertChannel
+  """
+  Delete an alert channel, it cannot be used by any alert rule
+  """
+  deleteAlertChannel(id: ID!): AlertChannel
+  """
+  Send a test notification through an alert channel now
+  """
+  testAlertChannel(id: ID!): AlertChannel
+
+  """
+  Create or update an automation rule, conditions and actions that are given replace the existing ones
+  """
+  modifyAutomationRule(automationRule: AutomationRuleInput!): AutomationRule
+  """
+  Delete an automation rule, a rule that reverts undoes its actions first when it is active, anything else it changed stays as it is
+  """
+  deleteAutomationRule(id: ID!): AutomationRule
+
+  """
+  Create or update a schedule, the actions that are given replace the existing ones
+  """
+  modifySchedule(schedule: ScheduleInput!): Schedule
+  """
+  Delete a schedule
+  """
+  deleteSchedule(id: ID!): Schedule
+  """
+  Run the actions of a schedule now, it still runs when it is next due
+  """
+  runSchedule(id: ID!): Schedule
+
+  """
+  Create or update a switch group, the switches that are given replace the existing ones
+  """
+  modifySwitchGroup(switchGroup: SwitchGroupInput!): SwitchGroup
+  """
+  Delete a switch group, it cannot be used by any scene
+  """
+  deleteSwitchGroup(id: ID!): SwitchGroup
+  """
+  Turn every switch in a group on or off
+  """
+  toggleSwitchGroup(id: ID!, mode: SwitchMode!): SwitchGroup
+  """
+  Create or update a scene, the steps and interlocks that are given replace the existing ones
+  """
+  modifyScene(scene: SceneInput!): Scene
+  """
+  Delete a scene, steps that are still waiting for their delay are not run
+  """
+  deleteScene(id: ID!): Scene
+  """
+  Check the interlocks of a scene and apply it, nothing is switched when an interlock does not match or a switch is missing
+  Steps without a delay are applied straight away, the rest follow after their delays
+  """
+  applyScene(id: ID!): Scene
+
+  """
+  Create or update a digital input and start watching it, it is saved even if the pin cannot be watched
+  """
+  modifyInPin(inPin: InPinInput!): InPin
+  """
+  Stop watching and delete a digital input
+  """
+  deleteInPin(id: ID!): InPin
+
+  """
+  Create or update a flow meter and start counting its pulses, it is saved even if the pin cannot be watched
+  """
+  modifyFlowMeter(flowMeter: FlowMeterInput!): FlowMeter
+  """
+  Stop counting and delete a flow meter
+  """
+  deleteFlowMeter(id: ID!): FlowMeter
+  """
+  Zero the resettable volume of a flow meter, the lifetime volume is kept
+  """
+  resetFlowMeter(id: ID!): FlowMeter
+  """
+  Turn on a switch and turn it off again once the litres have flowed through the meter, e.g. a strike water fill
+  """
+  dispense(flowMeterId: ID!, litres: Float!, switchId: ID!): FlowMeter
+  """
+  Stop a running dispense and turn off its switch
+  """
+  cancelDispense(flowMeterId: ID!): FlowMeter
+
+  """
+  Start tracking the gravity of a controller's vessel, replacing any fermentation it was tracking
+  """
+  startFermentation(fermentation: FermentationInput!): Fermentation
+  """
+  Add a gravity reading to a controller's fermentation, e.g. from a hydrometer sample
+  """
+  recordGravity(controllerId: ID!, gravity: Float!): Fermentation
+  """
+  Stop tracking a controller's fermentation
+  """
+  endFermentation(controllerId: ID!): Fermentation
+
+  """
+  Take a snapshot of the database now
+  """
+  createBackup: Backup
+  """
+  Admin: Restore the database from a snapshot, all controllers and switches are stopped while it is restored
+  """
+  restoreBackup(name: String!): Backup
+}
+
+"""The settings for heating or cooling on a temperature controller"""
+type PidSettings {
+  """Indicates if these settings have been configured yet"""
+  configured: Boolean
+
+  """The automatic cycle time in seconds"""
+  cycleTime: Int
+
+  """The minimum delay between turning an output on and off in seconds"""
+  delay: Int
+
+  """The derivative calculation value"""
+  derivative: Float
+
+  """The ID of an object"""
+  id: ID!
+
+  """The integral calculation value"""
+  integral: Float
+
+  """The proportional calculation value"""
+  proportional: Float
+
+  """The GPIO"""
+  gpio: String
+
+  """The PWM frequency in Hz, 0 cycles the output on and off over the cycle time"""
+  frequency: Int!
+}
+
+"""Used to configure a controller"""
+input TemperatureControllerSettingsInput {
+  """The controller Id"""
+  id: ID!
+
+  """The name of the controller."""
+  name: String
+
+  """The new mode for the controller"""
+  mode: ControllerMode
+
+  """The PID Settings for the cooling output"""
+  coolSettings: PidSettingsInput
+
+  """The PID settings for the heating output"""
+  heatSettings: PidSettingsInput
+
+  """The hysteria settings for controlling this temperature controller"""
+  hysteriaSettings: HysteriaSettingsInput
+
+  """The manual settings for this temperature controller"""
+  manualSettings: ManualSettingsInput
+
+  """The target for auto mode"""
+  setPoint: String
+
+  """How the readings of the probes are combined"""
+  aggregation: AggregationMode
+
+  """The number of seconds without an update before a probe is ignored, 0 uses the default of 30"""
+  staleAfter: Int
+}
+
+"""Used to configure how a probe is used by its temperature controller"""
+input TempProbeDetailsInput {
+  """The physical address of the probe"""
+  physAddr: String!
+
+  """The weight of this probe for the weighted aggregation"""
+  weight: Float
+
+  """The priority of this probe for the primary aggregation, lower is preferred"""
+  priority: Int
+}
+
+type Query {
+  probe(address: String): TemperatureProbe
+
+  """Get the list of probes"""
+  probeList(available: Boolean): [TemperatureProbe]
+
+  """Get a specific list of probes"""
+  fetchProbes(addresses: [String]): [TemperatureProbe]
+
+  """Get every probe that has been named, whether or not it is connected"""
+  registeredProbes: [TemperatureProbe]
+
+  """The temperature chips configured on SPI ports"""
+  spiProbes: [SPIProbe]
+
+  """The most recent probes found or lost on the bus, oldest first"""
+  probeEvents: [ProbeEvent]
+
+  """The most recent device events, oldest first"""
+  deviceEvents: [DeviceEvent]
+
+  """The webhooks that are configured"""
+  webhooks: [Webhook]
+
+  """The most recent webhook deliveries, newest first, for one webhook or all of them"""
+  webhookDeliveries(webhookId: ID, limit: Int): [WebhookDelivery]
+
+  """The alert rules that are configured, with their current state"""
+  alertRules: [AlertRule]
+
+  """The alert channels that are configured"""
+  alertChannels: [AlertChannel]
+
+  """The automation rules that are configured"""
+  automationRules: [AutomationRule]
+
+  """Check an automation rule now without running its actions, to see why it does or does not match"""
+  evaluateAutomationRule(id: ID!): AutomationTrace
+
+  """The schedules that are configured"""
+  schedules: [Schedule]
+
+  """The switch groups that are configured"""
+  switchGroups: [SwitchGroup]
+
+  """The scenes that are configured"""
+  scenes: [Scene]
+
+  """The GPIO expanders that are configured"""
+  expanders: [Expander]
+
+  """The digital inputs that are configured"""
+  inPins: [InPin]
+
+  """The flow meters that are configured"""
+  flowMeters: [FlowMeter]
+
+  """Fetch all the temperature controllers, or a subset by name"""
+  temperatureControllers(name: String): [TemperatureController]
+
+  """Fetch the current System Settings"""
+  settings: Settings
+
+  """Fetch switches that are configured"""
+  switches: [Switch]
+
+  """List the database snapshots, newest first"""
+  backups: [Backup]
+
+  """The Modbus TCP register map of the current devices, see the -modbus flag"""
+  modbusRegisters: [ModbusRegister]
+}
+
+type TemperatureController {
+  """
+  The PID calculated duty cycle, this can be overriden by the ManualDuty in manual mode
+  """
+  calculatedDuty: Int
+
+  """The cooling settings for this controller"""
+  coolSettings: PidSettings
+
+  """The percentage of time this controller is on"""
+  dutyCycle: Int
+
+  """The heating settings for this controller"""
+  heatSettings: PidSettings
+
+  """The hysteria mode settings for this controller"""
+  hysteriaSettings: HysteriaSettings
+
+  """The ID of an object"""
+  id: ID!
+
+  """The manual settings for this controller"""
+  manualSettings: ManualSettings
+
+  """The controller mode"""
+  mode: ControllerMode
+
+  """The assigned name of this controller"""
+  name: String
+
+  """The last time that the duty cycle was calculated"""
+  previousCalculationTime: Time
+
+  """The target temperature when in auto mode"""
+  setPoint: String
+
+  """The probes assigned to this controller"""
+  tempProbeDetails: [TempProbeDetails]
+
+  """How the readings of the probes are combined"""
+  aggregation: AggregationMode!
+
+  """The number of seconds without an update before a probe is ignored, 0 uses the default"""
+  staleAfter: Int
+
+  """The physical addresses of the probes used for the last temperature"""
+  contributingProbes: [String!]
+
+  """The fermentation in the vessel this controller is controlling"""
+  fermentation: Fermentation
+
+  """True when an interlock input is inactive, the outputs are held off until it is active again"""
+  interlocked: Boolean!
+}
+
+"""The gravity of a vessel over a fermentation"""
+type Fermentation {
+  id: ID!
+
+  """The original gravity, the first reading unless it was set"""
+  originalGravity: Float
+
+  """The most recent gravity"""
+  currentGravity: Float
+
+  """The percentage of the original gravity that has been fermented"""
+  apparentAttenuation: Float
+
+  """The estimated alcohol by volume, in percent"""
+  abv: Float
+
+  """The change in gravity per day over the slope window"""
+  slope: Float
+
+  status: FermentationStatus!
+
+  """The attenuation a stable gravity has to reach to be complete rather than stalled, in percent"""
+  targetAttenuation: Float!
+
+  """The hours of readings used for the slope"""
+  slopeWindow: Int!
+
+  """The drop in gravity per day that counts as stable"""
+  stableSlope: Float!
+
+  started: Time!
+
+  """Changes to the controller that are made when the attenuation reaches a threshold, lowest first"""
+  steps: [FermentationStep!]!
+
+  """The gravity readings, oldest first"""
+  readings: [GravityReading!]!
+}
+
+"""A change to a controller made once when the fermentation reaches an attenuation, e.g. a diacetyl rest"""
+type FermentationStep {
+  name: String
+
+  """The apparent attenuation that triggers this step, in percent"""
+  attenuation: Float!
+
+  """The new set point for the controller"""
+  setPoint: String
+
+  """The new mode for the controller"""
+  mode: ControllerMode
+
+  """When the step was triggered, null until then"""
+  triggered: Time
+}
+
+"""A specific gravity at a point in time"""
+type GravityReading {
+  gravity: Float!
+  time: Time!
+}
+
+"""A device that reads a temperature and is assigned to a temperature controller"""
+type TempProbeDetails {
+  """The ID of an object"""
+  id: ID!
+
+  """The physical address of this probe"""
+  physAddr: String
+
+  """The value of the reading, after calibration"""
+  reading: String
+
+  """The value of the reading as reported by the probe, before calibration"""
+  rawReading: String
+
+  """The specific gravity from a hydrometer probe"""
+  gravity: Float
+
+  """The friendly name of this probe"""
+  name: String
+
+  """The time that this reading was updated"""
+  updated: Time
+
+  """How the readings of this probe are calibrated"""
+  calibration: ProbeCalibration
+
+  """The weight of this probe for the weighted aggregation"""
+  weight: Float
+
+  """The priority of this probe for the primary aggregation, lower is preferred"""
+  priority: Int
+
+  """Whether this probe can be used by its controller"""
+  status: ProbeStatus
+
+  """The last error reported by this probe"""
+  fault: String
+
+  """True when this probe was used for the last temperature of its controller"""
+  contributing: Boolean
+}
+
+"""The calibration applied to a probe"""
+type ProbeCalibration {
+  """How the readings are corrected"""
+  mode: CalibrationMode!
+
+  """The offset added to readings in offset mode"""
+  offset: String
+
+  """The probe reading at the low calibration point"""
+  lowRawReading: String
+
+  """The actual temperature at the low calibration point"""
+  lowReference: String
+
+  """The probe reading at the high calibration point"""
+  highRawReading: String
+
+  """The actual temperature at the high calibration point"""
+  highReference: String
+}
+
+"""A device that reads a temperature"""
+type TemperatureProbe {
+  """The physical address of this probe"""
+  physAddr: String
+
+  """The value of the reading"""
+  reading: String
+
+  """The time that this reading was updated"""
+  updated: Time
+
+  """The name of this probe, defaults to the physical address"""
+  name: String
+
+  """Where this probe is, e.g. Fermenter 2 thermowell"""
+  location: String
+
+  """Free form notes about this probe"""
+  notes: String
+
+  """The type of sensor, e.g. DS18B20"""
+  sensorType: String
+
+  """Disabled probes cannot be assigned to a temperature controller"""
+  enabled: Boolean!
+
+  """True when the probe is currently connected"""
+  connected: Boolean!
+
+  """The resolution the probe is read at, in bits"""
+  resolution: Int
+
+  """How long the last read took in milliseconds, after the bus conversion"""
+  readLatency: Float
+
+  """The number of reads attempted since startup"""
+  reads: Int
+
+  """The number of reads that failed since startup"""
+  readErrors: Int
+
+  """The temperature of the cold junction for thermocouple amplifiers that report it"""
+  coldJunction: String
+
+  """The specific gravity from hydrometers such as an iSpindel or Tilt"""
+  gravity: Float
+
+  """A probeOutOfRange event is sent when the reading goes below this"""
+  lowLimit: String
+
+  """A probeOutOfRange event is sent when the reading goes above this"""
+  highLimit: String
+}
+
+"""The probes that changed during a bus scan"""
+type ProbeScan {
+  """Probes that were not connected before the scan"""
+  discovered: [TemperatureProbe!]!
+
+  """Probes that were connected before the scan and are now missing"""
+  lost: [TemperatureProbe!]!
+}
+
+"""A temperature chip on an SPI port"""
+type SPIProbe {
+  id: ID!
+
+  """The physical address of the probe for this chip, e.g. max31855-spi0.1"""
+  physAddr: String!
+
+  driver: SPIDriver!
+
+  """The SPI bus, 0 for /dev/spidev0.x"""
+  bus: Int!
+
+  """The chip select on the bus, 1 for /dev/spidev0.1"""
+  chipSelect: Int!
+
+  """MAX31865: 2, 3 or 4 wire RTD"""
+  wires: Int
+
+  """MAX31865: The reference resistor on the board in Ohms, 0 for 4.3 times the nominal resistance"""
+  referenceResistor: Float
+
+  """MAX31865: The resistance of the RTD at 0°C, 100 for a PT100 (the default) or 1000 for a PT1000"""
+  nominalResistance: Float
+
+  """MAX31865: Reject 50Hz mains noise instead of 60Hz"""
+  filter50Hz: Boolean
+
+  """Why the chip could not be connected"""
+  connectError: String
+
+  """The probe read from this chip"""
+  probe: TemperatureProbe
+}
+
+"""The settings for a temperature chip on an SPI port"""
+input SPIProbeInput {
+  """The ID of the chip, if no ID, create a new one"""
+  id: ID
+
+  """Required when creating a chip"""
+  driver: SPIDriver
+
+  """Required when creating a chip"""
+  bus: Int
+
+  """Required when creating a chip"""
+  chipSelect: Int
+
+  wires: Int
+  referenceResistor: Float
+  nominalResistance: Float
+  filter50Hz: Boolean
+}
+
+"""A probe that was found or lost on the bus"""
+type ProbeEvent {
+  type: ProbeEventType!
+  physAddr: String!
+  time: Time!
+}
+
+"""Something that happened to a probe, controller or switch"""
+type DeviceEvent {
+  type: DeviceEventType!
+  time: Time!
+  """The name of the probe, controller or switch"""
+  device: String!
+  """The ID of the controller or switch, or the physical address of the probe"""
+  deviceId: String!
+  message: String!
+}
+
+"""A URL that device events are POSTed to as signed JSON"""
+type Webhook {
+  id: ID!
+  name: String!
+  url: String!
+  """The events that are sent"""
+  events: [DeviceEventType!]!
+  """Used to sign the payloads, the X-Elsinore-Signature header is sha256= and the hex HMAC-SHA256 of the body"""
+  secret: String!
+  enabled: Boolean!
+  """The most recent deliveries, newest first"""
+  deliveries(limit: Int): [WebhookDelivery!]!
+}
+
+input WebhookInput {
+  """The ID of the webhook, if no ID, create a new webhook"""
+  id: ID
+  """Required when creating a webhook"""
+  name: String
+  """Required when creating a webhook, an http or https URL"""
+  url: String
+  """Required when creating a webhook, at least one event"""
+  events: [DeviceEventType!]
+  secret: String
+  """Defaults to true"""
+  enabled: Boolean
+}
+
+"""Notifies the channels when a temperature is out of range, or a probe stops updating, for long enough"""
+type AlertRule {
+  id: ID!
+  name: String!
+  condition: AlertCondition!
+  """The physical address of the probe that is watched"""
+  probe: String
+  """The controller whose temperature is watched"""
+  controller: TemperatureController
+  """The temperature for above and below alerts"""
+  threshold: String
+  """How far back past the threshold the temperature has to go before a firing alert resolves"""
+  hysteresis: String!
+  """The seconds the condition has to hold before the alert fires, for stale alerts the seconds without an update"""
+  duration: Int!
+  """The seconds between repeated notifications while the alert is firing and not acknowledged, 0 to notify once"""
+  repeatInterval: Int!
+  channels: [AlertChannel!]!
+  enabled: Boolean!
+  state: AlertState!
+  """When the alert entered its state"""
+  since: Time
+  """The last temperature, or when a stale probe was last updated"""
+  value: String
+  acknowledged: Boolean!
+  """Notifications are not sent until this time"""
+  silencedUntil: Time
+}
+
+input AlertRuleInput {
+  """The ID of the rule, if no ID, create a new rule"""
+  id: ID
+  """Required when creating a rule"""
+  name: String
+  """Required when creating a rule"""
+  condition: AlertCondition
+  """The physical address of a probe to watch, empty to watch a controller"""
+  probe: String
+  """A controller to watch, empty to watch a probe"""
+  controllerId: ID
+  """Required for above and below alerts, e.g. 21C"""
+  threshold: String
+  """e.g. 0.5C, defaults to none"""
+  hysteresis: String
+  duration: Int
+  repeatInterval: Int
+  channelIds: [ID!]
+  """Defaults to true"""
+  enabled: Boolean
+}
+
+"""Somewhere alerts are sent"""
+type AlertChannel {
+  id: ID!
+  name: String!
+  kind: AlertChannelKind!
+  url: String!
+  """The SMTP user name for email"""
+  username: String!
+  """The addresses email is sent to, comma separated"""
+  to: String!
+  """The address email is sent from"""
+  from: String!
+  enabled: Boolean!
+  """Why the last notification failed, empty when it was sent"""
+  lastError: String!
+}
+
+input AlertChannelInput {
+  """The ID of the channel, if no ID, create a new channel"""
+  id: ID
+  """Required when creating a channel"""
+  name: String
+  """Required when creating a channel"""
+  kind: AlertChannelKind
+  """Required when creating a channel"""
+  url: String
+  username: String
+  """The SMTP password, or the ntfy, Gotify or webhook token, it cannot be read back"""
+  token: String
+  to: String
+  from: String
+  """Defaults to true"""
+  enabled: Boolean
+}
+
+"""Runs its actions when its trigger and all of its conditions match"""
+type AutomationRule {
+  id: ID!
+  name: String!
+  enabled: Boolean!
+  """Undo the switch, mode and set point actions when the rule stops matching"""
+  revert: Boolean!
+  trigger: AutomationCondition!
+  """Checked as well as the trigger, every one has to match"""
+  conditions: [AutomationCondition!]!
+  """Run in order"""
+  actions: [AutomationAction!]!
+  """Whether the rule matched when it was last checked"""
+  active: Boolean!
+  """The most recent times the rule started or stopped matching, newest first"""
+  trace(limit: Int): [AutomationTrace!]!
+}
+
+"""A trigger or condition of an automation rule"""
+type AutomationCondition {
+  kind: AutomationConditionKind!
+  """The physical address of the probe for temperature conditions"""
+  probe: String
+  """The controller for temperature, heating and cooling conditions"""
+  controller: TemperatureController
+  switch: Switch
+  """The temperature for temperatureAbove and temperatureBelow"""
+  threshold: String
+  """How far back past the threshold the temperature has to go before it stops matching"""
+  hysteresis: String!
+  """The time of day, HH:MM"""
+  time: String
+  """The end of the time of day, HH:MM, without it the condition only matches as the time passes"""
+  until: String
+  event: DeviceEventType
+  """Only events from this device ID or name, any device when it is empty"""
+  deviceId: String
+}
+
+"""Something an automation rule does"""
+type AutomationAction {
+  kind: AutomationActionKind!
+  switch: Switch
+  controller: TemperatureController
+  mode: ControllerMode
+  setPoint: String
+  """The steps of the fermentation to start"""
+  steps: [FermentationStep!]!
+}
+
+"""Why an automation rule did or did not match"""
+type AutomationTrace {
+  time: Time!
+  matched: Boolean!
+  """The trigger followed by the conditions"""
+  checks: [AutomationCheck!]!
+  """What the actions did, empty when they were not run"""
+  actions: [String!]!
+  """The actions that failed"""
+  errors: [String!]!
+}
+
+"""The result of checking a trigger or condition"""
+type AutomationCheck {
+  """e.g. Fermenter 1 is 22°C, above 21°C"""
+  description: String!
+  matched: Boolean!
+}
+
+"""Actions that run at times in the timezone of the system settings, repeatedly with a cron expression or once at a time"""
+type Schedule {
+  id: ID!
+  name: String!
+  enabled: Boolean!
+  """minute hour day-of-month month day-of-week, e.g. 0 6 * * SAT, empty for a one-shot schedule"""
+  cron: String!
+  """When a one-shot schedule runs"""
+  at: Time
+  missedRun: MissedRunPolicy!
+  """Run in order"""
+  actions: [AutomationAction!]!
+  """When the schedule runs next, empty when it is disabled or a one-shot schedule has run"""
+  nextRun: Time
+  lastRun: Time
+  """What the actions did when the schedule last ran, or that a missed run was skipped"""
+  lastResult: String!
+  """The actions that failed when the schedule last ran"""
+  lastError: String!
+}
+
+input AutomationRuleInput {
+  """The ID of the rule, if no ID, create a new rule"""
+  id: ID
+  """Required when creating a rule"""
+  name: String
+  """Defaults to true"""
+  enabled: Boolean
+  revert: Boolean
+  """Required when creating a rule"""
+  trigger: AutomationConditionInput
+  conditions: [AutomationConditionInput!]
+  """Required when creating a rule, at least one"""
+  actions: [AutomationActionInput!]
+}
+
+input AutomationConditionInput {
+  kind: AutomationConditionKind!
+  """A probe physical address for temperature conditions, use it or controllerId"""
+  probe: String
+  """A controller for temperature conditions, or for heating and cooling, empty for any controller"""
+  controllerId: ID
+  """Required for switchOn and switchOff"""
+  switchId: ID
+  """Required for temperature conditions, e.g. 21C"""
+  threshold: String
+  """e.g. 0.5C, defaults to none"""
+  hysteresis: String
+  """Required for timeOfDay, HH:MM"""
+  time: String
+  """HH:MM, required for timeOfDay conditions that are not the trigger"""
+  until: String
+  """Required for event"""
+  event: DeviceEventType
+  deviceId: String
+}
+
+input AutomationActionInput {
+  kind: AutomationActionKind!
+  """Required for switchOn and switchOff"""
+  switchId: ID
+  """Required for the controller actions"""
+  controllerId: ID
+  """Required for controllerMode"""
+  mode: ControllerMode
+  """Required for controllerSetPoint, e.g. 18C"""
+  setPoint: String
+  """The steps for startFermentation"""
+  steps: [FermentationStepInput!]
+}
+
+"""A named set of switches that are turned on and off together"""
+type SwitchGroup {
+  id: ID!
+  name: String!
+  switches: [Switch!]!
+}
+
+"""Switch states that are applied together, e.g. Recirculate mash = pump 1 on, valve A open, valve B closed"""
+type Scene {
+  id: ID!
+  name: String!
+  """Applied in order"""
+  steps: [SceneStep!]!
+  """Checked before the scene is applied and before each step with a delay, every one has to match"""
+  interlocks: [AutomationCondition!]!
+  """True while steps are waiting for their delays"""
+  applying: Boolean!
+  lastApplied: Time
+  """What the steps did when the scene was last applied"""
+  lastResult: String!
+  """Why the scene was not applied, or stopped, the last time"""
+  lastError: String!
+}
+
+"""Turns a switch, or every switch in a group, on or off"""
+type SceneStep {
+  switch: Switch
+  group: SwitchGroup
+  state: SwitchMode!
+  """How long to wait after the previous step"""
+  delaySeconds: Float!
+}
+
+input SwitchGroupInput {
+  """The ID of the group, if no ID, create a new group"""
+  id: ID
+  """Required when creating a group"""
+  name: String
+  """Required when creating a group, at least one"""
+  switchIds: [ID!]
+}
+
+input SceneInput {
+  """The ID of the scene, if no ID, create a new scene"""
+  id: ID
+  """Required when creating a scene"""
+  name: String
+  """Required when creating a scene, at least one"""
+  steps: [SceneStepInput!]
+  """Conditions that have to match to apply the scene, e.g. switchOff for a heating element while its pump is off"""
+  interlocks: [AutomationConditionInput!]
+}
+
+input SceneStepInput {
+  """Use it or groupId"""
+  switchId: ID
+  """Use it or switchId"""
+  groupId: ID
+  state: SwitchMode!
+  """How long to wait after the previous step, defaults to 0"""
+  delaySeconds: Float
+}
+
+input ScheduleInput {
+  """The ID of the schedule, if no ID, create a new schedule"""
+  id: ID
+  """Required when creating a schedule"""
+  name: String
+  """Defaults to true"""
+  enabled: Boolean
+  """minute hour day-of-month month day-of-week, or @hourly, @daily, @weekly, @monthly or @yearly, use it or at"""
+  cron: String
+  """When a one-shot schedule runs, use it or cron"""
+  at: Time
+  """Defaults to skip"""
+  missedRun: MissedRunPolicy
+  """Required when creating a schedule, at least one"""
+  actions: [AutomationActionInput!]
+}
+
+"""An attempt to send an event to a webhook"""
+type WebhookDelivery {
+  id: ID!
+  webhookId: ID!
+  event: DeviceEventType!
+  """The JSON that was POSTed"""
+  payload: String!
+  status: WebhookDeliveryStatus!
+  """The number of times the payload was POSTed"""
+  attempts: Int!
+  """The HTTP status of the last attempt, empty when there was no response"""
+  responseCode: Int
+  """Why the last attempt failed"""
+  error: String
+  createdAt: Time!
+  updatedAt: Time!
+}
+
+"""The settings for a new fermentation"""
+input FermentationInput {
+  """The controller for the vessel"""
+  controllerId: ID!
+
+  """The original gravity, the first reading is used if this is not set"""
+  originalGravity: Float
+
+  """Defaults to 65%"""
+  targetAttenuation: Float
+
+  """Defaults to 48 hours"""
+  slopeWindow: Int
+
+  """Defaults to 0.001 per day"""
+  stableSlope: Float
+
+  steps: [FermentationStepInput!]
+}
+
+"""A change to make to the controller when the fermentation reaches an attenuation"""
+input FermentationStepInput {
+  name: String
+
+  """The apparent attenuation that triggers this step, in percent"""
+  attenuation: Float!
+
+  """The new set point for the controller, e.g. 20C"""
+  setPoint: String
+
+  """The new mode for the controller"""
+  mode: ControllerMode
+}
+
+"""The name and description of a probe"""
+input ProbeSettingsInput {
+  """The physical address of the probe"""
+  physAddr: String!
+
+  """The new name for the probe"""
+  name: String
+
+  """The new location of the probe"""
+  location: String
+
+  """The new notes for the probe"""
+  notes: String
+
+  """The new sensor type of the probe"""
+  sensorType: String
+
+  """Enable or disable the probe"""
+  enabled: Boolean
+
+  """The resolution to read the probe at, 9 to 12 bits, 0 for the default of 10"""
+  resolution: Int
+
+  """The lowest expected reading, e.g. 2C, empty to remove the limit"""
+  lowLimit: String
+
+  """The highest expected reading, e.g. 24C, empty to remove the limit"""
+  highLimit: String
+}
+
+
+"""The new settings for hysteria mode"""
+input HysteriaSettingsInput {
+  """Indicates if these settings have been configured yet"""
+  configured: Boolean
+
+  """When this temperature is hit, turn on the cooling output"""
+  maxTemp: String
+
+  """When this temperature is hit, turn on the heating output"""
+  minTemp: String
+
+  """The minimum amount of time to turn the outputs on for."""
+  minTime: Int
+}
+
+"""The new manual settings for this controller"""
+input ManualSettingsInput {
+  """Indicates if these settings have been configured yet"""
+  configured: Boolean
+
+  """The time for one duty cycle in seconds"""
+  cycleTime: Int
+
+  """The manual duty cycle percentage for this controller"""
+  dutyCycle: Int
+}
+
+"""The settings for heating or cooling on a temperature controller"""
+input PidSettingsInput {
+  """Indicates if these settings have been configured yet"""
+  configured: Boolean
+
+  """The automatic cycle time in seconds"""
+  cycleTime: Int
+
+  """The minimum delay between turning an output on and off in seconds"""
+  delay: Int
+
+  """The derivative calculation value"""
+  derivative: Float
+
+  """The integral calculation value"""
+  integral: Float
+
+  """The proportional calculation value"""
+  proportional: Float
+
+  """The friendly name of the GPIO Value"""
+  gpio: String
+
+  """The PWM frequency in Hz, the output runs at the duty cycle instead of cycling on and off, 0 turns PWM off"""
+  frequency: Int
+}
+
+"""The deleted controller"""
+type DeleteTemperatureControllerReturnType {
+  """The ID of the deleted Controller"""
+  id: ID!
+  """Temperatures Probes that were associated with this controller"""
+  temperatureProbes: [String]
+}
+
+"""The overall settings for this brewery"""
+type Settings {
+  """The current brewery name"""
+  breweryName: String!
+
+  """The IANA timezone schedules and automations use, such as America/Toronto (blank for the local time of the server)"""
+  timezone: String!
+}
+
+"""The new settings for this brewery"""
+input SettingsInput {
+  """The new brewery name (blank for no change)"""
+  breweryName: String
+
+  """The new IANA timezone, an empty string uses the local time of the server (blank for no change)"""
+  timezone: String
+}
+
+type Switch {
+  """The ID of the switch"""
+  id: ID!
+  """The GPIO for the pin, the URL of a network output, e.g. tasmota://192.168.1.50, or a serial relay, e.g. modbus:/dev/ttyUSB0:3:coil5"""
+  gpio: String!
+  """The name of the switch"""
+  name: String!
+  """The state of the switch, a network output that cannot be reached is off"""
+  state: SwitchMode!
+  """True when the switch is a smart plug or relay on the network"""
+  networked: Boolean!
+  """Why the output could not be set or read back, null when it is working, for network outputs and serial relays"""
+  outputError: String
+  """The PWM frequency in Hz, a switch with a frequency is a dimmer"""
+  frequency: Int!
+  """The PWM duty in percent that a dimmer runs at when it is on, 100 when it has not been set"""
+  duty: Int!
+  """True when the pin has no hardware PWM and is toggled in software"""
+  softwarePWM: Boolean!
+  """The longest the switch stays on before it is turned off, 0 for no limit"""
+  maxOnSeconds: Int!
+  """Seconds until the maximum on time turns the switch off, null when it is off or has no maximum"""
+  autoOffSeconds: Float
+  """The timer or pulse running on the switch, null when there is none"""
+  timer: SwitchTimer
+}
+
+"""A timer or pulse running on a switch"""
+type SwitchTimer {
+  kind: SwitchTimerKind!
+  """How long the switch is on, each time for a pulse"""
+  onSeconds: Float!
+  """How long a pulse is off between pulses, 0 for onFor"""
+  offSeconds: Float!
+  """Whether the pulse has the switch on now, always true for onFor"""
+  on: Boolean!
+  """The pulses left including this one, null for a pulse that runs until it is stopped"""
+  cyclesLeft: Int
+  """Seconds until the switch is next turned on or off"""
+  remainingSeconds: Float!
+}
+
+input SwitchSettingsInput {
+  """
+  The Id of the switch, if no ID, create a new switch
+  """
+  id: ID
+  """
+  The new Name for the switch (required during switch creation)
+  """
+  name: String
+  """
+  The new GPIO for the switch (required during switch creation)
+  Network outputs are URLs, tasmota://host/relay, shelly://host/relay, shellyplus://host/relay or http://host/path?power={state}
+  Serial relays are modbus:port[@baud]:slave:coilN or lcus:port[@baud]:relay
+  """
+  gpio: String
+  """
+  The new state for the switch
+  """
+  state: SwitchMode
+  """
+  The PWM frequency in Hz, 0 makes it a plain on/off switch
+  """
+  frequency: Int
+  """
+  The PWM duty in percent for a dimmer, 0 is off, defaults to 100
+  """
+  duty: Int
+  """
+  The longest the switch stays on before it is turned off, e.g. for a solenoid that must not stay open, 0 for no limit
+  """
+  maxOnSeconds: Int
+}
+
+"""A GPIO expander on an I2C bus, its pins are used like any other GPIO, e.g. mcp23017@0x20:A3"""
+type Expander {
+  id: ID!
+  chip: ExpanderChip!
+  """The I2C bus, e.g. 1 for /dev/i2c-1, empty for the first bus"""
+  bus: String!
+  address: Int!
+  """The name of the expander, e.g. mcp23017@0x20"""
+  name: String!
+  """The names of the pins"""
+  pins: [String!]!
+  """Why the chip could not be connected, empty when it is connected"""
+  connectError: String
+}
+
+input ExpanderInput {
+  """The ID of the expander, if no ID, create a new expander"""
+  id: ID
+  """Required when creating an expander"""
+  chip: ExpanderChip
+  bus: String
+  """Required when creating an expander, e.g. 32 for 0x20"""
+  address: Int
+}
+
+"""A digital input, e.g. a float switch, door sensor or panel button"""
+type InPin {
+  id: ID!
+  name: String!
+  """The GPIO for the pin"""
+  gpio: String!
+  pull: InputPull
+  """The input is active when the pin is Low rather than High"""
+  inverted: Boolean!
+  """The milliseconds the level has to settle before a change is accepted"""
+  debounce: Int!
+  action: InputAction
+  """The switch toggled by this input"""
+  switch: Switch
+  """The controller held off by this input"""
+  controller: TemperatureController
+  """Whether the input is active, after debouncing and inversion"""
+  active: Boolean!
+  """When the input last changed"""
+  changed: Time
+  """Why the pin could not be watched, empty when it is watched"""
+  connectError: String
+}
+
+input InPinInput {
+  """The ID of the input, if no ID, create a new input"""
+  id: ID
+  """Required when creating an input"""
+  name: String
+  """Required when creating an input"""
+  gpio: String
+  pull: InputPull
+  inverted: Boolean
+  debounce: Int
+  action: InputAction
+  """The switch to toggle, an empty ID clears it"""
+  switchId: ID
+  """The controller to hold off, an empty ID clears it"""
+  controllerId: ID
+}
+
+"""A pulse counting flow meter, e.g. a YF-S201 hall sensor, volumes are in litres"""
+type FlowMeter {
+  id: ID!
+  name: String!
+  """The GPIO for the pin"""
+  gpio: String!
+  """The calibration, 0 uses the default of 450"""
+  pulsesPerLitre: Float!
+  """The seconds without a pulse before a dispense is stopped, 0 uses the default of 30"""
+  noFlowTimeout: Int!
+  """The litres since the meter was last reset"""
+  volume: Float!
+  """The litres since the meter was created"""
+  lifetimeVolume: Float!
+  """The current flow in litres per minute"""
+  rate: Float!
+  """The running or last dispense"""
+  dispensing: FlowDispense
+  """Why the pin could not be watched, empty when it is watched"""
+  connectError: String
+}
+
+"""A fixed volume run through a flow meter"""
+type FlowDispense {
+  litres: Float!
+  """The litres that have flowed so far"""
+  dispensed: Float!
+  """The switch that is turned off when the litres have flowed"""
+  switch: Switch
+  started: Time!
+  finished: Time
+  """Why the dispense stopped early, e.g. no flow or cancelled"""
+  error: String
+}
+
+input FlowMeterInput {
+  """The ID of the flow meter, if no ID, create a new flow meter"""
+  id: ID
+  """Required when creating a flow meter"""
+  name: String
+  """Required when creating a flow meter"""
+  gpio: String
+  pulsesPerLitre: Float
+  noFlowTimeout: Int
+}
+
+"""A snapshot of the database"""
+type Backup {
+  """The file name of the snapshot"""
+  name: String!
+  """The size of the snapshot in bytes"""
+  size: Int!
+  """When the snapshot was taken"""
+  created: Time!
+}
+
+"""The Modbus data tables"""
+enum ModbusTable {
+  """Read/write bits, one per switch"""
+  coil
+
+  """Read only bits, one per digital input"""
+  discreteInput
+
+  """Read only words, controller and probe readings"""
+  inputRegister
+
+  """Read/write words, controller settings"""
+  holdingRegister
+}
+
+"""A coil, discrete input or register served over Modbus TCP"""
+type ModbusRegister {
+  """The table this is in"""
+  table: ModbusTable!
+
+  """The zero based address in the table"""
+  address: Int!
+
+  """The device and value this maps"""
+  name: String!
+
+  """The units and values of this register"""
+  description: String!
+
+  """True when Modbus clients can write this"""
+  writable: Boolean!
+
+  """The current value"""
+  value: Int!
+}
+`, BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_AutomationRule_trace_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_acknowledgeAlert_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_applyScene_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_assignProbe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["address"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["address"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_calibrateProbe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["address"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["address"] = arg0
+	var arg1 model.CalibrationPoint
+	if tmp, ok := rawArgs["point"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("point"))
+		arg1, err = ec.unmarshalNCalibrationPoint2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐCalibrationPoint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["point"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["reference"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reference"))
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reference"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_cancelDispense_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["flowMeterId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flowMeterId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["flowMeterId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteAlertChannel_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteAlertRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteAutomationRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteExpander_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteFlowMeter_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteInPin_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteSPIProbe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteScene_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteSchedule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteSwitchGroup_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteSwitch_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteTemperatureController_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deleteWebhook_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_dispense_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["flowMeterId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flowMeterId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["flowMeterId"] = arg0
+	var arg1 float64
+	if tmp, ok := rawArgs["litres"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("litres"))
+		arg1, err = ec.unmarshalNFloat2float64(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["litres"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["switchId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("switchId"))
+		arg2, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["switchId"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_endFermentation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["controllerId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("controllerId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["controllerId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_forgetProbe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["address"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["address"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifyAlertChannel_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.AlertChannelInput
+	if tmp, ok := rawArgs["alertChannel"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("alertChannel"))
+		arg0, err = ec.unmarshalNAlertChannelInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertChannelInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["alertChannel"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifyAlertRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.AlertRuleInput
+	if tmp, ok := rawArgs["alertRule"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("alertRule"))
+		arg0, err = ec.unmarshalNAlertRuleInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertRuleInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["alertRule"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifyAutomationRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.AutomationRuleInput
+	if tmp, ok := rawArgs["automationRule"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("automationRule"))
+		arg0, err = ec.unmarshalNAutomationRuleInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationRuleInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["automationRule"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifyExpander_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.ExpanderInput
+	if tmp, ok := rawArgs["expander"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("expander"))
+		arg0, err = ec.unmarshalNExpanderInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐExpanderInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["expander"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifyFlowMeter_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.FlowMeterInput
+	if tmp, ok := rawArgs["flowMeter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("flowMeter"))
+		arg0, err = ec.unmarshalNFlowMeterInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFlowMeterInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["flowMeter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifyInPin_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.InPinInput
+	if tmp, ok := rawArgs["inPin"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("inPin"))
+		arg0, err = ec.unmarshalNInPinInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInPinInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["inPin"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifySPIProbe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.SPIProbeInput
+	if tmp, ok := rawArgs["spiProbe"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("spiProbe"))
+		arg0, err = ec.unmarshalNSPIProbeInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSPIProbeInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["spiProbe"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifyScene_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.SceneInput
+	if tmp, ok := rawArgs["scene"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scene"))
+		arg0, err = ec.unmarshalNSceneInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSceneInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["scene"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifySchedule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.ScheduleInput
+	if tmp, ok := rawArgs["schedule"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("schedule"))
+		arg0, err = ec.unmarshalNScheduleInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐScheduleInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["schedule"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifySwitchGroup_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.SwitchGroupInput
+	if tmp, ok := rawArgs["switchGroup"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("switchGroup"))
+		arg0, err = ec.unmarshalNSwitchGroupInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchGroupInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["switchGroup"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifySwitch_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.SwitchSettingsInput
+	if tmp, ok := rawArgs["switchSettings"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("switchSettings"))
+		arg0, err = ec.unmarshalNSwitchSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchSettingsInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["switchSettings"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_modifyWebhook_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.WebhookInput
+	if tmp, ok := rawArgs["webhook"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("webhook"))
+		arg0, err = ec.unmarshalNWebhookInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐWebhookInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["webhook"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_pulseSwitch_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 float64
+	if tmp, ok := rawArgs["onSeconds"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("onSeconds"))
+		arg1, err = ec.unmarshalNFloat2float64(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["onSeconds"] = arg1
+	var arg2 float64
+	if tmp, ok := rawArgs["offSeconds"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offSeconds"))
+		arg2, err = ec.unmarshalNFloat2float64(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["offSeconds"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["cycles"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cycles"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["cycles"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_recordGravity_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["controllerId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("controllerId"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["controllerId"] = arg0
+	var arg1 float64
+	if tmp, ok := rawArgs["gravity"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gravity"))
+		arg1, err = ec.unmarshalNFloat2float64(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["gravity"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_removeProbeFromTemperatureController_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["address"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["address"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_resetFlowMeter_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_resetProbeCalibration_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["address"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["address"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_restoreBackup_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_runSchedule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_silenceAlert_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 int
+	if tmp, ok := rawArgs["minutes"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minutes"))
+		arg1, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["minutes"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_startFermentation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.FermentationInput
+	if tmp, ok := rawArgs["fermentation"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fermentation"))
+		arg0, err = ec.unmarshalNFermentationInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFermentationInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["fermentation"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_switchOnFor_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 float64
+	if tmp, ok := rawArgs["seconds"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("seconds"))
+		arg1, err = ec.unmarshalNFloat2float64(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["seconds"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_testAlertChannel_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_testWebhook_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_toggleSwitchGroup_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 model.SwitchMode
+	if tmp, ok := rawArgs["mode"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mode"))
+		arg1, err = ec.unmarshalNSwitchMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["mode"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_toggleSwitch_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 model.SwitchMode
+	if tmp, ok := rawArgs["mode"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mode"))
+		arg1, err = ec.unmarshalNSwitchMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["mode"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateProbe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.ProbeSettingsInput
+	if tmp, ok := rawArgs["probeSettings"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("probeSettings"))
+		arg0, err = ec.unmarshalNProbeSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeSettingsInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["probeSettings"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateSettings_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.SettingsInput
+	if tmp, ok := rawArgs["settings"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("settings"))
+		arg0, err = ec.unmarshalNSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSettingsInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["settings"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTempProbeDetails_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.TempProbeDetailsInput
+	if tmp, ok := rawArgs["details"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("details"))
+		arg0, err = ec.unmarshalNTempProbeDetailsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTempProbeDetailsInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["details"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateTemperatureController_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.TemperatureControllerSettingsInput
+	if tmp, ok := rawArgs["controllerSettings"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("controllerSettings"))
+		arg0, err = ec.unmarshalNTemperatureControllerSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureControllerSettingsInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["controllerSettings"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_evaluateAutomationRule_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_fetchProbes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []*string
+	if tmp, ok := rawArgs["addresses"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("addresses"))
+		arg0, err = ec.unmarshalOString2ᚕᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["addresses"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_probeList_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *bool
+	if tmp, ok := rawArgs["available"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("available"))
+		arg0, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["available"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_probe_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["address"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["address"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_temperatureControllers_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_webhookDeliveries_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *string
+	if tmp, ok := rawArgs["webhookId"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("webhookId"))
+		arg0, err = ec.unmarshalOID2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["webhookId"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Webhook_deliveries_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _AlertChannel_id(ctx context.Context, field graphql.CollectedField, obj *devices.AlertChannel) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertChannel",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.AlertChannel().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertChannel_name(ctx context.Context, field graphql.CollectedField, obj *devices.AlertChannel) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertChannel",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertChannel_kind(ctx context.Context, field graphql.CollectedField, obj *devices.AlertChannel) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertChannel",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.AlertChannelKind)
+	fc.Result = res
+	return ec.marshalNAlertChannelKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertChannelKind(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertChannel_url(ctx context.Context, field graphql.CollectedField, obj *devices.AlertChannel) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertChannel",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertChannel_username(ctx context.Context, field graphql.CollectedField, obj *devices.AlertChannel) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertChannel",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Username, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertChannel_to(ctx context.Context, field graphql.CollectedField, obj *devices.AlertChannel) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertChannel",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.To, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertChannel_from(ctx context.Context, field graphql.CollectedField, obj *devices.AlertChannel) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertChannel",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.From, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertChannel_enabled(ctx context.Context, field graphql.CollectedField, obj *devices.AlertChannel) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertChannel",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertChannel_lastError(ctx context.Context, field graphql.CollectedField, obj *devices.AlertChannel) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertChannel",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastError, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_id(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.AlertRule().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_name(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_condition(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Condition, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.AlertCondition)
+	fc.Result = res
+	return ec.marshalNAlertCondition2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertCondition(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_probe(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Probe, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_controller(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Controller(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.TemperatureController)
+	fc.Result = res
+	return ec.marshalOTemperatureController2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_threshold(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Threshold(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_hysteresis(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hysteresis(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_duration(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Duration, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_repeatInterval(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RepeatInterval, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_channels(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Channels(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.AlertChannel)
+	fc.Result = res
+	return ec.marshalNAlertChannel2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertChannelᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_enabled(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_state(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.State, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.AlertState)
+	fc.Result = res
+	return ec.marshalNAlertState2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertState(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_since(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Since, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_value(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_acknowledged(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Acknowledged, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AlertRule_silencedUntil(ctx context.Context, field graphql.CollectedField, obj *devices.AlertRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AlertRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SilencedUntil, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationAction_kind(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationAction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationAction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.AutomationActionKind)
+	fc.Result = res
+	return ec.marshalNAutomationActionKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationActionKind(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationAction_switch(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationAction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationAction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Switch(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Switch)
+	fc.Result = res
+	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationAction_controller(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationAction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationAction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Controller(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.TemperatureController)
+	fc.Result = res
+	return ec.marshalOTemperatureController2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationAction_mode(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationAction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationAction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Mode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.ControllerMode)
+	fc.Result = res
+	return ec.marshalOControllerMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationAction_setPoint(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationAction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationAction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SetPoint, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationAction_steps(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationAction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationAction",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Steps(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.FermentationStep)
+	fc.Result = res
+	return ec.marshalNFermentationStep2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFermentationStepᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCheck_description(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCheck) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCheck",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCheck_matched(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCheck) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCheck",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Matched, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCondition_kind(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCondition) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCondition",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.AutomationConditionKind)
+	fc.Result = res
+	return ec.marshalNAutomationConditionKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationConditionKind(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCondition_probe(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCondition) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCondition",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Probe, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCondition_controller(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCondition) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCondition",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Controller(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.TemperatureController)
+	fc.Result = res
+	return ec.marshalOTemperatureController2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCondition_switch(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCondition) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCondition",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Switch(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Switch)
+	fc.Result = res
+	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCondition_threshold(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCondition) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCondition",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Threshold(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCondition_hysteresis(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCondition) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCondition",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hysteresis(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCondition_time(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCondition) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCondition",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCondition_until(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCondition) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCondition",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Until, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCondition_event(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCondition) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCondition",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Event, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.DeviceEventType)
+	fc.Result = res
+	return ec.marshalODeviceEventType2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationCondition_deviceId(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationCondition) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationCondition",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeviceID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationRule_id(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.AutomationRule().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationRule_name(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationRule_enabled(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationRule_revert(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Revert, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationRule_trigger(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Trigger(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*devices.AutomationCondition)
+	fc.Result = res
+	return ec.marshalNAutomationCondition2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationCondition(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationRule_conditions(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Conditions(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.AutomationCondition)
+	fc.Result = res
+	return ec.marshalNAutomationCondition2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationConditionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationRule_actions(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Actions, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.AutomationAction)
+	fc.Result = res
+	return ec.marshalNAutomationAction2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationActionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationRule_active(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Active, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationRule_trace(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationRule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationRule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_AutomationRule_trace_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Trace(args["limit"].(*int)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.AutomationTrace)
+	fc.Result = res
+	return ec.marshalNAutomationTrace2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationTraceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationTrace_time(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationTrace) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationTrace",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationTrace_matched(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationTrace) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationTrace",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Matched, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationTrace_checks(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationTrace) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationTrace",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Checks, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.AutomationCheck)
+	fc.Result = res
+	return ec.marshalNAutomationCheck2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationCheckᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationTrace_actions(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationTrace) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationTrace",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Actions, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _AutomationTrace_errors(ctx context.Context, field graphql.CollectedField, obj *devices.AutomationTrace) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "AutomationTrace",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Backup_name(ctx context.Context, field graphql.CollectedField, obj *model.Backup) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Backup",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Backup_size(ctx context.Context, field graphql.CollectedField, obj *model.Backup) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Backup",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Size, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Backup_created(ctx context.Context, field graphql.CollectedField, obj *model.Backup) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Backup",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Created, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DeleteTemperatureControllerReturnType_id(ctx context.Context, field graphql.CollectedField, obj *model.DeleteTemperatureControllerReturnType) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DeleteTemperatureControllerReturnType",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DeleteTemperatureControllerReturnType_temperatureProbes(ctx context.Context, field graphql.CollectedField, obj *model.DeleteTemperatureControllerReturnType) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DeleteTemperatureControllerReturnType",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TemperatureProbes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*string)
+	fc.Result = res
+	return ec.marshalOString2ᚕᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DeviceEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.DeviceEvent) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DeviceEvent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.DeviceEventType)
+	fc.Result = res
+	return ec.marshalNDeviceEventType2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DeviceEvent_time(ctx context.Context, field graphql.CollectedField, obj *model.DeviceEvent) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DeviceEvent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DeviceEvent_device(ctx context.Context, field graphql.CollectedField, obj *model.DeviceEvent) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DeviceEvent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Device, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DeviceEvent_deviceId(ctx context.Context, field graphql.CollectedField, obj *model.DeviceEvent) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DeviceEvent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeviceID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DeviceEvent_message(ctx context.Context, field graphql.CollectedField, obj *model.DeviceEvent) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "DeviceEvent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Expander_id(ctx context.Context, field graphql.CollectedField, obj *devices.Expander) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Expander",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Expander().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Expander_chip(ctx context.Context, field graphql.CollectedField, obj *devices.Expander) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Expander",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Chip, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ExpanderChip)
+	fc.Result = res
+	return ec.marshalNExpanderChip2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐExpanderChip(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Expander_bus(ctx context.Context, field graphql.CollectedField, obj *devices.Expander) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Expander",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bus, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Expander_address(ctx context.Context, field graphql.CollectedField, obj *devices.Expander) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Expander",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Address, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Expander_name(ctx context.Context, field graphql.CollectedField, obj *devices.Expander) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Expander",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Expander_pins(ctx context.Context, field graphql.CollectedField, obj *devices.Expander) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Expander",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pins(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Expander_connectError(ctx context.Context, field graphql.CollectedField, obj *devices.Expander) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Expander",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConnectError, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_id(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Fermentation().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_originalGravity(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OriginalGravity, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_currentGravity(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CurrentGravity(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_apparentAttenuation(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ApparentAttenuation(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_abv(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Abv(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_slope(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Slope(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_status(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.FermentationStatus)
+	fc.Result = res
+	return ec.marshalNFermentationStatus2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFermentationStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_targetAttenuation(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TargetAttenuation, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_slopeWindow(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SlopeWindow, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_stableSlope(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StableSlope, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_started(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Started, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_steps(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Steps, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.FermentationStep)
+	fc.Result = res
+	return ec.marshalNFermentationStep2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFermentationStepᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Fermentation_readings(ctx context.Context, field graphql.CollectedField, obj *devices.Fermentation) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Fermentation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Readings, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.GravityReading)
+	fc.Result = res
+	return ec.marshalNGravityReading2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐGravityReadingᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FermentationStep_name(ctx context.Context, field graphql.CollectedField, obj *devices.FermentationStep) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FermentationStep",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FermentationStep_attenuation(ctx context.Context, field graphql.CollectedField, obj *devices.FermentationStep) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FermentationStep",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Attenuation, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FermentationStep_setPoint(ctx context.Context, field graphql.CollectedField, obj *devices.FermentationStep) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FermentationStep",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SetPoint, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FermentationStep_mode(ctx context.Context, field graphql.CollectedField, obj *devices.FermentationStep) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FermentationStep",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Mode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.ControllerMode)
+	fc.Result = res
+	return ec.marshalOControllerMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FermentationStep_triggered(ctx context.Context, field graphql.CollectedField, obj *devices.FermentationStep) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FermentationStep",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Triggered, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowDispense_litres(ctx context.Context, field graphql.CollectedField, obj *devices.FlowDispense) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowDispense",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Litres, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowDispense_dispensed(ctx context.Context, field graphql.CollectedField, obj *devices.FlowDispense) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowDispense",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Dispensed(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowDispense_switch(ctx context.Context, field graphql.CollectedField, obj *devices.FlowDispense) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowDispense",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Switch, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Switch)
+	fc.Result = res
+	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowDispense_started(ctx context.Context, field graphql.CollectedField, obj *devices.FlowDispense) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowDispense",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Started, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowDispense_finished(ctx context.Context, field graphql.CollectedField, obj *devices.FlowDispense) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowDispense",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Finished, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowDispense_error(ctx context.Context, field graphql.CollectedField, obj *devices.FlowDispense) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowDispense",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Error, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowMeter_id(ctx context.Context, field graphql.CollectedField, obj *devices.FlowMeter) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowMeter",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.FlowMeter().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowMeter_name(ctx context.Context, field graphql.CollectedField, obj *devices.FlowMeter) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowMeter",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowMeter_gpio(ctx context.Context, field graphql.CollectedField, obj *devices.FlowMeter) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowMeter",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Gpio(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowMeter_pulsesPerLitre(ctx context.Context, field graphql.CollectedField, obj *devices.FlowMeter) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowMeter",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PulsesPerLitre, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowMeter_noFlowTimeout(ctx context.Context, field graphql.CollectedField, obj *devices.FlowMeter) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowMeter",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NoFlowTimeout, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowMeter_volume(ctx context.Context, field graphql.CollectedField, obj *devices.FlowMeter) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowMeter",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Volume(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowMeter_lifetimeVolume(ctx context.Context, field graphql.CollectedField, obj *devices.FlowMeter) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowMeter",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LifetimeVolume(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowMeter_rate(ctx context.Context, field graphql.CollectedField, obj *devices.FlowMeter) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowMeter",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Rate(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowMeter_dispensing(ctx context.Context, field graphql.CollectedField, obj *devices.FlowMeter) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowMeter",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Dispensing(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.FlowDispense)
+	fc.Result = res
+	return ec.marshalOFlowDispense2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFlowDispense(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FlowMeter_connectError(ctx context.Context, field graphql.CollectedField, obj *devices.FlowMeter) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "FlowMeter",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConnectError, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GravityReading_gravity(ctx context.Context, field graphql.CollectedField, obj *devices.GravityReading) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "GravityReading",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Gravity, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GravityReading_time(ctx context.Context, field graphql.CollectedField, obj *devices.GravityReading) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "GravityReading",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _HysteriaSettings_configured(ctx context.Context, field graphql.CollectedField, obj *devices.HysteriaSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "HysteriaSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Configured, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _HysteriaSettings_id(ctx context.Context, field graphql.CollectedField, obj *devices.HysteriaSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "HysteriaSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.HysteriaSettings().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _HysteriaSettings_maxTemp(ctx context.Context, field graphql.CollectedField, obj *devices.HysteriaSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "HysteriaSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxTemp(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _HysteriaSettings_minTemp(ctx context.Context, field graphql.CollectedField, obj *devices.HysteriaSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "HysteriaSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MinTemp(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _HysteriaSettings_minTime(ctx context.Context, field graphql.CollectedField, obj *devices.HysteriaSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "HysteriaSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MinTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalOInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_id(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.InPin().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_name(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_gpio(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Gpio(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_pull(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Pull, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(model.InputPull)
+	fc.Result = res
+	return ec.marshalOInputPull2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputPull(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_inverted(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Inverted, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_debounce(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Debounce, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_action(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Action, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(model.InputAction)
+	fc.Result = res
+	return ec.marshalOInputAction2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputAction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_switch(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Switch(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Switch)
+	fc.Result = res
+	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_controller(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Controller(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.TemperatureController)
+	fc.Result = res
+	return ec.marshalOTemperatureController2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_active(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Active(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_changed(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Changed(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _InPin_connectError(ctx context.Context, field graphql.CollectedField, obj *devices.InPin) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "InPin",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConnectError, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ManualSettings_configured(ctx context.Context, field graphql.CollectedField, obj *devices.ManualSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ManualSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Configured, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ManualSettings_cycleTime(ctx context.Context, field graphql.CollectedField, obj *devices.ManualSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ManualSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CycleTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalOInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ManualSettings_dutyCycle(ctx context.Context, field graphql.CollectedField, obj *devices.ManualSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ManualSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DutyCycle, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalOInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ManualSettings_id(ctx context.Context, field graphql.CollectedField, obj *devices.ManualSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ManualSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ManualSettings().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModbusRegister_table(ctx context.Context, field graphql.CollectedField, obj *model.ModbusRegister) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ModbusRegister",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Table, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ModbusTable)
+	fc.Result = res
+	return ec.marshalNModbusTable2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐModbusTable(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModbusRegister_address(ctx context.Context, field graphql.CollectedField, obj *model.ModbusRegister) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ModbusRegister",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Address, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModbusRegister_name(ctx context.Context, field graphql.CollectedField, obj *model.ModbusRegister) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ModbusRegister",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModbusRegister_description(ctx context.Context, field graphql.CollectedField, obj *model.ModbusRegister) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ModbusRegister",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModbusRegister_writable(ctx context.Context, field graphql.CollectedField, obj *model.ModbusRegister) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ModbusRegister",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Writable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ModbusRegister_value(ctx context.Context, field graphql.CollectedField, obj *model.ModbusRegister) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ModbusRegister",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_assignProbe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_assignProbe_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AssignProbe(rctx, args["name"].(string), args["address"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.TemperatureController)
+	fc.Result = res
+	return ec.marshalOTemperatureController2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_removeProbeFromTemperatureController(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_removeProbeFromTemperatureController_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RemoveProbeFromTemperatureController(rctx, args["address"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.TemperatureController)
+	fc.Result = res
+	return ec.marshalOTemperatureController2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_updateTemperatureController(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateTemperatureController_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateTemperatureController(rctx, args["controllerSettings"].(model.TemperatureControllerSettingsInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.TemperatureController)
+	fc.Result = res
+	return ec.marshalOTemperatureController2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteTemperatureController(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteTemperatureController_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteTemperatureController(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.DeleteTemperatureControllerReturnType)
+	fc.Result = res
+	return ec.marshalODeleteTemperatureControllerReturnType2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeleteTemperatureControllerReturnType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_updateSettings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateSettings_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateSettings(rctx, args["settings"].(model.SettingsInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*system.Settings)
+	fc.Result = res
+	return ec.marshalOSettings2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋsystemᚐSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifySwitch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifySwitch_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifySwitch(rctx, args["switchSettings"].(model.SwitchSettingsInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Switch)
+	fc.Result = res
+	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteSwitch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteSwitch_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteSwitch(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Switch)
+	fc.Result = res
+	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_toggleSwitch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_toggleSwitch_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ToggleSwitch(rctx, args["id"].(string), args["mode"].(model.SwitchMode))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Switch)
+	fc.Result = res
+	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_switchOnFor(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_switchOnFor_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SwitchOnFor(rctx, args["id"].(string), args["seconds"].(float64))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Switch)
+	fc.Result = res
+	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_pulseSwitch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_pulseSwitch_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().PulseSwitch(rctx, args["id"].(string), args["onSeconds"].(float64), args["offSeconds"].(float64), args["cycles"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Switch)
+	fc.Result = res
+	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_calibrateProbe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_calibrateProbe_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CalibrateProbe(rctx, args["address"].(string), args["point"].(model.CalibrationPoint), args["reference"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TempProbeDetails)
+	fc.Result = res
+	return ec.marshalOTempProbeDetails2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTempProbeDetails(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_resetProbeCalibration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_resetProbeCalibration_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ResetProbeCalibration(rctx, args["address"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TempProbeDetails)
+	fc.Result = res
+	return ec.marshalOTempProbeDetails2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTempProbeDetails(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_updateTempProbeDetails(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateTempProbeDetails_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateTempProbeDetails(rctx, args["details"].(model.TempProbeDetailsInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TempProbeDetails)
+	fc.Result = res
+	return ec.marshalOTempProbeDetails2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTempProbeDetails(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_updateProbe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_updateProbe_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().UpdateProbe(rctx, args["probeSettings"].(model.ProbeSettingsInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TemperatureProbe)
+	fc.Result = res
+	return ec.marshalOTemperatureProbe2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_forgetProbe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_forgetProbe_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ForgetProbe(rctx, args["address"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TemperatureProbe)
+	fc.Result = res
+	return ec.marshalOTemperatureProbe2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_rescanProbes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RescanProbes(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.ProbeScan)
+	fc.Result = res
+	return ec.marshalOProbeScan2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeScan(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifySPIProbe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifySPIProbe_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifySPIProbe(rctx, args["spiProbe"].(model.SPIProbeInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.SPIProbe)
+	fc.Result = res
+	return ec.marshalOSPIProbe2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSPIProbe(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteSPIProbe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteSPIProbe_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteSPIProbe(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.SPIProbe)
+	fc.Result = res
+	return ec.marshalOSPIProbe2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSPIProbe(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifyExpander(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifyExpander_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifyExpander(rctx, args["expander"].(model.ExpanderInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Expander)
+	fc.Result = res
+	return ec.marshalOExpander2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐExpander(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteExpander(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteExpander_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteExpander(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Expander)
+	fc.Result = res
+	return ec.marshalOExpander2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐExpander(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifyWebhook(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifyWebhook_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifyWebhook(rctx, args["webhook"].(model.WebhookInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Webhook)
+	fc.Result = res
+	return ec.marshalOWebhook2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhook(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteWebhook(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteWebhook_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteWebhook(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Webhook)
+	fc.Result = res
+	return ec.marshalOWebhook2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhook(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_testWebhook(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_testWebhook_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().TestWebhook(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.WebhookDelivery)
+	fc.Result = res
+	return ec.marshalOWebhookDelivery2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhookDelivery(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifyAlertRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifyAlertRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifyAlertRule(rctx, args["alertRule"].(model.AlertRuleInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.AlertRule)
+	fc.Result = res
+	return ec.marshalOAlertRule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertRule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteAlertRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteAlertRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteAlertRule(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.AlertRule)
+	fc.Result = res
+	return ec.marshalOAlertRule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertRule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_acknowledgeAlert(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_acknowledgeAlert_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().AcknowledgeAlert(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.AlertRule)
+	fc.Result = res
+	return ec.marshalOAlertRule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertRule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_silenceAlert(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_silenceAlert_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SilenceAlert(rctx, args["id"].(string), args["minutes"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.AlertRule)
+	fc.Result = res
+	return ec.marshalOAlertRule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertRule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifyAlertChannel(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifyAlertChannel_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifyAlertChannel(rctx, args["alertChannel"].(model.AlertChannelInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.AlertChannel)
+	fc.Result = res
+	return ec.marshalOAlertChannel2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertChannel(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteAlertChannel(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteAlertChannel_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteAlertChannel(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.AlertChannel)
+	fc.Result = res
+	return ec.marshalOAlertChannel2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertChannel(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_testAlertChannel(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_testAlertChannel_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().TestAlertChannel(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.AlertChannel)
+	fc.Result = res
+	return ec.marshalOAlertChannel2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertChannel(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifyAutomationRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifyAutomationRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifyAutomationRule(rctx, args["automationRule"].(model.AutomationRuleInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.AutomationRule)
+	fc.Result = res
+	return ec.marshalOAutomationRule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationRule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteAutomationRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteAutomationRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteAutomationRule(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.AutomationRule)
+	fc.Result = res
+	return ec.marshalOAutomationRule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationRule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifySchedule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifySchedule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifySchedule(rctx, args["schedule"].(model.ScheduleInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Schedule)
+	fc.Result = res
+	return ec.marshalOSchedule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSchedule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteSchedule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteSchedule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteSchedule(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Schedule)
+	fc.Result = res
+	return ec.marshalOSchedule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSchedule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_runSchedule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_runSchedule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RunSchedule(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Schedule)
+	fc.Result = res
+	return ec.marshalOSchedule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSchedule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifySwitchGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifySwitchGroup_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifySwitchGroup(rctx, args["switchGroup"].(model.SwitchGroupInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.SwitchGroup)
+	fc.Result = res
+	return ec.marshalOSwitchGroup2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteSwitchGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteSwitchGroup_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteSwitchGroup(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.SwitchGroup)
+	fc.Result = res
+	return ec.marshalOSwitchGroup2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_toggleSwitchGroup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_toggleSwitchGroup_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ToggleSwitchGroup(rctx, args["id"].(string), args["mode"].(model.SwitchMode))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.SwitchGroup)
+	fc.Result = res
+	return ec.marshalOSwitchGroup2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifyScene(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifyScene_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifyScene(rctx, args["scene"].(model.SceneInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Scene)
+	fc.Result = res
+	return ec.marshalOScene2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐScene(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteScene(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteScene_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteScene(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Scene)
+	fc.Result = res
+	return ec.marshalOScene2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐScene(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_applyScene(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_applyScene_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ApplyScene(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Scene)
+	fc.Result = res
+	return ec.marshalOScene2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐScene(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifyInPin(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifyInPin_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifyInPin(rctx, args["inPin"].(model.InPinInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.InPin)
+	fc.Result = res
+	return ec.marshalOInPin2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐInPin(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteInPin(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteInPin_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteInPin(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.InPin)
+	fc.Result = res
+	return ec.marshalOInPin2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐInPin(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_modifyFlowMeter(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_modifyFlowMeter_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ModifyFlowMeter(rctx, args["flowMeter"].(model.FlowMeterInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.FlowMeter)
+	fc.Result = res
+	return ec.marshalOFlowMeter2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFlowMeter(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_deleteFlowMeter(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_deleteFlowMeter_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().DeleteFlowMeter(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.FlowMeter)
+	fc.Result = res
+	return ec.marshalOFlowMeter2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFlowMeter(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_resetFlowMeter(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_resetFlowMeter_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().ResetFlowMeter(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.FlowMeter)
+	fc.Result = res
+	return ec.marshalOFlowMeter2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFlowMeter(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_dispense(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_dispense_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().Dispense(rctx, args["flowMeterId"].(string), args["litres"].(float64), args["switchId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.FlowMeter)
+	fc.Result = res
+	return ec.marshalOFlowMeter2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFlowMeter(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_cancelDispense(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_cancelDispense_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CancelDispense(rctx, args["flowMeterId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.FlowMeter)
+	fc.Result = res
+	return ec.marshalOFlowMeter2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFlowMeter(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_startFermentation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_startFermentation_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().StartFermentation(rctx, args["fermentation"].(model.FermentationInput))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Fermentation)
+	fc.Result = res
+	return ec.marshalOFermentation2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFermentation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_recordGravity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_recordGravity_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RecordGravity(rctx, args["controllerId"].(string), args["gravity"].(float64))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Fermentation)
+	fc.Result = res
+	return ec.marshalOFermentation2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFermentation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_endFermentation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_endFermentation_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().EndFermentation(rctx, args["controllerId"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Fermentation)
+	fc.Result = res
+	return ec.marshalOFermentation2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFermentation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_createBackup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().CreateBackup(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Backup)
+	fc.Result = res
+	return ec.marshalOBackup2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐBackup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_restoreBackup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_restoreBackup_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RestoreBackup(rctx, args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.Backup)
+	fc.Result = res
+	return ec.marshalOBackup2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐBackup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PidSettings_configured(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PidSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Configured, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PidSettings_cycleTime(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PidSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CycleTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalOInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PidSettings_delay(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PidSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Delay, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalOInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PidSettings_derivative(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PidSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Derivative, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PidSettings_id(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PidSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.PidSettings().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PidSettings_integral(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PidSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Integral, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PidSettings_proportional(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PidSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Proportional, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PidSettings_gpio(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PidSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Gpio, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _PidSettings_frequency(ctx context.Context, field graphql.CollectedField, obj *devices.PidSettings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "PidSettings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Frequency, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProbeCalibration_mode(ctx context.Context, field graphql.CollectedField, obj *model.ProbeCalibration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProbeCalibration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Mode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.CalibrationMode)
+	fc.Result = res
+	return ec.marshalNCalibrationMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐCalibrationMode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProbeCalibration_offset(ctx context.Context, field graphql.CollectedField, obj *model.ProbeCalibration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProbeCalibration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Offset, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProbeCalibration_lowRawReading(ctx context.Context, field graphql.CollectedField, obj *model.ProbeCalibration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProbeCalibration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LowRawReading, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProbeCalibration_lowReference(ctx context.Context, field graphql.CollectedField, obj *model.ProbeCalibration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProbeCalibration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LowReference, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProbeCalibration_highRawReading(ctx context.Context, field graphql.CollectedField, obj *model.ProbeCalibration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProbeCalibration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HighRawReading, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProbeCalibration_highReference(ctx context.Context, field graphql.CollectedField, obj *model.ProbeCalibration) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProbeCalibration",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HighReference, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProbeEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.ProbeEvent) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProbeEvent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.ProbeEventType)
+	fc.Result = res
+	return ec.marshalNProbeEventType2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeEventType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProbeEvent_physAddr(ctx context.Context, field graphql.CollectedField, obj *model.ProbeEvent) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProbeEvent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PhysAddr, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProbeEvent_time(ctx context.Context, field graphql.CollectedField, obj *model.ProbeEvent) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProbeEvent",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Time, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProbeScan_discovered(ctx context.Context, field graphql.CollectedField, obj *model.ProbeScan) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProbeScan",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Discovered, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TemperatureProbe)
+	fc.Result = res
+	return ec.marshalNTemperatureProbe2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ProbeScan_lost(ctx context.Context, field graphql.CollectedField, obj *model.ProbeScan) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ProbeScan",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Lost, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TemperatureProbe)
+	fc.Result = res
+	return ec.marshalNTemperatureProbe2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_probe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_probe_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Probe(rctx, args["address"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TemperatureProbe)
+	fc.Result = res
+	return ec.marshalOTemperatureProbe2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_probeList(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_probeList_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ProbeList(rctx, args["available"].(*bool))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TemperatureProbe)
+	fc.Result = res
+	return ec.marshalOTemperatureProbe2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_fetchProbes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_fetchProbes_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().FetchProbes(rctx, args["addresses"].([]*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TemperatureProbe)
+	fc.Result = res
+	return ec.marshalOTemperatureProbe2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_registeredProbes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().RegisteredProbes(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TemperatureProbe)
+	fc.Result = res
+	return ec.marshalOTemperatureProbe2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_spiProbes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SpiProbes(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.SPIProbe)
+	fc.Result = res
+	return ec.marshalOSPIProbe2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSPIProbe(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_probeEvents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ProbeEvents(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ProbeEvent)
+	fc.Result = res
+	return ec.marshalOProbeEvent2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeEvent(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_deviceEvents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().DeviceEvents(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.DeviceEvent)
+	fc.Result = res
+	return ec.marshalODeviceEvent2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEvent(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_webhooks(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Webhooks(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.Webhook)
+	fc.Result = res
+	return ec.marshalOWebhook2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhook(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_webhookDeliveries(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_webhookDeliveries_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().WebhookDeliveries(rctx, args["webhookId"].(*string), args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.WebhookDelivery)
+	fc.Result = res
+	return ec.marshalOWebhookDelivery2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhookDelivery(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_alertRules(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AlertRules(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.AlertRule)
+	fc.Result = res
+	return ec.marshalOAlertRule2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertRule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_alertChannels(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AlertChannels(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.AlertChannel)
+	fc.Result = res
+	return ec.marshalOAlertChannel2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertChannel(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_automationRules(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().AutomationRules(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.AutomationRule)
+	fc.Result = res
+	return ec.marshalOAutomationRule2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationRule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_evaluateAutomationRule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_evaluateAutomationRule_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().EvaluateAutomationRule(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.AutomationTrace)
+	fc.Result = res
+	return ec.marshalOAutomationTrace2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationTrace(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_schedules(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Schedules(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.Schedule)
+	fc.Result = res
+	return ec.marshalOSchedule2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSchedule(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_switchGroups(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().SwitchGroups(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.SwitchGroup)
+	fc.Result = res
+	return ec.marshalOSwitchGroup2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_scenes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Scenes(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.Scene)
+	fc.Result = res
+	return ec.marshalOScene2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐScene(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_expanders(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Expanders(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.Expander)
+	fc.Result = res
+	return ec.marshalOExpander2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐExpander(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_inPins(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().InPins(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.InPin)
+	fc.Result = res
+	return ec.marshalOInPin2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐInPin(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_flowMeters(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().FlowMeters(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.FlowMeter)
+	fc.Result = res
+	return ec.marshalOFlowMeter2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFlowMeter(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_temperatureControllers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_temperatureControllers_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().TemperatureControllers(rctx, args["name"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.TemperatureController)
+	fc.Result = res
+	return ec.marshalOTemperatureController2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐTemperatureController(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_settings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Settings(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*system.Settings)
+	fc.Result = res
+	return ec.marshalOSettings2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋsystemᚐSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_switches(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Switches(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.Switch)
+	fc.Result = res
+	return ec.marshalOSwitch2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_backups(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Backups(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Backup)
+	fc.Result = res
+	return ec.marshalOBackup2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐBackup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_modbusRegisters(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ModbusRegisters(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ModbusRegister)
+	fc.Result = res
+	return ec.marshalOModbusRegister2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐModbusRegister(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SPIProbe_id(ctx context.Context, field graphql.CollectedField, obj *devices.SPIProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SPIProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SPIProbe().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SPIProbe_physAddr(ctx context.Context, field graphql.CollectedField, obj *devices.SPIProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SPIProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PhysAddr(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SPIProbe_driver(ctx context.Context, field graphql.CollectedField, obj *devices.SPIProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SPIProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Driver, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SPIDriver)
+	fc.Result = res
+	return ec.marshalNSPIDriver2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSPIDriver(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SPIProbe_bus(ctx context.Context, field graphql.CollectedField, obj *devices.SPIProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SPIProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Bus, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SPIProbe_chipSelect(ctx context.Context, field graphql.CollectedField, obj *devices.SPIProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SPIProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ChipSelect, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SPIProbe_wires(ctx context.Context, field graphql.CollectedField, obj *devices.SPIProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SPIProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Wires, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalOInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SPIProbe_referenceResistor(ctx context.Context, field graphql.CollectedField, obj *devices.SPIProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SPIProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ReferenceResistor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SPIProbe_nominalResistance(ctx context.Context, field graphql.CollectedField, obj *devices.SPIProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SPIProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NominalResistance, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SPIProbe_filter50Hz(ctx context.Context, field graphql.CollectedField, obj *devices.SPIProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SPIProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Filter50Hz, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalOBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SPIProbe_connectError(ctx context.Context, field graphql.CollectedField, obj *devices.SPIProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SPIProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConnectError, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SPIProbe_probe(ctx context.Context, field graphql.CollectedField, obj *devices.SPIProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SPIProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SPIProbe().Probe(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TemperatureProbe)
+	fc.Result = res
+	return ec.marshalOTemperatureProbe2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Scene_id(ctx context.Context, field graphql.CollectedField, obj *devices.Scene) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Scene",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Scene().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Scene_name(ctx context.Context, field graphql.CollectedField, obj *devices.Scene) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Scene",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Scene_steps(ctx context.Context, field graphql.CollectedField, obj *devices.Scene) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Scene",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Steps, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.SceneStep)
+	fc.Result = res
+	return ec.marshalNSceneStep2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSceneStepᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Scene_interlocks(ctx context.Context, field graphql.CollectedField, obj *devices.Scene) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Scene",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interlocks, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.AutomationCondition)
+	fc.Result = res
+	return ec.marshalNAutomationCondition2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationConditionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Scene_applying(ctx context.Context, field graphql.CollectedField, obj *devices.Scene) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Scene",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Applying(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Scene_lastApplied(ctx context.Context, field graphql.CollectedField, obj *devices.Scene) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Scene",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastApplied, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Scene_lastResult(ctx context.Context, field graphql.CollectedField, obj *devices.Scene) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Scene",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastResult, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Scene_lastError(ctx context.Context, field graphql.CollectedField, obj *devices.Scene) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Scene",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastError, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SceneStep_switch(ctx context.Context, field graphql.CollectedField, obj *devices.SceneStep) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SceneStep",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Switch(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Switch)
+	fc.Result = res
+	return ec.marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SceneStep_group(ctx context.Context, field graphql.CollectedField, obj *devices.SceneStep) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SceneStep",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Group(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.SwitchGroup)
+	fc.Result = res
+	return ec.marshalOSwitchGroup2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SceneStep_state(ctx context.Context, field graphql.CollectedField, obj *devices.SceneStep) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SceneStep",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.State, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SwitchMode)
+	fc.Result = res
+	return ec.marshalNSwitchMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SceneStep_delaySeconds(ctx context.Context, field graphql.CollectedField, obj *devices.SceneStep) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SceneStep",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DelaySeconds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Schedule_id(ctx context.Context, field graphql.CollectedField, obj *devices.Schedule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Schedule().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Schedule_name(ctx context.Context, field graphql.CollectedField, obj *devices.Schedule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Schedule_enabled(ctx context.Context, field graphql.CollectedField, obj *devices.Schedule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Schedule_cron(ctx context.Context, field graphql.CollectedField, obj *devices.Schedule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cron, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Schedule_at(ctx context.Context, field graphql.CollectedField, obj *devices.Schedule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.At, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Schedule_missedRun(ctx context.Context, field graphql.CollectedField, obj *devices.Schedule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MissedRun, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.MissedRunPolicy)
+	fc.Result = res
+	return ec.marshalNMissedRunPolicy2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐMissedRunPolicy(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Schedule_actions(ctx context.Context, field graphql.CollectedField, obj *devices.Schedule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Actions, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.AutomationAction)
+	fc.Result = res
+	return ec.marshalNAutomationAction2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationActionᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Schedule_nextRun(ctx context.Context, field graphql.CollectedField, obj *devices.Schedule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NextRun(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Schedule_lastRun(ctx context.Context, field graphql.CollectedField, obj *devices.Schedule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastRun, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Schedule_lastResult(ctx context.Context, field graphql.CollectedField, obj *devices.Schedule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastResult, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Schedule_lastError(ctx context.Context, field graphql.CollectedField, obj *devices.Schedule) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Schedule",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastError, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Settings_breweryName(ctx context.Context, field graphql.CollectedField, obj *system.Settings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Settings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BreweryName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Settings_timezone(ctx context.Context, field graphql.CollectedField, obj *system.Settings) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Settings",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timezone, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_id(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Switch().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_gpio(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Gpio(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_name(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_state(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.State(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SwitchMode)
+	fc.Result = res
+	return ec.marshalNSwitchMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_networked(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Networked(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_outputError(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OutputError(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_frequency(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Frequency, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_duty(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DimmerDuty(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_softwarePWM(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SoftwarePWM(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_maxOnSeconds(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxOnSeconds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_autoOffSeconds(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Switch().AutoOffSeconds(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Switch_timer(ctx context.Context, field graphql.CollectedField, obj *devices.Switch) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Switch",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Switch().Timer(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.SwitchTimer)
+	fc.Result = res
+	return ec.marshalOSwitchTimer2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchTimer(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SwitchGroup_id(ctx context.Context, field graphql.CollectedField, obj *devices.SwitchGroup) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SwitchGroup",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SwitchGroup().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SwitchGroup_name(ctx context.Context, field graphql.CollectedField, obj *devices.SwitchGroup) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SwitchGroup",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SwitchGroup_switches(ctx context.Context, field graphql.CollectedField, obj *devices.SwitchGroup) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SwitchGroup",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Switches(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.Switch)
+	fc.Result = res
+	return ec.marshalNSwitch2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SwitchTimer_kind(ctx context.Context, field graphql.CollectedField, obj *devices.SwitchTimer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SwitchTimer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SwitchTimerKind)
+	fc.Result = res
+	return ec.marshalNSwitchTimerKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchTimerKind(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SwitchTimer_onSeconds(ctx context.Context, field graphql.CollectedField, obj *devices.SwitchTimer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SwitchTimer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OnSeconds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SwitchTimer_offSeconds(ctx context.Context, field graphql.CollectedField, obj *devices.SwitchTimer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SwitchTimer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OffSeconds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SwitchTimer_on(ctx context.Context, field graphql.CollectedField, obj *devices.SwitchTimer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SwitchTimer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.On, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SwitchTimer_cyclesLeft(ctx context.Context, field graphql.CollectedField, obj *devices.SwitchTimer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SwitchTimer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CyclesLeft, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SwitchTimer_remainingSeconds(ctx context.Context, field graphql.CollectedField, obj *devices.SwitchTimer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SwitchTimer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RemainingSeconds, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_id(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_physAddr(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PhysAddr, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_reading(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reading, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_rawReading(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RawReading, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_gravity(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Gravity, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_name(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_updated(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Updated, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_calibration(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Calibration, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.ProbeCalibration)
+	fc.Result = res
+	return ec.marshalOProbeCalibration2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeCalibration(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_weight(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Weight, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_priority(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Priority, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_status(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.ProbeStatus)
+	fc.Result = res
+	return ec.marshalOProbeStatus2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_fault(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fault, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TempProbeDetails_contributing(ctx context.Context, field graphql.CollectedField, obj *model.TempProbeDetails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TempProbeDetails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Contributing, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_calculatedDuty(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CalculatedDuty, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalOInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_coolSettings(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CoolSettings, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(devices.PidSettings)
+	fc.Result = res
+	return ec.marshalOPidSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐPidSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_dutyCycle(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DutyCycle, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalOInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_heatSettings(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HeatSettings, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(devices.PidSettings)
+	fc.Result = res
+	return ec.marshalOPidSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐPidSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_hysteriaSettings(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HysteriaSettings, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(devices.HysteriaSettings)
+	fc.Result = res
+	return ec.marshalOHysteriaSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐHysteriaSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_id(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.TemperatureController().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_manualSettings(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ManualSettings, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(devices.ManualSettings)
+	fc.Result = res
+	return ec.marshalOManualSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐManualSettings(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_mode(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Mode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(model.ControllerMode)
+	fc.Result = res
+	return ec.marshalOControllerMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_name(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_previousCalculationTime(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PreviousCalculationTime, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_setPoint(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SetPoint(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_tempProbeDetails(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.TemperatureController().TempProbeDetails(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TempProbeDetails)
+	fc.Result = res
+	return ec.marshalOTempProbeDetails2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTempProbeDetails(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_aggregation(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Aggregation(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.AggregationMode)
+	fc.Result = res
+	return ec.marshalNAggregationMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAggregationMode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_staleAfter(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StaleAfter, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalOInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_contributingProbes(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ContributingProbes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_fermentation(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fermentation(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*devices.Fermentation)
+	fc.Result = res
+	return ec.marshalOFermentation2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFermentation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureController_interlocked(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureController",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interlocked(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_physAddr(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PhysAddr, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_reading(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reading, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_updated(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Updated, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*time.Time)
+	fc.Result = res
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_name(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_location(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Location, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_notes(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Notes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_sensorType(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SensorType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_enabled(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_connected(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Connected, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_resolution(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Resolution, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_readLatency(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ReadLatency, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_reads(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reads, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_readErrors(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ReadErrors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_coldJunction(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ColdJunction, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_gravity(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Gravity, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*float64)
+	fc.Result = res
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_lowLimit(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LowLimit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TemperatureProbe_highLimit(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TemperatureProbe",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HighLimit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_id(ctx context.Context, field graphql.CollectedField, obj *devices.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Webhook().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_name(ctx context.Context, field graphql.CollectedField, obj *devices.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_url(ctx context.Context, field graphql.CollectedField, obj *devices.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.URL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_events(ctx context.Context, field graphql.CollectedField, obj *devices.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Events(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]model.DeviceEventType)
+	fc.Result = res
+	return ec.marshalNDeviceEventType2ᚕgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_secret(ctx context.Context, field graphql.CollectedField, obj *devices.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Secret, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_enabled(ctx context.Context, field graphql.CollectedField, obj *devices.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Enabled, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Webhook_deliveries(ctx context.Context, field graphql.CollectedField, obj *devices.Webhook) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Webhook",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Webhook_deliveries_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Deliveries(args["limit"].(*int)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*devices.WebhookDelivery)
+	fc.Result = res
+	return ec.marshalNWebhookDelivery2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhookDeliveryᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_id(ctx context.Context, field graphql.CollectedField, obj *devices.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.WebhookDelivery().ID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_webhookId(ctx context.Context, field graphql.CollectedField, obj *devices.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.WebhookDelivery().WebhookID(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_event(ctx context.Context, field graphql.CollectedField, obj *devices.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Event, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.DeviceEventType)
+	fc.Result = res
+	return ec.marshalNDeviceEventType2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_payload(ctx context.Context, field graphql.CollectedField, obj *devices.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Payload, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_status(ctx context.Context, field graphql.CollectedField, obj *devices.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.WebhookDeliveryStatus)
+	fc.Result = res
+	return ec.marshalNWebhookDeliveryStatus2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐWebhookDeliveryStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_attempts(ctx context.Context, field graphql.CollectedField, obj *devices.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Attempts, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_responseCode(ctx context.Context, field graphql.CollectedField, obj *devices.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ResponseCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_error(ctx context.Context, field graphql.CollectedField, obj *devices.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Error, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_createdAt(ctx context.Context, field graphql.CollectedField, obj *devices.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WebhookDelivery_updatedAt(ctx context.Context, field graphql.CollectedField, obj *devices.WebhookDelivery) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WebhookDelivery",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputAlertChannelInput(ctx context.Context, obj interface{}) (model.AlertChannelInput, error) {
+	var it model.AlertChannelInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "kind":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			it.Kind, err = ec.unmarshalOAlertChannelKind2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertChannelKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "url":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			it.URL, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "username":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("username"))
+			it.Username, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "token":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("token"))
+			it.Token, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "to":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			it.To, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "from":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			it.From, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAlertRuleInput(ctx context.Context, obj interface{}) (model.AlertRuleInput, error) {
+	var it model.AlertRuleInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "condition":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("condition"))
+			it.Condition, err = ec.unmarshalOAlertCondition2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertCondition(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "probe":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("probe"))
+			it.Probe, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "controllerId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("controllerId"))
+			it.ControllerID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "threshold":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("threshold"))
+			it.Threshold, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "hysteresis":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hysteresis"))
+			it.Hysteresis, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "duration":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("duration"))
+			it.Duration, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "repeatInterval":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("repeatInterval"))
+			it.RepeatInterval, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "channelIds":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("channelIds"))
+			it.ChannelIds, err = ec.unmarshalOID2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAutomationActionInput(ctx context.Context, obj interface{}) (model.AutomationActionInput, error) {
+	var it model.AutomationActionInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "kind":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			it.Kind, err = ec.unmarshalNAutomationActionKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationActionKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "switchId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("switchId"))
+			it.SwitchID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "controllerId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("controllerId"))
+			it.ControllerID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "mode":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mode"))
+			it.Mode, err = ec.unmarshalOControllerMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "setPoint":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("setPoint"))
+			it.SetPoint, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "steps":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("steps"))
+			it.Steps, err = ec.unmarshalOFermentationStepInput2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFermentationStepInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAutomationConditionInput(ctx context.Context, obj interface{}) (model.AutomationConditionInput, error) {
+	var it model.AutomationConditionInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "kind":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			it.Kind, err = ec.unmarshalNAutomationConditionKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationConditionKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "probe":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("probe"))
+			it.Probe, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "controllerId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("controllerId"))
+			it.ControllerID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "switchId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("switchId"))
+			it.SwitchID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "threshold":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("threshold"))
+			it.Threshold, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "hysteresis":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hysteresis"))
+			it.Hysteresis, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "time":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("time"))
+			it.Time, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "until":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("until"))
+			it.Until, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "event":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("event"))
+			it.Event, err = ec.unmarshalODeviceEventType2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "deviceId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("deviceId"))
+			it.DeviceID, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputAutomationRuleInput(ctx context.Context, obj interface{}) (model.AutomationRuleInput, error) {
+	var it model.AutomationRuleInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "revert":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("revert"))
+			it.Revert, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "trigger":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("trigger"))
+			it.Trigger, err = ec.unmarshalOAutomationConditionInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationConditionInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "conditions":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("conditions"))
+			it.Conditions, err = ec.unmarshalOAutomationConditionInput2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationConditionInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "actions":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("actions"))
+			it.Actions, err = ec.unmarshalOAutomationActionInput2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationActionInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputExpanderInput(ctx context.Context, obj interface{}) (model.ExpanderInput, error) {
+	var it model.ExpanderInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "chip":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chip"))
+			it.Chip, err = ec.unmarshalOExpanderChip2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐExpanderChip(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "bus":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bus"))
+			it.Bus, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "address":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+			it.Address, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputFermentationInput(ctx context.Context, obj interface{}) (model.FermentationInput, error) {
+	var it model.FermentationInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "controllerId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("controllerId"))
+			it.ControllerID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "originalGravity":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("originalGravity"))
+			it.OriginalGravity, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "targetAttenuation":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("targetAttenuation"))
+			it.TargetAttenuation, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "slopeWindow":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slopeWindow"))
+			it.SlopeWindow, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "stableSlope":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stableSlope"))
+			it.StableSlope, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "steps":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("steps"))
+			it.Steps, err = ec.unmarshalOFermentationStepInput2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFermentationStepInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputFermentationStepInput(ctx context.Context, obj interface{}) (model.FermentationStepInput, error) {
+	var it model.FermentationStepInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "attenuation":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attenuation"))
+			it.Attenuation, err = ec.unmarshalNFloat2float64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "setPoint":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("setPoint"))
+			it.SetPoint, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "mode":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mode"))
+			it.Mode, err = ec.unmarshalOControllerMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputFlowMeterInput(ctx context.Context, obj interface{}) (model.FlowMeterInput, error) {
+	var it model.FlowMeterInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gpio":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gpio"))
+			it.Gpio, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "pulsesPerLitre":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pulsesPerLitre"))
+			it.PulsesPerLitre, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "noFlowTimeout":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("noFlowTimeout"))
+			it.NoFlowTimeout, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputHysteriaSettingsInput(ctx context.Context, obj interface{}) (model.HysteriaSettingsInput, error) {
+	var it model.HysteriaSettingsInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "configured":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("configured"))
+			it.Configured, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "maxTemp":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxTemp"))
+			it.MaxTemp, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "minTemp":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minTemp"))
+			it.MinTemp, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "minTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minTime"))
+			it.MinTime, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputInPinInput(ctx context.Context, obj interface{}) (model.InPinInput, error) {
+	var it model.InPinInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gpio":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gpio"))
+			it.Gpio, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "pull":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pull"))
+			it.Pull, err = ec.unmarshalOInputPull2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputPull(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "inverted":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("inverted"))
+			it.Inverted, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "debounce":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("debounce"))
+			it.Debounce, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "action":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("action"))
+			it.Action, err = ec.unmarshalOInputAction2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputAction(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "switchId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("switchId"))
+			it.SwitchID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "controllerId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("controllerId"))
+			it.ControllerID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputManualSettingsInput(ctx context.Context, obj interface{}) (model.ManualSettingsInput, error) {
+	var it model.ManualSettingsInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "configured":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("configured"))
+			it.Configured, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "cycleTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cycleTime"))
+			it.CycleTime, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "dutyCycle":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dutyCycle"))
+			it.DutyCycle, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPidSettingsInput(ctx context.Context, obj interface{}) (model.PidSettingsInput, error) {
+	var it model.PidSettingsInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "configured":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("configured"))
+			it.Configured, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "cycleTime":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cycleTime"))
+			it.CycleTime, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "delay":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("delay"))
+			it.Delay, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "derivative":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("derivative"))
+			it.Derivative, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "integral":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("integral"))
+			it.Integral, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "proportional":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proportional"))
+			it.Proportional, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gpio":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gpio"))
+			it.Gpio, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "frequency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("frequency"))
+			it.Frequency, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputProbeSettingsInput(ctx context.Context, obj interface{}) (model.ProbeSettingsInput, error) {
+	var it model.ProbeSettingsInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "physAddr":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("physAddr"))
+			it.PhysAddr, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "location":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("location"))
+			it.Location, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "notes":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("notes"))
+			it.Notes, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "sensorType":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("sensorType"))
+			it.SensorType, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "resolution":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("resolution"))
+			it.Resolution, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "lowLimit":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lowLimit"))
+			it.LowLimit, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "highLimit":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("highLimit"))
+			it.HighLimit, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSPIProbeInput(ctx context.Context, obj interface{}) (model.SPIProbeInput, error) {
+	var it model.SPIProbeInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "driver":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("driver"))
+			it.Driver, err = ec.unmarshalOSPIDriver2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSPIDriver(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "bus":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("bus"))
+			it.Bus, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "chipSelect":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("chipSelect"))
+			it.ChipSelect, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "wires":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("wires"))
+			it.Wires, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "referenceResistor":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("referenceResistor"))
+			it.ReferenceResistor, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "nominalResistance":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nominalResistance"))
+			it.NominalResistance, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "filter50Hz":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter50Hz"))
+			it.Filter50Hz, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSceneInput(ctx context.Context, obj interface{}) (model.SceneInput, error) {
+	var it model.SceneInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "steps":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("steps"))
+			it.Steps, err = ec.unmarshalOSceneStepInput2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSceneStepInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "interlocks":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("interlocks"))
+			it.Interlocks, err = ec.unmarshalOAutomationConditionInput2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationConditionInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSceneStepInput(ctx context.Context, obj interface{}) (model.SceneStepInput, error) {
+	var it model.SceneStepInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "switchId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("switchId"))
+			it.SwitchID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "groupId":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("groupId"))
+			it.GroupID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "state":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
+			it.State, err = ec.unmarshalNSwitchMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "delaySeconds":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("delaySeconds"))
+			it.DelaySeconds, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputScheduleInput(ctx context.Context, obj interface{}) (model.ScheduleInput, error) {
+	var it model.ScheduleInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "cron":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cron"))
+			it.Cron, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "at":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("at"))
+			it.At, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "missedRun":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("missedRun"))
+			it.MissedRun, err = ec.unmarshalOMissedRunPolicy2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐMissedRunPolicy(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "actions":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("actions"))
+			it.Actions, err = ec.unmarshalOAutomationActionInput2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationActionInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSettingsInput(ctx context.Context, obj interface{}) (model.SettingsInput, error) {
+	var it model.SettingsInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "breweryName":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("breweryName"))
+			it.BreweryName, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "timezone":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("timezone"))
+			it.Timezone, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSwitchGroupInput(ctx context.Context, obj interface{}) (model.SwitchGroupInput, error) {
+	var it model.SwitchGroupInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "switchIds":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("switchIds"))
+			it.SwitchIds, err = ec.unmarshalOID2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSwitchSettingsInput(ctx context.Context, obj interface{}) (model.SwitchSettingsInput, error) {
+	var it model.SwitchSettingsInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gpio":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gpio"))
+			it.Gpio, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "state":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
+			it.State, err = ec.unmarshalOSwitchMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "frequency":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("frequency"))
+			it.Frequency, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "duty":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("duty"))
+			it.Duty, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "maxOnSeconds":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxOnSeconds"))
+			it.MaxOnSeconds, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTempProbeDetailsInput(ctx context.Context, obj interface{}) (model.TempProbeDetailsInput, error) {
+	var it model.TempProbeDetailsInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "physAddr":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("physAddr"))
+			it.PhysAddr, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "weight":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weight"))
+			it.Weight, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "priority":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("priority"))
+			it.Priority, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTemperatureControllerSettingsInput(ctx context.Context, obj interface{}) (model.TemperatureControllerSettingsInput, error) {
+	var it model.TemperatureControllerSettingsInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "mode":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mode"))
+			it.Mode, err = ec.unmarshalOControllerMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "coolSettings":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("coolSettings"))
+			it.CoolSettings, err = ec.unmarshalOPidSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐPidSettingsInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "heatSettings":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("heatSettings"))
+			it.HeatSettings, err = ec.unmarshalOPidSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐPidSettingsInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "hysteriaSettings":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hysteriaSettings"))
+			it.HysteriaSettings, err = ec.unmarshalOHysteriaSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐHysteriaSettingsInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "manualSettings":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("manualSettings"))
+			it.ManualSettings, err = ec.unmarshalOManualSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐManualSettingsInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "setPoint":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("setPoint"))
+			it.SetPoint, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "aggregation":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("aggregation"))
+			it.Aggregation, err = ec.unmarshalOAggregationMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAggregationMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "staleAfter":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("staleAfter"))
+			it.StaleAfter, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputWebhookInput(ctx context.Context, obj interface{}) (model.WebhookInput, error) {
+	var it model.WebhookInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "id":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "url":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("url"))
+			it.URL, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "events":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("events"))
+			it.Events, err = ec.unmarshalODeviceEventType2ᚕgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventTypeᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "secret":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("secret"))
+			it.Secret, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "enabled":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("enabled"))
+			it.Enabled, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var alertChannelImplementors = []string{"AlertChannel"}
+
+func (ec *executionContext) _AlertChannel(ctx context.Context, sel ast.SelectionSet, obj *devices.AlertChannel) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, alertChannelImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AlertChannel")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AlertChannel_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "name":
+			out.Values[i] = ec._AlertChannel_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "kind":
+			out.Values[i] = ec._AlertChannel_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "url":
+			out.Values[i] = ec._AlertChannel_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "username":
+			out.Values[i] = ec._AlertChannel_username(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "to":
+			out.Values[i] = ec._AlertChannel_to(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "from":
+			out.Values[i] = ec._AlertChannel_from(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "enabled":
+			out.Values[i] = ec._AlertChannel_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "lastError":
+			out.Values[i] = ec._AlertChannel_lastError(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var alertRuleImplementors = []string{"AlertRule"}
+
+func (ec *executionContext) _AlertRule(ctx context.Context, sel ast.SelectionSet, obj *devices.AlertRule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, alertRuleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AlertRule")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AlertRule_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "name":
+			out.Values[i] = ec._AlertRule_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "condition":
+			out.Values[i] = ec._AlertRule_condition(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "probe":
+			out.Values[i] = ec._AlertRule_probe(ctx, field, obj)
+		case "controller":
+			out.Values[i] = ec._AlertRule_controller(ctx, field, obj)
+		case "threshold":
+			out.Values[i] = ec._AlertRule_threshold(ctx, field, obj)
+		case "hysteresis":
+			out.Values[i] = ec._AlertRule_hysteresis(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "duration":
+			out.Values[i] = ec._AlertRule_duration(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "repeatInterval":
+			out.Values[i] = ec._AlertRule_repeatInterval(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "channels":
+			out.Values[i] = ec._AlertRule_channels(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "enabled":
+			out.Values[i] = ec._AlertRule_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "state":
+			out.Values[i] = ec._AlertRule_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "since":
+			out.Values[i] = ec._AlertRule_since(ctx, field, obj)
+		case "value":
+			out.Values[i] = ec._AlertRule_value(ctx, field, obj)
+		case "acknowledged":
+			out.Values[i] = ec._AlertRule_acknowledged(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "silencedUntil":
+			out.Values[i] = ec._AlertRule_silencedUntil(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var automationActionImplementors = []string{"AutomationAction"}
+
+func (ec *executionContext) _AutomationAction(ctx context.Context, sel ast.SelectionSet, obj *devices.AutomationAction) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, automationActionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AutomationAction")
+		case "kind":
+			out.Values[i] = ec._AutomationAction_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "switch":
+			out.Values[i] = ec._AutomationAction_switch(ctx, field, obj)
+		case "controller":
+			out.Values[i] = ec._AutomationAction_controller(ctx, field, obj)
+		case "mode":
+			out.Values[i] = ec._AutomationAction_mode(ctx, field, obj)
+		case "setPoint":
+			out.Values[i] = ec._AutomationAction_setPoint(ctx, field, obj)
+		case "steps":
+			out.Values[i] = ec._AutomationAction_steps(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var automationCheckImplementors = []string{"AutomationCheck"}
+
+func (ec *executionContext) _AutomationCheck(ctx context.Context, sel ast.SelectionSet, obj *devices.AutomationCheck) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, automationCheckImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AutomationCheck")
+		case "description":
+			out.Values[i] = ec._AutomationCheck_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "matched":
+			out.Values[i] = ec._AutomationCheck_matched(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var automationConditionImplementors = []string{"AutomationCondition"}
+
+func (ec *executionContext) _AutomationCondition(ctx context.Context, sel ast.SelectionSet, obj *devices.AutomationCondition) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, automationConditionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AutomationCondition")
+		case "kind":
+			out.Values[i] = ec._AutomationCondition_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "probe":
+			out.Values[i] = ec._AutomationCondition_probe(ctx, field, obj)
+		case "controller":
+			out.Values[i] = ec._AutomationCondition_controller(ctx, field, obj)
+		case "switch":
+			out.Values[i] = ec._AutomationCondition_switch(ctx, field, obj)
+		case "threshold":
+			out.Values[i] = ec._AutomationCondition_threshold(ctx, field, obj)
+		case "hysteresis":
+			out.Values[i] = ec._AutomationCondition_hysteresis(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "time":
+			out.Values[i] = ec._AutomationCondition_time(ctx, field, obj)
+		case "until":
+			out.Values[i] = ec._AutomationCondition_until(ctx, field, obj)
+		case "event":
+			out.Values[i] = ec._AutomationCondition_event(ctx, field, obj)
+		case "deviceId":
+			out.Values[i] = ec._AutomationCondition_deviceId(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var automationRuleImplementors = []string{"AutomationRule"}
+
+func (ec *executionContext) _AutomationRule(ctx context.Context, sel ast.SelectionSet, obj *devices.AutomationRule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, automationRuleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AutomationRule")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._AutomationRule_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "name":
+			out.Values[i] = ec._AutomationRule_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "enabled":
+			out.Values[i] = ec._AutomationRule_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "revert":
+			out.Values[i] = ec._AutomationRule_revert(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "trigger":
+			out.Values[i] = ec._AutomationRule_trigger(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "conditions":
+			out.Values[i] = ec._AutomationRule_conditions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "actions":
+			out.Values[i] = ec._AutomationRule_actions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "active":
+			out.Values[i] = ec._AutomationRule_active(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "trace":
+			out.Values[i] = ec._AutomationRule_trace(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var automationTraceImplementors = []string{"AutomationTrace"}
+
+func (ec *executionContext) _AutomationTrace(ctx context.Context, sel ast.SelectionSet, obj *devices.AutomationTrace) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, automationTraceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AutomationTrace")
+		case "time":
+			out.Values[i] = ec._AutomationTrace_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "matched":
+			out.Values[i] = ec._AutomationTrace_matched(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "checks":
+			out.Values[i] = ec._AutomationTrace_checks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "actions":
+			out.Values[i] = ec._AutomationTrace_actions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "errors":
+			out.Values[i] = ec._AutomationTrace_errors(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var backupImplementors = []string{"Backup"}
+
+func (ec *executionContext) _Backup(ctx context.Context, sel ast.SelectionSet, obj *model.Backup) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, backupImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Backup")
+		case "name":
+			out.Values[i] = ec._Backup_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "size":
+			out.Values[i] = ec._Backup_size(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "created":
+			out.Values[i] = ec._Backup_created(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var deleteTemperatureControllerReturnTypeImplementors = []string{"DeleteTemperatureControllerReturnType"}
+
+func (ec *executionContext) _DeleteTemperatureControllerReturnType(ctx context.Context, sel ast.SelectionSet, obj *model.DeleteTemperatureControllerReturnType) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deleteTemperatureControllerReturnTypeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeleteTemperatureControllerReturnType")
+		case "id":
+			out.Values[i] = ec._DeleteTemperatureControllerReturnType_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "temperatureProbes":
+			out.Values[i] = ec._DeleteTemperatureControllerReturnType_temperatureProbes(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var deviceEventImplementors = []string{"DeviceEvent"}
+
+func (ec *executionContext) _DeviceEvent(ctx context.Context, sel ast.SelectionSet, obj *model.DeviceEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deviceEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeviceEvent")
+		case "type":
+			out.Values[i] = ec._DeviceEvent_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "time":
+			out.Values[i] = ec._DeviceEvent_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "device":
+			out.Values[i] = ec._DeviceEvent_device(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deviceId":
+			out.Values[i] = ec._DeviceEvent_deviceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "message":
+			out.Values[i] = ec._DeviceEvent_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var expanderImplementors = []string{"Expander"}
+
+func (ec *executionContext) _Expander(ctx context.Context, sel ast.SelectionSet, obj *devices.Expander) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, expanderImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Expander")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Expander_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "chip":
+			out.Values[i] = ec._Expander_chip(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "bus":
+			out.Values[i] = ec._Expander_bus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "address":
+			out.Values[i] = ec._Expander_address(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Expander_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "pins":
+			out.Values[i] = ec._Expander_pins(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "connectError":
+			out.Values[i] = ec._Expander_connectError(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var fermentationImplementors = []string{"Fermentation"}
+
+func (ec *executionContext) _Fermentation(ctx context.Context, sel ast.SelectionSet, obj *devices.Fermentation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fermentationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Fermentation")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Fermentation_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "originalGravity":
+			out.Values[i] = ec._Fermentation_originalGravity(ctx, field, obj)
+		case "currentGravity":
+			out.Values[i] = ec._Fermentation_currentGravity(ctx, field, obj)
+		case "apparentAttenuation":
+			out.Values[i] = ec._Fermentation_apparentAttenuation(ctx, field, obj)
+		case "abv":
+			out.Values[i] = ec._Fermentation_abv(ctx, field, obj)
+		case "slope":
+			out.Values[i] = ec._Fermentation_slope(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._Fermentation_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "targetAttenuation":
+			out.Values[i] = ec._Fermentation_targetAttenuation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "slopeWindow":
+			out.Values[i] = ec._Fermentation_slopeWindow(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "stableSlope":
+			out.Values[i] = ec._Fermentation_stableSlope(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "started":
+			out.Values[i] = ec._Fermentation_started(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "steps":
+			out.Values[i] = ec._Fermentation_steps(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "readings":
+			out.Values[i] = ec._Fermentation_readings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var fermentationStepImplementors = []string{"FermentationStep"}
+
+func (ec *executionContext) _FermentationStep(ctx context.Context, sel ast.SelectionSet, obj *devices.FermentationStep) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fermentationStepImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FermentationStep")
+		case "name":
+			out.Values[i] = ec._FermentationStep_name(ctx, field, obj)
+		case "attenuation":
+			out.Values[i] = ec._FermentationStep_attenuation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "setPoint":
+			out.Values[i] = ec._FermentationStep_setPoint(ctx, field, obj)
+		case "mode":
+			out.Values[i] = ec._FermentationStep_mode(ctx, field, obj)
+		case "triggered":
+			out.Values[i] = ec._FermentationStep_triggered(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var flowDispenseImplementors = []string{"FlowDispense"}
+
+func (ec *executionContext) _FlowDispense(ctx context.Context, sel ast.SelectionSet, obj *devices.FlowDispense) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, flowDispenseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FlowDispense")
+		case "litres":
+			out.Values[i] = ec._FlowDispense_litres(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "dispensed":
+			out.Values[i] = ec._FlowDispense_dispensed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "switch":
+			out.Values[i] = ec._FlowDispense_switch(ctx, field, obj)
+		case "started":
+			out.Values[i] = ec._FlowDispense_started(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "finished":
+			out.Values[i] = ec._FlowDispense_finished(ctx, field, obj)
+		case "error":
+			out.Values[i] = ec._FlowDispense_error(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var flowMeterImplementors = []string{"FlowMeter"}
+
+func (ec *executionContext) _FlowMeter(ctx context.Context, sel ast.SelectionSet, obj *devices.FlowMeter) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, flowMeterImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FlowMeter")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FlowMeter_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "name":
+			out.Values[i] = ec._FlowMeter_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "gpio":
+			out.Values[i] = ec._FlowMeter_gpio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "pulsesPerLitre":
+			out.Values[i] = ec._FlowMeter_pulsesPerLitre(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "noFlowTimeout":
+			out.Values[i] = ec._FlowMeter_noFlowTimeout(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "volume":
+			out.Values[i] = ec._FlowMeter_volume(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "lifetimeVolume":
+			out.Values[i] = ec._FlowMeter_lifetimeVolume(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "rate":
+			out.Values[i] = ec._FlowMeter_rate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "dispensing":
+			out.Values[i] = ec._FlowMeter_dispensing(ctx, field, obj)
+		case "connectError":
+			out.Values[i] = ec._FlowMeter_connectError(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var gravityReadingImplementors = []string{"GravityReading"}
+
+func (ec *executionContext) _GravityReading(ctx context.Context, sel ast.SelectionSet, obj *devices.GravityReading) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, gravityReadingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GravityReading")
+		case "gravity":
+			out.Values[i] = ec._GravityReading_gravity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "time":
+			out.Values[i] = ec._GravityReading_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var hysteriaSettingsImplementors = []string{"HysteriaSettings"}
+
+func (ec *executionContext) _HysteriaSettings(ctx context.Context, sel ast.SelectionSet, obj *devices.HysteriaSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, hysteriaSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HysteriaSettings")
+		case "configured":
+			out.Values[i] = ec._HysteriaSettings_configured(ctx, field, obj)
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._HysteriaSettings_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "maxTemp":
+			out.Values[i] = ec._HysteriaSettings_maxTemp(ctx, field, obj)
+		case "minTemp":
+			out.Values[i] = ec._HysteriaSettings_minTemp(ctx, field, obj)
+		case "minTime":
+			out.Values[i] = ec._HysteriaSettings_minTime(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var inPinImplementors = []string{"InPin"}
+
+func (ec *executionContext) _InPin(ctx context.Context, sel ast.SelectionSet, obj *devices.InPin) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, inPinImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InPin")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._InPin_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "name":
+			out.Values[i] = ec._InPin_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "gpio":
+			out.Values[i] = ec._InPin_gpio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "pull":
+			out.Values[i] = ec._InPin_pull(ctx, field, obj)
+		case "inverted":
+			out.Values[i] = ec._InPin_inverted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "debounce":
+			out.Values[i] = ec._InPin_debounce(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "action":
+			out.Values[i] = ec._InPin_action(ctx, field, obj)
+		case "switch":
+			out.Values[i] = ec._InPin_switch(ctx, field, obj)
+		case "controller":
+			out.Values[i] = ec._InPin_controller(ctx, field, obj)
+		case "active":
+			out.Values[i] = ec._InPin_active(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "changed":
+			out.Values[i] = ec._InPin_changed(ctx, field, obj)
+		case "connectError":
+			out.Values[i] = ec._InPin_connectError(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var manualSettingsImplementors = []string{"ManualSettings"}
+
+func (ec *executionContext) _ManualSettings(ctx context.Context, sel ast.SelectionSet, obj *devices.ManualSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, manualSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ManualSettings")
+		case "configured":
+			out.Values[i] = ec._ManualSettings_configured(ctx, field, obj)
+		case "cycleTime":
+			out.Values[i] = ec._ManualSettings_cycleTime(ctx, field, obj)
+		case "dutyCycle":
+			out.Values[i] = ec._ManualSettings_dutyCycle(ctx, field, obj)
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._ManualSettings_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var modbusRegisterImplementors = []string{"ModbusRegister"}
+
+func (ec *executionContext) _ModbusRegister(ctx context.Context, sel ast.SelectionSet, obj *model.ModbusRegister) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, modbusRegisterImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ModbusRegister")
+		case "table":
+			out.Values[i] = ec._ModbusRegister_table(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "address":
+			out.Values[i] = ec._ModbusRegister_address(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._ModbusRegister_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec._ModbusRegister_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "writable":
+			out.Values[i] = ec._ModbusRegister_writable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "value":
+			out.Values[i] = ec._ModbusRegister_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
 	})
-	if err != nil {
-		ec.Error(ctx, err)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "assignProbe":
+			out.Values[i] = ec._Mutation_assignProbe(ctx, field)
+		case "removeProbeFromTemperatureController":
+			out.Values[i] = ec._Mutation_removeProbeFromTemperatureController(ctx, field)
+		case "updateTemperatureController":
+			out.Values[i] = ec._Mutation_updateTemperatureController(ctx, field)
+		case "deleteTemperatureController":
+			out.Values[i] = ec._Mutation_deleteTemperatureController(ctx, field)
+		case "updateSettings":
+			out.Values[i] = ec._Mutation_updateSettings(ctx, field)
+		case "modifySwitch":
+			out.Values[i] = ec._Mutation_modifySwitch(ctx, field)
+		case "deleteSwitch":
+			out.Values[i] = ec._Mutation_deleteSwitch(ctx, field)
+		case "toggleSwitch":
+			out.Values[i] = ec._Mutation_toggleSwitch(ctx, field)
+		case "switchOnFor":
+			out.Values[i] = ec._Mutation_switchOnFor(ctx, field)
+		case "pulseSwitch":
+			out.Values[i] = ec._Mutation_pulseSwitch(ctx, field)
+		case "calibrateProbe":
+			out.Values[i] = ec._Mutation_calibrateProbe(ctx, field)
+		case "resetProbeCalibration":
+			out.Values[i] = ec._Mutation_resetProbeCalibration(ctx, field)
+		case "updateTempProbeDetails":
+			out.Values[i] = ec._Mutation_updateTempProbeDetails(ctx, field)
+		case "updateProbe":
+			out.Values[i] = ec._Mutation_updateProbe(ctx, field)
+		case "forgetProbe":
+			out.Values[i] = ec._Mutation_forgetProbe(ctx, field)
+		case "rescanProbes":
+			out.Values[i] = ec._Mutation_rescanProbes(ctx, field)
+		case "modifySPIProbe":
+			out.Values[i] = ec._Mutation_modifySPIProbe(ctx, field)
+		case "deleteSPIProbe":
+			out.Values[i] = ec._Mutation_deleteSPIProbe(ctx, field)
+		case "modifyExpander":
+			out.Values[i] = ec._Mutation_modifyExpander(ctx, field)
+		case "deleteExpander":
+			out.Values[i] = ec._Mutation_deleteExpander(ctx, field)
+		case "modifyWebhook":
+			out.Values[i] = ec._Mutation_modifyWebhook(ctx, field)
+		case "deleteWebhook":
+			out.Values[i] = ec._Mutation_deleteWebhook(ctx, field)
+		case "testWebhook":
+			out.Values[i] = ec._Mutation_testWebhook(ctx, field)
+		case "modifyAlertRule":
+			out.Values[i] = ec._Mutation_modifyAlertRule(ctx, field)
+		case "deleteAlertRule":
+			out.Values[i] = ec._Mutation_deleteAlertRule(ctx, field)
+		case "acknowledgeAlert":
+			out.Values[i] = ec._Mutation_acknowledgeAlert(ctx, field)
+		case "silenceAlert":
+			out.Values[i] = ec._Mutation_silenceAlert(ctx, field)
+		case "modifyAlertChannel":
+			out.Values[i] = ec._Mutation_modifyAlertChannel(ctx, field)
+		case "deleteAlertChannel":
+			out.Values[i] = ec._Mutation_deleteAlertChannel(ctx, field)
+		case "testAlertChannel":
+			out.Values[i] = ec._Mutation_testAlertChannel(ctx, field)
+		case "modifyAutomationRule":
+			out.Values[i] = ec._Mutation_modifyAutomationRule(ctx, field)
+		case "deleteAutomationRule":
+			out.Values[i] = ec._Mutation_deleteAutomationRule(ctx, field)
+		case "modifySchedule":
+			out.Values[i] = ec._Mutation_modifySchedule(ctx, field)
+		case "deleteSchedule":
+			out.Values[i] = ec._Mutation_deleteSchedule(ctx, field)
+		case "runSchedule":
+			out.Values[i] = ec._Mutation_runSchedule(ctx, field)
+		case "modifySwitchGroup":
+			out.Values[i] = ec._Mutation_modifySwitchGroup(ctx, field)
+		case "deleteSwitchGroup":
+			out.Values[i] = ec._Mutation_deleteSwitchGroup(ctx, field)
+		case "toggleSwitchGroup":
+			out.Values[i] = ec._Mutation_toggleSwitchGroup(ctx, field)
+		case "modifyScene":
+			out.Values[i] = ec._Mutation_modifyScene(ctx, field)
+		case "deleteScene":
+			out.Values[i] = ec._Mutation_deleteScene(ctx, field)
+		case "applyScene":
+			out.Values[i] = ec._Mutation_applyScene(ctx, field)
+		case "modifyInPin":
+			out.Values[i] = ec._Mutation_modifyInPin(ctx, field)
+		case "deleteInPin":
+			out.Values[i] = ec._Mutation_deleteInPin(ctx, field)
+		case "modifyFlowMeter":
+			out.Values[i] = ec._Mutation_modifyFlowMeter(ctx, field)
+		case "deleteFlowMeter":
+			out.Values[i] = ec._Mutation_deleteFlowMeter(ctx, field)
+		case "resetFlowMeter":
+			out.Values[i] = ec._Mutation_resetFlowMeter(ctx, field)
+		case "dispense":
+			out.Values[i] = ec._Mutation_dispense(ctx, field)
+		case "cancelDispense":
+			out.Values[i] = ec._Mutation_cancelDispense(ctx, field)
+		case "startFermentation":
+			out.Values[i] = ec._Mutation_startFermentation(ctx, field)
+		case "recordGravity":
+			out.Values[i] = ec._Mutation_recordGravity(ctx, field)
+		case "endFermentation":
+			out.Values[i] = ec._Mutation_endFermentation(ctx, field)
+		case "createBackup":
+			out.Values[i] = ec._Mutation_createBackup(ctx, field)
+		case "restoreBackup":
+			out.Values[i] = ec._Mutation_restoreBackup(ctx, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var pidSettingsImplementors = []string{"PidSettings"}
+
+func (ec *executionContext) _PidSettings(ctx context.Context, sel ast.SelectionSet, obj *devices.PidSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pidSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PidSettings")
+		case "configured":
+			out.Values[i] = ec._PidSettings_configured(ctx, field, obj)
+		case "cycleTime":
+			out.Values[i] = ec._PidSettings_cycleTime(ctx, field, obj)
+		case "delay":
+			out.Values[i] = ec._PidSettings_delay(ctx, field, obj)
+		case "derivative":
+			out.Values[i] = ec._PidSettings_derivative(ctx, field, obj)
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PidSettings_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "integral":
+			out.Values[i] = ec._PidSettings_integral(ctx, field, obj)
+		case "proportional":
+			out.Values[i] = ec._PidSettings_proportional(ctx, field, obj)
+		case "gpio":
+			out.Values[i] = ec._PidSettings_gpio(ctx, field, obj)
+		case "frequency":
+			out.Values[i] = ec._PidSettings_frequency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var probeCalibrationImplementors = []string{"ProbeCalibration"}
+
+func (ec *executionContext) _ProbeCalibration(ctx context.Context, sel ast.SelectionSet, obj *model.ProbeCalibration) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, probeCalibrationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProbeCalibration")
+		case "mode":
+			out.Values[i] = ec._ProbeCalibration_mode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "offset":
+			out.Values[i] = ec._ProbeCalibration_offset(ctx, field, obj)
+		case "lowRawReading":
+			out.Values[i] = ec._ProbeCalibration_lowRawReading(ctx, field, obj)
+		case "lowReference":
+			out.Values[i] = ec._ProbeCalibration_lowReference(ctx, field, obj)
+		case "highRawReading":
+			out.Values[i] = ec._ProbeCalibration_highRawReading(ctx, field, obj)
+		case "highReference":
+			out.Values[i] = ec._ProbeCalibration_highReference(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var probeEventImplementors = []string{"ProbeEvent"}
+
+func (ec *executionContext) _ProbeEvent(ctx context.Context, sel ast.SelectionSet, obj *model.ProbeEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, probeEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProbeEvent")
+		case "type":
+			out.Values[i] = ec._ProbeEvent_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "physAddr":
+			out.Values[i] = ec._ProbeEvent_physAddr(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "time":
+			out.Values[i] = ec._ProbeEvent_time(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var probeScanImplementors = []string{"ProbeScan"}
+
+func (ec *executionContext) _ProbeScan(ctx context.Context, sel ast.SelectionSet, obj *model.ProbeScan) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, probeScanImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProbeScan")
+		case "discovered":
+			out.Values[i] = ec._ProbeScan_discovered(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lost":
+			out.Values[i] = ec._ProbeScan_lost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "probe":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_probe(ctx, field)
+				return res
+			})
+		case "probeList":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_probeList(ctx, field)
+				return res
+			})
+		case "fetchProbes":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_fetchProbes(ctx, field)
+				return res
+			})
+		case "registeredProbes":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_registeredProbes(ctx, field)
+				return res
+			})
+		case "spiProbes":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_spiProbes(ctx, field)
+				return res
+			})
+		case "probeEvents":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_probeEvents(ctx, field)
+				return res
+			})
+		case "deviceEvents":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_deviceEvents(ctx, field)
+				return res
+			})
+		case "webhooks":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_webhooks(ctx, field)
+				return res
+			})
+		case "webhookDeliveries":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_webhookDeliveries(ctx, field)
+				return res
+			})
+		case "alertRules":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_alertRules(ctx, field)
+				return res
+			})
+		case "alertChannels":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_alertChannels(ctx, field)
+				return res
+			})
+		case "automationRules":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_automationRules(ctx, field)
+				return res
+			})
+		case "evaluateAutomationRule":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_evaluateAutomationRule(ctx, field)
+				return res
+			})
+		case "schedules":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_schedules(ctx, field)
+				return res
+			})
+		case "switchGroups":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_switchGroups(ctx, field)
+				return res
+			})
+		case "scenes":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_scenes(ctx, field)
+				return res
+			})
+		case "expanders":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_expanders(ctx, field)
+				return res
+			})
+		case "inPins":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_inPins(ctx, field)
+				return res
+			})
+		case "flowMeters":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_flowMeters(ctx, field)
+				return res
+			})
+		case "temperatureControllers":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_temperatureControllers(ctx, field)
+				return res
+			})
+		case "settings":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_settings(ctx, field)
+				return res
+			})
+		case "switches":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_switches(ctx, field)
+				return res
+			})
+		case "backups":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_backups(ctx, field)
+				return res
+			})
+		case "modbusRegisters":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_modbusRegisters(ctx, field)
+				return res
+			})
+		case "__type":
+			out.Values[i] = ec._Query___type(ctx, field)
+		case "__schema":
+			out.Values[i] = ec._Query___schema(ctx, field)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(devices.ManualSettings)
-	fc.Result = res
-	return ec.marshalOManualSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐManualSettings(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) _TemperatureController_mode(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+var sPIProbeImplementors = []string{"SPIProbe"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Mode, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(model.ControllerMode)
-	fc.Result = res
-	return ec.marshalOControllerMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx, field.Selections, res)
-}
+func (ec *executionContext) _SPIProbe(ctx context.Context, sel ast.SelectionSet, obj *devices.SPIProbe) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sPIProbeImplementors)
 
-func (ec *executionContext) _TemperatureController_name(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SPIProbe")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SPIProbe_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "physAddr":
+			out.Values[i] = ec._SPIProbe_physAddr(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "driver":
+			out.Values[i] = ec._SPIProbe_driver(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "bus":
+			out.Values[i] = ec._SPIProbe_bus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "chipSelect":
+			out.Values[i] = ec._SPIProbe_chipSelect(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "wires":
+			out.Values[i] = ec._SPIProbe_wires(ctx, field, obj)
+		case "referenceResistor":
+			out.Values[i] = ec._SPIProbe_referenceResistor(ctx, field, obj)
+		case "nominalResistance":
+			out.Values[i] = ec._SPIProbe_nominalResistance(ctx, field, obj)
+		case "filter50Hz":
+			out.Values[i] = ec._SPIProbe_filter50Hz(ctx, field, obj)
+		case "connectError":
+			out.Values[i] = ec._SPIProbe_connectError(ctx, field, obj)
+		case "probe":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SPIProbe_probe(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) _TemperatureController_previousCalculationTime(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+var sceneImplementors = []string{"Scene"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PreviousCalculationTime, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) _Scene(ctx context.Context, sel ast.SelectionSet, obj *devices.Scene) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sceneImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Scene")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Scene_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "name":
+			out.Values[i] = ec._Scene_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "steps":
+			out.Values[i] = ec._Scene_steps(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "interlocks":
+			out.Values[i] = ec._Scene_interlocks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "applying":
+			out.Values[i] = ec._Scene_applying(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "lastApplied":
+			out.Values[i] = ec._Scene_lastApplied(ctx, field, obj)
+		case "lastResult":
+			out.Values[i] = ec._Scene_lastResult(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "lastError":
+			out.Values[i] = ec._Scene_lastError(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
-	fc.Result = res
-	return ec.marshalOTime2timeᚐTime(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) _TemperatureController_setPoint(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
+var sceneStepImplementors = []string{"SceneStep"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SetPoint(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) _SceneStep(ctx context.Context, sel ast.SelectionSet, obj *devices.SceneStep) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sceneStepImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SceneStep")
+		case "switch":
+			out.Values[i] = ec._SceneStep_switch(ctx, field, obj)
+		case "group":
+			out.Values[i] = ec._SceneStep_group(ctx, field, obj)
+		case "state":
+			out.Values[i] = ec._SceneStep_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "delaySeconds":
+			out.Values[i] = ec._SceneStep_delaySeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) _TemperatureController_tempProbeDetails(ctx context.Context, field graphql.CollectedField, obj *devices.TemperatureController) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureController",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
-	}
+var scheduleImplementors = []string{"Schedule"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.TemperatureController().TempProbeDetails(rctx, obj)
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) _Schedule(ctx context.Context, sel ast.SelectionSet, obj *devices.Schedule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, scheduleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Schedule")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Schedule_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "name":
+			out.Values[i] = ec._Schedule_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "enabled":
+			out.Values[i] = ec._Schedule_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "cron":
+			out.Values[i] = ec._Schedule_cron(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "at":
+			out.Values[i] = ec._Schedule_at(ctx, field, obj)
+		case "missedRun":
+			out.Values[i] = ec._Schedule_missedRun(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "actions":
+			out.Values[i] = ec._Schedule_actions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "nextRun":
+			out.Values[i] = ec._Schedule_nextRun(ctx, field, obj)
+		case "lastRun":
+			out.Values[i] = ec._Schedule_lastRun(ctx, field, obj)
+		case "lastResult":
+			out.Values[i] = ec._Schedule_lastResult(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "lastError":
+			out.Values[i] = ec._Schedule_lastError(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TempProbeDetails)
-	fc.Result = res
-	return ec.marshalOTempProbeDetails2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTempProbeDetails(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) _TemperatureProbe_physAddr(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var settingsImplementors = []string{"Settings"}
+
+func (ec *executionContext) _Settings(ctx context.Context, sel ast.SelectionSet, obj *system.Settings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, settingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Settings")
+		case "breweryName":
+			out.Values[i] = ec._Settings_breweryName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "timezone":
+			out.Values[i] = ec._Settings_timezone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureProbe",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
 	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PhysAddr, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
+	return out
+}
+
+var switchImplementors = []string{"Switch"}
+
+func (ec *executionContext) _Switch(ctx context.Context, sel ast.SelectionSet, obj *devices.Switch) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, switchImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Switch")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Switch_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "gpio":
+			out.Values[i] = ec._Switch_gpio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Switch_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "state":
+			out.Values[i] = ec._Switch_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "networked":
+			out.Values[i] = ec._Switch_networked(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "outputError":
+			out.Values[i] = ec._Switch_outputError(ctx, field, obj)
+		case "frequency":
+			out.Values[i] = ec._Switch_frequency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "duty":
+			out.Values[i] = ec._Switch_duty(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "softwarePWM":
+			out.Values[i] = ec._Switch_softwarePWM(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "maxOnSeconds":
+			out.Values[i] = ec._Switch_maxOnSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "autoOffSeconds":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Switch_autoOffSeconds(ctx, field, obj)
+				return res
+			})
+		case "timer":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Switch_timer(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) _TemperatureProbe_reading(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureProbe",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+var switchGroupImplementors = []string{"SwitchGroup"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Reading, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) _SwitchGroup(ctx context.Context, sel ast.SelectionSet, obj *devices.SwitchGroup) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, switchGroupImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SwitchGroup")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SwitchGroup_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "name":
+			out.Values[i] = ec._SwitchGroup_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "switches":
+			out.Values[i] = ec._SwitchGroup_switches(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) _TemperatureProbe_updated(ctx context.Context, field graphql.CollectedField, obj *model.TemperatureProbe) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "TemperatureProbe",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+var switchTimerImplementors = []string{"SwitchTimer"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Updated, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) _SwitchTimer(ctx context.Context, sel ast.SelectionSet, obj *devices.SwitchTimer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, switchTimerImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SwitchTimer")
+		case "kind":
+			out.Values[i] = ec._SwitchTimer_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "onSeconds":
+			out.Values[i] = ec._SwitchTimer_onSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "offSeconds":
+			out.Values[i] = ec._SwitchTimer_offSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "on":
+			out.Values[i] = ec._SwitchTimer_on(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "cyclesLeft":
+			out.Values[i] = ec._SwitchTimer_cyclesLeft(ctx, field, obj)
+		case "remainingSeconds":
+			out.Values[i] = ec._SwitchTimer_remainingSeconds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
-	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+var tempProbeDetailsImplementors = []string{"TempProbeDetails"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+func (ec *executionContext) _TempProbeDetails(ctx context.Context, sel ast.SelectionSet, obj *model.TempProbeDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tempProbeDetailsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TempProbeDetails")
+		case "id":
+			out.Values[i] = ec._TempProbeDetails_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "physAddr":
+			out.Values[i] = ec._TempProbeDetails_physAddr(ctx, field, obj)
+		case "reading":
+			out.Values[i] = ec._TempProbeDetails_reading(ctx, field, obj)
+		case "rawReading":
+			out.Values[i] = ec._TempProbeDetails_rawReading(ctx, field, obj)
+		case "gravity":
+			out.Values[i] = ec._TempProbeDetails_gravity(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._TempProbeDetails_name(ctx, field, obj)
+		case "updated":
+			out.Values[i] = ec._TempProbeDetails_updated(ctx, field, obj)
+		case "calibration":
+			out.Values[i] = ec._TempProbeDetails_calibration(ctx, field, obj)
+		case "weight":
+			out.Values[i] = ec._TempProbeDetails_weight(ctx, field, obj)
+		case "priority":
+			out.Values[i] = ec._TempProbeDetails_priority(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._TempProbeDetails_status(ctx, field, obj)
+		case "fault":
+			out.Values[i] = ec._TempProbeDetails_fault(ctx, field, obj)
+		case "contributing":
+			out.Values[i] = ec._TempProbeDetails_contributing(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+var temperatureControllerImplementors = []string{"TemperatureController"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) _TemperatureController(ctx context.Context, sel ast.SelectionSet, obj *devices.TemperatureController) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, temperatureControllerImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TemperatureController")
+		case "calculatedDuty":
+			out.Values[i] = ec._TemperatureController_calculatedDuty(ctx, field, obj)
+		case "coolSettings":
+			out.Values[i] = ec._TemperatureController_coolSettings(ctx, field, obj)
+		case "dutyCycle":
+			out.Values[i] = ec._TemperatureController_dutyCycle(ctx, field, obj)
+		case "heatSettings":
+			out.Values[i] = ec._TemperatureController_heatSettings(ctx, field, obj)
+		case "hysteriaSettings":
+			out.Values[i] = ec._TemperatureController_hysteriaSettings(ctx, field, obj)
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._TemperatureController_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "manualSettings":
+			out.Values[i] = ec._TemperatureController_manualSettings(ctx, field, obj)
+		case "mode":
+			out.Values[i] = ec._TemperatureController_mode(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._TemperatureController_name(ctx, field, obj)
+		case "previousCalculationTime":
+			out.Values[i] = ec._TemperatureController_previousCalculationTime(ctx, field, obj)
+		case "setPoint":
+			out.Values[i] = ec._TemperatureController_setPoint(ctx, field, obj)
+		case "tempProbeDetails":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._TemperatureController_tempProbeDetails(ctx, field, obj)
+				return res
+			})
+		case "aggregation":
+			out.Values[i] = ec._TemperatureController_aggregation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "staleAfter":
+			out.Values[i] = ec._TemperatureController_staleAfter(ctx, field, obj)
+		case "contributingProbes":
+			out.Values[i] = ec._TemperatureController_contributingProbes(ctx, field, obj)
+		case "fermentation":
+			out.Values[i] = ec._TemperatureController_fermentation(ctx, field, obj)
+		case "interlocked":
+			out.Values[i] = ec._TemperatureController_interlocked(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+var temperatureProbeImplementors = []string{"TemperatureProbe"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+func (ec *executionContext) _TemperatureProbe(ctx context.Context, sel ast.SelectionSet, obj *model.TemperatureProbe) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, temperatureProbeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TemperatureProbe")
+		case "physAddr":
+			out.Values[i] = ec._TemperatureProbe_physAddr(ctx, field, obj)
+		case "reading":
+			out.Values[i] = ec._TemperatureProbe_reading(ctx, field, obj)
+		case "updated":
+			out.Values[i] = ec._TemperatureProbe_updated(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._TemperatureProbe_name(ctx, field, obj)
+		case "location":
+			out.Values[i] = ec._TemperatureProbe_location(ctx, field, obj)
+		case "notes":
+			out.Values[i] = ec._TemperatureProbe_notes(ctx, field, obj)
+		case "sensorType":
+			out.Values[i] = ec._TemperatureProbe_sensorType(ctx, field, obj)
+		case "enabled":
+			out.Values[i] = ec._TemperatureProbe_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "connected":
+			out.Values[i] = ec._TemperatureProbe_connected(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "resolution":
+			out.Values[i] = ec._TemperatureProbe_resolution(ctx, field, obj)
+		case "readLatency":
+			out.Values[i] = ec._TemperatureProbe_readLatency(ctx, field, obj)
+		case "reads":
+			out.Values[i] = ec._TemperatureProbe_reads(ctx, field, obj)
+		case "readErrors":
+			out.Values[i] = ec._TemperatureProbe_readErrors(ctx, field, obj)
+		case "coldJunction":
+			out.Values[i] = ec._TemperatureProbe_coldJunction(ctx, field, obj)
+		case "gravity":
+			out.Values[i] = ec._TemperatureProbe_gravity(ctx, field, obj)
+		case "lowLimit":
+			out.Values[i] = ec._TemperatureProbe_lowLimit(ctx, field, obj)
+		case "highLimit":
+			out.Values[i] = ec._TemperatureProbe_highLimit(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]string)
-	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+var webhookImplementors = []string{"Webhook"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+func (ec *executionContext) _Webhook(ctx context.Context, sel ast.SelectionSet, obj *devices.Webhook) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Webhook")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Webhook_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "name":
+			out.Values[i] = ec._Webhook_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "url":
+			out.Values[i] = ec._Webhook_url(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "events":
+			out.Values[i] = ec._Webhook_events(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "secret":
+			out.Values[i] = ec._Webhook_secret(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "enabled":
+			out.Values[i] = ec._Webhook_enabled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "deliveries":
+			out.Values[i] = ec._Webhook_deliveries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
-	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var webhookDeliveryImplementors = []string{"WebhookDelivery"}
+
+func (ec *executionContext) _WebhookDelivery(ctx context.Context, sel ast.SelectionSet, obj *devices.WebhookDelivery) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, webhookDeliveryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WebhookDelivery")
+		case "id":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._WebhookDelivery_id(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "webhookId":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._WebhookDelivery_webhookId(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "event":
+			out.Values[i] = ec._WebhookDelivery_event(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "payload":
+			out.Values[i] = ec._WebhookDelivery_payload(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "status":
+			out.Values[i] = ec._WebhookDelivery_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "attempts":
+			out.Values[i] = ec._WebhookDelivery_attempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "responseCode":
+			out.Values[i] = ec._WebhookDelivery_responseCode(ctx, field, obj)
+		case "error":
+			out.Values[i] = ec._WebhookDelivery_error(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._WebhookDelivery_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._WebhookDelivery_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
 	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+var __EnumValueImplementors = []string{"__EnumValue"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var __FieldImplementors = []string{"__Field"}
+
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
 	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	return out
+}
+
+var __InputValueImplementors = []string{"__InputValue"}
+
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
+var __SchemaImplementors = []string{"__Schema"}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-	if resTmp == nil {
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+var __TypeImplementors = []string{"__Type"}
+
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
+	out.Dispatch()
+	if invalids > 0 {
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return out
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+// endregion **************************** object.gotpl ****************************
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNAggregationMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAggregationMode(ctx context.Context, v interface{}) (model.AggregationMode, error) {
+	var res model.AggregationMode
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+func (ec *executionContext) marshalNAggregationMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAggregationMode(ctx context.Context, sel ast.SelectionSet, v model.AggregationMode) graphql.Marshaler {
+	return v
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) marshalNAlertChannel2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertChannelᚄ(ctx context.Context, sel ast.SelectionSet, v []*devices.AlertChannel) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.InputValue)
-	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAlertChannel2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertChannel(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNAlertChannel2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertChannel(ctx context.Context, sel ast.SelectionSet, v *devices.AlertChannel) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec._AlertChannel(ctx, sel, v)
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
+func (ec *executionContext) unmarshalNAlertChannelInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertChannelInput(ctx context.Context, v interface{}) (model.AlertChannelInput, error) {
+	res, err := ec.unmarshalInputAlertChannelInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+func (ec *executionContext) unmarshalNAlertChannelKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertChannelKind(ctx context.Context, v interface{}) (model.AlertChannelKind, error) {
+	var res model.AlertChannelKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
+func (ec *executionContext) marshalNAlertChannelKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertChannelKind(ctx context.Context, sel ast.SelectionSet, v model.AlertChannelKind) graphql.Marshaler {
+	return v
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+func (ec *executionContext) unmarshalNAlertCondition2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertCondition(ctx context.Context, v interface{}) (model.AlertCondition, error) {
+	var res model.AlertCondition
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+func (ec *executionContext) marshalNAlertCondition2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertCondition(ctx context.Context, sel ast.SelectionSet, v model.AlertCondition) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNAlertRuleInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertRuleInput(ctx context.Context, v interface{}) (model.AlertRuleInput, error) {
+	res, err := ec.unmarshalInputAlertRuleInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNAlertState2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertState(ctx context.Context, v interface{}) (model.AlertState, error) {
+	var res model.AlertState
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAlertState2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertState(ctx context.Context, sel ast.SelectionSet, v model.AlertState) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNAutomationAction2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationActionᚄ(ctx context.Context, sel ast.SelectionSet, v []*devices.AutomationAction) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAutomationAction2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationAction(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNAutomationAction2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationAction(ctx context.Context, sel ast.SelectionSet, v *devices.AutomationAction) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec._AutomationAction(ctx, sel, v)
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
-	}
+func (ec *executionContext) unmarshalNAutomationActionInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationActionInput(ctx context.Context, v interface{}) (*model.AutomationActionInput, error) {
+	res, err := ec.unmarshalInputAutomationActionInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+func (ec *executionContext) unmarshalNAutomationActionKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationActionKind(ctx context.Context, v interface{}) (model.AutomationActionKind, error) {
+	var res model.AutomationActionKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+func (ec *executionContext) marshalNAutomationActionKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationActionKind(ctx context.Context, sel ast.SelectionSet, v model.AutomationActionKind) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNAutomationCheck2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationCheckᚄ(ctx context.Context, sel ast.SelectionSet, v []*devices.AutomationCheck) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAutomationCheck2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationCheck(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNAutomationCheck2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationCheck(ctx context.Context, sel ast.SelectionSet, v *devices.AutomationCheck) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec._AutomationCheck(ctx, sel, v)
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+func (ec *executionContext) marshalNAutomationCondition2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationConditionᚄ(ctx context.Context, sel ast.SelectionSet, v []*devices.AutomationCondition) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAutomationCondition2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationCondition(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNAutomationCondition2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationCondition(ctx context.Context, sel ast.SelectionSet, v *devices.AutomationCondition) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec._AutomationCondition(ctx, sel, v)
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
+func (ec *executionContext) unmarshalNAutomationConditionInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationConditionInput(ctx context.Context, v interface{}) (*model.AutomationConditionInput, error) {
+	res, err := ec.unmarshalInputAutomationConditionInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNAutomationConditionKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationConditionKind(ctx context.Context, v interface{}) (model.AutomationConditionKind, error) {
+	var res model.AutomationConditionKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAutomationConditionKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationConditionKind(ctx context.Context, sel ast.SelectionSet, v model.AutomationConditionKind) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNAutomationRuleInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationRuleInput(ctx context.Context, v interface{}) (model.AutomationRuleInput, error) {
+	res, err := ec.unmarshalInputAutomationRuleInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAutomationTrace2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationTraceᚄ(ctx context.Context, sel ast.SelectionSet, v []*devices.AutomationTrace) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAutomationTrace2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationTrace(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNAutomationTrace2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationTrace(ctx context.Context, sel ast.SelectionSet, v *devices.AutomationTrace) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec._AutomationTrace(ctx, sel, v)
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return res
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
+func (ec *executionContext) unmarshalNCalibrationMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐCalibrationMode(ctx context.Context, v interface{}) (model.CalibrationMode, error) {
+	var res model.CalibrationMode
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+func (ec *executionContext) marshalNCalibrationMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐCalibrationMode(ctx context.Context, sel ast.SelectionSet, v model.CalibrationMode) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) unmarshalNCalibrationPoint2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐCalibrationPoint(ctx context.Context, v interface{}) (model.CalibrationPoint, error) {
+	var res model.CalibrationPoint
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCalibrationPoint2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐCalibrationPoint(ctx context.Context, sel ast.SelectionSet, v model.CalibrationPoint) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNDeviceEventType2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx context.Context, v interface{}) (model.DeviceEventType, error) {
+	var res model.DeviceEventType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDeviceEventType2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx context.Context, sel ast.SelectionSet, v model.DeviceEventType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNDeviceEventType2ᚕgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventTypeᚄ(ctx context.Context, v interface{}) ([]model.DeviceEventType, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]model.DeviceEventType, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNDeviceEventType2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
 	}
+	return res, nil
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) marshalNDeviceEventType2ᚕgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []model.DeviceEventType) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	if resTmp == nil {
-		return graphql.Null
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDeviceEventType2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) unmarshalNExpanderChip2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐExpanderChip(ctx context.Context, v interface{}) (model.ExpanderChip, error) {
+	var res model.ExpanderChip
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNExpanderChip2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐExpanderChip(ctx context.Context, sel ast.SelectionSet, v model.ExpanderChip) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNExpanderInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐExpanderInput(ctx context.Context, v interface{}) (model.ExpanderInput, error) {
+	res, err := ec.unmarshalInputExpanderInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNFermentationInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFermentationInput(ctx context.Context, v interface{}) (model.FermentationInput, error) {
+	res, err := ec.unmarshalInputFermentationInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNFermentationStatus2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFermentationStatus(ctx context.Context, v interface{}) (model.FermentationStatus, error) {
+	var res model.FermentationStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFermentationStatus2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFermentationStatus(ctx context.Context, sel ast.SelectionSet, v model.FermentationStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNFermentationStep2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFermentationStepᚄ(ctx context.Context, sel ast.SelectionSet, v []*devices.FermentationStep) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNFermentationStep2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFermentationStep(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
+	wg.Wait()
+	return ret
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
+func (ec *executionContext) marshalNFermentationStep2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFermentationStep(ctx context.Context, sel ast.SelectionSet, v *devices.FermentationStep) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
+	return ec._FermentationStep(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNFermentationStepInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFermentationStepInput(ctx context.Context, v interface{}) (*model.FermentationStepInput, error) {
+	res, err := ec.unmarshalInputFermentationStepInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloat(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloat(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
-	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return res
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
+func (ec *executionContext) unmarshalNFlowMeterInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFlowMeterInput(ctx context.Context, v interface{}) (model.FlowMeterInput, error) {
+	res, err := ec.unmarshalInputFlowMeterInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNGravityReading2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐGravityReadingᚄ(ctx context.Context, sel ast.SelectionSet, v []*devices.GravityReading) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNGravityReading2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐGravityReading(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNGravityReading2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐGravityReading(ctx context.Context, sel ast.SelectionSet, v *devices.GravityReading) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec._GravityReading(ctx, sel, v)
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
 	}
+	return res
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+func (ec *executionContext) unmarshalNInPinInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInPinInput(ctx context.Context, v interface{}) (model.InPinInput, error) {
+	res, err := ec.unmarshalInputInPinInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
 	}
+	return res
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+func (ec *executionContext) unmarshalNInt2int64(ctx context.Context, v interface{}) (int64, error) {
+	res, err := graphql.UnmarshalInt64(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) marshalNInt2int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
+	res := graphql.MarshalInt64(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
 	}
+	return res
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_fields_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(args["includeDeprecated"].(bool)), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Field)
-	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+func (ec *executionContext) unmarshalNMissedRunPolicy2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐMissedRunPolicy(ctx context.Context, v interface{}) (model.MissedRunPolicy, error) {
+	var res model.MissedRunPolicy
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) marshalNMissedRunPolicy2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐMissedRunPolicy(ctx context.Context, sel ast.SelectionSet, v model.MissedRunPolicy) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNModbusTable2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐModbusTable(ctx context.Context, v interface{}) (model.ModbusTable, error) {
+	var res model.ModbusTable
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNModbusTable2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐModbusTable(ctx context.Context, sel ast.SelectionSet, v model.ModbusTable) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNProbeEventType2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeEventType(ctx context.Context, v interface{}) (model.ProbeEventType, error) {
+	var res model.ProbeEventType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNProbeEventType2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeEventType(ctx context.Context, sel ast.SelectionSet, v model.ProbeEventType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNProbeSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeSettingsInput(ctx context.Context, v interface{}) (model.ProbeSettingsInput, error) {
+	res, err := ec.unmarshalInputProbeSettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNSPIDriver2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSPIDriver(ctx context.Context, v interface{}) (model.SPIDriver, error) {
+	var res model.SPIDriver
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSPIDriver2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSPIDriver(ctx context.Context, sel ast.SelectionSet, v model.SPIDriver) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNSPIProbeInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSPIProbeInput(ctx context.Context, v interface{}) (model.SPIProbeInput, error) {
+	res, err := ec.unmarshalInputSPIProbeInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNSceneInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSceneInput(ctx context.Context, v interface{}) (model.SceneInput, error) {
+	res, err := ec.unmarshalInputSceneInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSceneStep2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSceneStepᚄ(ctx context.Context, sel ast.SelectionSet, v []*devices.SceneStep) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSceneStep2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSceneStep(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) marshalNSceneStep2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSceneStep(ctx context.Context, sel ast.SelectionSet, v *devices.SceneStep) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
-	}
-
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec._SceneStep(ctx, sel, v)
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+func (ec *executionContext) unmarshalNSceneStepInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSceneStepInput(ctx context.Context, v interface{}) (*model.SceneStepInput, error) {
+	res, err := ec.unmarshalInputSceneStepInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNScheduleInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐScheduleInput(ctx context.Context, v interface{}) (model.ScheduleInput, error) {
+	res, err := ec.unmarshalInputScheduleInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSettingsInput(ctx context.Context, v interface{}) (model.SettingsInput, error) {
+	res, err := ec.unmarshalInputSettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
 	}
+	return res
+}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
 	}
-	if resTmp == nil {
-		return graphql.Null
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	res := resTmp.([]introspection.EnumValue)
-	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return res, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
 	}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.([]introspection.InputValue)
-	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ret
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	fc := &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		Args:       nil,
-		IsMethod:   true,
-		IsResolver: false,
+func (ec *executionContext) marshalNSwitch2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchᚄ(ctx context.Context, sel ast.SelectionSet, v []*devices.Switch) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	ctx = graphql.WithFieldContext(ctx, fc)
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
 	}
-	if resTmp == nil {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitch(ctx context.Context, sel ast.SelectionSet, v *devices.Switch) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
-	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec._Switch(ctx, sel, v)
 }
 
-// endregion **************************** field.gotpl *****************************
+func (ec *executionContext) unmarshalNSwitchGroupInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchGroupInput(ctx context.Context, v interface{}) (model.SwitchGroupInput, error) {
+	res, err := ec.unmarshalInputSwitchGroupInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) unmarshalNSwitchMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx context.Context, v interface{}) (model.SwitchMode, error) {
+	var res model.SwitchMode
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) unmarshalInputHysteriaSettingsInput(ctx context.Context, obj interface{}) (model.HysteriaSettingsInput, error) {
-	var it model.HysteriaSettingsInput
-	var asMap = obj.(map[string]interface{})
+func (ec *executionContext) marshalNSwitchMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx context.Context, sel ast.SelectionSet, v model.SwitchMode) graphql.Marshaler {
+	return v
+}
 
-	for k, v := range asMap {
-		switch k {
-		case "configured":
-			var err error
+func (ec *executionContext) unmarshalNSwitchSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchSettingsInput(ctx context.Context, v interface{}) (model.SwitchSettingsInput, error) {
+	res, err := ec.unmarshalInputSwitchSettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("configured"))
-			it.Configured, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "maxTemp":
-			var err error
+func (ec *executionContext) unmarshalNSwitchTimerKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchTimerKind(ctx context.Context, v interface{}) (model.SwitchTimerKind, error) {
+	var res model.SwitchTimerKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSwitchTimerKind2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchTimerKind(ctx context.Context, sel ast.SelectionSet, v model.SwitchTimerKind) graphql.Marshaler {
+	return v
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxTemp"))
-			it.MaxTemp, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "minTemp":
-			var err error
+func (ec *executionContext) unmarshalNTempProbeDetailsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTempProbeDetailsInput(ctx context.Context, v interface{}) (model.TempProbeDetailsInput, error) {
+	res, err := ec.unmarshalInputTempProbeDetailsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minTemp"))
-			it.MinTemp, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "minTime":
-			var err error
+func (ec *executionContext) unmarshalNTemperatureControllerSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureControllerSettingsInput(ctx context.Context, v interface{}) (model.TemperatureControllerSettingsInput, error) {
+	res, err := ec.unmarshalInputTemperatureControllerSettingsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minTime"))
-			it.MinTime, err = ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) marshalNTemperatureProbe2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TemperatureProbe) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNTemperatureProbe2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
+	wg.Wait()
+	return ret
+}
 
-	return it, nil
+func (ec *executionContext) marshalNTemperatureProbe2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureProbe(ctx context.Context, sel ast.SelectionSet, v *model.TemperatureProbe) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._TemperatureProbe(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalInputManualSettingsInput(ctx context.Context, obj interface{}) (model.ManualSettingsInput, error) {
-	var it model.ManualSettingsInput
-	var asMap = obj.(map[string]interface{})
+func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for k, v := range asMap {
-		switch k {
-		case "configured":
-			var err error
+func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	res := graphql.MarshalTime(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("configured"))
-			it.Configured, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) marshalNWebhookDelivery2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhookDeliveryᚄ(ctx context.Context, sel ast.SelectionSet, v []*devices.WebhookDelivery) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "cycleTime":
-			var err error
+			ret[i] = ec.marshalNWebhookDelivery2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhookDelivery(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cycleTime"))
-			it.CycleTime, err = ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "dutyCycle":
-			var err error
+	}
+	wg.Wait()
+	return ret
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dutyCycle"))
-			it.DutyCycle, err = ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) marshalNWebhookDelivery2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhookDelivery(ctx context.Context, sel ast.SelectionSet, v *devices.WebhookDelivery) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-
-	return it, nil
+	return ec._WebhookDelivery(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalInputPidSettingsInput(ctx context.Context, obj interface{}) (model.PidSettingsInput, error) {
-	var it model.PidSettingsInput
-	var asMap = obj.(map[string]interface{})
+func (ec *executionContext) unmarshalNWebhookDeliveryStatus2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐWebhookDeliveryStatus(ctx context.Context, v interface{}) (model.WebhookDeliveryStatus, error) {
+	var res model.WebhookDeliveryStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for k, v := range asMap {
-		switch k {
-		case "configured":
-			var err error
+func (ec *executionContext) marshalNWebhookDeliveryStatus2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐWebhookDeliveryStatus(ctx context.Context, sel ast.SelectionSet, v model.WebhookDeliveryStatus) graphql.Marshaler {
+	return v
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("configured"))
-			it.Configured, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "cycleTime":
-			var err error
+func (ec *executionContext) unmarshalNWebhookInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐWebhookInput(ctx context.Context, v interface{}) (model.WebhookInput, error) {
+	res, err := ec.unmarshalInputWebhookInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cycleTime"))
-			it.CycleTime, err = ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "delay":
-			var err error
+func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
+	return ec.___Directive(ctx, sel, &v)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("delay"))
-			it.Delay, err = ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "derivative":
-			var err error
+			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("derivative"))
-			it.Derivative, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "integral":
-			var err error
+	}
+	wg.Wait()
+	return ret
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("integral"))
-			it.Integral, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "proportional":
-			var err error
+func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("proportional"))
-			it.Proportional, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "gpio":
-			var err error
+func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gpio"))
-			it.Gpio, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
 	}
-
-	return it, nil
+	return res, nil
 }
 
-func (ec *executionContext) unmarshalInputSettingsInput(ctx context.Context, obj interface{}) (model.SettingsInput, error) {
-	var it model.SettingsInput
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "breweryName":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("breweryName"))
-			it.BreweryName, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
 
-	return it, nil
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) unmarshalInputSwitchSettingsInput(ctx context.Context, obj interface{}) (model.SwitchSettingsInput, error) {
-	var it model.SwitchSettingsInput
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "id":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-			it.ID, err = ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "name":
-			var err error
+func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
+	return ec.___EnumValue(ctx, sel, &v)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "gpio":
-			var err error
+func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
+	return ec.___Field(ctx, sel, &v)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gpio"))
-			it.Gpio, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "state":
-			var err error
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("state"))
-			it.State, err = ec.unmarshalOSwitchMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
 
-	return it, nil
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) unmarshalInputTemperatureControllerSettingsInput(ctx context.Context, obj interface{}) (model.TemperatureControllerSettingsInput, error) {
-	var it model.TemperatureControllerSettingsInput
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "id":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-			it.ID, err = ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "name":
-			var err error
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			it.Name, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "mode":
-			var err error
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mode"))
-			it.Mode, err = ec.unmarshalOControllerMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "coolSettings":
-			var err error
+	}
+	wg.Wait()
+	return ret
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("coolSettings"))
-			it.CoolSettings, err = ec.unmarshalOPidSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐPidSettingsInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "heatSettings":
-			var err error
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec.___Type(ctx, sel, v)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("heatSettings"))
-			it.HeatSettings, err = ec.unmarshalOPidSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐPidSettingsInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "hysteriaSettings":
-			var err error
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("hysteriaSettings"))
-			it.HysteriaSettings, err = ec.unmarshalOHysteriaSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐHysteriaSettingsInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "manualSettings":
-			var err error
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("manualSettings"))
-			it.ManualSettings, err = ec.unmarshalOManualSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐManualSettingsInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "setPoint":
-			var err error
+func (ec *executionContext) unmarshalOAggregationMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAggregationMode(ctx context.Context, v interface{}) (*model.AggregationMode, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.AggregationMode)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("setPoint"))
-			it.SetPoint, err = ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) marshalOAggregationMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAggregationMode(ctx context.Context, sel ast.SelectionSet, v *model.AggregationMode) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOAlertChannel2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertChannel(ctx context.Context, sel ast.SelectionSet, v []*devices.AlertChannel) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalOAlertChannel2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertChannel(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
 
-	return it, nil
+	}
+	wg.Wait()
+	return ret
 }
 
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) marshalOAlertChannel2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertChannel(ctx context.Context, sel ast.SelectionSet, v *devices.AlertChannel) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AlertChannel(ctx, sel, v)
+}
 
-// endregion ************************** interface.gotpl ***************************
+func (ec *executionContext) unmarshalOAlertChannelKind2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertChannelKind(ctx context.Context, v interface{}) (*model.AlertChannelKind, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.AlertChannelKind)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-// region    **************************** object.gotpl ****************************
+func (ec *executionContext) marshalOAlertChannelKind2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertChannelKind(ctx context.Context, sel ast.SelectionSet, v *model.AlertChannelKind) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
 
-var deleteTemperatureControllerReturnTypeImplementors = []string{"DeleteTemperatureControllerReturnType"}
+func (ec *executionContext) unmarshalOAlertCondition2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertCondition(ctx context.Context, v interface{}) (*model.AlertCondition, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.AlertCondition)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _DeleteTemperatureControllerReturnType(ctx context.Context, sel ast.SelectionSet, obj *model.DeleteTemperatureControllerReturnType) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, deleteTemperatureControllerReturnTypeImplementors)
+func (ec *executionContext) marshalOAlertCondition2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAlertCondition(ctx context.Context, sel ast.SelectionSet, v *model.AlertCondition) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("DeleteTemperatureControllerReturnType")
-		case "id":
-			out.Values[i] = ec._DeleteTemperatureControllerReturnType_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalOAlertRule2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertRule(ctx context.Context, sel ast.SelectionSet, v []*devices.AlertRule) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "temperatureProbes":
-			out.Values[i] = ec._DeleteTemperatureControllerReturnType_temperatureProbes(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalOAlertRule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertRule(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOAlertRule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAlertRule(ctx context.Context, sel ast.SelectionSet, v *devices.AlertRule) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return ec._AlertRule(ctx, sel, v)
 }
 
-var hysteriaSettingsImplementors = []string{"HysteriaSettings"}
-
-func (ec *executionContext) _HysteriaSettings(ctx context.Context, sel ast.SelectionSet, obj *devices.HysteriaSettings) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, hysteriaSettingsImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("HysteriaSettings")
-		case "configured":
-			out.Values[i] = ec._HysteriaSettings_configured(ctx, field, obj)
-		case "id":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._HysteriaSettings_id(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "maxTemp":
-			out.Values[i] = ec._HysteriaSettings_maxTemp(ctx, field, obj)
-		case "minTemp":
-			out.Values[i] = ec._HysteriaSettings_minTemp(ctx, field, obj)
-		case "minTime":
-			out.Values[i] = ec._HysteriaSettings_minTime(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalOAutomationActionInput2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationActionInputᚄ(ctx context.Context, v interface{}) ([]*model.AutomationActionInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
+	var err error
+	res := make([]*model.AutomationActionInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNAutomationActionInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationActionInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	return out
+	return res, nil
 }
 
-var manualSettingsImplementors = []string{"ManualSettings"}
+func (ec *executionContext) unmarshalOAutomationConditionInput2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationConditionInputᚄ(ctx context.Context, v interface{}) ([]*model.AutomationConditionInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*model.AutomationConditionInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNAutomationConditionInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationConditionInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-func (ec *executionContext) _ManualSettings(ctx context.Context, sel ast.SelectionSet, obj *devices.ManualSettings) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, manualSettingsImplementors)
+func (ec *executionContext) unmarshalOAutomationConditionInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐAutomationConditionInput(ctx context.Context, v interface{}) (*model.AutomationConditionInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputAutomationConditionInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ManualSettings")
-		case "configured":
-			out.Values[i] = ec._ManualSettings_configured(ctx, field, obj)
-		case "cycleTime":
-			out.Values[i] = ec._ManualSettings_cycleTime(ctx, field, obj)
-		case "dutyCycle":
-			out.Values[i] = ec._ManualSettings_dutyCycle(ctx, field, obj)
-		case "id":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._ManualSettings_id(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalOAutomationRule2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationRule(ctx context.Context, sel ast.SelectionSet, v []*devices.AutomationRule) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOAutomationRule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationRule(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOAutomationRule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationRule(ctx context.Context, sel ast.SelectionSet, v *devices.AutomationRule) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return ec._AutomationRule(ctx, sel, v)
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+func (ec *executionContext) marshalOAutomationTrace2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐAutomationTrace(ctx context.Context, sel ast.SelectionSet, v *devices.AutomationTrace) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._AutomationTrace(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "assignProbe":
-			out.Values[i] = ec._Mutation_assignProbe(ctx, field)
-		case "removeProbeFromTemperatureController":
-			out.Values[i] = ec._Mutation_removeProbeFromTemperatureController(ctx, field)
-		case "updateTemperatureController":
-			out.Values[i] = ec._Mutation_updateTemperatureController(ctx, field)
-		case "deleteTemperatureController":
-			out.Values[i] = ec._Mutation_deleteTemperatureController(ctx, field)
-		case "updateSettings":
-			out.Values[i] = ec._Mutation_updateSettings(ctx, field)
-		case "modifySwitch":
-			out.Values[i] = ec._Mutation_modifySwitch(ctx, field)
-		case "deleteSwitch":
-			out.Values[i] = ec._Mutation_deleteSwitch(ctx, field)
-		case "toggleSwitch":
-			out.Values[i] = ec._Mutation_toggleSwitch(ctx, field)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalOBackup2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐBackup(ctx context.Context, sel ast.SelectionSet, v []*model.Backup) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOBackup2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐBackup(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOBackup2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐBackup(ctx context.Context, sel ast.SelectionSet, v *model.Backup) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return ec._Backup(ctx, sel, v)
 }
 
-var pidSettingsImplementors = []string{"PidSettings"}
+func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _PidSettings(ctx context.Context, sel ast.SelectionSet, obj *devices.PidSettings) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, pidSettingsImplementors)
+func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	return graphql.MarshalBoolean(v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("PidSettings")
-		case "configured":
-			out.Values[i] = ec._PidSettings_configured(ctx, field, obj)
-		case "cycleTime":
-			out.Values[i] = ec._PidSettings_cycleTime(ctx, field, obj)
-		case "delay":
-			out.Values[i] = ec._PidSettings_delay(ctx, field, obj)
-		case "derivative":
-			out.Values[i] = ec._PidSettings_derivative(ctx, field, obj)
-		case "id":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._PidSettings_id(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "integral":
-			out.Values[i] = ec._PidSettings_integral(ctx, field, obj)
-		case "proportional":
-			out.Values[i] = ec._PidSettings_proportional(ctx, field, obj)
-		case "gpio":
-			out.Values[i] = ec._PidSettings_gpio(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
+func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
+	if v == nil {
+		return nil, nil
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	res, err := graphql.UnmarshalBoolean(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return graphql.MarshalBoolean(*v)
 }
 
-var queryImplementors = []string{"Query"}
-
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+func (ec *executionContext) unmarshalOControllerMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx context.Context, v interface{}) (model.ControllerMode, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	res := model.ControllerMode(tmp)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) marshalOControllerMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx context.Context, sel ast.SelectionSet, v model.ControllerMode) graphql.Marshaler {
+	return graphql.MarshalString(string(v))
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "probe":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_probe(ctx, field)
-				return res
-			})
-		case "probeList":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_probeList(ctx, field)
-				return res
-			})
-		case "fetchProbes":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_fetchProbes(ctx, field)
-				return res
-			})
-		case "temperatureControllers":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_temperatureControllers(ctx, field)
-				return res
-			})
-		case "settings":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_settings(ctx, field)
-				return res
-			})
-		case "switches":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_switches(ctx, field)
-				return res
-			})
-		case "__type":
-			out.Values[i] = ec._Query___type(ctx, field)
-		case "__schema":
-			out.Values[i] = ec._Query___schema(ctx, field)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
+func (ec *executionContext) unmarshalOControllerMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx context.Context, v interface{}) (*model.ControllerMode, error) {
+	if v == nil {
+		return nil, nil
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	tmp, err := graphql.UnmarshalString(v)
+	res := model.ControllerMode(tmp)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOControllerMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx context.Context, sel ast.SelectionSet, v *model.ControllerMode) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return graphql.MarshalString(string(*v))
 }
 
-var settingsImplementors = []string{"Settings"}
-
-func (ec *executionContext) _Settings(ctx context.Context, sel ast.SelectionSet, obj *system.Settings) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, settingsImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Settings")
-		case "breweryName":
-			out.Values[i] = ec._Settings_breweryName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
+func (ec *executionContext) marshalODeleteTemperatureControllerReturnType2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeleteTemperatureControllerReturnType(ctx context.Context, sel ast.SelectionSet, v *model.DeleteTemperatureControllerReturnType) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return ec._DeleteTemperatureControllerReturnType(ctx, sel, v)
 }
 
-var switchImplementors = []string{"Switch"}
-
-func (ec *executionContext) _Switch(ctx context.Context, sel ast.SelectionSet, obj *devices.Switch) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, switchImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Switch")
-		case "id":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Switch_id(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalODeviceEvent2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEvent(ctx context.Context, sel ast.SelectionSet, v []*model.DeviceEvent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
-			})
-		case "gpio":
-			out.Values[i] = ec._Switch_gpio(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "name":
-			out.Values[i] = ec._Switch_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "state":
-			out.Values[i] = ec._Switch_state(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalODeviceEvent2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalODeviceEvent2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEvent(ctx context.Context, sel ast.SelectionSet, v *model.DeviceEvent) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return ec._DeviceEvent(ctx, sel, v)
 }
 
-var tempProbeDetailsImplementors = []string{"TempProbeDetails"}
-
-func (ec *executionContext) _TempProbeDetails(ctx context.Context, sel ast.SelectionSet, obj *model.TempProbeDetails) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, tempProbeDetailsImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TempProbeDetails")
-		case "id":
-			out.Values[i] = ec._TempProbeDetails_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "physAddr":
-			out.Values[i] = ec._TempProbeDetails_physAddr(ctx, field, obj)
-		case "reading":
-			out.Values[i] = ec._TempProbeDetails_reading(ctx, field, obj)
-		case "name":
-			out.Values[i] = ec._TempProbeDetails_name(ctx, field, obj)
-		case "updated":
-			out.Values[i] = ec._TempProbeDetails_updated(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalODeviceEventType2ᚕgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventTypeᚄ(ctx context.Context, v interface{}) ([]model.DeviceEventType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
+	var err error
+	res := make([]model.DeviceEventType, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNDeviceEventType2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	return out
+	return res, nil
 }
 
-var temperatureControllerImplementors = []string{"TemperatureController"}
-
-func (ec *executionContext) _TemperatureController(ctx context.Context, sel ast.SelectionSet, obj *devices.TemperatureController) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, temperatureControllerImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TemperatureController")
-		case "calculatedDuty":
-			out.Values[i] = ec._TemperatureController_calculatedDuty(ctx, field, obj)
-		case "coolSettings":
-			out.Values[i] = ec._TemperatureController_coolSettings(ctx, field, obj)
-		case "dutyCycle":
-			out.Values[i] = ec._TemperatureController_dutyCycle(ctx, field, obj)
-		case "heatSettings":
-			out.Values[i] = ec._TemperatureController_heatSettings(ctx, field, obj)
-		case "hysteriaSettings":
-			out.Values[i] = ec._TemperatureController_hysteriaSettings(ctx, field, obj)
-		case "id":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._TemperatureController_id(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
+func (ec *executionContext) marshalODeviceEventType2ᚕgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []model.DeviceEventType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
-			})
-		case "manualSettings":
-			out.Values[i] = ec._TemperatureController_manualSettings(ctx, field, obj)
-		case "mode":
-			out.Values[i] = ec._TemperatureController_mode(ctx, field, obj)
-		case "name":
-			out.Values[i] = ec._TemperatureController_name(ctx, field, obj)
-		case "previousCalculationTime":
-			out.Values[i] = ec._TemperatureController_previousCalculationTime(ctx, field, obj)
-		case "setPoint":
-			out.Values[i] = ec._TemperatureController_setPoint(ctx, field, obj)
-		case "tempProbeDetails":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._TemperatureController_tempProbeDetails(ctx, field, obj)
-				return res
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDeviceEventType2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalODeviceEventType2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx context.Context, v interface{}) (*model.DeviceEventType, error) {
+	if v == nil {
+		return nil, nil
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	var res = new(model.DeviceEventType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODeviceEventType2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeviceEventType(ctx context.Context, sel ast.SelectionSet, v *model.DeviceEventType) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return v
 }
 
-var temperatureProbeImplementors = []string{"TemperatureProbe"}
-
-func (ec *executionContext) _TemperatureProbe(ctx context.Context, sel ast.SelectionSet, obj *model.TemperatureProbe) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, temperatureProbeImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TemperatureProbe")
-		case "physAddr":
-			out.Values[i] = ec._TemperatureProbe_physAddr(ctx, field, obj)
-		case "reading":
-			out.Values[i] = ec._TemperatureProbe_reading(ctx, field, obj)
-		case "updated":
-			out.Values[i] = ec._TemperatureProbe_updated(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalOExpander2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐExpander(ctx context.Context, sel ast.SelectionSet, v []*devices.Expander) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOExpander2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐExpander(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOExpander2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐExpander(ctx context.Context, sel ast.SelectionSet, v *devices.Expander) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return ec._Expander(ctx, sel, v)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+func (ec *executionContext) unmarshalOExpanderChip2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐExpanderChip(ctx context.Context, v interface{}) (*model.ExpanderChip, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ExpanderChip)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalOExpanderChip2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐExpanderChip(ctx context.Context, sel ast.SelectionSet, v *model.ExpanderChip) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalOFermentation2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFermentation(ctx context.Context, sel ast.SelectionSet, v *devices.Fermentation) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Fermentation(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOFermentationStepInput2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFermentationStepInputᚄ(ctx context.Context, v interface{}) ([]*model.FermentationStepInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
+	var err error
+	res := make([]*model.FermentationStepInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNFermentationStepInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐFermentationStepInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-	return out
+	return res, nil
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+func (ec *executionContext) unmarshalOFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloat(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) marshalOFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	return graphql.MarshalFloat(v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
+	if v == nil {
+		return nil, nil
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	res, err := graphql.UnmarshalFloat(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return graphql.MarshalFloat(*v)
 }
 
-var __FieldImplementors = []string{"__Field"}
-
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalOFlowDispense2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFlowDispense(ctx context.Context, sel ast.SelectionSet, v *devices.FlowDispense) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._FlowDispense(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalOFlowMeter2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFlowMeter(ctx context.Context, sel ast.SelectionSet, v []*devices.FlowMeter) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalOFlowMeter2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFlowMeter(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOFlowMeter2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐFlowMeter(ctx context.Context, sel ast.SelectionSet, v *devices.FlowMeter) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return ec._FlowMeter(ctx, sel, v)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
+func (ec *executionContext) marshalOHysteriaSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐHysteriaSettings(ctx context.Context, sel ast.SelectionSet, v devices.HysteriaSettings) graphql.Marshaler {
+	return ec._HysteriaSettings(ctx, sel, &v)
+}
 
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+func (ec *executionContext) unmarshalOHysteriaSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐHysteriaSettingsInput(ctx context.Context, v interface{}) (*model.HysteriaSettingsInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputHysteriaSettingsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalOID2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
+
+	return ret
 }
 
-var __SchemaImplementors = []string{"__Schema"}
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalID(*v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalOInPin2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐInPin(ctx context.Context, sel ast.SelectionSet, v []*devices.InPin) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalOInPin2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐInPin(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOInPin2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐInPin(ctx context.Context, sel ast.SelectionSet, v *devices.InPin) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return ec._InPin(ctx, sel, v)
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) unmarshalOInputAction2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputAction(ctx context.Context, v interface{}) (model.InputAction, error) {
+	var res model.InputAction
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) marshalOInputAction2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputAction(ctx context.Context, sel ast.SelectionSet, v model.InputAction) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
+func (ec *executionContext) unmarshalOInputAction2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputAction(ctx context.Context, v interface{}) (*model.InputAction, error) {
+	if v == nil {
+		return nil, nil
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	var res = new(model.InputAction)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInputAction2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputAction(ctx context.Context, sel ast.SelectionSet, v *model.InputAction) graphql.Marshaler {
+	if v == nil {
 		return graphql.Null
 	}
-	return out
+	return v
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) unmarshalOInputPull2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputPull(ctx context.Context, v interface{}) (model.InputPull, error) {
+	var res model.InputPull
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalOInputPull2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputPull(ctx context.Context, sel ast.SelectionSet, v model.InputPull) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalOInputPull2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputPull(ctx context.Context, v interface{}) (*model.InputPull, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.InputPull)
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
+func (ec *executionContext) marshalOInputPull2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐInputPull(ctx context.Context, sel ast.SelectionSet, v *model.InputPull) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res
+	return v
 }
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalID(v)
+func (ec *executionContext) unmarshalOInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
+func (ec *executionContext) marshalOInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	return graphql.MarshalInt(v)
 }
 
-func (ec *executionContext) unmarshalNSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSettingsInput(ctx context.Context, v interface{}) (model.SettingsInput, error) {
-	res, err := ec.unmarshalInputSettingsInput(ctx, v)
+func (ec *executionContext) unmarshalOInt2int64(ctx context.Context, v interface{}) (int64, error) {
+	res, err := graphql.UnmarshalInt64(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalOInt2int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
+	return graphql.MarshalInt64(v)
 }
 
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
 	}
-	return res
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNSwitchMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx context.Context, v interface{}) (model.SwitchMode, error) {
-	var res model.SwitchMode
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalInt(*v)
 }
 
-func (ec *executionContext) marshalNSwitchMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx context.Context, sel ast.SelectionSet, v model.SwitchMode) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalOManualSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐManualSettings(ctx context.Context, sel ast.SelectionSet, v devices.ManualSettings) graphql.Marshaler {
+	return ec._ManualSettings(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNSwitchSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchSettingsInput(ctx context.Context, v interface{}) (model.SwitchSettingsInput, error) {
-	res, err := ec.unmarshalInputSwitchSettingsInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) unmarshalOManualSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐManualSettingsInput(ctx context.Context, v interface{}) (*model.ManualSettingsInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputManualSettingsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) unmarshalNTemperatureControllerSettingsInput2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTemperatureControllerSettingsInput(ctx context.Context, v interface{}) (model.TemperatureControllerSettingsInput, error) {
-	res, err := ec.unmarshalInputTemperatureControllerSettingsInput(ctx, v)
+func (ec *executionContext) unmarshalOMissedRunPolicy2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐMissedRunPolicy(ctx context.Context, v interface{}) (*model.MissedRunPolicy, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.MissedRunPolicy)
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
-	return ec.___Directive(ctx, sel, &v)
+func (ec *executionContext) marshalOMissedRunPolicy2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐMissedRunPolicy(ctx context.Context, sel ast.SelectionSet, v *model.MissedRunPolicy) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
 }
 
-func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) marshalOModbusRegister2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐModbusRegister(ctx context.Context, sel ast.SelectionSet, v []*model.ModbusRegister) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -5585,7 +26090,7 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+			ret[i] = ec.marshalOModbusRegister2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐModbusRegister(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -5598,43 +26103,36 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	return ret
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalOModbusRegister2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐModbusRegister(ctx context.Context, sel ast.SelectionSet, v *model.ModbusRegister) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ModbusRegister(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
+func (ec *executionContext) marshalOPidSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐPidSettings(ctx context.Context, sel ast.SelectionSet, v devices.PidSettings) graphql.Marshaler {
+	return ec._PidSettings(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
-	var vSlice []interface{}
-	if v != nil {
-		if tmp1, ok := v.([]interface{}); ok {
-			vSlice = tmp1
-		} else {
-			vSlice = []interface{}{v}
-		}
+func (ec *executionContext) unmarshalOPidSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐPidSettingsInput(ctx context.Context, v interface{}) (*model.PidSettingsInput, error) {
+	if v == nil {
+		return nil, nil
 	}
-	var err error
-	res := make([]string, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
+	res, err := ec.unmarshalInputPidSettingsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOProbeCalibration2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeCalibration(ctx context.Context, sel ast.SelectionSet, v *model.ProbeCalibration) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res, nil
+	return ec._ProbeCalibration(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+func (ec *executionContext) marshalOProbeEvent2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeEvent(ctx context.Context, sel ast.SelectionSet, v []*model.ProbeEvent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -5658,7 +26156,7 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+			ret[i] = ec.marshalOProbeEvent2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeEvent(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -5671,19 +26169,56 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	return ret
 }
 
-func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
-	return ec.___EnumValue(ctx, sel, &v)
+func (ec *executionContext) marshalOProbeEvent2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeEvent(ctx context.Context, sel ast.SelectionSet, v *model.ProbeEvent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ProbeEvent(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
-	return ec.___Field(ctx, sel, &v)
+func (ec *executionContext) marshalOProbeScan2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeScan(ctx context.Context, sel ast.SelectionSet, v *model.ProbeScan) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ProbeScan(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
-	return ec.___InputValue(ctx, sel, &v)
+func (ec *executionContext) unmarshalOProbeStatus2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeStatus(ctx context.Context, v interface{}) (*model.ProbeStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ProbeStatus)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) marshalOProbeStatus2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐProbeStatus(ctx context.Context, sel ast.SelectionSet, v *model.ProbeStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOSPIDriver2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSPIDriver(ctx context.Context, v interface{}) (*model.SPIDriver, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.SPIDriver)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOSPIDriver2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSPIDriver(ctx context.Context, sel ast.SelectionSet, v *model.SPIDriver) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOSPIProbe2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSPIProbe(ctx context.Context, sel ast.SelectionSet, v []*devices.SPIProbe) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -5707,7 +26242,7 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+			ret[i] = ec.marshalOSPIProbe2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSPIProbe(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -5720,11 +26255,17 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
-	return ec.___Type(ctx, sel, &v)
+func (ec *executionContext) marshalOSPIProbe2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSPIProbe(ctx context.Context, sel ast.SelectionSet, v *devices.SPIProbe) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SPIProbe(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalOScene2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐScene(ctx context.Context, sel ast.SelectionSet, v []*devices.Scene) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -5748,198 +26289,95 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+			ret[i] = ec.marshalOScene2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐScene(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
 		} else {
 			go f(i)
-		}
-
-	}
-	wg.Wait()
-	return ret
-}
-
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec.___Type(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	return graphql.MarshalBoolean(v)
-}
-
-func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := graphql.UnmarshalBoolean(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return graphql.MarshalBoolean(*v)
-}
-
-func (ec *executionContext) unmarshalOControllerMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx context.Context, v interface{}) (model.ControllerMode, error) {
-	tmp, err := graphql.UnmarshalString(v)
-	res := model.ControllerMode(tmp)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOControllerMode2githubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx context.Context, sel ast.SelectionSet, v model.ControllerMode) graphql.Marshaler {
-	return graphql.MarshalString(string(v))
-}
-
-func (ec *executionContext) unmarshalOControllerMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx context.Context, v interface{}) (*model.ControllerMode, error) {
-	if v == nil {
-		return nil, nil
-	}
-	tmp, err := graphql.UnmarshalString(v)
-	res := model.ControllerMode(tmp)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOControllerMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐControllerMode(ctx context.Context, sel ast.SelectionSet, v *model.ControllerMode) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return graphql.MarshalString(string(*v))
-}
-
-func (ec *executionContext) marshalODeleteTemperatureControllerReturnType2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐDeleteTemperatureControllerReturnType(ctx context.Context, sel ast.SelectionSet, v *model.DeleteTemperatureControllerReturnType) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return ec._DeleteTemperatureControllerReturnType(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalOFloat2float64(ctx context.Context, v interface{}) (float64, error) {
-	res, err := graphql.UnmarshalFloat(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
-	return graphql.MarshalFloat(v)
-}
-
-func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := graphql.UnmarshalFloat(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return graphql.MarshalFloat(*v)
-}
-
-func (ec *executionContext) marshalOHysteriaSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐHysteriaSettings(ctx context.Context, sel ast.SelectionSet, v devices.HysteriaSettings) graphql.Marshaler {
-	return ec._HysteriaSettings(ctx, sel, &v)
-}
-
-func (ec *executionContext) unmarshalOHysteriaSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐHysteriaSettingsInput(ctx context.Context, v interface{}) (*model.HysteriaSettingsInput, error) {
-	if v == nil {
-		return nil, nil
-	}
-	res, err := ec.unmarshalInputHysteriaSettingsInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
+		}
 
-func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
-	if v == nil {
-		return nil, nil
 	}
-	res, err := graphql.UnmarshalID(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+func (ec *executionContext) marshalOScene2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐScene(ctx context.Context, sel ast.SelectionSet, v *devices.Scene) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return graphql.MarshalID(*v)
-}
-
-func (ec *executionContext) unmarshalOInt2int64(ctx context.Context, v interface{}) (int64, error) {
-	res, err := graphql.UnmarshalInt64(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOInt2int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
-	return graphql.MarshalInt64(v)
+	return ec._Scene(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+func (ec *executionContext) unmarshalOSceneStepInput2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSceneStepInputᚄ(ctx context.Context, v interface{}) ([]*model.SceneStepInput, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalInt(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*model.SceneStepInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSceneStepInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSceneStepInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+func (ec *executionContext) marshalOSchedule2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSchedule(ctx context.Context, sel ast.SelectionSet, v []*devices.Schedule) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return graphql.MarshalInt(*v)
-}
-
-func (ec *executionContext) marshalOManualSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐManualSettings(ctx context.Context, sel ast.SelectionSet, v devices.ManualSettings) graphql.Marshaler {
-	return ec._ManualSettings(ctx, sel, &v)
-}
-
-func (ec *executionContext) unmarshalOManualSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐManualSettingsInput(ctx context.Context, v interface{}) (*model.ManualSettingsInput, error) {
-	if v == nil {
-		return nil, nil
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
 	}
-	res, err := ec.unmarshalInputManualSettingsInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOSchedule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSchedule(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) marshalOPidSettings2githubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐPidSettings(ctx context.Context, sel ast.SelectionSet, v devices.PidSettings) graphql.Marshaler {
-	return ec._PidSettings(ctx, sel, &v)
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) unmarshalOPidSettingsInput2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐPidSettingsInput(ctx context.Context, v interface{}) (*model.PidSettingsInput, error) {
+func (ec *executionContext) marshalOSchedule2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSchedule(ctx context.Context, sel ast.SelectionSet, v *devices.Schedule) graphql.Marshaler {
 	if v == nil {
-		return nil, nil
+		return graphql.Null
 	}
-	res, err := ec.unmarshalInputPidSettingsInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+	return ec._Schedule(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalOSettings2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋsystemᚐSettings(ctx context.Context, sel ast.SelectionSet, v *system.Settings) graphql.Marshaler {
@@ -5958,6 +26396,42 @@ func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.S
 	return graphql.MarshalString(v)
 }
 
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚕᚖstring(ctx context.Context, v interface{}) ([]*string, error) {
 	if v == nil {
 		return nil, nil
@@ -6056,6 +26530,53 @@ func (ec *executionContext) marshalOSwitch2ᚖgithubᚗcomᚋdougedeyᚋelsinore
 	return ec._Switch(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOSwitchGroup2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchGroup(ctx context.Context, sel ast.SelectionSet, v []*devices.SwitchGroup) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOSwitchGroup2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchGroup(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOSwitchGroup2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchGroup(ctx context.Context, sel ast.SelectionSet, v *devices.SwitchGroup) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SwitchGroup(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOSwitchMode2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐSwitchMode(ctx context.Context, v interface{}) (*model.SwitchMode, error) {
 	if v == nil {
 		return nil, nil
@@ -6072,6 +26593,13 @@ func (ec *executionContext) marshalOSwitchMode2ᚖgithubᚗcomᚋdougedeyᚋelsi
 	return v
 }
 
+func (ec *executionContext) marshalOSwitchTimer2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐSwitchTimer(ctx context.Context, sel ast.SelectionSet, v *devices.SwitchTimer) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SwitchTimer(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOTempProbeDetails2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋgraphᚋmodelᚐTempProbeDetails(ctx context.Context, sel ast.SelectionSet, v []*model.TempProbeDetails) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -6237,6 +26765,100 @@ func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel
 	return graphql.MarshalTime(*v)
 }
 
+func (ec *executionContext) marshalOWebhook2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhook(ctx context.Context, sel ast.SelectionSet, v []*devices.Webhook) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOWebhook2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhook(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOWebhook2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhook(ctx context.Context, sel ast.SelectionSet, v *devices.Webhook) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Webhook(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOWebhookDelivery2ᚕᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhookDelivery(ctx context.Context, sel ast.SelectionSet, v []*devices.WebhookDelivery) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOWebhookDelivery2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhookDelivery(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOWebhookDelivery2ᚖgithubᚗcomᚋdougedeyᚋelsinoreᚋdevicesᚐWebhookDelivery(ctx context.Context, sel ast.SelectionSet, v *devices.WebhookDelivery) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._WebhookDelivery(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -3,6 +3,15 @@ package model
 // ControllerMode Auto, Manual, Off, Hysteria
 type ControllerMode string
 
+// IsValid - Whether this is one of the controller modes
+func (e ControllerMode) IsValid() bool {
+	switch e {
+	case "auto", "manual", "off", "hysteria":
+		return true
+	}
+	return false
+}
+
 // DeleteTemperatureControllerReturnType The deleted controller
 type DeleteTemperatureControllerReturnType struct {
 	// The ID of the deleted Controller
@@ -9,6 +9,164 @@ import (
 	"time"
 )
 
+type AlertChannelInput struct {
+	// The ID of the channel, if no ID, create a new channel
+	ID *string `json:"id"`
+	// Required when creating a channel
+	Name *string `json:"name"`
+	// Required when creating a channel
+	Kind *AlertChannelKind `json:"kind"`
+	// Required when creating a channel
+	URL      *string `json:"url"`
+	Username *string `json:"username"`
+	// The SMTP password, or the ntfy, Gotify or webhook token, it cannot be read back
+	Token *string `json:"token"`
+	To    *string `json:"to"`
+	From  *string `json:"from"`
+	// Defaults to true
+	Enabled *bool `json:"enabled"`
+}
+
+type AlertRuleInput struct {
+	// The ID of the rule, if no ID, create a new rule
+	ID *string `json:"id"`
+	// Required when creating a rule
+	Name *string `json:"name"`
+	// Required when creating a rule
+	Condition *AlertCondition `json:"condition"`
+	// The physical address of a probe to watch, empty to watch a controller
+	Probe *string `json:"probe"`
+	// A controller to watch, empty to watch a probe
+	ControllerID *string `json:"controllerId"`
+	// Required for above and below alerts, e.g. 21C
+	Threshold *string `json:"threshold"`
+	// e.g. 0.5C, defaults to none
+	Hysteresis     *string  `json:"hysteresis"`
+	Duration       *int     `json:"duration"`
+	RepeatInterval *int     `json:"repeatInterval"`
+	ChannelIds     []string `json:"channelIds"`
+	// Defaults to true
+	Enabled *bool `json:"enabled"`
+}
+
+type AutomationActionInput struct {
+	Kind AutomationActionKind `json:"kind"`
+	// Required for switchOn and switchOff
+	SwitchID *string `json:"switchId"`
+	// Required for the controller actions
+	ControllerID *string `json:"controllerId"`
+	// Required for controllerMode
+	Mode *ControllerMode `json:"mode"`
+	// Required for controllerSetPoint, e.g. 18C
+	SetPoint *string `json:"setPoint"`
+	// The steps for startFermentation
+	Steps []*FermentationStepInput `json:"steps"`
+}
+
+type AutomationConditionInput struct {
+	Kind AutomationConditionKind `json:"kind"`
+	// A probe physical address for temperature conditions, use it or controllerId
+	Probe *string `json:"probe"`
+	// A controller for temperature conditions, or for heating and cooling, empty for any controller
+	ControllerID *string `json:"controllerId"`
+	// Required for switchOn and switchOff
+	SwitchID *string `json:"switchId"`
+	// Required for temperature conditions, e.g. 21C
+	Threshold *string `json:"threshold"`
+	// e.g. 0.5C, defaults to none
+	Hysteresis *string `json:"hysteresis"`
+	// Required for timeOfDay, HH:MM
+	Time *string `json:"time"`
+	// HH:MM, required for timeOfDay conditions that are not the trigger
+	Until *string `json:"until"`
+	// Required for event
+	Event    *DeviceEventType `json:"event"`
+	DeviceID *string          `json:"deviceId"`
+}
+
+type AutomationRuleInput struct {
+	// The ID of the rule, if no ID, create a new rule
+	ID *string `json:"id"`
+	// Required when creating a rule
+	Name *string `json:"name"`
+	// Defaults to true
+	Enabled *bool `json:"enabled"`
+	Revert  *bool `json:"revert"`
+	// Required when creating a rule
+	Trigger    *AutomationConditionInput   `json:"trigger"`
+	Conditions []*AutomationConditionInput `json:"conditions"`
+	// Required when creating a rule, at least one
+	Actions []*AutomationActionInput `json:"actions"`
+}
+
+// A snapshot of the database
+type Backup struct {
+	// The file name of the snapshot
+	Name string `json:"name"`
+	// The size of the snapshot in bytes
+	Size int `json:"size"`
+	// When the snapshot was taken
+	Created time.Time `json:"created"`
+}
+
+// Something that happened to a probe, controller or switch
+type DeviceEvent struct {
+	Type DeviceEventType `json:"type"`
+	Time time.Time       `json:"time"`
+	// The name of the probe, controller or switch
+	Device string `json:"device"`
+	// The ID of the controller or switch, or the physical address of the probe
+	DeviceID string `json:"deviceId"`
+	Message  string `json:"message"`
+}
+
+type ExpanderInput struct {
+	// The ID of the expander, if no ID, create a new expander
+	ID *string `json:"id"`
+	// Required when creating an expander
+	Chip *ExpanderChip `json:"chip"`
+	Bus  *string       `json:"bus"`
+	// Required when creating an expander, e.g. 32 for 0x20
+	Address *int `json:"address"`
+}
+
+// The settings for a new fermentation
+type FermentationInput struct {
+	// The controller for the vessel
+	ControllerID string `json:"controllerId"`
+	// The original gravity, the first reading is used if this is not set
+	OriginalGravity *float64 `json:"originalGravity"`
+	// Defaults to 65%
+	TargetAttenuation *float64 `json:"targetAttenuation"`
+	// Defaults to 48 hours
+	SlopeWindow *int `json:"slopeWindow"`
+	// Defaults to 0.001 per day
+	StableSlope *float64                 `json:"stableSlope"`
+	Steps       []*FermentationStepInput `json:"steps"`
+}
+
+// A change to make to the controller when the fermentation reaches an attenuation
+type FermentationStepInput struct {
+	Name *string `json:"name"`
+	// The apparent attenuation that triggers this step, in percent
+	Attenuation float64 `json:"attenuation"`
+	// The new set point for the controller, e.g. 20C
+	SetPoint *string `json:"setPoint"`
+	// The new mode for the controller
+	Mode *ControllerMode `json:"mode"`
+}
+
+type FlowMeterInput struct {
+	// The ID of the flow meter, if no ID, create a new flow meter
+	ID *string `json:"id"`
+	// Required when creating a flow meter
+	Name *string `json:"name"`
+	// Required when creating a flow meter
+	Gpio           *string  `json:"gpio"`
+	PulsesPerLitre *float64 `json:"pulsesPerLitre"`
+	NoFlowTimeout  *int     `json:"noFlowTimeout"`
+}
+
 // The new settings for hysteria mode
 type HysteriaSettingsInput struct {
 	// Indicates if these settings have been configured yet
@@ -21,6 +179,23 @@ type HysteriaSettingsInput struct {
 	MinTime *int `json:"minTime"`
 }
 
+type InPinInput struct {
+	// The ID of the input, if no ID, create a new input
+	ID *string `json:"id"`
+	// Required when creating an input
+	Name *string `json:"name"`
+	// Required when creating an input
+	Gpio     *string      `json:"gpio"`
+	Pull     *InputPull   `json:"pull"`
+	Inverted *bool        `json:"inverted"`
+	Debounce *int         `json:"debounce"`
+	Action   *InputAction `json:"action"`
+	// The switch to toggle, an empty ID clears it
+	SwitchID *string `json:"switchId"`
+	// The controller to hold off, an empty ID clears it
+	ControllerID *string `json:"controllerId"`
+}
+
 // The new manual settings for this controller
 type ManualSettingsInput struct {
 	// Indicates if these settings have been configured yet
@@ -31,6 +206,22 @@ type ManualSettingsInput struct {
 	DutyCycle *int `json:"dutyCycle"`
 }
 
+// A coil, discrete input or register served over Modbus TCP
+type ModbusRegister struct {
+	// The table this is in
+	Table ModbusTable `json:"table"`
+	// The zero based address in the table
+	Address int `json:"address"`
+	// The device and value this maps
+	Name string `json:"name"`
+	// The units and values of this register
+	Description string `json:"description"`
+	// True when Modbus clients can write this
+	Writable bool `json:"writable"`
+	// The current value
+	Value int `json:"value"`
+}
+
 // The settings for heating or cooling on a temperature controller
 type PidSettingsInput struct {
 	// Indicates if these settings have been configured yet
@@ -47,12 +238,132 @@ type PidSettingsInput struct {
 	Proportional *float64 `json:"proportional"`
 	// The friendly name of the GPIO Value
 	Gpio *string `json:"gpio"`
+	// The PWM frequency in Hz, the output runs at the duty cycle instead of cycling on and off, 0 turns PWM off
+	Frequency *int `json:"frequency"`
+}
+
+// The calibration applied to a probe
+type ProbeCalibration struct {
+	// How the readings are corrected
+	Mode CalibrationMode `json:"mode"`
+	// The offset added to readings in offset mode
+	Offset *string `json:"offset"`
+	// The probe reading at the low calibration point
+	LowRawReading *string `json:"lowRawReading"`
+	// The actual temperature at the low calibration point
+	LowReference *string `json:"lowReference"`
+	// The probe reading at the high calibration point
+	HighRawReading *string `json:"highRawReading"`
+	// The actual temperature at the high calibration point
+	HighReference *string `json:"highReference"`
+}
+
+// A probe that was found or lost on the bus
+type ProbeEvent struct {
+	Type     ProbeEventType `json:"type"`
+	PhysAddr string         `json:"physAddr"`
+	Time     time.Time      `json:"time"`
+}
+
+// The probes that changed during a bus scan
+type ProbeScan struct {
+	// Probes that were not connected before the scan
+	Discovered []*TemperatureProbe `json:"discovered"`
+	// Probes that were connected before the scan and are now missing
+	Lost []*TemperatureProbe `json:"lost"`
+}
+
+// The name and description of a probe
+type ProbeSettingsInput struct {
+	// The physical address of the probe
+	PhysAddr string `json:"physAddr"`
+	// The new name for the probe
+	Name *string `json:"name"`
+	// The new location of the probe
+	Location *string `json:"location"`
+	// The new notes for the probe
+	Notes *string `json:"notes"`
+	// The new sensor type of the probe
+	SensorType *string `json:"sensorType"`
+	// Enable or disable the probe
+	Enabled *bool `json:"enabled"`
+	// The resolution to read the probe at, 9 to 12 bits, 0 for the default of 10
+	Resolution *int `json:"resolution"`
+	// The lowest expected reading, e.g. 2C, empty to remove the limit
+	LowLimit *string `json:"lowLimit"`
+	// The highest expected reading, e.g. 24C, empty to remove the limit
+	HighLimit *string `json:"highLimit"`
+}
+
+// The settings for a temperature chip on an SPI port
+type SPIProbeInput struct {
+	// The ID of the chip, if no ID, create a new one
+	ID *string `json:"id"`
+	// Required when creating a chip
+	Driver *SPIDriver `json:"driver"`
+	// Required when creating a chip
+	Bus *int `json:"bus"`
+	// Required when creating a chip
+	ChipSelect        *int     `json:"chipSelect"`
+	Wires             *int     `json:"wires"`
+	ReferenceResistor *float64 `json:"referenceResistor"`
+	NominalResistance *float64 `json:"nominalResistance"`
+	Filter50Hz        *bool    `json:"filter50Hz"`
+}
+
+type SceneInput struct {
+	// The ID of the scene, if no ID, create a new scene
+	ID *string `json:"id"`
+	// Required when creating a scene
+	Name *string `json:"name"`
+	// Required when creating a scene, at least one
+	Steps []*SceneStepInput `json:"steps"`
+	// Conditions that have to match to apply the scene, e.g. switchOff for a heating element while its pump is off
+	Interlocks []*AutomationConditionInput `json:"interlocks"`
+}
+
+type SceneStepInput struct {
+	// Use it or groupId
+	SwitchID *string `json:"switchId"`
+	// Use it or switchId
+	GroupID *string    `json:"groupId"`
+	State   SwitchMode `json:"state"`
+	// How long to wait after the previous step, defaults to 0
+	DelaySeconds *float64 `json:"delaySeconds"`
+}
+
+type ScheduleInput struct {
+	// The ID of the schedule, if no ID, create a new schedule
+	ID *string `json:"id"`
+	// Required when creating a schedule
+	Name *string `json:"name"`
+	// Defaults to true
+	Enabled *bool `json:"enabled"`
+	// minute hour day-of-month month day-of-week, or @hourly, @daily, @weekly, @monthly or @yearly, use it or at
+	Cron *string `json:"cron"`
+	// When a one-shot schedule runs, use it or cron
+	At *time.Time `json:"at"`
+	// Defaults to skip
+	MissedRun *MissedRunPolicy `json:"missedRun"`
+	// Required when creating a schedule, at least one
+	Actions []*AutomationActionInput `json:"actions"`
 }
 
 // The new settings for this brewery
 type SettingsInput struct {
 	// The new brewery name (blank for no change)
 	BreweryName *string `json:"breweryName"`
+	// The new IANA timezone, an empty string uses the local time of the server (blank for no change)
+	Timezone *string `json:"timezone"`
+}
+
+type SwitchGroupInput struct {
+	// The ID of the group, if no ID, create a new group
+	ID *string `json:"id"`
+	// Required when creating a group
+	Name *string `json:"name"`
+	// Required when creating a group, at least one
+	SwitchIds []string `json:"switchIds"`
 }
 
 type SwitchSettingsInput struct {
@@ -61,9 +372,17 @@ type SwitchSettingsInput struct {
 	// The new Name for the switch (required during switch creation)
 	Name *string `json:"name"`
 	// The new GPIO for the switch (required during switch creation)
+	// Network outputs are URLs, tasmota://host/relay, shelly://host/relay, shellyplus://host/relay or http://host/path?power={state}
+	// Serial relays are modbus:port[@baud]:slave:coilN or lcus:port[@baud]:relay
 	Gpio *string `json:"gpio"`
 	// The new state for the switch
 	State *SwitchMode `json:"state"`
+	// The PWM frequency in Hz, 0 makes it a plain on/off switch
+	Frequency *int `json:"frequency"`
+	// The PWM duty in percent for a dimmer, 0 is off, defaults to 100
+	Duty *int `json:"duty"`
+	// The longest the switch stays on before it is turned off, e.g. for a solenoid that must not stay open, 0 for no limit
+	MaxOnSeconds *int `json:"maxOnSeconds"`
 }
 
 // A device that reads a temperature and is assigned to a temperature controller
@@ -72,12 +391,38 @@ type TempProbeDetails struct {
 	ID string `json:"id"`
 	// The physical address of this probe
 	PhysAddr *string `json:"physAddr"`
-	// The value of the reading
+	// The value of the reading, after calibration
 	Reading *string `json:"reading"`
+	// The value of the reading as reported by the probe, before calibration
+	RawReading *string `json:"rawReading"`
+	// The specific gravity from a hydrometer probe
+	Gravity *float64 `json:"gravity"`
 	// The friendly name of this probe
 	Name *string `json:"name"`
 	// The time that this reading was updated
 	Updated *time.Time `json:"updated"`
+	// How the readings of this probe are calibrated
+	Calibration *ProbeCalibration `json:"calibration"`
+	// The weight of this probe for the weighted aggregation
+	Weight *float64 `json:"weight"`
+	// The priority of this probe for the primary aggregation, lower is preferred
+	Priority *int `json:"priority"`
+	// Whether this probe can be used by its controller
+	Status *ProbeStatus `json:"status"`
+	// The last error reported by this probe
+	Fault *string `json:"fault"`
+	// True when this probe was used for the last temperature of its controller
+	Contributing *bool `json:"contributing"`
+}
+
+// Used to configure how a probe is used by its temperature controller
+type TempProbeDetailsInput struct {
+	// The physical address of the probe
+	PhysAddr string `json:"physAddr"`
+	// The weight of this probe for the weighted aggregation
+	Weight *float64 `json:"weight"`
+	// The priority of this probe for the primary aggregation, lower is preferred
+	Priority *int `json:"priority"`
 }
 
 // Used to configure a controller
@@ -98,6 +443,10 @@ type TemperatureControllerSettingsInput struct {
 	ManualSettings *ManualSettingsInput `json:"manualSettings"`
 	// The target for auto mode
 	SetPoint *string `json:"setPoint"`
+	// How the readings of the probes are combined
+	Aggregation *AggregationMode `json:"aggregation"`
+	// The number of seconds without an update before a probe is ignored, 0 uses the default of 30
+	StaleAfter *int `json:"staleAfter"`
 }
 
 // A device that reads a temperature
@@ -108,45 +457,1057 @@ type TemperatureProbe struct {
 	Reading *string `json:"reading"`
 	// The time that this reading was updated
 	Updated *time.Time `json:"updated"`
+	// The name of this probe, defaults to the physical address
+	Name *string `json:"name"`
+	// Where this probe is, e.g. Fermenter 2 thermowell
+	Location *string `json:"location"`
+	// Free form notes about this probe
+	Notes *string `json:"notes"`
+	// The type of sensor, e.g. DS18B20
+	SensorType *string `json:"sensorType"`
+	// Disabled probes cannot be assigned to a temperature controller
+	Enabled bool `json:"enabled"`
+	// True when the probe is currently connected
+	Connected bool `json:"connected"`
+	// The resolution the probe is read at, in bits
+	Resolution *int `json:"resolution"`
+	// How long the last read took in milliseconds, after the bus conversion
+	ReadLatency *float64 `json:"readLatency"`
+	// The number of reads attempted since startup
+	Reads *int `json:"reads"`
+	// The number of reads that failed since startup
+	ReadErrors *int `json:"readErrors"`
+	// The temperature of the cold junction for thermocouple amplifiers that report it
+	ColdJunction *string `json:"coldJunction"`
+	// The specific gravity from hydrometers such as an iSpindel or Tilt
+	Gravity *float64 `json:"gravity"`
+	// A probeOutOfRange event is sent when the reading goes below this
+	LowLimit *string `json:"lowLimit"`
+	// A probeOutOfRange event is sent when the reading goes above this
+	HighLimit *string `json:"highLimit"`
 }
 
-type SwitchMode string
+type WebhookInput struct {
+	// The ID of the webhook, if no ID, create a new webhook
+	ID *string `json:"id"`
+	// Required when creating a webhook
+	Name *string `json:"name"`
+	// Required when creating a webhook, an http or https URL
+	URL *string `json:"url"`
+	// Required when creating a webhook, at least one event
+	Events []DeviceEventType `json:"events"`
+	Secret *string           `json:"secret"`
+	// Defaults to true
+	Enabled *bool `json:"enabled"`
+}
+
+// How the readings of the probes on a temperature controller are combined
+type AggregationMode string
 
 const (
-	SwitchModeOn  SwitchMode = "on"
-	SwitchModeOff SwitchMode = "off"
+	// The average of the usable probes
+	AggregationModeMean AggregationMode = "mean"
+	// The middle reading of the usable probes
+	AggregationModeMedian AggregationMode = "median"
+	// The lowest reading of the usable probes
+	AggregationModeMin AggregationMode = "min"
+	// The highest reading of the usable probes
+	AggregationModeMax AggregationMode = "max"
+	// The average of the usable probes, weighted by each probes weight
+	AggregationModeWeighted AggregationMode = "weighted"
+	// The usable probe with the lowest priority, falling back to the next when it is unusable
+	AggregationModePrimary AggregationMode = "primary"
 )
 
-var AllSwitchMode = []SwitchMode{
-	SwitchModeOn,
-	SwitchModeOff,
+var AllAggregationMode = []AggregationMode{
+	AggregationModeMean,
+	AggregationModeMedian,
+	AggregationModeMin,
+	AggregationModeMax,
+	AggregationModeWeighted,
+	AggregationModePrimary,
 }
 
-func (e SwitchMode) IsValid() bool {
+func (e AggregationMode) IsValid() bool {
 	switch e {
-	case SwitchModeOn, SwitchModeOff:
+	case AggregationModeMean, AggregationModeMedian, AggregationModeMin, AggregationModeMax, AggregationModeWeighted, AggregationModePrimary:
 		return true
 	}
 	return false
 }
 
-func (e SwitchMode) String() string {
+func (e AggregationMode) String() string {
 	return string(e)
 }
 
-func (e *SwitchMode) UnmarshalGQL(v interface{}) error {
+func (e *AggregationMode) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = SwitchMode(str)
+	*e = AggregationMode(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid SwitchMode", str)
+		return fmt.Errorf("%s is not a valid AggregationMode", str)
 	}
 	return nil
 }
 
-func (e SwitchMode) MarshalGQL(w io.Writer) {
+func (e AggregationMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// How an alert channel notifies
+type AlertChannelKind string
+
+const (
+	// Email through an SMTP server, url is smtp://host:port (STARTTLS when offered) or smtps://host:port
+	AlertChannelKindEmail AlertChannelKind = "email"
+	// A push to an ntfy topic, url is the topic, e.g. https://ntfy.sh/my-brewery, token is an optional access token
+	AlertChannelKindNtfy AlertChannelKind = "ntfy"
+	// A push to a Gotify server, url is the server, token is the application token
+	AlertChannelKindGotify AlertChannelKind = "gotify"
+	// A JSON POST, token is an optional secret to sign it with like webhooks
+	AlertChannelKindWebhook AlertChannelKind = "webhook"
+)
+
+var AllAlertChannelKind = []AlertChannelKind{
+	AlertChannelKindEmail,
+	AlertChannelKindNtfy,
+	AlertChannelKindGotify,
+	AlertChannelKindWebhook,
+}
+
+func (e AlertChannelKind) IsValid() bool {
+	switch e {
+	case AlertChannelKindEmail, AlertChannelKindNtfy, AlertChannelKindGotify, AlertChannelKindWebhook:
+		return true
+	}
+	return false
+}
+
+func (e AlertChannelKind) String() string {
+	return string(e)
+}
+
+func (e *AlertChannelKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AlertChannelKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AlertChannelKind", str)
+	}
+	return nil
+}
+
+func (e AlertChannelKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// What an alert rule watches for
+type AlertCondition string
+
+const (
+	// The temperature is above the threshold
+	AlertConditionAbove AlertCondition = "above"
+	// The temperature is below the threshold
+	AlertConditionBelow AlertCondition = "below"
+	// The probe has not been updated for the duration
+	AlertConditionStale AlertCondition = "stale"
+)
+
+var AllAlertCondition = []AlertCondition{
+	AlertConditionAbove,
+	AlertConditionBelow,
+	AlertConditionStale,
+}
+
+func (e AlertCondition) IsValid() bool {
+	switch e {
+	case AlertConditionAbove, AlertConditionBelow, AlertConditionStale:
+		return true
+	}
+	return false
+}
+
+func (e AlertCondition) String() string {
+	return string(e)
+}
+
+func (e *AlertCondition) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AlertCondition(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AlertCondition", str)
+	}
+	return nil
+}
+
+func (e AlertCondition) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Whether an alert rule is firing
+type AlertState string
+
+const (
+	// The condition is not met
+	AlertStateOk AlertState = "ok"
+	// The condition is met but has not held for the duration yet
+	AlertStatePending AlertState = "pending"
+	// The condition has held for the duration, the channels have been notified
+	AlertStateFiring AlertState = "firing"
+)
+
+var AllAlertState = []AlertState{
+	AlertStateOk,
+	AlertStatePending,
+	AlertStateFiring,
+}
+
+func (e AlertState) IsValid() bool {
+	switch e {
+	case AlertStateOk, AlertStatePending, AlertStateFiring:
+		return true
+	}
+	return false
+}
+
+func (e AlertState) String() string {
+	return string(e)
+}
+
+func (e *AlertState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AlertState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AlertState", str)
+	}
+	return nil
+}
+
+func (e AlertState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// What an automation does when it matches
+type AutomationActionKind string
+
+const (
+	// Turn the switch on
+	AutomationActionKindSwitchOn AutomationActionKind = "switchOn"
+	// Turn the switch off
+	AutomationActionKindSwitchOff AutomationActionKind = "switchOff"
+	// Change the mode of the controller
+	AutomationActionKindControllerMode AutomationActionKind = "controllerMode"
+	// Change the set point of the controller
+	AutomationActionKindControllerSetPoint AutomationActionKind = "controllerSetPoint"
+	// Start a fermentation on the controller with the steps
+	AutomationActionKindStartFermentation AutomationActionKind = "startFermentation"
+)
+
+var AllAutomationActionKind = []AutomationActionKind{
+	AutomationActionKindSwitchOn,
+	AutomationActionKindSwitchOff,
+	AutomationActionKindControllerMode,
+	AutomationActionKindControllerSetPoint,
+	AutomationActionKindStartFermentation,
+}
+
+func (e AutomationActionKind) IsValid() bool {
+	switch e {
+	case AutomationActionKindSwitchOn, AutomationActionKindSwitchOff, AutomationActionKindControllerMode, AutomationActionKindControllerSetPoint, AutomationActionKindStartFermentation:
+		return true
+	}
+	return false
+}
+
+func (e AutomationActionKind) String() string {
+	return string(e)
+}
+
+func (e *AutomationActionKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AutomationActionKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AutomationActionKind", str)
+	}
+	return nil
+}
+
+func (e AutomationActionKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// What an automation trigger or condition checks
+type AutomationConditionKind string
+
+const (
+	// The temperature of the probe or controller is above the threshold
+	AutomationConditionKindTemperatureAbove AutomationConditionKind = "temperatureAbove"
+	// The temperature of the probe or controller is below the threshold
+	AutomationConditionKindTemperatureBelow AutomationConditionKind = "temperatureBelow"
+	// The switch is on
+	AutomationConditionKindSwitchOn AutomationConditionKind = "switchOn"
+	// The switch is off
+	AutomationConditionKindSwitchOff AutomationConditionKind = "switchOff"
+	// The controller, or any controller when there is none, is calling for heat
+	AutomationConditionKindHeating AutomationConditionKind = "heating"
+	// The controller, or any controller when there is none, is calling for cooling
+	AutomationConditionKindCooling AutomationConditionKind = "cooling"
+	// The time of day passes time, or is between time and until
+	AutomationConditionKindTimeOfDay AutomationConditionKind = "timeOfDay"
+	// A device event was published, only for triggers
+	AutomationConditionKindEvent AutomationConditionKind = "event"
+)
+
+var AllAutomationConditionKind = []AutomationConditionKind{
+	AutomationConditionKindTemperatureAbove,
+	AutomationConditionKindTemperatureBelow,
+	AutomationConditionKindSwitchOn,
+	AutomationConditionKindSwitchOff,
+	AutomationConditionKindHeating,
+	AutomationConditionKindCooling,
+	AutomationConditionKindTimeOfDay,
+	AutomationConditionKindEvent,
+}
+
+func (e AutomationConditionKind) IsValid() bool {
+	switch e {
+	case AutomationConditionKindTemperatureAbove, AutomationConditionKindTemperatureBelow, AutomationConditionKindSwitchOn, AutomationConditionKindSwitchOff, AutomationConditionKindHeating, AutomationConditionKindCooling, AutomationConditionKindTimeOfDay, AutomationConditionKindEvent:
+		return true
+	}
+	return false
+}
+
+func (e AutomationConditionKind) String() string {
+	return string(e)
+}
+
+func (e *AutomationConditionKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AutomationConditionKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AutomationConditionKind", str)
+	}
+	return nil
+}
+
+func (e AutomationConditionKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type CalibrationMode string
+
+const (
+	// Readings are used as they are
+	CalibrationModeNone CalibrationMode = "none"
+	// A fixed offset is added to every reading
+	CalibrationModeOffset CalibrationMode = "offset"
+	// Readings are linearly corrected between a low and a high reference point
+	CalibrationModeTwoPoint CalibrationMode = "twoPoint"
+)
+
+var AllCalibrationMode = []CalibrationMode{
+	CalibrationModeNone,
+	CalibrationModeOffset,
+	CalibrationModeTwoPoint,
+}
+
+func (e CalibrationMode) IsValid() bool {
+	switch e {
+	case CalibrationModeNone, CalibrationModeOffset, CalibrationModeTwoPoint:
+		return true
+	}
+	return false
+}
+
+func (e CalibrationMode) String() string {
+	return string(e)
+}
+
+func (e *CalibrationMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CalibrationMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CalibrationMode", str)
+	}
+	return nil
+}
+
+func (e CalibrationMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// A reference point captured while calibrating a probe
+type CalibrationPoint string
+
+const (
+	// The difference between the reading and the reference is used as an offset
+	CalibrationPointOffset CalibrationPoint = "offset"
+	// The low point of a two point calibration, defaults to an ice bath at 0°C
+	CalibrationPointLow CalibrationPoint = "low"
+	// The high point of a two point calibration, defaults to boiling water at 100°C
+	CalibrationPointHigh CalibrationPoint = "high"
+)
+
+var AllCalibrationPoint = []CalibrationPoint{
+	CalibrationPointOffset,
+	CalibrationPointLow,
+	CalibrationPointHigh,
+}
+
+func (e CalibrationPoint) IsValid() bool {
+	switch e {
+	case CalibrationPointOffset, CalibrationPointLow, CalibrationPointHigh:
+		return true
+	}
+	return false
+}
+
+func (e CalibrationPoint) String() string {
+	return string(e)
+}
+
+func (e *CalibrationPoint) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CalibrationPoint(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CalibrationPoint", str)
+	}
+	return nil
+}
+
+func (e CalibrationPoint) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Something that happened to a device, webhooks are sent for the types they subscribe to
+type DeviceEventType string
+
+const (
+	// A probe reading went outside its low or high limit
+	DeviceEventTypeProbeOutOfRange DeviceEventType = "probeOutOfRange"
+	// A temperature controller changed mode
+	DeviceEventTypeControllerMode DeviceEventType = "controllerMode"
+	// A fermentation reached the attenuation of a step and the step was applied
+	DeviceEventTypeStepComplete DeviceEventType = "stepComplete"
+	// A switch was turned on or off
+	DeviceEventTypeSwitchToggled DeviceEventType = "switchToggled"
+	// An interlock held a controller off, a probe faulted or an output could not be set
+	DeviceEventTypeSafetyFault DeviceEventType = "safetyFault"
+)
+
+var AllDeviceEventType = []DeviceEventType{
+	DeviceEventTypeProbeOutOfRange,
+	DeviceEventTypeControllerMode,
+	DeviceEventTypeStepComplete,
+	DeviceEventTypeSwitchToggled,
+	DeviceEventTypeSafetyFault,
+}
+
+func (e DeviceEventType) IsValid() bool {
+	switch e {
+	case DeviceEventTypeProbeOutOfRange, DeviceEventTypeControllerMode, DeviceEventTypeStepComplete, DeviceEventTypeSwitchToggled, DeviceEventTypeSafetyFault:
+		return true
+	}
+	return false
+}
+
+func (e DeviceEventType) String() string {
+	return string(e)
+}
+
+func (e *DeviceEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DeviceEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DeviceEventType", str)
+	}
+	return nil
+}
+
+func (e DeviceEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ExpanderChip string
+
+const (
+	// 16 pins, A0-A7 and B0-B7, at 0x20-0x27
+	ExpanderChipMcp23017 ExpanderChip = "mcp23017"
+	// 8 pins, P0-P7, at 0x20-0x27 or 0x38-0x3f for a PCF8574A
+	ExpanderChipPcf8574 ExpanderChip = "pcf8574"
+)
+
+var AllExpanderChip = []ExpanderChip{
+	ExpanderChipMcp23017,
+	ExpanderChipPcf8574,
+}
+
+func (e ExpanderChip) IsValid() bool {
+	switch e {
+	case ExpanderChipMcp23017, ExpanderChipPcf8574:
+		return true
+	}
+	return false
+}
+
+func (e ExpanderChip) String() string {
+	return string(e)
+}
+
+func (e *ExpanderChip) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExpanderChip(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExpanderChip", str)
+	}
+	return nil
+}
+
+func (e ExpanderChip) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FermentationStatus string
+
+const (
+	// No gravity has been read yet
+	FermentationStatusWaiting FermentationStatus = "waiting"
+	// The gravity is still dropping, or there are not enough readings to tell
+	FermentationStatusFermenting FermentationStatus = "fermenting"
+	// The gravity is stable but the attenuation is below the target
+	FermentationStatusStalled FermentationStatus = "stalled"
+	// The gravity is stable and the attenuation reached the target
+	FermentationStatusComplete FermentationStatus = "complete"
+)
+
+var AllFermentationStatus = []FermentationStatus{
+	FermentationStatusWaiting,
+	FermentationStatusFermenting,
+	FermentationStatusStalled,
+	FermentationStatusComplete,
+}
+
+func (e FermentationStatus) IsValid() bool {
+	switch e {
+	case FermentationStatusWaiting, FermentationStatusFermenting, FermentationStatusStalled, FermentationStatusComplete:
+		return true
+	}
+	return false
+}
+
+func (e FermentationStatus) String() string {
+	return string(e)
+}
+
+func (e *FermentationStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FermentationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FermentationStatus", str)
+	}
+	return nil
+}
+
+func (e FermentationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type InputAction string
+
+const (
+	// The input is only watched
+	InputActionNone InputAction = "none"
+	// Toggle a switch each time the input becomes active, e.g. a panel button for a pump
+	InputActionToggleSwitch InputAction = "toggleSwitch"
+	// Hold off a controller's outputs while the input is inactive, e.g. a low level float switch for an element
+	InputActionInterlock InputAction = "interlock"
+)
+
+var AllInputAction = []InputAction{
+	InputActionNone,
+	InputActionToggleSwitch,
+	InputActionInterlock,
+}
+
+func (e InputAction) IsValid() bool {
+	switch e {
+	case InputActionNone, InputActionToggleSwitch, InputActionInterlock:
+		return true
+	}
+	return false
+}
+
+func (e InputAction) String() string {
+	return string(e)
+}
+
+func (e *InputAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InputAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InputAction", str)
+	}
+	return nil
+}
+
+func (e InputAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type InputPull string
+
+const (
+	// The pin floats, the input has its own pull resistor
+	InputPullNone InputPull = "none"
+	// The pin is pulled High, e.g. a switch to ground
+	InputPullUp InputPull = "up"
+	// The pin is pulled Low, e.g. a switch to 3.3V
+	InputPullDown InputPull = "down"
+)
+
+var AllInputPull = []InputPull{
+	InputPullNone,
+	InputPullUp,
+	InputPullDown,
+}
+
+func (e InputPull) IsValid() bool {
+	switch e {
+	case InputPullNone, InputPullUp, InputPullDown:
+		return true
+	}
+	return false
+}
+
+func (e InputPull) String() string {
+	return string(e)
+}
+
+func (e *InputPull) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InputPull(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InputPull", str)
+	}
+	return nil
+}
+
+func (e InputPull) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// What a schedule does about a run that was missed, e.g. while Elsinore was not running
+type MissedRunPolicy string
+
+const (
+	// Wait for the next run
+	MissedRunPolicySkip MissedRunPolicy = "skip"
+	// Run once as soon as possible, however many runs were missed
+	MissedRunPolicyRunOnce MissedRunPolicy = "runOnce"
+)
+
+var AllMissedRunPolicy = []MissedRunPolicy{
+	MissedRunPolicySkip,
+	MissedRunPolicyRunOnce,
+}
+
+func (e MissedRunPolicy) IsValid() bool {
+	switch e {
+	case MissedRunPolicySkip, MissedRunPolicyRunOnce:
+		return true
+	}
+	return false
+}
+
+func (e MissedRunPolicy) String() string {
+	return string(e)
+}
+
+func (e *MissedRunPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MissedRunPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MissedRunPolicy", str)
+	}
+	return nil
+}
+
+func (e MissedRunPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// The Modbus data tables
+type ModbusTable string
+
+const (
+	// Read/write bits, one per switch
+	ModbusTableCoil ModbusTable = "coil"
+	// Read only bits, one per digital input
+	ModbusTableDiscreteInput ModbusTable = "discreteInput"
+	// Read only words, controller and probe readings
+	ModbusTableInputRegister ModbusTable = "inputRegister"
+	// Read/write words, controller settings
+	ModbusTableHoldingRegister ModbusTable = "holdingRegister"
+)
+
+var AllModbusTable = []ModbusTable{
+	ModbusTableCoil,
+	ModbusTableDiscreteInput,
+	ModbusTableInputRegister,
+	ModbusTableHoldingRegister,
+}
+
+func (e ModbusTable) IsValid() bool {
+	switch e {
+	case ModbusTableCoil, ModbusTableDiscreteInput, ModbusTableInputRegister, ModbusTableHoldingRegister:
+		return true
+	}
+	return false
+}
+
+func (e ModbusTable) String() string {
+	return string(e)
+}
+
+func (e *ModbusTable) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModbusTable(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModbusTable", str)
+	}
+	return nil
+}
+
+func (e ModbusTable) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// How the readings of a probe are corrected
+type ProbeEventType string
+
+const (
+	// A probe was found on the bus, for the first time or after being lost
+	ProbeEventTypeDiscovered ProbeEventType = "discovered"
+	// A probe is no longer found on the bus
+	ProbeEventTypeLost ProbeEventType = "lost"
+)
+
+var AllProbeEventType = []ProbeEventType{
+	ProbeEventTypeDiscovered,
+	ProbeEventTypeLost,
+}
+
+func (e ProbeEventType) IsValid() bool {
+	switch e {
+	case ProbeEventTypeDiscovered, ProbeEventTypeLost:
+		return true
+	}
+	return false
+}
+
+func (e ProbeEventType) String() string {
+	return string(e)
+}
+
+func (e *ProbeEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProbeEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProbeEventType", str)
+	}
+	return nil
+}
+
+func (e ProbeEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Whether a probe can be used by its temperature controller
+type ProbeStatus string
+
+const (
+	// The probe is being used
+	ProbeStatusOk ProbeStatus = "ok"
+	// The probe has not been updated recently, or has no reading yet
+	ProbeStatusStale ProbeStatus = "stale"
+	// The probe reported an error
+	ProbeStatusFaulted ProbeStatus = "faulted"
+	// The probe has been disabled
+	ProbeStatusDisabled ProbeStatus = "disabled"
+)
+
+var AllProbeStatus = []ProbeStatus{
+	ProbeStatusOk,
+	ProbeStatusStale,
+	ProbeStatusFaulted,
+	ProbeStatusDisabled,
+}
+
+func (e ProbeStatus) IsValid() bool {
+	switch e {
+	case ProbeStatusOk, ProbeStatusStale, ProbeStatusFaulted, ProbeStatusDisabled:
+		return true
+	}
+	return false
+}
+
+func (e ProbeStatus) String() string {
+	return string(e)
+}
+
+func (e *ProbeStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProbeStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProbeStatus", str)
+	}
+	return nil
+}
+
+func (e ProbeStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SPIDriver string
+
+const (
+	// MAX31865 PT100/PT1000 RTD converter
+	SPIDriverMax31865 SPIDriver = "max31865"
+	// MAX31855 K-type thermocouple converter, reports the cold junction temperature and open/short faults
+	SPIDriverMax31855 SPIDriver = "max31855"
+	// MAX6675 K-type thermocouple converter, reports open faults
+	SPIDriverMax6675 SPIDriver = "max6675"
+)
+
+var AllSPIDriver = []SPIDriver{
+	SPIDriverMax31865,
+	SPIDriverMax31855,
+	SPIDriverMax6675,
+}
+
+func (e SPIDriver) IsValid() bool {
+	switch e {
+	case SPIDriverMax31865, SPIDriverMax31855, SPIDriverMax6675:
+		return true
+	}
+	return false
+}
+
+func (e SPIDriver) String() string {
+	return string(e)
+}
+
+func (e *SPIDriver) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SPIDriver(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SPIDriver", str)
+	}
+	return nil
+}
+
+func (e SPIDriver) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SwitchMode string
+
+const (
+	SwitchModeOn  SwitchMode = "on"
+	SwitchModeOff SwitchMode = "off"
+)
+
+var AllSwitchMode = []SwitchMode{
+	SwitchModeOn,
+	SwitchModeOff,
+}
+
+func (e SwitchMode) IsValid() bool {
+	switch e {
+	case SwitchModeOn, SwitchModeOff:
+		return true
+	}
+	return false
+}
+
+func (e SwitchMode) String() string {
+	return string(e)
+}
+
+func (e *SwitchMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SwitchMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SwitchMode", str)
+	}
+	return nil
+}
+
+func (e SwitchMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// A timed operation running on a switch
+type SwitchTimerKind string
+
+const (
+	// The switch is on, then turned off
+	SwitchTimerKindOnFor SwitchTimerKind = "onFor"
+	// The switch is turned on and off over and over
+	SwitchTimerKindPulse SwitchTimerKind = "pulse"
+)
+
+var AllSwitchTimerKind = []SwitchTimerKind{
+	SwitchTimerKindOnFor,
+	SwitchTimerKindPulse,
+}
+
+func (e SwitchTimerKind) IsValid() bool {
+	switch e {
+	case SwitchTimerKindOnFor, SwitchTimerKindPulse:
+		return true
+	}
+	return false
+}
+
+func (e SwitchTimerKind) String() string {
+	return string(e)
+}
+
+func (e *SwitchTimerKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SwitchTimerKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SwitchTimerKind", str)
+	}
+	return nil
+}
+
+func (e SwitchTimerKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// How far a webhook delivery has got
+type WebhookDeliveryStatus string
+
+const (
+	// The delivery is being attempted or is waiting to be retried
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+	// The URL responded with a 2xx status
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	// Every attempt failed, or the URL rejected the delivery
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "failed"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusDelivered,
+	WebhookDeliveryStatusFailed,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusDelivered, WebhookDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
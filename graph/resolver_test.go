@@ -2,8 +2,12 @@ package graph_test
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -13,27 +17,71 @@ import (
 	"github.com/dougedey/elsinore/graph/generated"
 	"github.com/dougedey/elsinore/graph/model"
 	"github.com/dougedey/elsinore/hardware"
+	"github.com/dougedey/elsinore/modbus"
+	"github.com/dougedey/elsinore/system"
 	"github.com/stretchr/testify/require"
 	"periph.io/x/periph/conn/gpio/gpioreg"
 	"periph.io/x/periph/conn/gpio/gpiotest"
 	"periph.io/x/periph/conn/onewire"
+	"periph.io/x/periph/conn/physic"
 )
 
+// setupTestDb uses a local SQLite database, or the database in ELSINORE_TEST_DSN when it is set (e.g. a local PostgreSQL)
 func setupTestDb(t *testing.T) {
 	dbName := "test"
+	testDSN := os.Getenv("ELSINORE_TEST_DSN")
+	if testDSN != "" {
+		dbName = testDSN
+	}
 	database.InitDatabase(&dbName,
 		&devices.TempProbeDetail{}, &devices.PidSettings{}, &devices.HysteriaSettings{},
 		&devices.ManualSettings{}, &devices.TemperatureController{}, &devices.Switch{},
+		&devices.ProbeSettings{}, &devices.SPIProbe{}, &devices.InPin{}, &devices.FlowMeter{},
+		&devices.Expander{},
+		&devices.Fermentation{}, &devices.FermentationStep{}, &devices.GravityReading{},
+		&devices.Webhook{}, &devices.WebhookDelivery{}, &devices.AlertRule{}, &devices.AlertChannel{},
+		&devices.AutomationRule{}, &devices.AutomationCondition{}, &devices.AutomationAction{},
+		&devices.Schedule{}, &devices.SwitchGroup{}, &devices.SwitchGroupMember{}, &devices.Scene{}, &devices.SceneStep{},
 	)
 	devices.ClearControllers()
 
 	t.Cleanup(func() {
+		if testDSN != "" {
+			if e := database.DropTables(); e != nil {
+				t.Fatal(e)
+			}
+			database.Close()
+			devices.ClearControllers()
+			devices.ClearProbeSettings()
+			devices.ClearSPIProbes()
+			devices.ClearFermentations()
+			devices.ClearInPins()
+			devices.ClearFlowMeters()
+			devices.ClearExpanders()
+			devices.ClearWebhooks()
+			devices.ClearAlerts()
+			devices.ClearAutomations()
+			devices.ClearSchedules()
+			devices.ClearScenes()
+			return
+		}
 		database.Close()
 		e := os.Remove("test.db")
 		if e != nil {
 			t.Fatal(e)
 		}
 		devices.ClearControllers()
+		devices.ClearProbeSettings()
+		devices.ClearSPIProbes()
+		devices.ClearFermentations()
+		devices.ClearInPins()
+		devices.ClearFlowMeters()
+		devices.ClearExpanders()
+		devices.ClearWebhooks()
+		devices.ClearAlerts()
+		devices.ClearAutomations()
+		devices.ClearSchedules()
+		devices.ClearScenes()
 	})
 }
 
@@ -613,6 +661,24 @@ func TestSystemSettings(t *testing.T) {
 			updateSettingsResp.UpdateSettings.BreweryName,
 		)
 	})
+
+	t.Run("The timezone can be updated", func(t *testing.T) {
+		var timezoneResp struct {
+			UpdateSettings struct{ BreweryName, Timezone string }
+		}
+		c.MustPost(`mutation { updateSettings(settings: {timezone: " Europe/Copenhagen "}) { breweryName timezone } }`, &timezoneResp)
+		require.Equal(t, "Europe/Copenhagen", timezoneResp.UpdateSettings.Timezone)
+		require.Equal(t, "Some Name", timezoneResp.UpdateSettings.BreweryName)
+		require.Equal(t, "Europe/Copenhagen", system.CurrentSettings().Location().String())
+
+		err := c.Post(`mutation { updateSettings(settings: {breweryName: "Other Name", timezone: "Mars/Olympus_Mons"}) { timezone } }`, &timezoneResp)
+		require.Equal(t, `[{"message":"'Mars/Olympus_Mons' is not a known timezone","path":["updateSettings"]}]`, err.Error())
+		require.Equal(t, "Some Name", system.CurrentSettings().BreweryName)
+
+		c.MustPost(`mutation { updateSettings(settings: {timezone: ""}) { timezone } }`, &timezoneResp)
+		require.Empty(t, timezoneResp.UpdateSettings.Timezone)
+		require.Equal(t, time.Local, system.CurrentSettings().Location())
+	})
 }
 
 func TestSwitches(t *testing.T) {
@@ -803,6 +869,161 @@ func TestSwitches(t *testing.T) {
 		require.Equal(t, "off", toggleSwitchResp.ToggleSwitch.State)
 	})
 
+	t.Run("Can make a switch a dimmer", func(t *testing.T) {
+		var dimmerResp struct {
+			ModifySwitch struct {
+				Frequency   int
+				Duty        int
+				State       string
+				SoftwarePWM bool
+			}
+		}
+		c.MustPost(`
+			mutation {
+				modifySwitch(switchSettings: {id: 1, frequency: 25000, duty: 40, state: on} ) {
+					frequency
+					duty
+					state
+					softwarePWM
+				}
+			}
+		`, &dimmerResp)
+
+		require.Equal(t, 25000, dimmerResp.ModifySwitch.Frequency)
+		require.Equal(t, 40, dimmerResp.ModifySwitch.Duty)
+		require.Equal(t, "on", dimmerResp.ModifySwitch.State)
+		require.False(t, dimmerResp.ModifySwitch.SoftwarePWM)
+
+		c.MustPost(`
+			mutation {
+				modifySwitch(switchSettings: {id: 1, frequency: 0, state: off} ) {
+					frequency
+					duty
+					state
+					softwarePWM
+				}
+			}
+		`, &dimmerResp)
+		require.Equal(t, 0, dimmerResp.ModifySwitch.Frequency)
+		require.Equal(t, "off", dimmerResp.ModifySwitch.State)
+	})
+
+	t.Run("Cannot set a duty over 100%", func(t *testing.T) {
+		var dimmerResp struct{}
+		err := c.Post(`
+			mutation {
+				modifySwitch(switchSettings: {id: 1, duty: 150} ) {
+					id
+				}
+			}
+		`, &dimmerResp)
+
+		require.NotNil(t, err)
+	})
+
+	t.Run("Can create a switch on a network plug", func(t *testing.T) {
+		plug := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"POWER":"%v"}`, strings.ToUpper(strings.TrimPrefix(r.URL.Query().Get("cmnd"), "Power ")))
+		}))
+		t.Cleanup(plug.Close)
+
+		var plugResp struct {
+			ModifySwitch struct {
+				ID          string
+				State       string
+				Networked   bool
+				OutputError *string
+			}
+		}
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				modifySwitch(switchSettings: {name: "Fridge", gpio: "tasmota://%v", state: on} ) {
+					id
+					state
+					networked
+					outputError
+				}
+			}
+		`, strings.TrimPrefix(plug.URL, "http://")), &plugResp)
+
+		require.Equal(t, "on", plugResp.ModifySwitch.State)
+		require.True(t, plugResp.ModifySwitch.Networked)
+		require.Nil(t, plugResp.ModifySwitch.OutputError)
+
+		var deleteResp struct {
+			DeleteSwitch struct {
+				ID string
+			}
+		}
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				deleteSwitch(id: %v) {
+					id
+				}
+			}
+		`, plugResp.ModifySwitch.ID), &deleteResp)
+		require.Equal(t, plugResp.ModifySwitch.ID, deleteResp.DeleteSwitch.ID)
+	})
+
+	t.Run("Cannot create a switch on an invalid network output", func(t *testing.T) {
+		var plugResp struct{}
+		err := c.Post(`
+			mutation {
+				modifySwitch(switchSettings: {name: "Fridge", gpio: "tasmota://"} ) {
+					id
+				}
+			}
+		`, &plugResp)
+
+		require.NotNil(t, err)
+	})
+
+	t.Run("A switch can run a timer", func(t *testing.T) {
+		type timedSwitch struct {
+			State          string
+			MaxOnSeconds   int
+			AutoOffSeconds *float64
+			Timer          *struct {
+				Kind             string
+				OnSeconds        float64
+				OffSeconds       float64
+				On               bool
+				CyclesLeft       *int
+				RemainingSeconds float64
+			}
+		}
+		var onForResp struct{ SwitchOnFor timedSwitch }
+		c.MustPost(`mutation { switchOnFor(id: 1, seconds: 90) { state timer { kind onSeconds cyclesLeft remainingSeconds } } }`, &onForResp)
+		require.Equal(t, "on", onForResp.SwitchOnFor.State)
+		require.Equal(t, "onFor", onForResp.SwitchOnFor.Timer.Kind)
+		require.Equal(t, 90.0, onForResp.SwitchOnFor.Timer.OnSeconds)
+		require.Nil(t, onForResp.SwitchOnFor.Timer.CyclesLeft)
+		require.InDelta(t, 90, onForResp.SwitchOnFor.Timer.RemainingSeconds, 1)
+
+		var pulseResp struct{ PulseSwitch timedSwitch }
+		c.MustPost(`mutation { pulseSwitch(id: 1, onSeconds: 0.5, offSeconds: 30, cycles: 3) { state timer { kind onSeconds offSeconds on cyclesLeft } } }`, &pulseResp)
+		require.Equal(t, "pulse", pulseResp.PulseSwitch.Timer.Kind)
+		require.Equal(t, 0.5, pulseResp.PulseSwitch.Timer.OnSeconds)
+		require.Equal(t, 30.0, pulseResp.PulseSwitch.Timer.OffSeconds)
+		require.True(t, pulseResp.PulseSwitch.Timer.On)
+		require.Equal(t, 3, *pulseResp.PulseSwitch.Timer.CyclesLeft)
+
+		err := c.Post(`mutation { pulseSwitch(id: 1, onSeconds: 1, offSeconds: 0) { state } }`, &pulseResp)
+		require.Equal(t, `[{"message":"a pulse has to be on and off for more than 0 seconds, got 1 and 0","path":["pulseSwitch"]}]`, err.Error())
+
+		var maxOnResp struct{ ModifySwitch timedSwitch }
+		c.MustPost(`mutation { modifySwitch(switchSettings: {id: 1, maxOnSeconds: 600}) { maxOnSeconds autoOffSeconds timer { kind } } }`, &maxOnResp)
+		require.Equal(t, 600, maxOnResp.ModifySwitch.MaxOnSeconds)
+		require.InDelta(t, 600, *maxOnResp.ModifySwitch.AutoOffSeconds, 1)
+		require.NotNil(t, maxOnResp.ModifySwitch.Timer)
+
+		var toggleResp struct{ ToggleSwitch timedSwitch }
+		c.MustPost(`mutation { toggleSwitch(id: 1, mode: off) { state autoOffSeconds timer { kind } } }`, &toggleResp)
+		require.Equal(t, "off", toggleResp.ToggleSwitch.State)
+		require.Nil(t, toggleResp.ToggleSwitch.AutoOffSeconds)
+		require.Nil(t, toggleResp.ToggleSwitch.Timer)
+	})
+
 	var deleteSwitchResp struct {
 		DeleteSwitch struct {
 			Id    string
@@ -854,3 +1075,1512 @@ func TestSwitches(t *testing.T) {
 		require.Equal(t, "off", switchResp.ModifySwitch.State)
 	})
 }
+
+func TestBackups(t *testing.T) {
+	if os.Getenv("ELSINORE_TEST_DSN") != "" {
+		t.Skip("Backups are only supported for SQLite")
+	}
+	setupTestDb(t)
+	database.ConfigureBackups(database.BackupSettings{Directory: t.TempDir(), Keep: 5})
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+
+	var createBackupResp struct {
+		CreateBackup struct {
+			Name string
+			Size int
+		}
+	}
+
+	var backupsResp struct {
+		Backups []struct {
+			Name string
+		}
+	}
+
+	var restoreBackupResp struct {
+		RestoreBackup struct {
+			Name string
+		}
+	}
+
+	t.Run("Can create a backup", func(t *testing.T) {
+		c.MustPost(`
+			mutation {
+				createBackup {
+					name
+					size
+				}
+			}
+		`, &createBackupResp)
+
+		require.NotEmpty(t, createBackupResp.CreateBackup.Name)
+		require.True(t, createBackupResp.CreateBackup.Size > 0)
+	})
+
+	t.Run("Backups lists the created backup", func(t *testing.T) {
+		c.MustPost(`
+			query {
+				backups {
+					name
+				}
+			}
+		`, &backupsResp)
+
+		require.Len(t, backupsResp.Backups, 1)
+		require.Equal(t, createBackupResp.CreateBackup.Name, backupsResp.Backups[0].Name)
+	})
+
+	t.Run("Restoring a backup reloads the controllers from the snapshot", func(t *testing.T) {
+		_, err := devices.CreateTemperatureController("Restored away", &devices.TempProbeDetail{PhysAddr: "ARealAddress"})
+		require.Nil(t, err)
+
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				restoreBackup(name: "%v") {
+					name
+				}
+			}
+		`, createBackupResp.CreateBackup.Name), &restoreBackupResp)
+
+		require.Equal(t, createBackupResp.CreateBackup.Name, restoreBackupResp.RestoreBackup.Name)
+		require.False(t, devices.TemperatureControllersSuspended())
+		require.Empty(t, devices.AllTemperatureControllers())
+	})
+
+	t.Run("Restoring an unknown backup returns an error", func(t *testing.T) {
+		err := c.Post(`
+			mutation {
+				restoreBackup(name: "../test.db") {
+					name
+				}
+			}
+		`, &restoreBackupResp)
+
+		require.NotNil(t, err)
+		require.Equal(t,
+			`[{"message":"invalid backup name '../test.db'","path":["restoreBackup"]}]`,
+			err.Error(),
+		)
+	})
+}
+
+func TestCalibrateProbe(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+
+	hardwareProbe := &hardware.TemperatureProbe{PhysAddr: "CalibrateAddress", Address: onewire.Address(4321)}
+	require.Nil(t, hardwareProbe.UpdateTemperature("21C"))
+	hardware.SetProbe(hardwareProbe)
+
+	var calibrateResp struct {
+		CalibrateProbe struct {
+			Reading     string
+			RawReading  string
+			Calibration struct {
+				Mode   string
+				Offset *string
+			}
+		}
+	}
+
+	t.Run("Calibrating an unassigned probe returns an error", func(t *testing.T) {
+		err := c.Post(`
+			mutation {
+				calibrateProbe(address: "CalibrateAddress", point: offset, reference: "20C") {
+					reading
+				}
+			}
+		`, &calibrateResp)
+
+		require.NotNil(t, err)
+		require.Equal(t,
+			`[{"message":"no temperature controller has the probe CalibrateAddress assigned","path":["calibrateProbe"]}]`,
+			err.Error(),
+		)
+	})
+
+	_, err := devices.CreateTemperatureController("Calibration", &devices.TempProbeDetail{PhysAddr: "CalibrateAddress"})
+	require.Nil(t, err)
+
+	t.Run("Calibrating an offset returns the raw and calibrated readings", func(t *testing.T) {
+		c.MustPost(`
+			mutation {
+				calibrateProbe(address: "CalibrateAddress", point: offset, reference: "20C") {
+					reading
+					rawReading
+					calibration {
+						mode
+						offset
+					}
+				}
+			}
+		`, &calibrateResp)
+
+		require.Equal(t, "20°C", calibrateResp.CalibrateProbe.Reading)
+		require.Equal(t, "21°C", calibrateResp.CalibrateProbe.RawReading)
+		require.Equal(t, "offset", calibrateResp.CalibrateProbe.Calibration.Mode)
+		require.Equal(t, "-1°C", *calibrateResp.CalibrateProbe.Calibration.Offset)
+	})
+
+	var resetResp struct {
+		ResetProbeCalibration struct {
+			Reading     string
+			Calibration struct {
+				Mode   string
+				Offset *string
+			}
+		}
+	}
+
+	t.Run("Resetting the calibration returns the raw reading", func(t *testing.T) {
+		c.MustPost(`
+			mutation {
+				resetProbeCalibration(address: "CalibrateAddress") {
+					reading
+					calibration {
+						mode
+						offset
+					}
+				}
+			}
+		`, &resetResp)
+
+		require.Equal(t, "21°C", resetResp.ResetProbeCalibration.Reading)
+		require.Equal(t, "none", resetResp.ResetProbeCalibration.Calibration.Mode)
+		require.Nil(t, resetResp.ResetProbeCalibration.Calibration.Offset)
+	})
+}
+
+func TestProbeRegistry(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+
+	hardware.SetProbe(&hardware.TemperatureProbe{PhysAddr: "RegistryAddress", Address: onewire.Address(9876)})
+
+	var updateProbeResp struct {
+		UpdateProbe struct {
+			PhysAddr  string
+			Name      string
+			Location  string
+			Enabled   bool
+			Connected bool
+		}
+	}
+
+	var registeredProbesResp struct {
+		RegisteredProbes []struct {
+			PhysAddr  string
+			Name      string
+			Connected bool
+		}
+	}
+
+	var probeListResp struct {
+		ProbeList []struct {
+			PhysAddr string
+		}
+	}
+
+	t.Run("A connected probe can be named", func(t *testing.T) {
+		c.MustPost(`
+			mutation {
+				updateProbe(probeSettings: { physAddr: "RegistryAddress", name: "Kettle", location: "Brewhouse" }) {
+					physAddr
+					name
+					location
+					enabled
+					connected
+				}
+			}
+		`, &updateProbeResp)
+
+		require.Equal(t, "Kettle", updateProbeResp.UpdateProbe.Name)
+		require.Equal(t, "Brewhouse", updateProbeResp.UpdateProbe.Location)
+		require.True(t, updateProbeResp.UpdateProbe.Enabled)
+		require.True(t, updateProbeResp.UpdateProbe.Connected)
+	})
+
+	t.Run("A probe that is not connected can be named", func(t *testing.T) {
+		c.MustPost(`
+			mutation {
+				updateProbe(probeSettings: { physAddr: "SpareProbe", name: "Spare" }) {
+					physAddr
+					name
+					connected
+				}
+			}
+		`, &updateProbeResp)
+
+		require.Equal(t, "Spare", updateProbeResp.UpdateProbe.Name)
+		require.False(t, updateProbeResp.UpdateProbe.Connected)
+	})
+
+	t.Run("Registered probes are listed with their live state", func(t *testing.T) {
+		c.MustPost(`
+			query {
+				registeredProbes {
+					physAddr
+					name
+					connected
+				}
+			}
+		`, &registeredProbesResp)
+
+		require.Len(t, registeredProbesResp.RegisteredProbes, 2)
+		for _, probe := range registeredProbesResp.RegisteredProbes {
+			require.Equal(t, probe.PhysAddr == "RegistryAddress", probe.Connected)
+		}
+	})
+
+	t.Run("Disabled probes are not available and cannot be assigned", func(t *testing.T) {
+		c.MustPost(`
+			mutation {
+				updateProbe(probeSettings: { physAddr: "RegistryAddress", enabled: false }) {
+					physAddr
+				}
+			}
+		`, &updateProbeResp)
+
+		c.MustPost(`
+			query {
+				probeList(available: true) {
+					physAddr
+				}
+			}
+		`, &probeListResp)
+		for _, probe := range probeListResp.ProbeList {
+			require.NotEqual(t, "RegistryAddress", probe.PhysAddr)
+		}
+
+		var assignResp struct {
+			AssignProbe struct {
+				ID string
+			}
+		}
+		err := c.Post(`
+			mutation {
+				assignProbe(address: "RegistryAddress", name: "Kettle") {
+					id
+				}
+			}
+		`, &assignResp)
+		require.NotNil(t, err)
+		require.Equal(t,
+			`[{"message":"probe RegistryAddress is disabled","path":["assignProbe"]}]`,
+			err.Error(),
+		)
+	})
+
+	t.Run("Forgetting a probe returns it to its address", func(t *testing.T) {
+		var forgetResp struct {
+			ForgetProbe struct {
+				Name string
+			}
+		}
+		c.MustPost(`
+			mutation {
+				forgetProbe(address: "RegistryAddress") {
+					name
+				}
+			}
+		`, &forgetResp)
+
+		require.Equal(t, "RegistryAddress", forgetResp.ForgetProbe.Name)
+	})
+}
+
+func TestRescanProbes(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+
+	t.Run("Rescanning without a bus returns an error", func(t *testing.T) {
+		var rescanResp struct {
+			RescanProbes struct {
+				Discovered []struct {
+					PhysAddr string
+				}
+			}
+		}
+		err := c.Post(`
+			mutation {
+				rescanProbes {
+					discovered {
+						physAddr
+					}
+				}
+			}
+		`, &rescanResp)
+
+		require.NotNil(t, err)
+		require.Equal(t,
+			`[{"message":"no 1-Wire bus is open to scan","path":["rescanProbes"]}]`,
+			err.Error(),
+		)
+	})
+
+	t.Run("Probe events can be listed", func(t *testing.T) {
+		var eventsResp struct {
+			ProbeEvents []struct {
+				Type     string
+				PhysAddr string
+			}
+		}
+		c.MustPost(`
+			query {
+				probeEvents {
+					type
+					physAddr
+				}
+			}
+		`, &eventsResp)
+
+		require.NotNil(t, eventsResp.ProbeEvents)
+	})
+}
+
+func TestSPIProbes(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+
+	var modifyResp struct {
+		ModifySPIProbe struct {
+			ID           string
+			PhysAddr     string
+			Driver       string
+			Wires        int
+			ConnectError string
+			Probe        struct {
+				Connected bool
+			}
+		}
+	}
+
+	t.Run("An SPI probe can be created", func(t *testing.T) {
+		c.MustPost(`
+			mutation {
+				modifySPIProbe(spiProbe: { driver: max31865, bus: 0, chipSelect: 0, wires: 4 }) {
+					id
+					physAddr
+					driver
+					wires
+					connectError
+					probe {
+						connected
+					}
+				}
+			}
+		`, &modifyResp)
+
+		require.Equal(t, "max31865-spi0.0", modifyResp.ModifySPIProbe.PhysAddr)
+		require.Equal(t, "max31865", modifyResp.ModifySPIProbe.Driver)
+		require.Equal(t, 4, modifyResp.ModifySPIProbe.Wires)
+		require.NotEmpty(t, modifyResp.ModifySPIProbe.ConnectError)
+		require.False(t, modifyResp.ModifySPIProbe.Probe.Connected)
+	})
+
+	t.Run("SPI probes are listed", func(t *testing.T) {
+		var listResp struct {
+			SpiProbes []struct {
+				ID string
+			}
+		}
+		c.MustPost(`
+			query {
+				spiProbes {
+					id
+				}
+			}
+		`, &listResp)
+
+		require.Len(t, listResp.SpiProbes, 1)
+		require.Equal(t, modifyResp.ModifySPIProbe.ID, listResp.SpiProbes[0].ID)
+	})
+
+	t.Run("An SPI probe can be deleted", func(t *testing.T) {
+		var deleteResp struct {
+			DeleteSPIProbe struct {
+				ID string
+			}
+		}
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				deleteSPIProbe(id: "%v") {
+					id
+				}
+			}
+		`, modifyResp.ModifySPIProbe.ID), &deleteResp)
+
+		require.Equal(t, modifyResp.ModifySPIProbe.ID, deleteResp.DeleteSPIProbe.ID)
+	})
+}
+
+func TestFermentation(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+
+	controller, err := devices.CreateTemperatureController("Fermenter", &devices.TempProbeDetail{PhysAddr: "FermenterAddress"})
+	require.Nil(t, err)
+
+	var startResp struct {
+		StartFermentation struct {
+			ID                string
+			OriginalGravity   *float64
+			TargetAttenuation float64
+			Status            string
+			Steps             []struct {
+				Name        string
+				Attenuation float64
+			}
+		}
+	}
+
+	t.Run("A fermentation can be started", func(t *testing.T) {
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				startFermentation(fermentation: { controllerId: "%v", steps: [{ name: "Diacetyl rest", attenuation: 50, setPoint: "20C" }] }) {
+					id
+					originalGravity
+					targetAttenuation
+					status
+					steps {
+						name
+						attenuation
+					}
+				}
+			}
+		`, controller.ID), &startResp)
+
+		require.Nil(t, startResp.StartFermentation.OriginalGravity)
+		require.Equal(t, 65.0, startResp.StartFermentation.TargetAttenuation)
+		require.Equal(t, "waiting", startResp.StartFermentation.Status)
+		require.Len(t, startResp.StartFermentation.Steps, 1)
+	})
+
+	t.Run("A gravity can be recorded", func(t *testing.T) {
+		var recordResp struct {
+			RecordGravity struct {
+				OriginalGravity float64
+				CurrentGravity  float64
+				Status          string
+				Readings        []struct {
+					Gravity float64
+				}
+			}
+		}
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				recordGravity(controllerId: "%v", gravity: 1.048) {
+					originalGravity
+					currentGravity
+					status
+					readings {
+						gravity
+					}
+				}
+			}
+		`, controller.ID), &recordResp)
+
+		require.Equal(t, 1.048, recordResp.RecordGravity.OriginalGravity)
+		require.Equal(t, 1.048, recordResp.RecordGravity.CurrentGravity)
+		require.Equal(t, "fermenting", recordResp.RecordGravity.Status)
+		require.Len(t, recordResp.RecordGravity.Readings, 1)
+	})
+
+	t.Run("An invalid gravity returns an error", func(t *testing.T) {
+		var recordResp struct{}
+		err := c.Post(fmt.Sprintf(`
+			mutation {
+				recordGravity(controllerId: "%v", gravity: 12) {
+					id
+				}
+			}
+		`, controller.ID), &recordResp)
+
+		require.NotNil(t, err)
+	})
+
+	t.Run("The controller returns its fermentation", func(t *testing.T) {
+		var controllerResp struct {
+			TemperatureControllers []struct {
+				Fermentation struct {
+					ID string
+				}
+			}
+		}
+		c.MustPost(`
+			query {
+				temperatureControllers(name: "Fermenter") {
+					fermentation {
+						id
+					}
+				}
+			}
+		`, &controllerResp)
+
+		require.Len(t, controllerResp.TemperatureControllers, 1)
+		require.Equal(t, startResp.StartFermentation.ID, controllerResp.TemperatureControllers[0].Fermentation.ID)
+	})
+
+	t.Run("A fermentation can be ended", func(t *testing.T) {
+		var endResp struct {
+			EndFermentation struct {
+				ID string
+			}
+		}
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				endFermentation(controllerId: "%v") {
+					id
+				}
+			}
+		`, controller.ID), &endResp)
+
+		require.Equal(t, startResp.StartFermentation.ID, endResp.EndFermentation.ID)
+		require.Nil(t, controller.Fermentation())
+	})
+}
+
+func TestInPins(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+
+	var modifyResp struct {
+		ModifyInPin struct {
+			ID           string
+			Name         string
+			Gpio         string
+			Pull         string
+			Debounce     int
+			Active       bool
+			ConnectError string
+		}
+	}
+
+	t.Run("An input can be created", func(t *testing.T) {
+		c.MustPost(`
+			mutation {
+				modifyInPin(inPin: { name: "Low level", gpio: "NotAPin", pull: up, debounce: 50 }) {
+					id
+					name
+					gpio
+					pull
+					debounce
+					active
+					connectError
+				}
+			}
+		`, &modifyResp)
+
+		require.Equal(t, "Low level", modifyResp.ModifyInPin.Name)
+		require.Equal(t, "NotAPin", modifyResp.ModifyInPin.Gpio)
+		require.Equal(t, "up", modifyResp.ModifyInPin.Pull)
+		require.Equal(t, 50, modifyResp.ModifyInPin.Debounce)
+		require.Equal(t, "no pin for NotAPin", modifyResp.ModifyInPin.ConnectError)
+	})
+
+	t.Run("An input cannot use a GPIO in use", func(t *testing.T) {
+		var errResp struct{}
+		err := c.Post(`
+			mutation {
+				modifySwitch(switchSettings: { name: "Pump", gpio: "NotAPin" }) {
+					id
+				}
+			}
+		`, &errResp)
+
+		require.NotNil(t, err)
+	})
+
+	t.Run("Inputs are listed", func(t *testing.T) {
+		var listResp struct {
+			InPins []struct {
+				ID string
+			}
+		}
+		c.MustPost(`
+			query {
+				inPins {
+					id
+				}
+			}
+		`, &listResp)
+
+		require.Len(t, listResp.InPins, 1)
+		require.Equal(t, modifyResp.ModifyInPin.ID, listResp.InPins[0].ID)
+	})
+
+	t.Run("An input can be deleted", func(t *testing.T) {
+		var deleteResp struct {
+			DeleteInPin struct {
+				ID string
+			}
+		}
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				deleteInPin(id: "%v") {
+					id
+				}
+			}
+		`, modifyResp.ModifyInPin.ID), &deleteResp)
+
+		require.Equal(t, modifyResp.ModifyInPin.ID, deleteResp.DeleteInPin.ID)
+	})
+}
+
+func TestExpanders(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+
+	var modifyResp struct {
+		ModifyExpander struct {
+			ID           string
+			Chip         string
+			Bus          string
+			Address      int
+			Name         string
+			Pins         []string
+			ConnectError string
+		}
+	}
+
+	t.Run("An expander can be created", func(t *testing.T) {
+		c.MustPost(`
+			mutation {
+				modifyExpander(expander: { chip: pcf8574, bus: "9", address: 57 }) {
+					id
+					chip
+					bus
+					address
+					name
+					pins
+					connectError
+				}
+			}
+		`, &modifyResp)
+
+		require.Equal(t, "pcf8574", modifyResp.ModifyExpander.Chip)
+		require.Equal(t, "9", modifyResp.ModifyExpander.Bus)
+		require.Equal(t, 57, modifyResp.ModifyExpander.Address)
+		require.Equal(t, "pcf8574@0x39", modifyResp.ModifyExpander.Name)
+		require.Len(t, modifyResp.ModifyExpander.Pins, 8)
+		require.Equal(t, "pcf8574@0x39:P7", modifyResp.ModifyExpander.Pins[7])
+		require.NotEmpty(t, modifyResp.ModifyExpander.ConnectError)
+	})
+
+	t.Run("An expander at an invalid address cannot be created", func(t *testing.T) {
+		var errResp struct{}
+		err := c.Post(`
+			mutation {
+				modifyExpander(expander: { chip: mcp23017, address: 57 }) {
+					id
+				}
+			}
+		`, &errResp)
+
+		require.NotNil(t, err)
+	})
+
+	t.Run("Expanders are listed", func(t *testing.T) {
+		var listResp struct {
+			Expanders []struct {
+				ID string
+			}
+		}
+		c.MustPost(`
+			query {
+				expanders {
+					id
+				}
+			}
+		`, &listResp)
+
+		require.Len(t, listResp.Expanders, 1)
+		require.Equal(t, modifyResp.ModifyExpander.ID, listResp.Expanders[0].ID)
+	})
+
+	t.Run("An expander can be deleted", func(t *testing.T) {
+		var deleteResp struct {
+			DeleteExpander struct {
+				ID string
+			}
+		}
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				deleteExpander(id: "%v") {
+					id
+				}
+			}
+		`, modifyResp.ModifyExpander.ID), &deleteResp)
+
+		require.Equal(t, modifyResp.ModifyExpander.ID, deleteResp.DeleteExpander.ID)
+	})
+}
+
+func TestFlowMeters(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+
+	var modifyResp struct {
+		ModifyFlowMeter struct {
+			ID             string
+			Name           string
+			PulsesPerLitre float64
+			Volume         float64
+			ConnectError   string
+		}
+	}
+
+	t.Run("A flow meter can be created", func(t *testing.T) {
+		c.MustPost(`
+			mutation {
+				modifyFlowMeter(flowMeter: { name: "Sparge", gpio: "NotAFlowPin", pulsesPerLitre: 330 }) {
+					id
+					name
+					pulsesPerLitre
+					volume
+					connectError
+				}
+			}
+		`, &modifyResp)
+
+		require.Equal(t, "Sparge", modifyResp.ModifyFlowMeter.Name)
+		require.Equal(t, 330.0, modifyResp.ModifyFlowMeter.PulsesPerLitre)
+		require.Equal(t, 0.0, modifyResp.ModifyFlowMeter.Volume)
+		require.Equal(t, "no pin for NotAFlowPin", modifyResp.ModifyFlowMeter.ConnectError)
+	})
+
+	t.Run("Dispensing needs a switch", func(t *testing.T) {
+		var dispenseResp struct{}
+		err := c.Post(fmt.Sprintf(`
+			mutation {
+				dispense(flowMeterId: "%v", litres: 20, switchId: "9999") {
+					id
+				}
+			}
+		`, modifyResp.ModifyFlowMeter.ID), &dispenseResp)
+
+		require.NotNil(t, err)
+		require.Equal(t, `[{"message":"no switch found with id '9999'","path":["dispense"]}]`, err.Error())
+	})
+
+	t.Run("A flow meter can be reset", func(t *testing.T) {
+		var resetResp struct {
+			ResetFlowMeter struct {
+				Volume         float64
+				LifetimeVolume float64
+			}
+		}
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				resetFlowMeter(id: "%v") {
+					volume
+					lifetimeVolume
+				}
+			}
+		`, modifyResp.ModifyFlowMeter.ID), &resetResp)
+
+		require.Equal(t, 0.0, resetResp.ResetFlowMeter.Volume)
+	})
+
+	t.Run("Flow meters are listed", func(t *testing.T) {
+		var listResp struct {
+			FlowMeters []struct {
+				ID string
+			}
+		}
+		c.MustPost(`
+			query {
+				flowMeters {
+					id
+				}
+			}
+		`, &listResp)
+
+		require.Len(t, listResp.FlowMeters, 1)
+		require.Equal(t, modifyResp.ModifyFlowMeter.ID, listResp.FlowMeters[0].ID)
+	})
+
+	t.Run("A flow meter can be deleted", func(t *testing.T) {
+		var deleteResp struct {
+			DeleteFlowMeter struct {
+				ID string
+			}
+		}
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				deleteFlowMeter(id: "%v") {
+					id
+				}
+			}
+		`, modifyResp.ModifyFlowMeter.ID), &deleteResp)
+
+		require.Equal(t, modifyResp.ModifyFlowMeter.ID, deleteResp.DeleteFlowMeter.ID)
+	})
+}
+
+func TestModbusRegisters(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+	controller, err := devices.CreateTemperatureController("Modbus Kettle", &devices.TempProbeDetail{PhysAddr: "ARealAddress"})
+	require.Nil(t, err)
+	require.Nil(t, controller.UpdateSetPoint("65C"))
+
+	var resp struct {
+		ModbusRegisters []struct {
+			Table       string
+			Address     int
+			Name        string
+			Description string
+			Writable    bool
+			Value       int
+		}
+	}
+	c.MustPost(`
+		query {
+			modbusRegisters {
+				table
+				address
+				name
+				description
+				writable
+				value
+			}
+		}
+	`, &resp)
+
+	found := false
+	for _, register := range resp.ModbusRegisters {
+		if register.Name != "Modbus Kettle set point" {
+			continue
+		}
+		found = true
+		require.Equal(t, "holdingRegister", register.Table)
+		require.Equal(t, int(controller.ID-1)*modbus.ControllerStride+1, register.Address)
+		require.True(t, register.Writable)
+		require.Equal(t, 650, register.Value)
+	}
+	require.True(t, found)
+}
+
+func TestWebhooks(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+	received := make(chan string, 10)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Get("X-Elsinore-Event")
+	}))
+	t.Cleanup(receiver.Close)
+
+	var modifyResp struct {
+		ModifyWebhook struct {
+			ID      string
+			Name    string
+			URL     string
+			Events  []string
+			Secret  string
+			Enabled bool
+		}
+	}
+
+	t.Run("A webhook can be created", func(t *testing.T) {
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				modifyWebhook(webhook: { name: "Slack", url: "%v", events: [probeOutOfRange, safetyFault], secret: "hunter2" }) {
+					id
+					name
+					url
+					events
+					secret
+					enabled
+				}
+			}
+		`, receiver.URL), &modifyResp)
+
+		require.Equal(t, "Slack", modifyResp.ModifyWebhook.Name)
+		require.Equal(t, receiver.URL, modifyResp.ModifyWebhook.URL)
+		require.Equal(t, []string{"probeOutOfRange", "safetyFault"}, modifyResp.ModifyWebhook.Events)
+		require.Equal(t, "hunter2", modifyResp.ModifyWebhook.Secret)
+		require.True(t, modifyResp.ModifyWebhook.Enabled)
+	})
+
+	t.Run("A webhook without a URL cannot be created", func(t *testing.T) {
+		var errResp struct{}
+		err := c.Post(`
+			mutation {
+				modifyWebhook(webhook: { name: "n8n", events: [switchToggled] }) {
+					id
+				}
+			}
+		`, &errResp)
+
+		require.NotNil(t, err)
+	})
+
+	t.Run("Out of range probes are sent and logged", func(t *testing.T) {
+		hardware.SetProbe(&hardware.TemperatureProbe{PhysAddr: "WebhookProbe", Address: onewire.Address(7070), ReadingRaw: physic.ZeroCelsius + 20*physic.Celsius})
+		var probeResp struct {
+			UpdateProbe struct {
+				LowLimit  string
+				HighLimit string
+			}
+		}
+		c.MustPost(`
+			mutation {
+				updateProbe(probeSettings: { physAddr: "WebhookProbe", lowLimit: "50C", highLimit: "80c" }) {
+					lowLimit
+					highLimit
+				}
+			}
+		`, &probeResp)
+		require.Equal(t, "50°C", probeResp.UpdateProbe.LowLimit)
+		require.Equal(t, "80°C", probeResp.UpdateProbe.HighLimit)
+
+		devices.CheckProbes()
+		select {
+		case event := <-received:
+			require.Equal(t, "probeOutOfRange", event)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "the webhook was not called")
+		}
+
+		var eventsResp struct {
+			DeviceEvents []struct {
+				Type     string
+				DeviceID string
+				Message  string
+			}
+		}
+		c.MustPost(`
+			query {
+				deviceEvents {
+					type
+					deviceId
+					message
+				}
+			}
+		`, &eventsResp)
+		last := eventsResp.DeviceEvents[len(eventsResp.DeviceEvents)-1]
+		require.Equal(t, "probeOutOfRange", last.Type)
+		require.Equal(t, "WebhookProbe", last.DeviceID)
+		require.Equal(t, "WebhookProbe read 20°C, below the low limit of 50°C", last.Message)
+
+		type delivery struct {
+			WebhookID string
+			Event     string
+			Status    string
+			Attempts  int
+		}
+		var deliveriesResp struct {
+			Webhooks []struct {
+				Deliveries []delivery
+			}
+			WebhookDeliveries []delivery
+		}
+		require.Eventually(t, func() bool {
+			c.MustPost(fmt.Sprintf(`
+				query {
+					webhooks {
+						deliveries(limit: 5) {
+							webhookId
+							event
+							status
+							attempts
+						}
+					}
+					webhookDeliveries(webhookId: "%v") {
+						webhookId
+						event
+						status
+						attempts
+					}
+				}
+			`, modifyResp.ModifyWebhook.ID), &deliveriesResp)
+			return deliveriesResp.WebhookDeliveries[0].Status == "delivered"
+		}, 5*time.Second, 10*time.Millisecond)
+		expected := delivery{WebhookID: modifyResp.ModifyWebhook.ID, Event: "probeOutOfRange", Status: "delivered", Attempts: 1}
+		require.Equal(t, []delivery{expected}, deliveriesResp.Webhooks[0].Deliveries)
+		require.Equal(t, []delivery{expected}, deliveriesResp.WebhookDeliveries)
+	})
+
+	t.Run("A webhook can be tested", func(t *testing.T) {
+		var testResp struct {
+			TestWebhook struct {
+				Status       string
+				ResponseCode int
+			}
+		}
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				testWebhook(id: "%v") {
+					status
+					responseCode
+				}
+			}
+		`, modifyResp.ModifyWebhook.ID), &testResp)
+		require.Equal(t, "delivered", testResp.TestWebhook.Status)
+		require.Equal(t, http.StatusOK, testResp.TestWebhook.ResponseCode)
+		require.Equal(t, "switchToggled", <-received)
+	})
+
+	t.Run("A webhook can be deleted", func(t *testing.T) {
+		var deleteResp struct {
+			DeleteWebhook struct {
+				ID string
+			}
+		}
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				deleteWebhook(id: "%v") {
+					id
+				}
+			}
+		`, modifyResp.ModifyWebhook.ID), &deleteResp)
+
+		require.Equal(t, modifyResp.ModifyWebhook.ID, deleteResp.DeleteWebhook.ID)
+		require.Empty(t, devices.AllWebhooks())
+		require.Empty(t, devices.WebhookDeliveries(0, 0))
+	})
+}
+
+func TestAlerts(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+	received := make(chan string, 10)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Get("Title")
+	}))
+	t.Cleanup(receiver.Close)
+
+	controller, err := devices.CreateTemperatureController("Fermenter 2", &devices.TempProbeDetail{PhysAddr: "AlertFermenter", ReadingRaw: physic.ZeroCelsius + 23*physic.Celsius, Updated: time.Now()})
+	require.Nil(t, err)
+
+	var channelResp struct {
+		ModifyAlertChannel struct {
+			ID      string
+			Name    string
+			Kind    string
+			URL     string
+			Enabled bool
+		}
+	}
+	c.MustPost(fmt.Sprintf(`
+		mutation {
+			modifyAlertChannel(alertChannel: { name: "Phone", kind: ntfy, url: "%v/brewery", token: "tk_ntfy" }) {
+				id
+				name
+				kind
+				url
+				enabled
+			}
+		}
+	`, receiver.URL), &channelResp)
+	require.Equal(t, "ntfy", channelResp.ModifyAlertChannel.Kind)
+	require.True(t, channelResp.ModifyAlertChannel.Enabled)
+	channelID := channelResp.ModifyAlertChannel.ID
+
+	type alertRule struct {
+		ID           string
+		Name         string
+		State        string
+		Value        *string
+		Threshold    string
+		Hysteresis   string
+		Acknowledged bool
+		Controller   struct{ Name string }
+		Channels     []struct{ Name string }
+	}
+	var ruleResp struct{ ModifyAlertRule alertRule }
+
+	t.Run("An alert rule can be created", func(t *testing.T) {
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				modifyAlertRule(alertRule: { name: "Fermenter 2 hot", condition: above, controllerId: "%v", threshold: "21C", hysteresis: "1F", channelIds: ["%v"] }) {
+					id
+					name
+					state
+					threshold
+					hysteresis
+					controller { name }
+					channels { name }
+				}
+			}
+		`, controller.ID, channelID), &ruleResp)
+		require.Equal(t, "ok", ruleResp.ModifyAlertRule.State)
+		require.Equal(t, "21°C", ruleResp.ModifyAlertRule.Threshold)
+		require.Equal(t, "0.556°C", ruleResp.ModifyAlertRule.Hysteresis)
+		require.Equal(t, "Fermenter 2", ruleResp.ModifyAlertRule.Controller.Name)
+		require.Equal(t, "Phone", ruleResp.ModifyAlertRule.Channels[0].Name)
+	})
+
+	t.Run("Alerts that are not firing cannot be acknowledged", func(t *testing.T) {
+		var errResp struct{}
+		err := c.Post(fmt.Sprintf(`mutation { acknowledgeAlert(id: "%v") { id } }`, ruleResp.ModifyAlertRule.ID), &errResp)
+		require.NotNil(t, err)
+	})
+
+	t.Run("Firing alerts are notified, acknowledged and silenced", func(t *testing.T) {
+		devices.CheckAlerts(nil)
+		select {
+		case title := <-received:
+			require.Equal(t, "Firing: Fermenter 2 hot", title)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "the alert was not sent")
+		}
+
+		var alertsResp struct{ AlertRules []alertRule }
+		c.MustPost(`query { alertRules { id state value } }`, &alertsResp)
+		require.Equal(t, "firing", alertsResp.AlertRules[0].State)
+		require.Equal(t, "23°C", *alertsResp.AlertRules[0].Value)
+
+		var ackResp struct{ AcknowledgeAlert alertRule }
+		c.MustPost(fmt.Sprintf(`mutation { acknowledgeAlert(id: "%v") { acknowledged } }`, ruleResp.ModifyAlertRule.ID), &ackResp)
+		require.True(t, ackResp.AcknowledgeAlert.Acknowledged)
+
+		var silenceResp struct {
+			SilenceAlert struct{ SilencedUntil string }
+		}
+		c.MustPost(fmt.Sprintf(`mutation { silenceAlert(id: "%v", minutes: 30) { silencedUntil } }`, ruleResp.ModifyAlertRule.ID), &silenceResp)
+		silencedUntil, err := time.Parse(time.RFC3339, silenceResp.SilenceAlert.SilencedUntil)
+		require.Nil(t, err)
+		require.WithinDuration(t, time.Now().Add(30*time.Minute), silencedUntil, time.Minute)
+	})
+
+	t.Run("Channels can be tested but not deleted while in use", func(t *testing.T) {
+		var testResp struct{ TestAlertChannel struct{ LastError string } }
+		c.MustPost(fmt.Sprintf(`mutation { testAlertChannel(id: "%v") { lastError } }`, channelID), &testResp)
+		require.Empty(t, testResp.TestAlertChannel.LastError)
+		require.Equal(t, "Test: Phone", <-received)
+
+		var errResp struct{}
+		err := c.Post(fmt.Sprintf(`mutation { deleteAlertChannel(id: "%v") { id } }`, channelID), &errResp)
+		require.NotNil(t, err)
+
+		var deleteResp struct{ DeleteAlertRule struct{ Name string } }
+		c.MustPost(fmt.Sprintf(`mutation { deleteAlertRule(id: "%v") { name } }`, ruleResp.ModifyAlertRule.ID), &deleteResp)
+		require.Equal(t, "Fermenter 2 hot", deleteResp.DeleteAlertRule.Name)
+		var channelsResp struct{ DeleteAlertChannel struct{ Name string } }
+		c.MustPost(fmt.Sprintf(`mutation { deleteAlertChannel(id: "%v") { name } }`, channelID), &channelsResp)
+		require.Equal(t, "Phone", channelsResp.DeleteAlertChannel.Name)
+	})
+}
+
+func TestAutomationRules(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+	controller, err := devices.CreateTemperatureController("Automated Fermenter", &devices.TempProbeDetail{PhysAddr: "AutomatedFermenter", ReadingRaw: physic.ZeroCelsius + 23*physic.Celsius, Updated: time.Now()})
+	require.Nil(t, err)
+
+	type automationRule struct {
+		ID      string
+		Name    string
+		Active  bool
+		Trigger struct {
+			Kind       string
+			Controller struct{ Name string }
+			Threshold  string
+		}
+		Conditions []struct{ Kind string }
+		Actions    []struct {
+			Kind     string
+			SetPoint string
+			Steps    []struct{ Name string }
+		}
+		Trace []struct {
+			Matched bool
+			Checks  []struct {
+				Description string
+				Matched     bool
+			}
+			Actions []string
+		}
+	}
+	var ruleResp struct{ ModifyAutomationRule automationRule }
+
+	t.Run("An automation rule can be created", func(t *testing.T) {
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				modifyAutomationRule(automationRule: {
+					name: "Crash cool",
+					trigger: { kind: temperatureAbove, controllerId: "%v", threshold: "21C" },
+					conditions: [{ kind: temperatureBelow, controllerId: "%v", threshold: "30C" }],
+					actions: [{ kind: controllerSetPoint, controllerId: "%v", setPoint: "2C" }]
+				}) {
+					id
+					name
+					active
+					trigger { kind controller { name } threshold }
+					conditions { kind }
+					actions { kind setPoint steps { name } }
+				}
+			}
+		`, controller.ID, controller.ID, controller.ID), &ruleResp)
+		require.Equal(t, "temperatureAbove", ruleResp.ModifyAutomationRule.Trigger.Kind)
+		require.Equal(t, "Automated Fermenter", ruleResp.ModifyAutomationRule.Trigger.Controller.Name)
+		require.Equal(t, "21°C", ruleResp.ModifyAutomationRule.Trigger.Threshold)
+		require.Equal(t, "temperatureBelow", ruleResp.ModifyAutomationRule.Conditions[0].Kind)
+		require.Equal(t, "2C", ruleResp.ModifyAutomationRule.Actions[0].SetPoint)
+		require.Empty(t, ruleResp.ModifyAutomationRule.Actions[0].Steps)
+	})
+
+	t.Run("A rule without actions cannot be created", func(t *testing.T) {
+		var errResp struct{}
+		err := c.Post(`mutation { modifyAutomationRule(automationRule: { name: "Nothing", trigger: { kind: cooling } }) { id } }`, &errResp)
+		require.NotNil(t, err)
+	})
+
+	t.Run("Rules can be evaluated without running the actions", func(t *testing.T) {
+		var evaluateResp struct {
+			EvaluateAutomationRule struct {
+				Matched bool
+				Checks  []struct{ Description string }
+				Actions []string
+			}
+		}
+		c.MustPost(fmt.Sprintf(`query { evaluateAutomationRule(id: "%v") { matched checks { description } actions } }`, ruleResp.ModifyAutomationRule.ID), &evaluateResp)
+		require.True(t, evaluateResp.EvaluateAutomationRule.Matched)
+		require.Equal(t, "Automated Fermenter is 23°C, above 21°C", evaluateResp.EvaluateAutomationRule.Checks[0].Description)
+		require.Empty(t, evaluateResp.EvaluateAutomationRule.Actions)
+		require.Equal(t, "", controller.SetPoint())
+	})
+
+	t.Run("The trace shows what the rule did", func(t *testing.T) {
+		devices.CheckAutomations(nil)
+		require.Equal(t, "2°C", controller.SetPoint())
+
+		var rulesResp struct{ AutomationRules []automationRule }
+		c.MustPost(`query { automationRules { active trace(limit: 5) { matched checks { description matched } actions } } }`, &rulesResp)
+		require.True(t, rulesResp.AutomationRules[0].Active)
+		trace := rulesResp.AutomationRules[0].Trace[0]
+		require.True(t, trace.Matched)
+		require.Len(t, trace.Checks, 2)
+		require.Equal(t, []string{"Set Automated Fermenter to 2°C"}, trace.Actions)
+
+		var deleteResp struct{ DeleteAutomationRule struct{ Name string } }
+		c.MustPost(fmt.Sprintf(`mutation { deleteAutomationRule(id: "%v") { name } }`, ruleResp.ModifyAutomationRule.ID), &deleteResp)
+		require.Equal(t, "Crash cool", deleteResp.DeleteAutomationRule.Name)
+	})
+}
+
+func TestSchedules(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+	controller, err := devices.CreateTemperatureController("HLT", &devices.TempProbeDetail{PhysAddr: "ScheduledHLT"})
+	require.Nil(t, err)
+
+	type schedule struct {
+		ID        string
+		Name      string
+		Cron      string
+		At        *string
+		MissedRun string
+		Actions   []struct {
+			Kind       string
+			Controller struct{ Name string }
+			SetPoint   string
+		}
+		NextRun    *string
+		LastRun    *string
+		LastResult string
+	}
+	var scheduleResp struct{ ModifySchedule schedule }
+
+	t.Run("A schedule can be created", func(t *testing.T) {
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				modifySchedule(schedule: {
+					name: "Heat the HLT",
+					cron: "0 6 * * SAT",
+					missedRun: runOnce,
+					actions: [{ kind: controllerSetPoint, controllerId: "%v", setPoint: "76C" }]
+				}) {
+					id
+					name
+					cron
+					at
+					missedRun
+					actions { kind controller { name } setPoint }
+					nextRun
+					lastRun
+				}
+			}
+		`, controller.ID), &scheduleResp)
+		require.Equal(t, "0 6 * * SAT", scheduleResp.ModifySchedule.Cron)
+		require.Nil(t, scheduleResp.ModifySchedule.At)
+		require.Equal(t, "runOnce", scheduleResp.ModifySchedule.MissedRun)
+		require.Equal(t, "HLT", scheduleResp.ModifySchedule.Actions[0].Controller.Name)
+		require.Nil(t, scheduleResp.ModifySchedule.LastRun)
+
+		nextRun, err := time.Parse(time.RFC3339, *scheduleResp.ModifySchedule.NextRun)
+		require.Nil(t, err)
+		nextRun = nextRun.In(system.CurrentSettings().Location())
+		require.Equal(t, time.Saturday, nextRun.Weekday())
+		require.Equal(t, "06:00", nextRun.Format("15:04"))
+	})
+
+	t.Run("A schedule needs a cron expression or a time", func(t *testing.T) {
+		var errResp struct{}
+		err := c.Post(fmt.Sprintf(`mutation { modifySchedule(schedule: { name: "Never", actions: [{ kind: controllerMode, controllerId: "%v", mode: auto }] }) { id } }`, controller.ID), &errResp)
+		require.NotNil(t, err)
+		err = c.Post(`mutation { modifySchedule(schedule: { name: "Never", at: "2001-01-01T06:00:00Z", actions: [] }) { id } }`, &errResp)
+		require.NotNil(t, err)
+	})
+
+	t.Run("A schedule can run now and be listed", func(t *testing.T) {
+		var runResp struct{ RunSchedule schedule }
+		c.MustPost(fmt.Sprintf(`mutation { runSchedule(id: "%v") { lastRun lastResult nextRun } }`, scheduleResp.ModifySchedule.ID), &runResp)
+		require.NotNil(t, runResp.RunSchedule.LastRun)
+		require.Equal(t, "Set HLT to 76°C", runResp.RunSchedule.LastResult)
+		require.Equal(t, scheduleResp.ModifySchedule.NextRun, runResp.RunSchedule.NextRun)
+		require.Equal(t, "76°C", controller.SetPoint())
+
+		var schedulesResp struct{ Schedules []schedule }
+		c.MustPost(`query { schedules { name lastResult } }`, &schedulesResp)
+		require.Len(t, schedulesResp.Schedules, 1)
+		require.Equal(t, "Heat the HLT", schedulesResp.Schedules[0].Name)
+		require.Equal(t, "Set HLT to 76°C", schedulesResp.Schedules[0].LastResult)
+	})
+
+	t.Run("A schedule can become a one-shot schedule", func(t *testing.T) {
+		at := time.Now().Add(time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+		c.MustPost(fmt.Sprintf(`mutation { modifySchedule(schedule: { id: "%v", at: "%v" }) { cron at nextRun } }`, scheduleResp.ModifySchedule.ID, at), &scheduleResp)
+		require.Empty(t, scheduleResp.ModifySchedule.Cron)
+		require.Equal(t, at, *scheduleResp.ModifySchedule.At)
+
+		nextRun, err := time.Parse(time.RFC3339, *scheduleResp.ModifySchedule.NextRun)
+		require.Nil(t, err)
+		require.Equal(t, at, nextRun.UTC().Format(time.RFC3339))
+
+		var deleteResp struct{ DeleteSchedule struct{ Name string } }
+		c.MustPost(fmt.Sprintf(`mutation { deleteSchedule(id: "%v") { name } }`, scheduleResp.ModifySchedule.ID), &deleteResp)
+		require.Equal(t, "Heat the HLT", deleteResp.DeleteSchedule.Name)
+		require.Empty(t, devices.AllSchedules())
+	})
+}
+
+func TestScenes(t *testing.T) {
+	setupTestDb(t)
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{}})))
+	gpioreg.Register(&gpiotest.Pin{N: "GPIO1001", Num: 1001})
+	gpioreg.Register(&gpiotest.Pin{N: "GPIO1002", Num: 1002})
+	gpioreg.Register(&gpiotest.Pin{N: "GPIO1003", Num: 1003})
+
+	switchIDs := map[string]string{}
+	for name, gpio := range map[string]string{"Mash Pump": "GPIO1001", "Valve A": "GPIO1002", "Valve B": "GPIO1003"} {
+		var switchResp struct{ ModifySwitch struct{ ID string } }
+		c.MustPost(fmt.Sprintf(`mutation { modifySwitch(switchSettings: { name: "%v", gpio: "%v" }) { id } }`, name, gpio), &switchResp)
+		switchIDs[name] = switchResp.ModifySwitch.ID
+	}
+	t.Cleanup(func() {
+		for _, id := range switchIDs {
+			devices.DeleteSwitchByID(id)
+		}
+	})
+
+	type switchGroup struct {
+		ID       string
+		Name     string
+		Switches []struct{ Name, State string }
+	}
+	var groupResp struct{ ModifySwitchGroup switchGroup }
+
+	t.Run("A switch group can be created and toggled", func(t *testing.T) {
+		c.MustPost(fmt.Sprintf(`mutation { modifySwitchGroup(switchGroup: { name: "Valves", switchIds: ["%v", "%v"] }) { id name switches { name } } }`, switchIDs["Valve A"], switchIDs["Valve B"]), &groupResp)
+		require.Equal(t, "Valves", groupResp.ModifySwitchGroup.Name)
+		require.Len(t, groupResp.ModifySwitchGroup.Switches, 2)
+
+		var toggleResp struct{ ToggleSwitchGroup switchGroup }
+		c.MustPost(fmt.Sprintf(`mutation { toggleSwitchGroup(id: "%v", mode: on) { switches { name state } } }`, groupResp.ModifySwitchGroup.ID), &toggleResp)
+		for _, s := range toggleResp.ToggleSwitchGroup.Switches {
+			require.Equal(t, "on", s.State, s.Name)
+		}
+
+		var errResp struct{}
+		err := c.Post(`mutation { toggleSwitchGroup(id: "999", mode: on) { name } }`, &errResp)
+		require.Equal(t, `[{"message":"no switch group found with id '999'","path":["toggleSwitchGroup"]}]`, err.Error())
+	})
+
+	type scene struct {
+		ID    string
+		Name  string
+		Steps []struct {
+			Switch       *struct{ Name string }
+			Group        *struct{ Name string }
+			State        string
+			DelaySeconds float64
+		}
+		Interlocks []struct{ Kind string }
+		Applying   bool
+		LastResult string
+		LastError  string
+	}
+	var sceneResp struct{ ModifyScene scene }
+
+	t.Run("A scene can be created and applied", func(t *testing.T) {
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				modifyScene(scene: {
+					name: "Recirculate mash",
+					steps: [
+						{ groupId: "%v", state: off },
+						{ switchId: "%v", state: on, delaySeconds: 30 }
+					]
+				}) {
+					id
+					name
+					steps { switch { name } group { name } state delaySeconds }
+					interlocks { kind }
+				}
+			}
+		`, groupResp.ModifySwitchGroup.ID, switchIDs["Mash Pump"]), &sceneResp)
+		require.Len(t, sceneResp.ModifyScene.Steps, 2)
+		require.Equal(t, "Valves", sceneResp.ModifyScene.Steps[0].Group.Name)
+		require.Nil(t, sceneResp.ModifyScene.Steps[0].Switch)
+		require.Equal(t, "Mash Pump", sceneResp.ModifyScene.Steps[1].Switch.Name)
+		require.Equal(t, 30.0, sceneResp.ModifyScene.Steps[1].DelaySeconds)
+		require.Empty(t, sceneResp.ModifyScene.Interlocks)
+
+		var applyResp struct{ ApplyScene scene }
+		c.MustPost(fmt.Sprintf(`mutation { applyScene(id: "%v") { applying lastResult lastError } }`, sceneResp.ModifyScene.ID), &applyResp)
+		require.True(t, applyResp.ApplyScene.Applying)
+		require.Equal(t, "Turned off Valves (Valve A, Valve B)", applyResp.ApplyScene.LastResult)
+		require.Equal(t, model.SwitchModeOff, devices.FindSwitchByID(switchIDs["Valve A"]).State())
+		require.Equal(t, model.SwitchModeOff, devices.FindSwitchByID(switchIDs["Mash Pump"]).State())
+	})
+
+	t.Run("A scene is not applied when an interlock does not match", func(t *testing.T) {
+		c.MustPost(fmt.Sprintf(`
+			mutation {
+				modifyScene(scene: { id: "%v", interlocks: [{ kind: switchOn, switchId: "%v" }] }) { interlocks { kind } }
+			}
+		`, sceneResp.ModifyScene.ID, switchIDs["Valve A"]), &sceneResp)
+		require.Equal(t, "switchOn", sceneResp.ModifyScene.Interlocks[0].Kind)
+
+		var errResp struct{}
+		err := c.Post(fmt.Sprintf(`mutation { applyScene(id: "%v") { lastError } }`, sceneResp.ModifyScene.ID), &errResp)
+		require.Equal(t, `[{"message":"interlock not met: Valve A is off","path":["applyScene"]}]`, err.Error())
+
+		var scenesResp struct{ Scenes []scene }
+		c.MustPost(`query { scenes { name applying lastError } }`, &scenesResp)
+		require.Len(t, scenesResp.Scenes, 1)
+		require.False(t, scenesResp.Scenes[0].Applying)
+		require.Equal(t, "interlock not met: Valve A is off", scenesResp.Scenes[0].LastError)
+	})
+
+	t.Run("A switch group used by a scene is deleted after the scene", func(t *testing.T) {
+		var errResp struct{}
+		err := c.Post(fmt.Sprintf(`mutation { deleteSwitchGroup(id: "%v") { name } }`, groupResp.ModifySwitchGroup.ID), &errResp)
+		require.Equal(t, `[{"message":"switch group 'Valves' is used by 'Recirculate mash', it cannot be deleted","path":["deleteSwitchGroup"]}]`, err.Error())
+
+		var deleteSceneResp struct{ DeleteScene struct{ Name string } }
+		c.MustPost(fmt.Sprintf(`mutation { deleteScene(id: "%v") { name } }`, sceneResp.ModifyScene.ID), &deleteSceneResp)
+		require.Equal(t, "Recirculate mash", deleteSceneResp.DeleteScene.Name)
+
+		var deleteGroupResp struct{ DeleteSwitchGroup struct{ Name string } }
+		c.MustPost(fmt.Sprintf(`mutation { deleteSwitchGroup(id: "%v") { name } }`, groupResp.ModifySwitchGroup.ID), &deleteGroupResp)
+		require.Equal(t, "Valves", deleteGroupResp.DeleteSwitchGroup.Name)
+
+		var groupsResp struct{ SwitchGroups []switchGroup }
+		c.MustPost(`query { switchGroups { name } }`, &groupsResp)
+		require.Empty(t, groupsResp.SwitchGroups)
+	})
+}
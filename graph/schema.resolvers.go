@@ -7,32 +7,54 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
+	"github.com/dougedey/elsinore/database"
 	"github.com/dougedey/elsinore/devices"
 	"github.com/dougedey/elsinore/graph/generated"
 	"github.com/dougedey/elsinore/graph/model"
 	"github.com/dougedey/elsinore/hardware"
+	"github.com/dougedey/elsinore/modbus"
 	"github.com/dougedey/elsinore/system"
-	"github.com/rs/zerolog/log"
 )
 
+func (r *alertChannelResolver) ID(ctx context.Context, obj *devices.AlertChannel) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *alertRuleResolver) ID(ctx context.Context, obj *devices.AlertRule) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *automationRuleResolver) ID(ctx context.Context, obj *devices.AutomationRule) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *expanderResolver) ID(ctx context.Context, obj *devices.Expander) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *fermentationResolver) ID(ctx context.Context, obj *devices.Fermentation) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *flowMeterResolver) ID(ctx context.Context, obj *devices.FlowMeter) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
 func (r *hysteriaSettingsResolver) ID(ctx context.Context, obj *devices.HysteriaSettings) (string, error) {
 	return fmt.Sprint(obj.ID), nil
 }
 
+func (r *inPinResolver) ID(ctx context.Context, obj *devices.InPin) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
 func (r *manualSettingsResolver) ID(ctx context.Context, obj *devices.ManualSettings) (string, error) {
 	return fmt.Sprint(obj.ID), nil
 }
 
 func (r *mutationResolver) AssignProbe(ctx context.Context, name string, address string) (*devices.TemperatureController, error) {
-	probe := hardware.GetTemperature(address)
-	if probe != nil {
-		probeDetails := devices.TempProbeDetail{FriendlyName: probe.PhysAddr, PhysAddr: probe.PhysAddr}
-		probeDetails.UpdateReading()
-		return devices.CreateTemperatureController(name, &probeDetails)
-	}
-	return nil, fmt.Errorf("could not find a probe for %v", address)
+	return devices.AssignProbe(name, address)
 }
 
 func (r *mutationResolver) RemoveProbeFromTemperatureController(ctx context.Context, address string) (*devices.TemperatureController, error) {
@@ -64,76 +86,346 @@ func (r *mutationResolver) DeleteTemperatureController(ctx context.Context, id s
 }
 
 func (r *mutationResolver) UpdateSettings(ctx context.Context, settings model.SettingsInput) (*system.Settings, error) {
-	if settings.BreweryName != nil {
-		system.CurrentSettings().BreweryName = *settings.BreweryName
+	if err := system.CurrentSettings().Update(settings); err != nil {
+		return nil, err
 	}
 	return system.CurrentSettings(), nil
 }
 
 func (r *mutationResolver) ModifySwitch(ctx context.Context, switchSettings model.SwitchSettingsInput) (*devices.Switch, error) {
-	var curSwitch *devices.Switch
-	if switchSettings.ID == nil {
-		errors := []string{}
-		if switchSettings.Gpio == nil || len(strings.TrimSpace(*switchSettings.Gpio)) == 0 {
-			errors = append(errors, "GPIO is required when creating a new switch")
-		}
-		if switchSettings.Name == nil || len(strings.TrimSpace(*switchSettings.Name)) == 0 {
-			errors = append(errors, "Name is required when creating a new switch")
-		}
-		if devices.GpioInUse(*switchSettings.Gpio) {
-			errors = append(errors, fmt.Sprintf("GPIO '%v' is already in use", *switchSettings.Gpio))
-		}
-		if len(errors) > 0 {
-			return nil, fmt.Errorf(strings.Join(errors, "\n"))
-		}
-		newSwitch, err := devices.CreateSwitch(*switchSettings.Gpio, *switchSettings.Name)
-		if err != nil {
-			return nil, err
-		}
-		curSwitch = newSwitch
-	} else {
-		curSwitch = devices.FindSwitchByID(*switchSettings.ID)
-		if curSwitch == nil {
-			return nil, fmt.Errorf("no switch with id: %v found", *switchSettings.ID)
-		}
+	return devices.ModifySwitch(switchSettings)
+}
+
+func (r *mutationResolver) DeleteSwitch(ctx context.Context, id string) (*devices.Switch, error) {
+	return devices.DeleteSwitchByID(id)
+}
+
+func (r *mutationResolver) ToggleSwitch(ctx context.Context, id string, mode model.SwitchMode) (*devices.Switch, error) {
+	s := devices.FindSwitchByID(id)
+	if s == nil {
+		return nil, fmt.Errorf("no switch found with id '%v'", id)
 	}
+	return s, s.SetState(mode)
+}
 
-	if switchSettings.Name != nil {
-		curSwitch.Output.FriendlyName = *switchSettings.Name
+func (r *mutationResolver) SwitchOnFor(ctx context.Context, id string, seconds float64) (*devices.Switch, error) {
+	s := devices.FindSwitchByID(id)
+	if s == nil {
+		return nil, fmt.Errorf("no switch found with id '%v'", id)
 	}
+	return s, s.OnFor(seconds, nil)
+}
 
-	if switchSettings.Gpio != nil {
-		err := curSwitch.UpdateIdentifier(*switchSettings.Gpio)
-		if err != nil {
-			return nil, err
-		}
+func (r *mutationResolver) PulseSwitch(ctx context.Context, id string, onSeconds float64, offSeconds float64, cycles *int) (*devices.Switch, error) {
+	s := devices.FindSwitchByID(id)
+	if s == nil {
+		return nil, fmt.Errorf("no switch found with id '%v'", id)
+	}
+	pulses := 0
+	if cycles != nil {
+		pulses = *cycles
 	}
+	return s, s.Pulse(onSeconds, offSeconds, pulses, nil)
+}
 
-	if switchSettings.State != nil {
-		log.Info().Msgf("Setting %v to %v", curSwitch.Output.Identifier, *switchSettings.State)
-		if *switchSettings.State == model.SwitchModeOn {
-			curSwitch.On()
-		}
-		if *switchSettings.State == model.SwitchModeOff {
-			curSwitch.Off()
-		}
+func (r *mutationResolver) CalibrateProbe(ctx context.Context, address string, point model.CalibrationPoint, reference *string) (*model.TempProbeDetails, error) {
+	probe := devices.FindTempProbeDetail(address)
+	if probe == nil {
+		return nil, fmt.Errorf("no temperature controller has the probe %v assigned", address)
+	}
+
+	referenceValue := ""
+	if reference != nil {
+		referenceValue = *reference
+	}
+	err := probe.CaptureCalibrationPoint(point, referenceValue)
+	if err != nil {
+		return nil, err
 	}
-	curSwitch.Save()
-	return curSwitch, nil
+	return toTempProbeDetailsModel(devices.FindTemperatureControllerForProbe(address), probe), nil
 }
 
-func (r *mutationResolver) DeleteSwitch(ctx context.Context, id string) (*devices.Switch, error) {
-	return devices.DeleteSwitchByID(id)
+func (r *mutationResolver) ResetProbeCalibration(ctx context.Context, address string) (*model.TempProbeDetails, error) {
+	probe := devices.FindTempProbeDetail(address)
+	if probe == nil {
+		return nil, fmt.Errorf("no temperature controller has the probe %v assigned", address)
+	}
+
+	probe.ResetCalibration()
+	return toTempProbeDetailsModel(devices.FindTemperatureControllerForProbe(address), probe), nil
 }
 
-func (r *mutationResolver) ToggleSwitch(ctx context.Context, id string, mode model.SwitchMode) (*devices.Switch, error) {
-	s := devices.FindSwitchByID(id)
-	if strings.EqualFold("on", mode.String()) {
-		s.On()
-	} else {
-		s.Off()
+func (r *mutationResolver) UpdateTempProbeDetails(ctx context.Context, details model.TempProbeDetailsInput) (*model.TempProbeDetails, error) {
+	probe := devices.FindTempProbeDetail(details.PhysAddr)
+	if probe == nil {
+		return nil, fmt.Errorf("no temperature controller has the probe %v assigned", details.PhysAddr)
+	}
+
+	err := probe.ApplySettings(details)
+	if err != nil {
+		return nil, err
+	}
+	return toTempProbeDetailsModel(devices.FindTemperatureControllerForProbe(details.PhysAddr), probe), nil
+}
+
+func (r *mutationResolver) UpdateProbe(ctx context.Context, probeSettings model.ProbeSettingsInput) (*model.TemperatureProbe, error) {
+	settings, err := devices.UpdateProbeSettings(probeSettings)
+	if err != nil {
+		return nil, err
+	}
+	return devices.TemperatureProbeModel(settings.PhysAddr), nil
+}
+
+func (r *mutationResolver) ForgetProbe(ctx context.Context, address string) (*model.TemperatureProbe, error) {
+	_, err := devices.DeleteProbeSettings(address)
+	if err != nil {
+		return nil, err
+	}
+	return devices.TemperatureProbeModel(address), nil
+}
+
+func (r *mutationResolver) RescanProbes(ctx context.Context) (*model.ProbeScan, error) {
+	scan, err := hardware.Rescan()
+	if err != nil {
+		return nil, err
+	}
+	return toProbeScanModel(scan), nil
+}
+
+func (r *mutationResolver) ModifySPIProbe(ctx context.Context, spiProbe model.SPIProbeInput) (*devices.SPIProbe, error) {
+	return devices.ModifySPIProbe(spiProbe)
+}
+
+func (r *mutationResolver) DeleteSPIProbe(ctx context.Context, id string) (*devices.SPIProbe, error) {
+	return devices.DeleteSPIProbeByID(id)
+}
+
+func (r *mutationResolver) ModifyExpander(ctx context.Context, expander model.ExpanderInput) (*devices.Expander, error) {
+	return devices.ModifyExpander(expander)
+}
+
+func (r *mutationResolver) DeleteExpander(ctx context.Context, id string) (*devices.Expander, error) {
+	return devices.DeleteExpanderByID(id)
+}
+
+func (r *mutationResolver) ModifyWebhook(ctx context.Context, webhook model.WebhookInput) (*devices.Webhook, error) {
+	return devices.ModifyWebhook(webhook)
+}
+
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, id string) (*devices.Webhook, error) {
+	return devices.DeleteWebhookByID(id)
+}
+
+func (r *mutationResolver) TestWebhook(ctx context.Context, id string) (*devices.WebhookDelivery, error) {
+	webhook := devices.FindWebhookByID(id)
+	if webhook == nil {
+		return nil, fmt.Errorf("no webhook found with id '%v'", id)
+	}
+	return webhook.Test()
+}
+
+func (r *mutationResolver) ModifyAlertRule(ctx context.Context, alertRule model.AlertRuleInput) (*devices.AlertRule, error) {
+	return devices.ModifyAlertRule(alertRule)
+}
+
+func (r *mutationResolver) DeleteAlertRule(ctx context.Context, id string) (*devices.AlertRule, error) {
+	return devices.DeleteAlertRuleByID(id)
+}
+
+func (r *mutationResolver) AcknowledgeAlert(ctx context.Context, id string) (*devices.AlertRule, error) {
+	rule := devices.FindAlertRuleByID(id)
+	if rule == nil {
+		return nil, fmt.Errorf("no alert rule found with id '%v'", id)
+	}
+	return rule, rule.Acknowledge()
+}
+
+func (r *mutationResolver) SilenceAlert(ctx context.Context, id string, minutes int) (*devices.AlertRule, error) {
+	rule := devices.FindAlertRuleByID(id)
+	if rule == nil {
+		return nil, fmt.Errorf("no alert rule found with id '%v'", id)
+	}
+	return rule, rule.Silence(minutes, nil)
+}
+
+func (r *mutationResolver) ModifyAlertChannel(ctx context.Context, alertChannel model.AlertChannelInput) (*devices.AlertChannel, error) {
+	return devices.ModifyAlertChannel(alertChannel)
+}
+
+func (r *mutationResolver) DeleteAlertChannel(ctx context.Context, id string) (*devices.AlertChannel, error) {
+	return devices.DeleteAlertChannelByID(id)
+}
+
+func (r *mutationResolver) TestAlertChannel(ctx context.Context, id string) (*devices.AlertChannel, error) {
+	channel := devices.FindAlertChannelByID(id)
+	if channel == nil {
+		return nil, fmt.Errorf("no alert channel found with id '%v'", id)
+	}
+	return channel, channel.Test()
+}
+
+func (r *mutationResolver) ModifyAutomationRule(ctx context.Context, automationRule model.AutomationRuleInput) (*devices.AutomationRule, error) {
+	return devices.ModifyAutomationRule(automationRule)
+}
+
+func (r *mutationResolver) DeleteAutomationRule(ctx context.Context, id string) (*devices.AutomationRule, error) {
+	return devices.DeleteAutomationRuleByID(id)
+}
+
+func (r *mutationResolver) ModifySchedule(ctx context.Context, schedule model.ScheduleInput) (*devices.Schedule, error) {
+	return devices.ModifySchedule(schedule, nil)
+}
+
+func (r *mutationResolver) DeleteSchedule(ctx context.Context, id string) (*devices.Schedule, error) {
+	return devices.DeleteScheduleByID(id)
+}
+
+func (r *mutationResolver) RunSchedule(ctx context.Context, id string) (*devices.Schedule, error) {
+	schedule := devices.FindScheduleByID(id)
+	if schedule == nil {
+		return nil, fmt.Errorf("no schedule found with id '%v'", id)
+	}
+	return schedule.Run(nil), nil
+}
+
+func (r *mutationResolver) ModifySwitchGroup(ctx context.Context, switchGroup model.SwitchGroupInput) (*devices.SwitchGroup, error) {
+	return devices.ModifySwitchGroup(switchGroup)
+}
+
+func (r *mutationResolver) DeleteSwitchGroup(ctx context.Context, id string) (*devices.SwitchGroup, error) {
+	return devices.DeleteSwitchGroupByID(id)
+}
+
+func (r *mutationResolver) ToggleSwitchGroup(ctx context.Context, id string, mode model.SwitchMode) (*devices.SwitchGroup, error) {
+	group := devices.FindSwitchGroupByID(id)
+	if group == nil {
+		return nil, fmt.Errorf("no switch group found with id '%v'", id)
+	}
+	return group, group.SetState(mode)
+}
+
+func (r *mutationResolver) ModifyScene(ctx context.Context, scene model.SceneInput) (*devices.Scene, error) {
+	return devices.ModifyScene(scene)
+}
+
+func (r *mutationResolver) DeleteScene(ctx context.Context, id string) (*devices.Scene, error) {
+	return devices.DeleteSceneByID(id)
+}
+
+func (r *mutationResolver) ApplyScene(ctx context.Context, id string) (*devices.Scene, error) {
+	scene := devices.FindSceneByID(id)
+	if scene == nil {
+		return nil, fmt.Errorf("no scene found with id '%v'", id)
+	}
+	return scene, scene.Apply(nil)
+}
+
+func (r *mutationResolver) ModifyInPin(ctx context.Context, inPin model.InPinInput) (*devices.InPin, error) {
+	return devices.ModifyInPin(inPin)
+}
+
+func (r *mutationResolver) DeleteInPin(ctx context.Context, id string) (*devices.InPin, error) {
+	return devices.DeleteInPinByID(id)
+}
+
+func (r *mutationResolver) ModifyFlowMeter(ctx context.Context, flowMeter model.FlowMeterInput) (*devices.FlowMeter, error) {
+	return devices.ModifyFlowMeter(flowMeter)
+}
+
+func (r *mutationResolver) DeleteFlowMeter(ctx context.Context, id string) (*devices.FlowMeter, error) {
+	return devices.DeleteFlowMeterByID(id)
+}
+
+func (r *mutationResolver) ResetFlowMeter(ctx context.Context, id string) (*devices.FlowMeter, error) {
+	meter := devices.FindFlowMeterByID(id)
+	if meter == nil {
+		return nil, fmt.Errorf("no flow meter found with id '%v'", id)
+	}
+	meter.Reset()
+	return meter, nil
+}
+
+func (r *mutationResolver) Dispense(ctx context.Context, flowMeterID string, litres float64, switchID string) (*devices.FlowMeter, error) {
+	meter := devices.FindFlowMeterByID(flowMeterID)
+	if meter == nil {
+		return nil, fmt.Errorf("no flow meter found with id '%v'", flowMeterID)
+	}
+	s := devices.FindSwitchByID(switchID)
+	if s == nil {
+		return nil, fmt.Errorf("no switch found with id '%v'", switchID)
+	}
+	if _, err := meter.Dispense(litres, s); err != nil {
+		return nil, err
+	}
+	return meter, nil
+}
+
+func (r *mutationResolver) CancelDispense(ctx context.Context, flowMeterID string) (*devices.FlowMeter, error) {
+	meter := devices.FindFlowMeterByID(flowMeterID)
+	if meter == nil {
+		return nil, fmt.Errorf("no flow meter found with id '%v'", flowMeterID)
+	}
+	meter.CancelDispense()
+	return meter, nil
+}
+
+func (r *mutationResolver) StartFermentation(ctx context.Context, fermentation model.FermentationInput) (*devices.Fermentation, error) {
+	controller := devices.FindTemperatureControllerByID(fermentation.ControllerID)
+	if controller == nil {
+		return nil, fmt.Errorf("no controller could be found for: %v", fermentation.ControllerID)
+	}
+	return controller.StartFermentation(fermentation)
+}
+
+func (r *mutationResolver) RecordGravity(ctx context.Context, controllerID string, gravity float64) (*devices.Fermentation, error) {
+	controller := devices.FindTemperatureControllerByID(controllerID)
+	if controller == nil {
+		return nil, fmt.Errorf("no controller could be found for: %v", controllerID)
+	}
+	return controller.RecordGravity(gravity)
+}
+
+func (r *mutationResolver) EndFermentation(ctx context.Context, controllerID string) (*devices.Fermentation, error) {
+	controller := devices.FindTemperatureControllerByID(controllerID)
+	if controller == nil {
+		return nil, fmt.Errorf("no controller could be found for: %v", controllerID)
+	}
+	return controller.EndFermentation()
+}
+
+func (r *mutationResolver) CreateBackup(ctx context.Context) (*model.Backup, error) {
+	backup, err := database.CreateBackup()
+	if err != nil {
+		return nil, err
+	}
+	return toBackupModel(backup), nil
+}
+
+func (r *mutationResolver) RestoreBackup(ctx context.Context, name string) (*model.Backup, error) {
+	path, err := database.BackupPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	devices.SuspendTemperatureControllers()
+	defer devices.ResumeTemperatureControllers()
+
+	err = database.RestoreBackup(path)
+	if err != nil {
+		return nil, err
+	}
+	system.ReloadSettings()
+
+	backups, err := database.ListBackups()
+	if err != nil {
+		return nil, err
 	}
-	return s, nil
+	for _, backup := range backups {
+		if backup.Name == name {
+			return toBackupModel(backup), nil
+		}
+	}
+	return nil, fmt.Errorf("no backup found with name '%v'", name)
 }
 
 func (r *pidSettingsResolver) ID(ctx context.Context, obj *devices.PidSettings) (string, error) {
@@ -143,8 +435,7 @@ func (r *pidSettingsResolver) ID(ctx context.Context, obj *devices.PidSettings)
 func (r *queryResolver) Probe(ctx context.Context, address *string) (*model.TemperatureProbe, error) {
 	device := hardware.GetTemperature(*address)
 	if device != nil {
-		reading := device.Reading()
-		return &model.TemperatureProbe{PhysAddr: &device.PhysAddr, Reading: &reading, Updated: &device.Updated}, nil
+		return devices.TemperatureProbeModel(device.PhysAddr), nil
 	}
 	return nil, fmt.Errorf("no device found for address %v", *address)
 }
@@ -152,11 +443,10 @@ func (r *queryResolver) Probe(ctx context.Context, address *string) (*model.Temp
 func (r *queryResolver) ProbeList(ctx context.Context, available *bool) ([]*model.TemperatureProbe, error) {
 	probeList := []*model.TemperatureProbe{}
 	for _, device := range hardware.GetProbes() {
-		if available != nil && *available && devices.FindTemperatureControllerForProbe(device.PhysAddr) != nil {
+		if available != nil && *available && (devices.FindTemperatureControllerForProbe(device.PhysAddr) != nil || !devices.ProbeEnabled(device.PhysAddr) || !device.Connected) {
 			continue
 		}
-		reading := device.Reading()
-		probeList = append(probeList, &model.TemperatureProbe{PhysAddr: &device.PhysAddr, Reading: &reading, Updated: &device.Updated})
+		probeList = append(probeList, devices.TemperatureProbeModel(device.PhysAddr))
 	}
 	return probeList, nil
 }
@@ -167,8 +457,7 @@ func (r *queryResolver) FetchProbes(ctx context.Context, addresses []*string) ([
 	for _, address := range addresses {
 		device := hardware.GetTemperature(*address)
 		if device != nil {
-			reading := device.Reading()
-			deviceList = append(deviceList, &model.TemperatureProbe{PhysAddr: &device.PhysAddr, Reading: &reading, Updated: &device.Updated})
+			deviceList = append(deviceList, devices.TemperatureProbeModel(device.PhysAddr))
 		} else {
 			missingAddresses = append(missingAddresses, *address)
 		}
@@ -182,6 +471,107 @@ func (r *queryResolver) FetchProbes(ctx context.Context, addresses []*string) ([
 	return deviceList, missingError
 }
 
+func (r *queryResolver) RegisteredProbes(ctx context.Context) ([]*model.TemperatureProbe, error) {
+	probeList := []*model.TemperatureProbe{}
+	for _, settings := range devices.AllProbeSettings() {
+		probeList = append(probeList, devices.TemperatureProbeModel(settings.PhysAddr))
+	}
+	return probeList, nil
+}
+
+func (r *queryResolver) SpiProbes(ctx context.Context) ([]*devices.SPIProbe, error) {
+	return devices.AllSPIProbes(), nil
+}
+
+func (r *queryResolver) ProbeEvents(ctx context.Context) ([]*model.ProbeEvent, error) {
+	events := []*model.ProbeEvent{}
+	for _, event := range hardware.ProbeEvents() {
+		events = append(events, &model.ProbeEvent{
+			Type:     model.ProbeEventType(event.Type),
+			PhysAddr: event.PhysAddr,
+			Time:     event.Time,
+		})
+	}
+	return events, nil
+}
+
+func (r *queryResolver) DeviceEvents(ctx context.Context) ([]*model.DeviceEvent, error) {
+	events := []*model.DeviceEvent{}
+	for _, event := range devices.DeviceEvents() {
+		events = append(events, &model.DeviceEvent{
+			Type:     event.Type,
+			Time:     event.Time,
+			Device:   event.Device,
+			DeviceID: event.DeviceID,
+			Message:  event.Message,
+		})
+	}
+	return events, nil
+}
+
+func (r *queryResolver) Webhooks(ctx context.Context) ([]*devices.Webhook, error) {
+	return devices.AllWebhooks(), nil
+}
+
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, webhookID *string, limit *int) ([]*devices.WebhookDelivery, error) {
+	limitValue := 0
+	if limit != nil {
+		limitValue = *limit
+	}
+	if webhookID == nil {
+		return devices.WebhookDeliveries(0, limitValue), nil
+	}
+	webhook := devices.FindWebhookByID(*webhookID)
+	if webhook == nil {
+		return nil, fmt.Errorf("no webhook found with id '%v'", *webhookID)
+	}
+	return devices.WebhookDeliveries(webhook.ID, limitValue), nil
+}
+
+func (r *queryResolver) AlertRules(ctx context.Context) ([]*devices.AlertRule, error) {
+	return devices.AllAlertRules(), nil
+}
+
+func (r *queryResolver) AlertChannels(ctx context.Context) ([]*devices.AlertChannel, error) {
+	return devices.AllAlertChannels(), nil
+}
+
+func (r *queryResolver) AutomationRules(ctx context.Context) ([]*devices.AutomationRule, error) {
+	return devices.AllAutomationRules(), nil
+}
+
+func (r *queryResolver) EvaluateAutomationRule(ctx context.Context, id string) (*devices.AutomationTrace, error) {
+	rule := devices.FindAutomationRuleByID(id)
+	if rule == nil {
+		return nil, fmt.Errorf("no automation rule found with id '%v'", id)
+	}
+	return rule.Evaluate(nil), nil
+}
+
+func (r *queryResolver) Schedules(ctx context.Context) ([]*devices.Schedule, error) {
+	return devices.AllSchedules(), nil
+}
+
+func (r *queryResolver) SwitchGroups(ctx context.Context) ([]*devices.SwitchGroup, error) {
+	return devices.AllSwitchGroups(), nil
+}
+
+func (r *queryResolver) Scenes(ctx context.Context) ([]*devices.Scene, error) {
+	return devices.AllScenes(), nil
+}
+
+func (r *queryResolver) Expanders(ctx context.Context) ([]*devices.Expander, error) {
+	return devices.AllExpanders(), nil
+}
+
+func (r *queryResolver) InPins(ctx context.Context) ([]*devices.InPin, error) {
+	return devices.AllInPins(), nil
+}
+
+func (r *queryResolver) FlowMeters(ctx context.Context) ([]*devices.FlowMeter, error) {
+	return devices.AllFlowMeters(), nil
+}
+
 func (r *queryResolver) TemperatureControllers(ctx context.Context, name *string) ([]*devices.TemperatureController, error) {
 	if name == nil {
 		return devices.AllTemperatureControllers(), nil
@@ -201,10 +591,59 @@ func (r *queryResolver) Switches(ctx context.Context) ([]*devices.Switch, error)
 	return devices.AllSwitches(), nil
 }
 
+func (r *queryResolver) Backups(ctx context.Context) ([]*model.Backup, error) {
+	backups, err := database.ListBackups()
+	if err != nil {
+		return nil, err
+	}
+
+	backupList := []*model.Backup{}
+	for _, backup := range backups {
+		backupList = append(backupList, toBackupModel(backup))
+	}
+	return backupList, nil
+}
+
+func (r *queryResolver) ModbusRegisters(ctx context.Context) ([]*model.ModbusRegister, error) {
+	registers := []*model.ModbusRegister{}
+	for _, register := range modbus.RegisterMap() {
+		registers = append(registers, toModbusRegisterModel(register))
+	}
+	return registers, nil
+}
+
+func (r *sPIProbeResolver) ID(ctx context.Context, obj *devices.SPIProbe) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *sPIProbeResolver) Probe(ctx context.Context, obj *devices.SPIProbe) (*model.TemperatureProbe, error) {
+	return devices.TemperatureProbeModel(obj.PhysAddr()), nil
+}
+
+func (r *sceneResolver) ID(ctx context.Context, obj *devices.Scene) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *scheduleResolver) ID(ctx context.Context, obj *devices.Schedule) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
 func (r *switchResolver) ID(ctx context.Context, obj *devices.Switch) (string, error) {
 	return strconv.FormatUint(uint64(obj.ID), 10), nil
 }
 
+func (r *switchResolver) AutoOffSeconds(ctx context.Context, obj *devices.Switch) (*float64, error) {
+	return obj.AutoOffSeconds(nil), nil
+}
+
+func (r *switchResolver) Timer(ctx context.Context, obj *devices.Switch) (*devices.SwitchTimer, error) {
+	return obj.Timer(nil), nil
+}
+
+func (r *switchGroupResolver) ID(ctx context.Context, obj *devices.SwitchGroup) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
 func (r *temperatureControllerResolver) ID(ctx context.Context, obj *devices.TemperatureController) (string, error) {
 	return strconv.FormatUint(uint64(obj.ID), 10), nil
 }
@@ -212,18 +651,51 @@ func (r *temperatureControllerResolver) ID(ctx context.Context, obj *devices.Tem
 func (r *temperatureControllerResolver) TempProbeDetails(ctx context.Context, obj *devices.TemperatureController) ([]*model.TempProbeDetails, error) {
 	probeList := []*model.TempProbeDetails{}
 	for _, tempProbe := range obj.TempProbeDetails {
-		reading := tempProbe.Reading()
-		probeDetail := model.TempProbeDetails{ID: fmt.Sprint(tempProbe.ID), PhysAddr: &tempProbe.PhysAddr, Reading: &reading, Name: &tempProbe.FriendlyName, Updated: &tempProbe.Updated}
-		probeList = append(probeList, &probeDetail)
+		probeList = append(probeList, toTempProbeDetailsModel(obj, tempProbe))
 	}
 	return probeList, nil
 }
 
+func (r *webhookResolver) ID(ctx context.Context, obj *devices.Webhook) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *webhookDeliveryResolver) ID(ctx context.Context, obj *devices.WebhookDelivery) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+func (r *webhookDeliveryResolver) WebhookID(ctx context.Context, obj *devices.WebhookDelivery) (string, error) {
+	return strconv.FormatUint(uint64(obj.WebhookID), 10), nil
+}
+
+// AlertChannel returns generated.AlertChannelResolver implementation.
+func (r *Resolver) AlertChannel() generated.AlertChannelResolver { return &alertChannelResolver{r} }
+
+// AlertRule returns generated.AlertRuleResolver implementation.
+func (r *Resolver) AlertRule() generated.AlertRuleResolver { return &alertRuleResolver{r} }
+
+// AutomationRule returns generated.AutomationRuleResolver implementation.
+func (r *Resolver) AutomationRule() generated.AutomationRuleResolver {
+	return &automationRuleResolver{r}
+}
+
+// Expander returns generated.ExpanderResolver implementation.
+func (r *Resolver) Expander() generated.ExpanderResolver { return &expanderResolver{r} }
+
+// Fermentation returns generated.FermentationResolver implementation.
+func (r *Resolver) Fermentation() generated.FermentationResolver { return &fermentationResolver{r} }
+
+// FlowMeter returns generated.FlowMeterResolver implementation.
+func (r *Resolver) FlowMeter() generated.FlowMeterResolver { return &flowMeterResolver{r} }
+
 // HysteriaSettings returns generated.HysteriaSettingsResolver implementation.
 func (r *Resolver) HysteriaSettings() generated.HysteriaSettingsResolver {
 	return &hysteriaSettingsResolver{r}
 }
 
+// InPin returns generated.InPinResolver implementation.
+func (r *Resolver) InPin() generated.InPinResolver { return &inPinResolver{r} }
+
 // ManualSettings returns generated.ManualSettingsResolver implementation.
 func (r *Resolver) ManualSettings() generated.ManualSettingsResolver {
 	return &manualSettingsResolver{r}
@@ -238,18 +710,51 @@ func (r *Resolver) PidSettings() generated.PidSettingsResolver { return &pidSett
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// SPIProbe returns generated.SPIProbeResolver implementation.
+func (r *Resolver) SPIProbe() generated.SPIProbeResolver { return &sPIProbeResolver{r} }
+
+// Scene returns generated.SceneResolver implementation.
+func (r *Resolver) Scene() generated.SceneResolver { return &sceneResolver{r} }
+
+// Schedule returns generated.ScheduleResolver implementation.
+func (r *Resolver) Schedule() generated.ScheduleResolver { return &scheduleResolver{r} }
+
 // Switch returns generated.SwitchResolver implementation.
 func (r *Resolver) Switch() generated.SwitchResolver { return &switchResolver{r} }
 
+// SwitchGroup returns generated.SwitchGroupResolver implementation.
+func (r *Resolver) SwitchGroup() generated.SwitchGroupResolver { return &switchGroupResolver{r} }
+
 // TemperatureController returns generated.TemperatureControllerResolver implementation.
 func (r *Resolver) TemperatureController() generated.TemperatureControllerResolver {
 	return &temperatureControllerResolver{r}
 }
 
+// Webhook returns generated.WebhookResolver implementation.
+func (r *Resolver) Webhook() generated.WebhookResolver { return &webhookResolver{r} }
+
+// WebhookDelivery returns generated.WebhookDeliveryResolver implementation.
+func (r *Resolver) WebhookDelivery() generated.WebhookDeliveryResolver {
+	return &webhookDeliveryResolver{r}
+}
+
+type alertChannelResolver struct{ *Resolver }
+type alertRuleResolver struct{ *Resolver }
+type automationRuleResolver struct{ *Resolver }
+type expanderResolver struct{ *Resolver }
+type fermentationResolver struct{ *Resolver }
+type flowMeterResolver struct{ *Resolver }
 type hysteriaSettingsResolver struct{ *Resolver }
+type inPinResolver struct{ *Resolver }
 type manualSettingsResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type pidSettingsResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type sPIProbeResolver struct{ *Resolver }
+type sceneResolver struct{ *Resolver }
+type scheduleResolver struct{ *Resolver }
 type switchResolver struct{ *Resolver }
+type switchGroupResolver struct{ *Resolver }
 type temperatureControllerResolver struct{ *Resolver }
+type webhookResolver struct{ *Resolver }
+type webhookDeliveryResolver struct{ *Resolver }
@@ -0,0 +1,413 @@
+package hardware
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/gpio/gpioreg"
+	"periph.io/x/periph/conn/i2c"
+	"periph.io/x/periph/conn/i2c/i2creg"
+	"periph.io/x/periph/conn/physic"
+)
+
+// The I2C GPIO expanders that can be used for pins
+const (
+	// MCP23017Chip - 16 pins in two ports, A0-A7 and B0-B7, with optional pull ups
+	MCP23017Chip = "mcp23017"
+	// PCF8574Chip - 8 quasi-bidirectional pins, P0-P7, that are weakly pulled up when they are inputs
+	PCF8574Chip = "pcf8574"
+)
+
+// MCP23017 registers, with IOCON.BANK = 0 so the A and B registers of each pair can be written together
+const (
+	mcp23017IODIR = 0x00
+	mcp23017GPPU  = 0x0C
+	mcp23017GPIO  = 0x12
+	mcp23017OLAT  = 0x14
+)
+
+var expanderPinName = regexp.MustCompile(`(?i)^(mcp23017|pcf8574)@0x([0-9a-f]{1,2}):([a-z]?[0-9])$`)
+
+var expanders = make(map[string]*Expander)
+var expanderLock sync.Mutex
+
+// Expander is a GPIO expander on an I2C bus, each of its pins is registered with periph as e.g. mcp23017@0x20:A3
+type Expander struct {
+	Chip    string
+	Address uint16
+	dev     *i2c.Dev
+	closer  io.Closer
+	pins    []*ExpanderPin
+	lock    sync.Mutex
+	latch   uint16 // The output levels
+	inputs  uint16 // The pins that are inputs (MCP23017 IODIR)
+	pullUps uint16 // The inputs with pull ups (MCP23017 GPPU)
+}
+
+// ExpanderName - The name of an expander, e.g. mcp23017@0x20, its pins are named after it
+func ExpanderName(chip string, address uint16) string {
+	return fmt.Sprintf("%v@0x%02x", strings.ToLower(chip), address)
+}
+
+// ExpanderPinNames - The names of every pin on a chip, in order
+func ExpanderPinNames(chip string, address uint16) []string {
+	names := []string{}
+	name := ExpanderName(chip, address)
+	switch strings.ToLower(chip) {
+	case MCP23017Chip:
+		for _, port := range []string{"A", "B"} {
+			for i := 0; i < 8; i++ {
+				names = append(names, fmt.Sprintf("%v:%v%v", name, port, i))
+			}
+		}
+	case PCF8574Chip:
+		for i := 0; i < 8; i++ {
+			names = append(names, fmt.Sprintf("%v:P%v", name, i))
+		}
+	}
+	return names
+}
+
+// ValidateExpander - Check the chip is supported and the address is one it can be strapped to
+func ValidateExpander(chip string, address uint16) error {
+	switch strings.ToLower(chip) {
+	case MCP23017Chip:
+		if address < 0x20 || address > 0x27 {
+			return fmt.Errorf("an MCP23017 address is between 0x20 and 0x27, got 0x%02x", address)
+		}
+	case PCF8574Chip:
+		// 0x38-0x3f is the PCF8574A
+		if address < 0x20 || (address > 0x27 && address < 0x38) || address > 0x3f {
+			return fmt.Errorf("a PCF8574 address is between 0x20 and 0x27, or 0x38 and 0x3f for a PCF8574A, got 0x%02x", address)
+		}
+	default:
+		return fmt.Errorf("unknown expander '%v'", chip)
+	}
+	return nil
+}
+
+// NormalizePinName - Returns the registered name of an expander pin written in any case, e.g. MCP23017@0x20:a3 is mcp23017@0x20:A3
+// Names that are not expander pins are returned as they are
+func NormalizePinName(name string) string {
+	match := expanderPinName.FindStringSubmatch(strings.TrimSpace(name))
+	if match == nil {
+		return name
+	}
+	address, err := strconv.ParseUint(match[2], 16, 16)
+	if err != nil {
+		return name
+	}
+	return fmt.Sprintf("%v:%v", ExpanderName(match[1], uint16(address)), strings.ToUpper(match[3]))
+}
+
+// Pin - Look up a GPIO by name, including expander pins in any case and outputs on other devices, nil if there is no such pin
+func Pin(name string) gpio.PinIO {
+	if IsExternalOutput(name) {
+		pin, err := DriverOutput(name)
+		if err != nil {
+			log.Error().Err(err).Msgf("Invalid output %v", name)
+			return nil
+		}
+		return pin
+	}
+	if pin := gpioreg.ByName(name); pin != nil {
+		return pin
+	}
+	return gpioreg.ByName(NormalizePinName(name))
+}
+
+// AddExpander - Open the I2C bus (e.g. "1" for /dev/i2c-1, "" for the first bus) and register the pins of the expander
+func AddExpander(chip string, busName string, address uint16) (*Expander, error) {
+	if err := ValidateExpander(chip, address); err != nil {
+		return nil, err
+	}
+	bus, err := i2creg.Open(busName)
+	if err != nil {
+		return nil, err
+	}
+	expander, err := ConnectExpander(chip, bus, address)
+	if err != nil {
+		bus.Close()
+		return nil, err
+	}
+	expander.closer = bus
+	return expander, nil
+}
+
+// ConnectExpander - Read the state of an expander on an open bus and register its pins
+// The outputs are left as they are, so a restart does not flick the relays
+func ConnectExpander(chip string, bus i2c.Bus, address uint16) (*Expander, error) {
+	if err := ValidateExpander(chip, address); err != nil {
+		return nil, err
+	}
+	chip = strings.ToLower(chip)
+	name := ExpanderName(chip, address)
+
+	expanderLock.Lock()
+	defer expanderLock.Unlock()
+	if _, ok := expanders[name]; ok {
+		return nil, fmt.Errorf("%v is already connected", name)
+	}
+
+	e := &Expander{Chip: chip, Address: address, dev: &i2c.Dev{Bus: bus, Addr: address}}
+	switch chip {
+	case MCP23017Chip:
+		var err error
+		if e.inputs, err = e.readPair(mcp23017IODIR); err != nil {
+			return nil, err
+		}
+		if e.pullUps, err = e.readPair(mcp23017GPPU); err != nil {
+			return nil, err
+		}
+		if e.latch, err = e.readPair(mcp23017OLAT); err != nil {
+			return nil, err
+		}
+	case PCF8574Chip:
+		state := []byte{0}
+		if err := e.dev.Tx(nil, state); err != nil {
+			return nil, err
+		}
+		e.latch = uint16(state[0])
+	}
+
+	for i, pinName := range ExpanderPinNames(chip, address) {
+		pin := &ExpanderPin{expander: e, name: pinName, bit: uint(i)}
+		if err := gpioreg.Register(pin); err != nil {
+			e.unregister()
+			return nil, err
+		}
+		e.pins = append(e.pins, pin)
+	}
+	expanders[name] = e
+	log.Info().Msgf("Connected %v with %v pins", name, len(e.pins))
+	return e, nil
+}
+
+// RemoveExpander - Unregister the pins of an expander and close its bus
+func RemoveExpander(chip string, address uint16) {
+	name := ExpanderName(chip, address)
+	expanderLock.Lock()
+	defer expanderLock.Unlock()
+	e, ok := expanders[name]
+	if !ok {
+		return
+	}
+	e.unregister()
+	if e.closer != nil {
+		e.closer.Close()
+	}
+	delete(expanders, name)
+}
+
+// Name - The name of the expander, e.g. mcp23017@0x20
+func (e *Expander) Name() string {
+	return ExpanderName(e.Chip, e.Address)
+}
+
+// Pins - The pins of the expander
+func (e *Expander) Pins() []*ExpanderPin {
+	return e.pins
+}
+
+func (e *Expander) unregister() {
+	for _, pin := range e.pins {
+		if err := gpioreg.Unregister(pin.name); err != nil {
+			log.Warn().Err(err).Msgf("Failed to unregister %v", pin.name)
+		}
+	}
+	e.pins = nil
+}
+
+func (e *Expander) readPair(register byte) (uint16, error) {
+	values := []byte{0, 0}
+	if err := e.dev.Tx([]byte{register}, values); err != nil {
+		return 0, err
+	}
+	return uint16(values[0]) | uint16(values[1])<<8, nil
+}
+
+func (e *Expander) writePair(register byte, value uint16) error {
+	return e.dev.Tx([]byte{register, byte(value), byte(value >> 8)}, nil)
+}
+
+// read returns the level of every pin
+func (e *Expander) read() (uint16, error) {
+	if e.Chip == MCP23017Chip {
+		return e.readPair(mcp23017GPIO)
+	}
+	state := []byte{0}
+	if err := e.dev.Tx(nil, state); err != nil {
+		return 0, err
+	}
+	return uint16(state[0]), nil
+}
+
+// ExpanderPin is a pin on a GPIO expander, it implements gpio.PinIO without edge detection or PWM
+type ExpanderPin struct {
+	expander *Expander
+	name     string
+	bit      uint
+}
+
+// String implements conn.Resource
+func (p *ExpanderPin) String() string {
+	return p.name
+}
+
+// Halt implements conn.Resource
+func (p *ExpanderPin) Halt() error {
+	return nil
+}
+
+// Name implements pin.Pin
+func (p *ExpanderPin) Name() string {
+	return p.name
+}
+
+// Number implements pin.Pin, expander pins have no number
+func (p *ExpanderPin) Number() int {
+	return -1
+}
+
+// Function implements pin.Pin
+func (p *ExpanderPin) Function() string {
+	e := p.expander
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.Chip == MCP23017Chip && e.inputs&p.mask() != 0 {
+		return "In"
+	}
+	if e.Chip == PCF8574Chip && e.latch&p.mask() != 0 {
+		return "In/High"
+	}
+	return "Out"
+}
+
+// In implements gpio.PinIn, only pull ups are supported and edges cannot be detected
+func (p *ExpanderPin) In(pull gpio.Pull, edge gpio.Edge) error {
+	if edge != gpio.NoEdge {
+		return fmt.Errorf("%v cannot detect edges", p.name)
+	}
+	if pull == gpio.PullDown {
+		return fmt.Errorf("%v cannot be pulled down", p.name)
+	}
+
+	e := p.expander
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	switch e.Chip {
+	case MCP23017Chip:
+		pullUps := e.pullUps
+		if pull == gpio.PullUp {
+			pullUps |= p.mask()
+		} else if pull == gpio.Float {
+			pullUps &^= p.mask()
+		}
+		if err := e.writePair(mcp23017GPPU, pullUps); err != nil {
+			return err
+		}
+		e.pullUps = pullUps
+		if err := e.writePair(mcp23017IODIR, e.inputs|p.mask()); err != nil {
+			return err
+		}
+		e.inputs |= p.mask()
+	case PCF8574Chip:
+		if pull == gpio.Float {
+			return fmt.Errorf("%v is always weakly pulled up as an input", p.name)
+		}
+		// Writing a 1 makes the pin an input
+		if err := e.dev.Tx([]byte{byte(e.latch | p.mask())}, nil); err != nil {
+			return err
+		}
+		e.latch |= p.mask()
+	}
+	return nil
+}
+
+// Read implements gpio.PinIn, Low is returned if the bus cannot be read
+func (p *ExpanderPin) Read() gpio.Level {
+	e := p.expander
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	state, err := e.read()
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to read %v", p.name)
+		return gpio.Low
+	}
+	return state&p.mask() != 0
+}
+
+// WaitForEdge implements gpio.PinIn, edges cannot be detected so it waits for the timeout
+func (p *ExpanderPin) WaitForEdge(timeout time.Duration) bool {
+	if timeout > 0 {
+		time.Sleep(timeout)
+	}
+	return false
+}
+
+// Pull implements gpio.PinIn
+func (p *ExpanderPin) Pull() gpio.Pull {
+	e := p.expander
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.Chip == PCF8574Chip || e.pullUps&p.mask() != 0 {
+		return gpio.PullUp
+	}
+	return gpio.Float
+}
+
+// DefaultPull implements gpio.PinIn
+func (p *ExpanderPin) DefaultPull() gpio.Pull {
+	if p.expander.Chip == PCF8574Chip {
+		return gpio.PullUp
+	}
+	return gpio.Float
+}
+
+// Out implements gpio.PinOut
+func (p *ExpanderPin) Out(l gpio.Level) error {
+	e := p.expander
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	latch := e.latch &^ p.mask()
+	if l == gpio.High {
+		latch |= p.mask()
+	}
+
+	switch e.Chip {
+	case MCP23017Chip:
+		if err := e.writePair(mcp23017OLAT, latch); err != nil {
+			return err
+		}
+		e.latch = latch
+		if e.inputs&p.mask() != 0 {
+			if err := e.writePair(mcp23017IODIR, e.inputs&^p.mask()); err != nil {
+				return err
+			}
+			e.inputs &^= p.mask()
+		}
+	case PCF8574Chip:
+		if err := e.dev.Tx([]byte{byte(latch)}, nil); err != nil {
+			return err
+		}
+		e.latch = latch
+	}
+	return nil
+}
+
+// PWM implements gpio.PinOut, expanders have no PWM
+func (p *ExpanderPin) PWM(duty gpio.Duty, f physic.Frequency) error {
+	return errors.New("expander pins do not support PWM")
+}
+
+func (p *ExpanderPin) mask() uint16 {
+	return 1 << p.bit
+}
@@ -0,0 +1,109 @@
+package hardware_test
+
+import (
+	"testing"
+
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/dougedey/elsinore/hardware/expandertest"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/gpio/gpioreg"
+)
+
+func TestExpanderNames(t *testing.T) {
+	require.Equal(t, "mcp23017@0x20:A3", hardware.NormalizePinName("MCP23017@0x20:a3"))
+	require.Equal(t, "pcf8574@0x3f:P0", hardware.NormalizePinName(" pcf8574@0x3F:p0 "))
+	require.Equal(t, "GPIO17", hardware.NormalizePinName("GPIO17"))
+
+	names := hardware.ExpanderPinNames(hardware.MCP23017Chip, 0x21)
+	require.Len(t, names, 16)
+	require.Equal(t, "mcp23017@0x21:A0", names[0])
+	require.Equal(t, "mcp23017@0x21:B7", names[15])
+	require.Len(t, hardware.ExpanderPinNames(hardware.PCF8574Chip, 0x38), 8)
+
+	require.Nil(t, hardware.ValidateExpander(hardware.PCF8574Chip, 0x38))
+	require.NotNil(t, hardware.ValidateExpander(hardware.PCF8574Chip, 0x30))
+	require.NotNil(t, hardware.ValidateExpander(hardware.MCP23017Chip, 0x38))
+	require.NotNil(t, hardware.ValidateExpander("mcp9999", 0x20))
+}
+
+func TestMCP23017(t *testing.T) {
+	bus := expandertest.NewMCP23017(0x20)
+	expander, err := hardware.ConnectExpander(hardware.MCP23017Chip, bus, 0x20)
+	require.Nil(t, err)
+	t.Cleanup(func() { hardware.RemoveExpander(hardware.MCP23017Chip, 0x20) })
+
+	t.Run("Every pin is registered", func(t *testing.T) {
+		require.Len(t, expander.Pins(), 16)
+		require.NotNil(t, gpioreg.ByName("mcp23017@0x20:B7"))
+		require.NotNil(t, hardware.Pin("MCP23017@0x20:b7"))
+	})
+
+	t.Run("An expander can only be connected once", func(t *testing.T) {
+		_, err := hardware.ConnectExpander(hardware.MCP23017Chip, bus, 0x20)
+		require.NotNil(t, err)
+	})
+
+	t.Run("Writing a pin makes it an output and sets its latch", func(t *testing.T) {
+		pin := hardware.Pin("mcp23017@0x20:A3")
+		require.Nil(t, pin.Out(gpio.High))
+		require.Equal(t, uint16(0x0008), bus.Latch())
+		require.Equal(t, uint16(0xfff7), bus.Directions())
+		require.Equal(t, gpio.High, pin.Read())
+		require.Equal(t, "Out", pin.Function())
+
+		b0 := hardware.Pin("mcp23017@0x20:B0")
+		require.Nil(t, b0.Out(gpio.High))
+		require.Nil(t, pin.Out(gpio.Low))
+		require.Equal(t, uint16(0x0100), bus.Latch())
+		require.Equal(t, gpio.Low, pin.Read())
+	})
+
+	t.Run("Inputs are read with an optional pull up", func(t *testing.T) {
+		pin := hardware.Pin("mcp23017@0x20:B4")
+		require.Nil(t, pin.In(gpio.PullUp, gpio.NoEdge))
+		require.Equal(t, gpio.PullUp, pin.Pull())
+		require.Equal(t, gpio.Low, pin.Read())
+
+		bus.SetInputs(0x1000)
+		require.Equal(t, gpio.High, pin.Read())
+
+		require.NotNil(t, pin.In(gpio.PullDown, gpio.NoEdge))
+		require.NotNil(t, pin.In(gpio.PullUp, gpio.BothEdges))
+		require.False(t, pin.WaitForEdge(0))
+		require.NotNil(t, pin.PWM(gpio.DutyHalf, 0))
+	})
+
+	t.Run("Reconnecting keeps the outputs", func(t *testing.T) {
+		hardware.RemoveExpander(hardware.MCP23017Chip, 0x20)
+		require.Nil(t, gpioreg.ByName("mcp23017@0x20:A0"))
+
+		_, err := hardware.ConnectExpander(hardware.MCP23017Chip, bus, 0x20)
+		require.Nil(t, err)
+		require.Equal(t, gpio.High, hardware.Pin("mcp23017@0x20:B0").Read())
+
+		require.Nil(t, hardware.Pin("mcp23017@0x20:A1").Out(gpio.High))
+		require.Equal(t, uint16(0x0102), bus.Latch())
+	})
+}
+
+func TestPCF8574(t *testing.T) {
+	bus := expandertest.NewPCF8574(0x38)
+	_, err := hardware.ConnectExpander(hardware.PCF8574Chip, bus, 0x38)
+	require.Nil(t, err)
+	t.Cleanup(func() { hardware.RemoveExpander(hardware.PCF8574Chip, 0x38) })
+
+	relay := hardware.Pin("pcf8574@0x38:P2")
+	require.Nil(t, relay.Out(gpio.Low))
+	require.Equal(t, byte(0xfb), bus.Latch())
+	require.Equal(t, gpio.Low, relay.Read())
+	require.Nil(t, relay.Out(gpio.High))
+	require.Equal(t, byte(0xff), bus.Latch())
+
+	button := hardware.Pin("pcf8574@0x38:P7")
+	require.Nil(t, button.In(gpio.PullUp, gpio.NoEdge))
+	require.Equal(t, gpio.High, button.Read())
+	bus.SetInputs(0x7f)
+	require.Equal(t, gpio.Low, button.Read())
+	require.NotNil(t, button.In(gpio.Float, gpio.NoEdge))
+}
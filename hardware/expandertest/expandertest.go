@@ -0,0 +1,140 @@
+// Package expandertest has fake I2C buses with GPIO expanders on them, for testing expander pins without hardware
+package expandertest
+
+import (
+	"fmt"
+	"sync"
+
+	"periph.io/x/periph/conn/physic"
+)
+
+// MCP23017 is an i2c.Bus with an MCP23017 at Addr, with IOCON.BANK = 0 and sequential addressing
+// Inputs holds the level of the pins driven from outside, output pins read back their latch
+type MCP23017 struct {
+	sync.Mutex
+	Addr      uint16
+	Registers [0x16]byte
+	Inputs    uint16
+	register  byte
+}
+
+// NewMCP23017 - An MCP23017 in its power on state, every pin an input without a pull up
+func NewMCP23017(addr uint16) *MCP23017 {
+	m := &MCP23017{Addr: addr}
+	m.Registers[0x00] = 0xff
+	m.Registers[0x01] = 0xff
+	return m
+}
+
+func (m *MCP23017) String() string {
+	return fmt.Sprintf("mcp23017-fake@0x%02x", m.Addr)
+}
+
+// Tx implements i2c.Bus, the first byte written selects the register, the rest are written from there
+func (m *MCP23017) Tx(addr uint16, w, r []byte) error {
+	m.Lock()
+	defer m.Unlock()
+	if addr != m.Addr {
+		return fmt.Errorf("no device at 0x%02x", addr)
+	}
+	if len(w) > 0 {
+		m.register = w[0]
+		for _, b := range w[1:] {
+			m.Registers[m.register%0x16] = b
+			m.register++
+		}
+	}
+	for i := range r {
+		register := m.register % 0x16
+		switch register {
+		case 0x12, 0x13:
+			// GPIO reads the inputs and the latch of the outputs
+			shift := uint(register-0x12) * 8
+			inputs := m.Registers[register-0x12]
+			r[i] = byte(m.Inputs>>shift)&inputs | m.Registers[register+2]&^inputs
+		default:
+			r[i] = m.Registers[register]
+		}
+		m.register++
+	}
+	return nil
+}
+
+// SetSpeed implements i2c.Bus
+func (m *MCP23017) SetSpeed(f physic.Frequency) error {
+	return nil
+}
+
+// Latch - The output latch of ports A and B
+func (m *MCP23017) Latch() uint16 {
+	m.Lock()
+	defer m.Unlock()
+	return uint16(m.Registers[0x14]) | uint16(m.Registers[0x15])<<8
+}
+
+// SetInputs - Drive the pins from outside, bit 0 is A0 and bit 8 is B0
+func (m *MCP23017) SetInputs(inputs uint16) {
+	m.Lock()
+	defer m.Unlock()
+	m.Inputs = inputs
+}
+
+// Directions - The IODIR of ports A and B, a set bit is an input
+func (m *MCP23017) Directions() uint16 {
+	m.Lock()
+	defer m.Unlock()
+	return uint16(m.Registers[0x00]) | uint16(m.Registers[0x01])<<8
+}
+
+// PCF8574 is an i2c.Bus with a PCF8574 at Addr
+// A pin written High is weakly pulled up and reads Inputs, a pin written Low reads Low
+type PCF8574 struct {
+	sync.Mutex
+	Addr   uint16
+	Port   byte
+	Inputs byte
+}
+
+// NewPCF8574 - A PCF8574 in its power on state, every pin High
+func NewPCF8574(addr uint16) *PCF8574 {
+	return &PCF8574{Addr: addr, Port: 0xff, Inputs: 0xff}
+}
+
+func (p *PCF8574) String() string {
+	return fmt.Sprintf("pcf8574-fake@0x%02x", p.Addr)
+}
+
+// Tx implements i2c.Bus, the last byte written sets the port
+func (p *PCF8574) Tx(addr uint16, w, r []byte) error {
+	p.Lock()
+	defer p.Unlock()
+	if addr != p.Addr {
+		return fmt.Errorf("no device at 0x%02x", addr)
+	}
+	if len(w) > 0 {
+		p.Port = w[len(w)-1]
+	}
+	for i := range r {
+		r[i] = p.Port & p.Inputs
+	}
+	return nil
+}
+
+// SetSpeed implements i2c.Bus
+func (p *PCF8574) SetSpeed(f physic.Frequency) error {
+	return nil
+}
+
+// SetInputs - Drive the pins that are High from outside
+func (p *PCF8574) SetInputs(inputs byte) {
+	p.Lock()
+	defer p.Unlock()
+	p.Inputs = inputs
+}
+
+// Latch - The last byte written to the port
+func (p *PCF8574) Latch() byte {
+	p.Lock()
+	defer p.Unlock()
+	return p.Port
+}
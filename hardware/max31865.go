@@ -0,0 +1,192 @@
+package hardware
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"periph.io/x/periph/conn/physic"
+	"periph.io/x/periph/conn/spi"
+)
+
+// MAX31865 registers, writes set the top bit of the address
+const (
+	max31865Config      = 0x00
+	max31865RTD         = 0x01
+	max31865FaultStatus = 0x07
+	max31865Write       = 0x80
+)
+
+// MAX31865 configuration bits
+const (
+	max31865Bias        = 0x80
+	max31865AutoConvert = 0x40
+	max31865ThreeWire   = 0x10
+	max31865FaultClear  = 0x02
+	max31865Filter50Hz  = 0x01
+)
+
+// Callendar–Van Dusen coefficients for IEC 60751 platinum RTDs
+const (
+	cvdA = 3.9083e-3
+	cvdB = -5.775e-7
+	cvdC = -4.183e-12
+)
+
+// The fault status bits, from the datasheet
+var max31865Faults = []struct {
+	bit         byte
+	description string
+}{
+	{0x80, "RTD resistance above the high threshold"},
+	{0x40, "RTD resistance below the low threshold"},
+	{0x20, "REFIN- above 0.85 x Vbias"},
+	{0x10, "REFIN- below 0.85 x Vbias, FORCE- open"},
+	{0x08, "RTDIN- below 0.85 x Vbias, FORCE- open"},
+	{0x04, "over or under voltage"},
+}
+
+// MAX31865Opts configure how the RTD is wired to the MAX31865
+// Wires -> 2, 3 or 4 wire RTD, defaults to 2
+// ReferenceResistor -> The reference resistor on the board in Ohms, defaults to 4.3 times the nominal resistance (430Ω for a PT100)
+// NominalResistance -> The resistance of the RTD at 0°C, 100 for a PT100 or 1000 for a PT1000, defaults to 100
+// Filter50Hz -> Reject 50Hz mains noise instead of 60Hz
+type MAX31865Opts struct {
+	Wires             int
+	ReferenceResistor float64
+	NominalResistance float64
+	Filter50Hz        bool
+}
+
+// MAX31865 is a PT100/PT1000 RTD to digital converter on an SPI port
+type MAX31865 struct {
+	conn   spi.Conn
+	opts   MAX31865Opts
+	config byte
+}
+
+// MAX31865Fault is returned when the MAX31865 reports a fault, Status is the raw fault status register
+type MAX31865Fault struct {
+	Status byte
+}
+
+func (f *MAX31865Fault) Error() string {
+	faults := []string{}
+	for _, fault := range max31865Faults {
+		if f.Status&fault.bit != 0 {
+			faults = append(faults, fault.description)
+		}
+	}
+	if len(faults) == 0 {
+		return fmt.Sprintf("max31865: fault 0x%02x", f.Status)
+	}
+	return "max31865: " + strings.Join(faults, ", ")
+}
+
+// NewMAX31865 - Connect to a MAX31865 on the port and start continuous conversions
+func NewMAX31865(port spi.Port, opts MAX31865Opts) (*MAX31865, error) {
+	if opts.Wires == 0 {
+		opts.Wires = 2
+	}
+	if opts.Wires < 2 || opts.Wires > 4 {
+		return nil, fmt.Errorf("max31865: an RTD has 2, 3 or 4 wires, got %v", opts.Wires)
+	}
+	if opts.NominalResistance == 0 {
+		opts.NominalResistance = 100
+	}
+	if opts.ReferenceResistor == 0 {
+		opts.ReferenceResistor = 4.3 * opts.NominalResistance
+	}
+	if opts.ReferenceResistor < 0 || opts.NominalResistance < 0 {
+		return nil, fmt.Errorf("max31865: resistances must be positive")
+	}
+
+	// The MAX31865 supports SPI modes 1 and 3, up to 5MHz
+	conn, err := port.Connect(physic.MegaHertz, spi.Mode1, 8)
+	if err != nil {
+		return nil, err
+	}
+
+	d := MAX31865{conn: conn, opts: opts, config: max31865Bias | max31865AutoConvert}
+	if opts.Wires == 3 {
+		d.config |= max31865ThreeWire
+	}
+	if opts.Filter50Hz {
+		d.config |= max31865Filter50Hz
+	}
+	if err := d.writeRegister(max31865Config, d.config|max31865FaultClear); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// Resistance - Read the resistance of the RTD in Ohms, returning a *MAX31865Fault if the chip reports one
+func (d *MAX31865) Resistance() (float64, error) {
+	data, err := d.readRegisters(max31865RTD, 2)
+	if err != nil {
+		return 0, err
+	}
+
+	// The lowest bit is the fault flag
+	if data[1]&0x01 != 0 {
+		status, err := d.readRegisters(max31865FaultStatus, 1)
+		if err != nil {
+			return 0, err
+		}
+		if err := d.writeRegister(max31865Config, d.config|max31865FaultClear); err != nil {
+			return 0, err
+		}
+		return 0, &MAX31865Fault{Status: status[0]}
+	}
+
+	code := (uint16(data[0])<<8 | uint16(data[1])) >> 1
+	return float64(code) * d.opts.ReferenceResistor / 32768, nil
+}
+
+// Temperature - Read the temperature of the RTD
+func (d *MAX31865) Temperature() (physic.Temperature, error) {
+	resistance, err := d.Resistance()
+	if err != nil {
+		return 0, err
+	}
+	celsius := RTDTemperature(resistance, d.opts.NominalResistance)
+	return physic.ZeroCelsius + physic.Temperature(celsius*float64(physic.Celsius)), nil
+}
+
+// RTDTemperature - Convert the resistance of a platinum RTD to °C with the Callendar–Van Dusen equation
+// nominal is the resistance at 0°C, 100 for a PT100
+func RTDTemperature(resistance float64, nominal float64) float64 {
+	ratio := resistance / nominal
+
+	// Above 0°C the equation is quadratic and can be solved directly
+	celsius := (-cvdA + math.Sqrt(cvdA*cvdA-4*cvdB*(1-ratio))) / (2 * cvdB)
+	if celsius >= 0 {
+		return celsius
+	}
+
+	// Below 0°C the C term applies, solve it with Newton's method starting from the quadratic solution
+	for i := 0; i < 10; i++ {
+		f := 1 + cvdA*celsius + cvdB*celsius*celsius + cvdC*(celsius-100)*celsius*celsius*celsius - ratio
+		df := cvdA + 2*cvdB*celsius + cvdC*(4*celsius*celsius*celsius-300*celsius*celsius)
+		step := f / df
+		celsius -= step
+		if math.Abs(step) < 1e-6 {
+			break
+		}
+	}
+	return celsius
+}
+
+func (d *MAX31865) readRegisters(register byte, length int) ([]byte, error) {
+	w := make([]byte, length+1)
+	r := make([]byte, length+1)
+	w[0] = register
+	if err := d.conn.Tx(w, r); err != nil {
+		return nil, err
+	}
+	return r[1:], nil
+}
+
+func (d *MAX31865) writeRegister(register byte, value byte) error {
+	return d.conn.Tx([]byte{register | max31865Write, value}, nil)
+}
@@ -0,0 +1,107 @@
+package hardware_test
+
+import (
+	"testing"
+
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/conntest"
+	"periph.io/x/periph/conn/physic"
+	"periph.io/x/periph/conn/spi/spitest"
+)
+
+func celsiusOf(temperature physic.Temperature) float64 {
+	return float64(temperature-physic.ZeroCelsius) / float64(physic.Celsius)
+}
+
+func TestRTDTemperature(t *testing.T) {
+	t.Run("0°C is the nominal resistance", func(t *testing.T) {
+		require.InDelta(t, 0, hardware.RTDTemperature(100, 100), 0.001)
+		require.InDelta(t, 0, hardware.RTDTemperature(1000, 1000), 0.001)
+	})
+
+	t.Run("Positive temperatures use the quadratic equation", func(t *testing.T) {
+		require.InDelta(t, 100, hardware.RTDTemperature(138.5055, 100), 0.001)
+		require.InDelta(t, 250, hardware.RTDTemperature(1940.98, 1000), 0.01)
+	})
+
+	t.Run("Negative temperatures include the C term", func(t *testing.T) {
+		require.InDelta(t, -100, hardware.RTDTemperature(60.2558, 100), 0.001)
+		require.InDelta(t, -200, hardware.RTDTemperature(18.5201, 100), 0.001)
+	})
+}
+
+func TestMAX31865(t *testing.T) {
+	t.Run("A 3 wire PT100 at 100°C", func(t *testing.T) {
+		port := spitest.Playback{Playback: conntest.Playback{Ops: []conntest.IO{
+			{W: []byte{0x80, 0xd2}},
+			{W: []byte{0x01, 0x00, 0x00}, R: []byte{0x00, 0x52, 0x76}},
+		}}}
+		dev, err := hardware.NewMAX31865(&port, hardware.MAX31865Opts{Wires: 3})
+		require.Nil(t, err)
+
+		temperature, err := dev.Temperature()
+		require.Nil(t, err)
+		require.InDelta(t, 100, celsiusOf(temperature), 0.05)
+		require.Nil(t, port.Close())
+	})
+
+	t.Run("Faults are decoded and cleared", func(t *testing.T) {
+		port := spitest.Playback{Playback: conntest.Playback{Ops: []conntest.IO{
+			{W: []byte{0x80, 0xc3}},
+			{W: []byte{0x01, 0x00, 0x00}, R: []byte{0x00, 0xff, 0xff}},
+			{W: []byte{0x07, 0x00}, R: []byte{0x00, 0x84}},
+			{W: []byte{0x80, 0xc3}},
+		}}}
+		dev, err := hardware.NewMAX31865(&port, hardware.MAX31865Opts{Wires: 4, Filter50Hz: true})
+		require.Nil(t, err)
+
+		_, err = dev.Temperature()
+		require.NotNil(t, err)
+		require.Equal(t, "max31865: RTD resistance above the high threshold, over or under voltage", err.Error())
+		fault, ok := err.(*hardware.MAX31865Fault)
+		require.True(t, ok)
+		require.Equal(t, byte(0x84), fault.Status)
+		require.Nil(t, port.Close())
+	})
+
+	t.Run("Only 2, 3 or 4 wire RTDs are supported", func(t *testing.T) {
+		_, err := hardware.NewMAX31865(&spitest.Playback{}, hardware.MAX31865Opts{Wires: 5})
+		require.NotNil(t, err)
+	})
+}
+
+func TestSPIProbes(t *testing.T) {
+	config := hardware.SPIProbeConfig{Driver: hardware.MAX31865Driver, Bus: 0, ChipSelect: 1, NominalResistance: 1000}
+	port := spitest.Playback{Playback: conntest.Playback{Ops: []conntest.IO{
+		{W: []byte{0x80, 0xc2}},
+		{W: []byte{0x01, 0x00, 0x00}, R: []byte{0x00, 0x3b, 0x88}},
+	}}}
+
+	t.Run("A chip is registered as a connected probe", func(t *testing.T) {
+		probe, err := hardware.ConnectSPIProbe(config, &port)
+		require.Nil(t, err)
+		require.Equal(t, "max31865-spi0.1", probe.PhysAddr)
+		require.True(t, probe.Connected)
+		require.Equal(t, probe, hardware.GetTemperature("max31865-spi0.1"))
+	})
+
+	t.Run("SPI probes are read", func(t *testing.T) {
+		hardware.ReadSPIProbes(nil)
+		probe := hardware.GetTemperature("max31865-spi0.1")
+		require.InDelta(t, 0, celsiusOf(probe.ReadingRaw), 0.05)
+		require.Equal(t, int64(1), probe.Reads)
+		require.Empty(t, probe.Fault)
+	})
+
+	t.Run("Removing a probe closes its port", func(t *testing.T) {
+		hardware.RemoveSPIProbe("max31865-spi0.1")
+		require.Nil(t, hardware.GetTemperature("max31865-spi0.1"))
+		require.Equal(t, len(port.Ops), port.Count)
+	})
+
+	t.Run("Unknown drivers are rejected", func(t *testing.T) {
+		_, err := hardware.ConnectSPIProbe(hardware.SPIProbeConfig{Driver: "unknown"}, &spitest.Playback{})
+		require.NotNil(t, err)
+	})
+}
@@ -0,0 +1,259 @@
+package hardware
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The network outputs that can be used as pins, the identifier is a URL with one of these schemes
+const (
+	// TasmotaScheme - A Tasmota device, e.g. tasmota://192.168.1.50, or tasmota://192.168.1.50/2 for its second relay
+	TasmotaScheme = "tasmota"
+	// ShellyScheme - A Gen1 Shelly, e.g. shelly://192.168.1.51, or shelly://192.168.1.51/1 for its second relay
+	ShellyScheme = "shelly"
+	// ShellyPlusScheme - A Gen2 Shelly Plus or Pro, e.g. shellyplus://192.168.1.52/0
+	ShellyPlusScheme = "shellyplus"
+	// StatePlaceholder - Replaced with on or off in the URL of a generic HTTP relay, e.g. http://relay.local/set?power={state}
+	StatePlaceholder = "{state}"
+)
+
+// NetworkTimeout - How long a request to a network output can take before it is retried
+var NetworkTimeout = 3 * time.Second
+
+// IsNetworkOutput - Returns true if the identifier is the URL of a network output rather than a GPIO
+func IsNetworkOutput(identifier string) bool {
+	return strings.Contains(identifier, "://")
+}
+
+// newNetworkDriver - The driver for the URL of a network output
+func newNetworkDriver(identifier string) (OutputDriver, error) {
+	identifier = strings.TrimSpace(identifier)
+	scheme := strings.ToLower(strings.SplitN(identifier, "://", 2)[0])
+	switch scheme {
+	case "http", "https":
+		if !strings.Contains(identifier, StatePlaceholder) {
+			return nil, fmt.Errorf("the URL of a generic relay needs %v to be replaced with on or off", StatePlaceholder)
+		}
+		if _, err := url.Parse(strings.ReplaceAll(identifier, StatePlaceholder, "on")); err != nil {
+			return nil, err
+		}
+		return &templateDriver{template: identifier, client: networkClient()}, nil
+	case TasmotaScheme, ShellyScheme, ShellyPlusScheme:
+	default:
+		return nil, fmt.Errorf("unknown network output '%v', expected tasmota://, shelly://, shellyplus:// or an http:// URL with %v", identifier, StatePlaceholder)
+	}
+
+	u, err := url.Parse(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("%v has no host", identifier)
+	}
+	relay := 0
+	if path := strings.Trim(u.Path, "/"); path != "" {
+		if relay, err = strconv.Atoi(path); err != nil || relay < 0 {
+			return nil, fmt.Errorf("the relay of %v must be a number, got '%v'", identifier, path)
+		}
+	}
+
+	base := device{name: identifier, base: "http://" + u.Host, user: u.User, client: networkClient()}
+	switch scheme {
+	case TasmotaScheme:
+		command := "Power"
+		if relay > 0 {
+			command += strconv.Itoa(relay)
+		}
+		// Tasmota takes the web password as parameters rather than basic auth
+		base.user = nil
+		return &tasmotaDriver{device: base, command: command, user: u.User}, nil
+	case ShellyScheme:
+		return &shellyDriver{device: base, relay: relay}, nil
+	default:
+		return &shellyPlusDriver{device: base, relay: relay}, nil
+	}
+}
+
+func networkClient() *http.Client {
+	return &http.Client{Timeout: NetworkTimeout}
+}
+
+// device is a device on the network with a JSON API
+type device struct {
+	name   string
+	base   string
+	user   *url.Userinfo
+	client *http.Client
+}
+
+func (d *device) String() string {
+	return d.name
+}
+
+// getJSON requests a path on the device and decodes the JSON response
+func (d *device) getJSON(path string, query url.Values, response interface{}) error {
+	request, err := http.NewRequest(http.MethodGet, d.base+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	if d.user != nil {
+		password, _ := d.user.Password()
+		request.SetBasicAuth(d.user.Username(), password)
+	}
+	return do(d.client, request, response)
+}
+
+// do sends the request and decodes the JSON response, unless response is nil
+func do(client *http.Client, request *http.Request, response interface{}) error {
+	resp, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 200))
+		return fmt.Errorf("%v responded %v %v", request.URL.Host, resp.Status, strings.TrimSpace(string(body)))
+	}
+	if response == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// tasmotaDriver uses the Tasmota web command API, e.g. /cm?cmnd=Power2%20On
+type tasmotaDriver struct {
+	device
+	command string
+	user    *url.Userinfo
+}
+
+func (t *tasmotaDriver) Set(on bool) (bool, error) {
+	value := "Off"
+	if on {
+		value = "On"
+	}
+	return t.power(t.command + " " + value)
+}
+
+func (t *tasmotaDriver) State() (bool, error) {
+	return t.power(t.command)
+}
+
+// power sends a Power command, the response is {"POWER2":"ON"}, or {"POWER":"ON"} for a single relay
+func (t *tasmotaDriver) power(command string) (bool, error) {
+	query := url.Values{"cmnd": {command}}
+	if t.user != nil {
+		password, _ := t.user.Password()
+		query.Set("user", t.user.Username())
+		query.Set("password", password)
+	}
+	response := map[string]interface{}{}
+	if err := t.getJSON("/cm", query, &response); err != nil {
+		return false, err
+	}
+	for _, key := range []string{strings.ToUpper(t.command), "POWER"} {
+		if state, ok := response[key].(string); ok {
+			return strings.EqualFold(state, "on"), nil
+		}
+	}
+	return false, fmt.Errorf("%v did not report %v, got %v", t.name, t.command, response)
+}
+
+// shellyDriver uses the Gen1 Shelly API, e.g. /relay/0?turn=on
+type shellyDriver struct {
+	device
+	relay int
+}
+
+type shellyRelay struct {
+	IsOn *bool `json:"ison"`
+}
+
+func (s *shellyDriver) Set(on bool) (bool, error) {
+	turn := "off"
+	if on {
+		turn = "on"
+	}
+	return s.relayState(url.Values{"turn": {turn}})
+}
+
+func (s *shellyDriver) State() (bool, error) {
+	return s.relayState(url.Values{})
+}
+
+func (s *shellyDriver) relayState(query url.Values) (bool, error) {
+	response := shellyRelay{}
+	if err := s.getJSON(fmt.Sprintf("/relay/%v", s.relay), query, &response); err != nil {
+		return false, err
+	}
+	if response.IsOn == nil {
+		return false, fmt.Errorf("%v did not report the state of relay %v", s.name, s.relay)
+	}
+	return *response.IsOn, nil
+}
+
+// shellyPlusDriver uses the Gen2 Shelly RPC API, e.g. /rpc/Switch.Set?id=0&on=true
+type shellyPlusDriver struct {
+	device
+	relay int
+}
+
+type shellyPlusStatus struct {
+	Output *bool `json:"output"`
+}
+
+func (s *shellyPlusDriver) Set(on bool) (bool, error) {
+	query := url.Values{"id": {strconv.Itoa(s.relay)}, "on": {strconv.FormatBool(on)}}
+	if err := s.getJSON("/rpc/Switch.Set", query, &map[string]interface{}{}); err != nil {
+		return false, err
+	}
+	// Switch.Set responds with the state it was in, so read it back
+	return s.State()
+}
+
+func (s *shellyPlusDriver) State() (bool, error) {
+	response := shellyPlusStatus{}
+	if err := s.getJSON("/rpc/Switch.GetStatus", url.Values{"id": {strconv.Itoa(s.relay)}}, &response); err != nil {
+		return false, err
+	}
+	if response.Output == nil {
+		return false, fmt.Errorf("%v did not report the state of switch %v", s.name, s.relay)
+	}
+	return *response.Output, nil
+}
+
+// templateDriver requests a URL with {state} replaced with on or off, it cannot be read back
+type templateDriver struct {
+	template string
+	client   *http.Client
+}
+
+func (t *templateDriver) String() string {
+	return t.template
+}
+
+func (t *templateDriver) Set(on bool) (bool, error) {
+	state := "off"
+	if on {
+		state = "on"
+	}
+	request, err := http.NewRequest(http.MethodGet, strings.ReplaceAll(t.template, StatePlaceholder, state), nil)
+	if err != nil {
+		return false, err
+	}
+	if err := do(t.client, request, nil); err != nil {
+		return false, err
+	}
+	return on, nil
+}
+
+func (t *templateDriver) State() (bool, error) {
+	return false, ErrNoReadBack
+}
@@ -0,0 +1,277 @@
+package hardware_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+)
+
+// fakePlug is a smart plug with relays, it can be made to fail every request
+type fakePlug struct {
+	sync.Mutex
+	relays   map[string]bool
+	failing  bool
+	requests []string
+}
+
+func newFakePlug() *fakePlug {
+	return &fakePlug{relays: map[string]bool{}}
+}
+
+func (f *fakePlug) handle(w http.ResponseWriter, r *http.Request, handler func() interface{}) {
+	f.Lock()
+	defer f.Unlock()
+	f.requests = append(f.requests, r.URL.RequestURI())
+	if f.failing {
+		http.Error(w, "relay fault", http.StatusInternalServerError)
+		return
+	}
+	if response := handler(); response != nil {
+		json.NewEncoder(w).Encode(response)
+	}
+}
+
+func (f *fakePlug) set(relay string, on bool) {
+	f.Lock()
+	defer f.Unlock()
+	f.relays[relay] = on
+}
+
+func (f *fakePlug) get(relay string) bool {
+	f.Lock()
+	defer f.Unlock()
+	return f.relays[relay]
+}
+
+func (f *fakePlug) fail(failing bool) {
+	f.Lock()
+	defer f.Unlock()
+	f.failing = failing
+}
+
+func (f *fakePlug) lastRequest() string {
+	f.Lock()
+	defer f.Unlock()
+	return f.requests[len(f.requests)-1]
+}
+
+// tasmota serves the Tasmota /cm?cmnd=Power1%20On API
+func (f *fakePlug) tasmota(w http.ResponseWriter, r *http.Request) {
+	f.handle(w, r, func() interface{} {
+		fields := strings.Fields(r.URL.Query().Get("cmnd"))
+		relay := strings.ToUpper(fields[0])
+		if len(fields) > 1 {
+			f.relays[relay] = strings.EqualFold(fields[1], "on")
+		}
+		state := "OFF"
+		if f.relays[relay] {
+			state = "ON"
+		}
+		return map[string]string{relay: state}
+	})
+}
+
+// shelly serves the Gen1 Shelly /relay/0?turn=on API
+func (f *fakePlug) shelly(w http.ResponseWriter, r *http.Request) {
+	f.handle(w, r, func() interface{} {
+		relay := strings.TrimPrefix(r.URL.Path, "/relay/")
+		if turn := r.URL.Query().Get("turn"); turn != "" {
+			f.relays[relay] = turn == "on"
+		}
+		return map[string]bool{"ison": f.relays[relay]}
+	})
+}
+
+// shellyPlus serves the Gen2 Shelly /rpc/Switch.Set?id=0&on=true API
+func (f *fakePlug) shellyPlus(w http.ResponseWriter, r *http.Request) {
+	f.handle(w, r, func() interface{} {
+		relay := r.URL.Query().Get("id")
+		wasOn := f.relays[relay]
+		if r.URL.Path == "/rpc/Switch.Set" {
+			f.relays[relay] = r.URL.Query().Get("on") == "true"
+			return map[string]bool{"was_on": wasOn}
+		}
+		return map[string]interface{}{"id": relay, "output": f.relays[relay]}
+	})
+}
+
+// fastNetwork shortens the timeouts and intervals for the test
+func fastNetwork(t *testing.T, pollInterval time.Duration) {
+	timeout, retries, interval := hardware.NetworkTimeout, hardware.OutputRetries, hardware.OutputPollInterval
+	hardware.NetworkTimeout, hardware.OutputRetries, hardware.OutputPollInterval = 100*time.Millisecond, 1, pollInterval
+	t.Cleanup(func() {
+		hardware.NetworkTimeout, hardware.OutputRetries, hardware.OutputPollInterval = timeout, retries, interval
+	})
+}
+
+func TestNetworkOutputDrivers(t *testing.T) {
+	t.Run("Invalid network outputs are rejected", func(t *testing.T) {
+		for _, identifier := range []string{
+			"ftp://192.168.1.50",
+			"tasmota://",
+			"shelly://192.168.1.51/relay",
+			"http://relay.local/set?power=on",
+		} {
+			_, err := hardware.NewOutputDriver(identifier)
+			require.NotNil(t, err, identifier)
+		}
+		require.Nil(t, hardware.Pin("tasmota://"))
+	})
+
+	t.Run("Tasmota relays are switched and read back", func(t *testing.T) {
+		plug := newFakePlug()
+		server := httptest.NewServer(http.HandlerFunc(plug.tasmota))
+		t.Cleanup(server.Close)
+		host := strings.TrimPrefix(server.URL, "http://")
+
+		driver, err := hardware.NewOutputDriver(fmt.Sprintf("tasmota://admin:secret@%v/2", host))
+		require.Nil(t, err)
+		on, err := driver.Set(true)
+		require.Nil(t, err)
+		require.True(t, on)
+		require.True(t, plug.get("POWER2"))
+		require.Contains(t, plug.lastRequest(), "cmnd=Power2+On")
+		require.Contains(t, plug.lastRequest(), "user=admin")
+		require.Contains(t, plug.lastRequest(), "password=secret")
+
+		plug.set("POWER2", false)
+		on, err = driver.State()
+		require.Nil(t, err)
+		require.False(t, on)
+
+		single, err := hardware.NewOutputDriver("tasmota://" + host)
+		require.Nil(t, err)
+		_, err = single.Set(true)
+		require.Nil(t, err)
+		require.True(t, plug.get("POWER"))
+	})
+
+	t.Run("Shelly relays are switched with basic auth", func(t *testing.T) {
+		plug := newFakePlug()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if user, password, ok := r.BasicAuth(); !ok || user != "admin" || password != "secret" {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			plug.shelly(w, r)
+		}))
+		t.Cleanup(server.Close)
+		host := strings.TrimPrefix(server.URL, "http://")
+
+		driver, err := hardware.NewOutputDriver(fmt.Sprintf("shelly://admin:secret@%v/1", host))
+		require.Nil(t, err)
+		on, err := driver.Set(true)
+		require.Nil(t, err)
+		require.True(t, on)
+		require.True(t, plug.get("1"))
+
+		unauthorized, err := hardware.NewOutputDriver("shelly://" + host)
+		require.Nil(t, err)
+		_, err = unauthorized.State()
+		require.NotNil(t, err)
+	})
+
+	t.Run("Shelly Plus switches are read back after they are set", func(t *testing.T) {
+		plug := newFakePlug()
+		server := httptest.NewServer(http.HandlerFunc(plug.shellyPlus))
+		t.Cleanup(server.Close)
+
+		driver, err := hardware.NewOutputDriver("shellyplus://" + strings.TrimPrefix(server.URL, "http://"))
+		require.Nil(t, err)
+		on, err := driver.Set(true)
+		require.Nil(t, err)
+		require.True(t, on)
+		require.True(t, plug.get("0"))
+		require.Equal(t, "/rpc/Switch.GetStatus?id=0", plug.lastRequest())
+	})
+
+	t.Run("Generic relays request the URL with the state", func(t *testing.T) {
+		plug := newFakePlug()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			plug.handle(w, r, func() interface{} { return nil })
+		}))
+		t.Cleanup(server.Close)
+
+		driver, err := hardware.NewOutputDriver(server.URL + "/set?power={state}")
+		require.Nil(t, err)
+		on, err := driver.Set(true)
+		require.Nil(t, err)
+		require.True(t, on)
+		require.Equal(t, "/set?power=on", plug.lastRequest())
+
+		_, err = driver.State()
+		require.True(t, errors.Is(err, hardware.ErrNoReadBack))
+	})
+}
+
+func TestDriverPin(t *testing.T) {
+	fastNetwork(t, 200*time.Millisecond)
+	plug := newFakePlug()
+	server := httptest.NewServer(http.HandlerFunc(plug.tasmota))
+	t.Cleanup(server.Close)
+	identifier := "tasmota://" + strings.TrimPrefix(server.URL, "http://")
+
+	pin, ok := hardware.Pin(identifier).(*hardware.DriverPin)
+	require.True(t, ok)
+	same, err := hardware.DriverOutput(identifier)
+	require.Nil(t, err)
+	require.Equal(t, pin, same)
+	require.NotNil(t, pin.PWM(gpio.DutyHalf, 0))
+	require.NotNil(t, pin.In(gpio.PullUp, gpio.NoEdge))
+
+	t.Run("The pin turns the plug on and off", func(t *testing.T) {
+		require.Nil(t, pin.Out(gpio.High))
+		require.True(t, plug.get("POWER"))
+		require.Equal(t, gpio.High, pin.Read())
+
+		require.Nil(t, pin.Out(gpio.Low))
+		require.False(t, plug.get("POWER"))
+		require.Nil(t, pin.Err())
+	})
+
+	t.Run("Changes made on the plug are read back", func(t *testing.T) {
+		plug.set("POWER", true)
+		require.Equal(t, gpio.Low, pin.Read())
+		require.Eventually(t, func() bool { return pin.Read() == gpio.High }, time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("An unreachable plug is treated as off and tried again later", func(t *testing.T) {
+		plug.fail(true)
+		err := pin.Out(gpio.High)
+		require.NotNil(t, err)
+		require.False(t, errors.Is(err, hardware.ErrUnreachable))
+		require.Equal(t, gpio.Low, pin.Read())
+		require.NotNil(t, pin.Err())
+
+		err = pin.Out(gpio.High)
+		require.True(t, errors.Is(err, hardware.ErrUnreachable))
+
+		plug.fail(false)
+		require.Eventually(t, func() bool { return pin.Out(gpio.High) == nil }, time.Second, 50*time.Millisecond)
+		require.Nil(t, pin.Err())
+		require.Equal(t, gpio.High, pin.Read())
+	})
+
+	t.Run("Requests time out", func(t *testing.T) {
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(300 * time.Millisecond)
+		}))
+		t.Cleanup(slow.Close)
+		slowPin, err := hardware.DriverOutput("shelly://" + strings.TrimPrefix(slow.URL, "http://"))
+		require.Nil(t, err)
+
+		start := time.Now()
+		require.NotNil(t, slowPin.Out(gpio.High))
+		require.Less(t, int64(time.Since(start)), int64(time.Second))
+	})
+}
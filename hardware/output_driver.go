@@ -0,0 +1,218 @@
+package hardware
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/physic"
+)
+
+// OutputRetries - How many times a failed request to an output on another device is retried
+var OutputRetries = 2
+
+// OutputPollInterval - How long the state read back from an output on another device is trusted before it is read again,
+// and how long an unreachable output is left before it is tried again
+var OutputPollInterval = 10 * time.Second
+
+// outputRetryBackoff is how long to wait before the first retry, it doubles for each retry after that
+const outputRetryBackoff = 100 * time.Millisecond
+
+// ErrUnreachable is returned when an output that could not be reached is set again before OutputPollInterval has passed
+var ErrUnreachable = errors.New("the output is unreachable")
+
+// ErrNoReadBack is returned by OutputDriver.State when the device cannot report its state
+var ErrNoReadBack = errors.New("the output cannot be read back")
+
+var outputPins = make(map[string]*DriverPin)
+var outputPinsLock sync.Mutex
+
+// OutputDriver switches an output on another device on and off
+type OutputDriver interface {
+	fmt.Stringer
+	// Set turns the output on or off, returning the state the device reports afterwards
+	Set(on bool) (bool, error)
+	// State reads the state back from the device, ErrNoReadBack if it cannot be read
+	State() (bool, error)
+}
+
+// IsExternalOutput - Returns true if the identifier is an output on another device, on the network or a serial port, rather than a GPIO
+func IsExternalOutput(identifier string) bool {
+	return IsNetworkOutput(identifier) || IsSerialOutput(identifier)
+}
+
+// NewOutputDriver - The driver for an output on another device, a network URL or a serial relay
+func NewOutputDriver(identifier string) (OutputDriver, error) {
+	identifier = strings.TrimSpace(identifier)
+	if IsSerialOutput(identifier) {
+		return newSerialDriver(identifier)
+	}
+	return newNetworkDriver(identifier)
+}
+
+// retry calls f until it succeeds or OutputRetries retries have failed, backing off between them
+// ErrNoReadBack is not retried
+func retry(f func() error) error {
+	backoff := outputRetryBackoff
+	err := f()
+	for i := 0; err != nil && !errors.Is(err, ErrNoReadBack) && i < OutputRetries; i++ {
+		time.Sleep(backoff)
+		backoff *= 2
+		err = f()
+	}
+	return err
+}
+
+// DriverOutput - The pin for an output on another device, the same pin is returned for the same identifier
+func DriverOutput(identifier string) (*DriverPin, error) {
+	identifier = strings.TrimSpace(identifier)
+	outputPinsLock.Lock()
+	defer outputPinsLock.Unlock()
+	if pin, ok := outputPins[identifier]; ok {
+		return pin, nil
+	}
+	driver, err := NewOutputDriver(identifier)
+	if err != nil {
+		return nil, err
+	}
+	pin := &DriverPin{driver: driver, name: identifier}
+	outputPins[identifier] = pin
+	return pin, nil
+}
+
+// DriverPin is an output on another device driven by an OutputDriver, it implements gpio.PinIO as an output without PWM
+// Requests are retried, and an output that cannot be reached is treated as off and tried again after OutputPollInterval
+type DriverPin struct {
+	driver   OutputDriver
+	name     string
+	lock     sync.Mutex
+	level    gpio.Level // The last state set or read back
+	readAt   time.Time  // When level was last confirmed by the device
+	failed   *gpio.Level
+	failedAt time.Time
+	err      error
+}
+
+// String implements conn.Resource
+func (p *DriverPin) String() string {
+	return p.name
+}
+
+// Halt implements conn.Resource
+func (p *DriverPin) Halt() error {
+	return nil
+}
+
+// Name implements pin.Pin
+func (p *DriverPin) Name() string {
+	return p.name
+}
+
+// Number implements pin.Pin, outputs on other devices have no number
+func (p *DriverPin) Number() int {
+	return -1
+}
+
+// Function implements pin.Pin
+func (p *DriverPin) Function() string {
+	return "Out"
+}
+
+// Err - Why the output could not be set or read back, nil when it is working
+func (p *DriverPin) Err() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.err
+}
+
+// In implements gpio.PinIn, outputs on other devices cannot be inputs
+func (p *DriverPin) In(pull gpio.Pull, edge gpio.Edge) error {
+	return fmt.Errorf("%v is an output on another device, it cannot be an input", p.name)
+}
+
+// Read implements gpio.PinIn, reading the state back from the device at most every OutputPollInterval
+// Low is returned if the device cannot be reached, so the output is turned on again once it can be
+func (p *DriverPin) Read() gpio.Level {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if time.Since(p.readAt) < OutputPollInterval || (p.err != nil && time.Since(p.failedAt) < OutputPollInterval) {
+		return p.level
+	}
+
+	var on bool
+	err := retry(func() (err error) {
+		on, err = p.driver.State()
+		return err
+	})
+	if errors.Is(err, ErrNoReadBack) {
+		return p.level
+	}
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to read back %v, treating it as off", p.name)
+		p.fail(nil, err)
+		return p.level
+	}
+	if gpio.Level(on) != p.level {
+		log.Warn().Msgf("%v was changed on the device, it is now %v", p.name, gpio.Level(on))
+	}
+	p.level, p.readAt, p.err = gpio.Level(on), time.Now(), nil
+	return p.level
+}
+
+// WaitForEdge implements gpio.PinIn, edges cannot be detected so it waits for the timeout
+func (p *DriverPin) WaitForEdge(timeout time.Duration) bool {
+	if timeout > 0 {
+		time.Sleep(timeout)
+	}
+	return false
+}
+
+// Pull implements gpio.PinIn
+func (p *DriverPin) Pull() gpio.Pull {
+	return gpio.Float
+}
+
+// DefaultPull implements gpio.PinIn
+func (p *DriverPin) DefaultPull() gpio.Pull {
+	return gpio.Float
+}
+
+// Out implements gpio.PinOut, the request is retried and the state the device reports is checked
+// After a failure the same level is not requested again until OutputPollInterval has passed
+func (p *DriverPin) Out(l gpio.Level) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.failed != nil && *p.failed == l && time.Since(p.failedAt) < OutputPollInterval {
+		return fmt.Errorf("%v: %w, it will be tried again in %v", p.name, ErrUnreachable, (OutputPollInterval - time.Since(p.failedAt)).Truncate(time.Second))
+	}
+
+	var on bool
+	err := retry(func() (err error) {
+		on, err = p.driver.Set(bool(l))
+		return err
+	})
+	if err == nil && gpio.Level(on) != l {
+		err = fmt.Errorf("%v reports %v after it was set %v", p.name, gpio.Level(on), l)
+	}
+	if err != nil {
+		p.fail(&l, err)
+		return err
+	}
+	p.level, p.readAt, p.failed, p.err = l, time.Now(), nil, nil
+	return nil
+}
+
+// fail records a failure, the state of the output is unknown so it is treated as off
+func (p *DriverPin) fail(level *gpio.Level, err error) {
+	p.level, p.readAt = gpio.Low, time.Time{}
+	p.failed, p.failedAt, p.err = level, time.Now(), err
+}
+
+// PWM implements gpio.PinOut, outputs on other devices have no PWM
+func (p *DriverPin) PWM(duty gpio.Duty, f physic.Frequency) error {
+	return fmt.Errorf("%v is an output on another device, it cannot run PWM", p.name)
+}
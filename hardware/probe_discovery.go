@@ -0,0 +1,159 @@
+package hardware
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"periph.io/x/periph/conn/onewire"
+)
+
+// DefaultRescanInterval is how often the 1-Wire bus is searched for probes that were plugged in or removed
+const DefaultRescanInterval = 30 * time.Second
+
+// The number of probe events kept for ProbeEvents
+const probeEventHistory = 50
+
+// ProbeEventType describes what happened to a probe on the bus
+type ProbeEventType string
+
+const (
+	// ProbeDiscovered - A probe was found on the bus, either for the first time or after being lost
+	ProbeDiscovered ProbeEventType = "discovered"
+	// ProbeLost - A probe that was on the bus is no longer found
+	ProbeLost ProbeEventType = "lost"
+)
+
+// ProbeEvent is published whenever a rescan finds or loses a probe
+type ProbeEvent struct {
+	Type     ProbeEventType
+	PhysAddr string
+	Time     time.Time
+}
+
+// ProbeScan is the result of searching a bus for probes
+// Discovered -> The physical addresses of the probes that were not connected before the scan
+// Lost -> The physical addresses of the probes that were connected before the scan but are now missing
+type ProbeScan struct {
+	Discovered []string
+	Lost       []string
+}
+
+var rescanInterval = DefaultRescanInterval
+var currentBus onewire.Bus
+var busLock sync.Mutex
+var probeListeners []func(ProbeEvent)
+var probeEvents []ProbeEvent
+var eventLock sync.Mutex
+
+// SetRescanInterval - How often ReadTemperatures searches the bus for new or missing probes, 0 disables periodic rescans
+func SetRescanInterval(interval time.Duration) {
+	rescanInterval = interval
+}
+
+// OnProbeEvent - Call listener every time a probe is discovered or lost
+func OnProbeEvent(listener func(ProbeEvent)) {
+	eventLock.Lock()
+	defer eventLock.Unlock()
+	probeListeners = append(probeListeners, listener)
+}
+
+// ProbeEvents - The most recent probe events, oldest first
+func ProbeEvents() []ProbeEvent {
+	eventLock.Lock()
+	defer eventLock.Unlock()
+	events := make([]ProbeEvent, len(probeEvents))
+	copy(events, probeEvents)
+	return events
+}
+
+// Rescan - Search the bus that ReadTemperatures is using for probes now
+func Rescan() (*ProbeScan, error) {
+	busLock.Lock()
+	bus := currentBus
+	busLock.Unlock()
+
+	if bus == nil {
+		return nil, fmt.Errorf("no 1-Wire bus is open to scan")
+	}
+	return ScanBus(bus)
+}
+
+// ScanBus - Search a bus for probes, adding new probes and marking the ones that are missing as disconnected
+// Disconnected probes are kept so that their settings and last reading are still available if they are plugged back in
+func ScanBus(bus onewire.Bus) (*ProbeScan, error) {
+	busLock.Lock()
+	addresses, err := bus.Search(false)
+	busLock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	scan := ProbeScan{Discovered: []string{}, Lost: []string{}}
+	found := make(map[string]bool)
+
+	probesLock.Lock()
+	for _, address := range addresses {
+		physAddr := physicalAddress(address)
+		found[physAddr] = true
+
+		probe := probes[physAddr]
+		if probe == nil {
+			probe = &TemperatureProbe{PhysAddr: physAddr, Address: address}
+			probes[physAddr] = probe
+		} else if probe.Connected {
+			continue
+		}
+		probe.Bus = bus.String()
+		probe.Connected = true
+		probe.Fault = ""
+		scan.Discovered = append(scan.Discovered, physAddr)
+	}
+
+	for physAddr, probe := range probes {
+		// Only probes that were found on this bus can go missing from it
+		if found[physAddr] || !probe.Connected || probe.Bus != bus.String() {
+			continue
+		}
+		probe.Connected = false
+		probe.Fault = "disconnected"
+		scan.Lost = append(scan.Lost, physAddr)
+	}
+	probesLock.Unlock()
+
+	now := time.Now()
+	for _, physAddr := range scan.Discovered {
+		log.Info().Msgf("Found %v", physAddr)
+		publishProbeEvent(ProbeEvent{Type: ProbeDiscovered, PhysAddr: physAddr, Time: now})
+	}
+	for _, physAddr := range scan.Lost {
+		log.Warn().Msgf("Lost %v", physAddr)
+		publishProbeEvent(ProbeEvent{Type: ProbeLost, PhysAddr: physAddr, Time: now})
+	}
+	return &scan, nil
+}
+
+func publishProbeEvent(event ProbeEvent) {
+	eventLock.Lock()
+	probeEvents = append(probeEvents, event)
+	if len(probeEvents) > probeEventHistory {
+		probeEvents = probeEvents[len(probeEvents)-probeEventHistory:]
+	}
+	listeners := make([]func(ProbeEvent), len(probeListeners))
+	copy(listeners, probeListeners)
+	eventLock.Unlock()
+
+	for _, listener := range listeners {
+		listener(event)
+	}
+}
+
+// physicalAddress converts a 1-Wire address into the family-serial form used on the filesystem, e.g. 28-0316a2c4b8ff
+func physicalAddress(address onewire.Address) string {
+	addrBytes := make([]byte, 8)
+	binary.LittleEndian.PutUint64(addrBytes, uint64(address))
+	return hex.EncodeToString(addrBytes[0:1]) + "-" + hex.EncodeToString(reverse(addrBytes[1:7]))
+}
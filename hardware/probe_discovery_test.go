@@ -0,0 +1,79 @@
+package hardware_test
+
+import (
+	"testing"
+
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/onewire"
+)
+
+type fakeBus struct {
+	addresses []onewire.Address
+}
+
+func (b *fakeBus) String() string {
+	return "fake"
+}
+
+func (b *fakeBus) Tx(w, r []byte, power onewire.Pullup) error {
+	return nil
+}
+
+func (b *fakeBus) Search(alarmOnly bool) ([]onewire.Address, error) {
+	return b.addresses, nil
+}
+
+func TestScanBus(t *testing.T) {
+	bus := fakeBus{addresses: []onewire.Address{0xff0316a2c4b8ff28}}
+	events := []hardware.ProbeEvent{}
+	hardware.OnProbeEvent(func(event hardware.ProbeEvent) {
+		events = append(events, event)
+	})
+
+	t.Run("New probes are added and published", func(t *testing.T) {
+		scan, err := hardware.ScanBus(&bus)
+		require.Nil(t, err)
+		require.Equal(t, []string{"28-0316a2c4b8ff"}, scan.Discovered)
+		require.Empty(t, scan.Lost)
+
+		probe := hardware.GetTemperature("28-0316a2c4b8ff")
+		require.NotNil(t, probe)
+		require.True(t, probe.Connected)
+		require.Equal(t, hardware.ProbeDiscovered, events[len(events)-1].Type)
+	})
+
+	t.Run("Scanning again does not rediscover connected probes", func(t *testing.T) {
+		scan, err := hardware.ScanBus(&bus)
+		require.Nil(t, err)
+		require.Empty(t, scan.Discovered)
+		require.Empty(t, scan.Lost)
+	})
+
+	t.Run("Missing probes are marked disconnected, not removed", func(t *testing.T) {
+		hardware.SetProbe(&hardware.TemperatureProbe{PhysAddr: "NotOnTheBus"})
+		bus.addresses = []onewire.Address{}
+		scan, err := hardware.ScanBus(&bus)
+		require.Nil(t, err)
+		require.Equal(t, []string{"28-0316a2c4b8ff"}, scan.Lost)
+
+		probe := hardware.GetTemperature("28-0316a2c4b8ff")
+		require.NotNil(t, probe)
+		require.False(t, probe.Connected)
+		require.Equal(t, "disconnected", probe.Fault)
+		require.True(t, hardware.GetTemperature("NotOnTheBus").Connected)
+		require.Equal(t, hardware.ProbeLost, events[len(events)-1].Type)
+	})
+
+	t.Run("Probes that come back are reconnected", func(t *testing.T) {
+		bus.addresses = []onewire.Address{0xff0316a2c4b8ff28}
+		scan, err := hardware.ScanBus(&bus)
+		require.Nil(t, err)
+		require.Equal(t, []string{"28-0316a2c4b8ff"}, scan.Discovered)
+
+		probe := hardware.GetTemperature("28-0316a2c4b8ff")
+		require.True(t, probe.Connected)
+		require.Empty(t, probe.Fault)
+		require.NotEmpty(t, hardware.ProbeEvents())
+	})
+}
@@ -0,0 +1,179 @@
+package hardware
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"periph.io/x/periph/conn/onewire"
+	"periph.io/x/periph/conn/physic"
+	"periph.io/x/periph/devices/ds18b20"
+)
+
+const (
+	// DefaultResolution is the resolution in bits used for probes that have not been configured
+	DefaultResolution = 10
+	// MinResolution is the lowest DS18B20 resolution, 0.5°C in ~94ms
+	MinResolution = 9
+	// MaxResolution is the highest DS18B20 resolution, 0.0625°C in ~750ms
+	MaxResolution = 12
+	// DefaultPollInterval is how often the probes are read
+	DefaultPollInterval = 5 * time.Second
+)
+
+var pollInterval = DefaultPollInterval
+var resolutions = make(map[string]int)
+
+// SetPollInterval - How often ReadTemperatures reads the probes, must be set before ReadTemperatures starts
+func SetPollInterval(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("the poll interval must be positive, got %v", interval)
+	}
+	pollInterval = interval
+	return nil
+}
+
+// SetResolution - Set the resolution in bits (9-12) for a probe, 0 uses the DefaultResolution
+// The probe does not need to be connected, the resolution is applied when it is next read
+func SetResolution(physAddr string, bits int) error {
+	if bits != 0 && (bits < MinResolution || bits > MaxResolution) {
+		return fmt.Errorf("resolution must be between %v and %v bits, got %v", MinResolution, MaxResolution, bits)
+	}
+
+	probesLock.Lock()
+	defer probesLock.Unlock()
+	if bits == 0 {
+		delete(resolutions, physAddr)
+	} else {
+		resolutions[physAddr] = bits
+	}
+	return nil
+}
+
+// Resolution - The resolution in bits this probe is read at
+func (t *TemperatureProbe) Resolution() int {
+	probesLock.RLock()
+	defer probesLock.RUnlock()
+	return t.resolution()
+}
+
+func (t *TemperatureProbe) resolution() int {
+	if bits, ok := resolutions[t.PhysAddr]; ok {
+		return bits
+	}
+	return DefaultResolution
+}
+
+// ReadAddresses -> Update the connected TemperatureProbes on the bus with the current value from the device
+// A single conversion is started for the whole bus at the highest resolution in use, then every probe is read at the same time
+func ReadAddresses(oneBus onewire.Bus, messages *chan string) {
+	busLock.Lock()
+	defer busLock.Unlock()
+
+	connected := []*TemperatureProbe{}
+	maxResolution := MinResolution
+	probesLock.Lock()
+	for _, probe := range probes {
+		if !probe.Connected || probe.Bus != oneBus.String() {
+			continue
+		}
+		connected = append(connected, probe)
+		if probe.resolution() > maxResolution {
+			maxResolution = probe.resolution()
+		}
+	}
+	probesLock.Unlock()
+	if len(connected) == 0 {
+		return
+	}
+
+	// The resolution is stored on the sensor, so it has to be set before the conversion starts
+	for _, probe := range connected {
+		if err := probe.prepareSensor(oneBus); err != nil {
+			log.Error().Err(err).Msgf("Failed to initialize probe %v", probe.PhysAddr)
+		}
+	}
+
+	if err := ds18b20.ConvertAll(oneBus, maxResolution); err != nil {
+		log.Error().Err(err).Msg("Failed to start a temperature conversion")
+		for _, probe := range connected {
+			probe.recordRead(0, 0, err)
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, probe := range connected {
+		wg.Add(1)
+		go func(probe *TemperatureProbe) {
+			defer wg.Done()
+			defer func() {
+				if err := recover(); err != nil {
+					log.Error().Msgf("Error reading temperature for %v", probe.PhysAddr)
+				}
+			}()
+
+			start := time.Now()
+			temp, err := probe.lastTemp()
+			probe.recordRead(temp, time.Since(start), err)
+			if err != nil {
+				log.Error().Err(err).Msgf("Failed to get the last temp for %v", probe.PhysAddr)
+				return
+			}
+			if messages != nil {
+				*messages <- fmt.Sprintf("Reading device %v: %v", probe.PhysAddr, temp)
+			}
+		}(probe)
+	}
+	wg.Wait()
+}
+
+// prepareSensor creates the ds18b20 device for the probe, or recreates it when the resolution has changed
+func (t *TemperatureProbe) prepareSensor(bus onewire.Bus) error {
+	probesLock.RLock()
+	resolution := t.resolution()
+	ready := t.sensor != nil && t.sensorResolution == resolution
+	probesLock.RUnlock()
+	if ready {
+		return nil
+	}
+
+	sensor, err := ds18b20.New(bus, t.Address, resolution)
+	probesLock.Lock()
+	defer probesLock.Unlock()
+	if err != nil {
+		t.sensor = nil
+		return err
+	}
+	t.sensor = sensor
+	t.sensorResolution = resolution
+	return nil
+}
+
+func (t *TemperatureProbe) lastTemp() (physic.Temperature, error) {
+	probesLock.RLock()
+	sensor := t.sensor
+	probesLock.RUnlock()
+	if sensor == nil {
+		return 0, fmt.Errorf("probe %v could not be initialized", t.PhysAddr)
+	}
+	return sensor.LastTemp()
+}
+
+// recordRead stores the result of a read, a failed read drops the sensor so it is initialized again on the next read
+func (t *TemperatureProbe) recordRead(temp physic.Temperature, latency time.Duration, err error) {
+	probesLock.Lock()
+	defer probesLock.Unlock()
+	t.Reads++
+	t.ReadLatency = latency
+	if err != nil {
+		t.ReadErrors++
+		t.Fault = err.Error()
+		t.sensor = nil
+		return
+	}
+	t.Updated = time.Now()
+	t.ReadingRaw = temp
+	t.Fault = ""
+}
@@ -0,0 +1,117 @@
+package hardware_test
+
+import (
+	"encoding/binary"
+	"sync"
+	"testing"
+
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/onewire"
+	"periph.io/x/periph/conn/physic"
+)
+
+// scratchpadBus answers every ds18b20 scratchpad read with the temperature for the addressed probe
+type scratchpadBus struct {
+	sync.Mutex
+	temperatures map[onewire.Address]float64
+	failing      onewire.Address
+	conversions  int
+	resolutions  map[onewire.Address]byte
+}
+
+func (b *scratchpadBus) String() string {
+	return "scratchpad"
+}
+
+func (b *scratchpadBus) Tx(w, r []byte, power onewire.Pullup) error {
+	b.Lock()
+	defer b.Unlock()
+	if len(w) == 2 && w[0] == 0xcc && w[1] == 0x44 {
+		b.conversions++
+		return nil
+	}
+
+	address := onewire.Address(binary.LittleEndian.Uint64(w[1:9]))
+	switch w[9] {
+	case 0x4e:
+		b.resolutions[address] = w[12]
+	case 0xbe:
+		if address == b.failing {
+			for i := range r {
+				r[i] = 0xff
+			}
+			return nil
+		}
+		raw := int16(b.temperatures[address] * 16)
+		r[0] = byte(raw)
+		r[1] = byte(raw >> 8)
+		r[4] = b.resolutions[address]
+		r[8] = onewire.CalcCRC(r[:8])
+	}
+	return nil
+}
+
+func (b *scratchpadBus) Search(alarmOnly bool) ([]onewire.Address, error) {
+	addresses := []onewire.Address{}
+	for address := range b.temperatures {
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+func TestReadAddresses(t *testing.T) {
+	bus := scratchpadBus{
+		temperatures: map[onewire.Address]float64{
+			0x0000000000000128: 18.5,
+			0x0000000000000228: 20,
+			0x0000000000000328: 65.25,
+		},
+		failing:     0x0000000000000328,
+		resolutions: make(map[onewire.Address]byte),
+	}
+	_, err := hardware.ScanBus(&bus)
+	require.Nil(t, err)
+	require.Nil(t, hardware.SetResolution("28-000000000001", 12))
+
+	hardware.ReadAddresses(&bus, nil)
+
+	t.Run("A single conversion is used for the whole bus", func(t *testing.T) {
+		require.Equal(t, 1, bus.conversions)
+	})
+
+	t.Run("Every probe is read", func(t *testing.T) {
+		first := hardware.GetTemperature("28-000000000001")
+		require.Equal(t, physic.ZeroCelsius+18500*physic.MilliCelsius, first.ReadingRaw)
+		require.Equal(t, int64(1), first.Reads)
+		require.Equal(t, int64(0), first.ReadErrors)
+		require.Empty(t, first.Fault)
+
+		second := hardware.GetTemperature("28-000000000002")
+		require.Equal(t, physic.ZeroCelsius+20*physic.Celsius, second.ReadingRaw)
+	})
+
+	t.Run("The resolution is set on each probe", func(t *testing.T) {
+		require.Equal(t, byte((12-9)<<5|0x1f), bus.resolutions[0x0000000000000128])
+		require.Equal(t, byte((hardware.DefaultResolution-9)<<5|0x1f), bus.resolutions[0x0000000000000228])
+		require.Equal(t, 12, hardware.GetTemperature("28-000000000001").Resolution())
+	})
+
+	t.Run("Failed reads are counted", func(t *testing.T) {
+		failed := hardware.GetTemperature("28-000000000003")
+		require.Equal(t, int64(1), failed.Reads)
+		require.Equal(t, int64(1), failed.ReadErrors)
+		require.NotEmpty(t, failed.Fault)
+	})
+
+	t.Run("Invalid resolutions are rejected", func(t *testing.T) {
+		require.NotNil(t, hardware.SetResolution("28-000000000001", 13))
+		require.NotNil(t, hardware.SetResolution("28-000000000001", 8))
+		require.Nil(t, hardware.SetResolution("28-000000000001", 0))
+		require.Equal(t, hardware.DefaultResolution, hardware.GetTemperature("28-000000000001").Resolution())
+	})
+
+	t.Run("The poll interval must be positive", func(t *testing.T) {
+		require.NotNil(t, hardware.SetPollInterval(0))
+	})
+}
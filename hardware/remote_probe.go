@@ -0,0 +1,78 @@
+package hardware
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"periph.io/x/periph/conn/physic"
+)
+
+// RemoteBus is the Bus of every probe that pushes its readings over HTTP
+const RemoteBus = "remote"
+
+// The sources of remote readings, used as the prefix of the physical address
+const (
+	// ISpindelSource - An iSpindel hydrometer
+	ISpindelSource = "ispindel"
+	// TiltSource - A Tilt hydrometer relayed by TiltPi or a Tilt bridge
+	TiltSource = "tilt"
+	// GenericSource - Anything posting {id, temperature, gravity}, e.g. a custom ESP32
+	GenericSource = "remote"
+)
+
+var invalidAddressCharacters = regexp.MustCompile(`[^a-z0-9_.]+`)
+
+// RemoteReading is a reading pushed by a remote sensor
+// Source/ID -> Identify the sensor, the physical address is source-id
+// Temperature -> The temperature reading
+// Gravity -> The specific gravity, nil for sensors that only measure temperature
+type RemoteReading struct {
+	Source      string
+	ID          string
+	Temperature physic.Temperature
+	Gravity     *float64
+}
+
+// RemotePhysAddr - The physical address of the probe for a remote sensor, e.g. tilt-red
+func RemotePhysAddr(source string, id string) string {
+	id = invalidAddressCharacters.ReplaceAllString(strings.ToLower(strings.TrimSpace(id)), "-")
+	return source + "-" + strings.Trim(id, "-")
+}
+
+// UpdateRemoteProbe - Store a reading from a remote sensor, creating its probe the first time it reports
+func UpdateRemoteProbe(reading RemoteReading) (*TemperatureProbe, error) {
+	if len(strings.Trim(RemotePhysAddr("", reading.ID), "-")) == 0 {
+		return nil, fmt.Errorf("a sensor ID is required")
+	}
+	if reading.Gravity != nil && (*reading.Gravity < 0.9 || *reading.Gravity > 1.2) {
+		return nil, fmt.Errorf("gravity %v is not a specific gravity", *reading.Gravity)
+	}
+
+	physAddr := RemotePhysAddr(reading.Source, reading.ID)
+	probesLock.Lock()
+	probe := probes[physAddr]
+	discovered := probe == nil || !probe.Connected
+	if probe == nil {
+		probe = &TemperatureProbe{PhysAddr: physAddr, Bus: RemoteBus}
+		probes[physAddr] = probe
+	}
+	probe.Connected = true
+	probe.ReadingRaw = reading.Temperature
+	probe.Gravity = reading.Gravity
+	probe.Updated = time.Now()
+	probe.Fault = ""
+	probe.Reads++
+	probesLock.Unlock()
+
+	if discovered {
+		publishProbeEvent(ProbeEvent{Type: ProbeDiscovered, PhysAddr: physAddr, Time: probe.Updated})
+	}
+	return probe, nil
+}
+
+// PlatoToSG - Convert degrees Plato to specific gravity
+func PlatoToSG(plato float64) float64 {
+	return 1 + plato/(258.6-(plato/258.2)*227.1)
+}
@@ -0,0 +1,42 @@
+package hardware_test
+
+import (
+	"testing"
+
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/physic"
+)
+
+func TestRemoteProbes(t *testing.T) {
+	t.Run("Sensor IDs are turned into addresses", func(t *testing.T) {
+		require.Equal(t, "tilt-red", hardware.RemotePhysAddr(hardware.TiltSource, "RED"))
+		require.Equal(t, "ispindel-fermenter-2", hardware.RemotePhysAddr(hardware.ISpindelSource, " Fermenter #2 "))
+	})
+
+	t.Run("The first reading discovers the probe", func(t *testing.T) {
+		gravity := 1.060
+		probe, err := hardware.UpdateRemoteProbe(hardware.RemoteReading{
+			Source:      hardware.GenericSource,
+			ID:          "Discovered",
+			Temperature: physic.ZeroCelsius + 18*physic.Celsius,
+			Gravity:     &gravity,
+		})
+		require.Nil(t, err)
+		require.Equal(t, "remote-discovered", probe.PhysAddr)
+		require.Equal(t, 1.060, *probe.Gravity)
+
+		events := hardware.ProbeEvents()
+		require.Equal(t, hardware.ProbeEvent{Type: hardware.ProbeDiscovered, PhysAddr: "remote-discovered", Time: probe.Updated}, events[len(events)-1])
+	})
+
+	t.Run("A sensor ID is required", func(t *testing.T) {
+		_, err := hardware.UpdateRemoteProbe(hardware.RemoteReading{Source: hardware.GenericSource, ID: " # "})
+		require.NotNil(t, err)
+	})
+
+	t.Run("Plato is converted to specific gravity", func(t *testing.T) {
+		require.InDelta(t, 1.040, hardware.PlatoToSG(10), 0.001)
+		require.Equal(t, 1.0, hardware.PlatoToSG(0))
+	})
+}
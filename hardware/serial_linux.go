@@ -0,0 +1,70 @@
+package hardware
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// cbaud masks the baud rate bits of the termios c_cflag
+const cbaud = 0x100f
+
+var baudRates = map[int]uint32{
+	1200:   syscall.B1200,
+	2400:   syscall.B2400,
+	4800:   syscall.B4800,
+	9600:   syscall.B9600,
+	19200:  syscall.B19200,
+	38400:  syscall.B38400,
+	57600:  syscall.B57600,
+	115200: syscall.B115200,
+}
+
+// OpenSerialPort - Open a serial port, e.g. /dev/ttyUSB0, in raw mode at 8N1 and the baud rate
+func OpenSerialPort(path string, baud int) (SerialPort, error) {
+	speed, ok := baudRates[baud]
+	if !ok {
+		return nil, fmt.Errorf("unsupported baud rate %v", baud)
+	}
+	// Non blocking so reads can time out
+	port, err := os.OpenFile(path, os.O_RDWR|syscall.O_NOCTTY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var termios syscall.Termios
+	if err := ioctl(port, syscall.TCGETS, unsafe.Pointer(&termios)); err != nil {
+		port.Close()
+		return nil, fmt.Errorf("%v is not a serial port: %w", path, err)
+	}
+	termios.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP | syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON | syscall.IXOFF
+	termios.Oflag &^= syscall.OPOST
+	termios.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	termios.Cflag &^= syscall.CSIZE | syscall.PARENB | syscall.CSTOPB | cbaud
+	termios.Cflag |= syscall.CS8 | syscall.CREAD | syscall.CLOCAL | speed
+	termios.Ispeed, termios.Ospeed = speed, speed
+	if err := ioctl(port, syscall.TCSETS, unsafe.Pointer(&termios)); err != nil {
+		port.Close()
+		return nil, fmt.Errorf("failed to configure %v: %w", path, err)
+	}
+	return port, nil
+}
+
+// ioctl through the raw connection, File.Fd would put the port in blocking mode and stop the read deadlines working
+func ioctl(file *os.File, request uintptr, arg unsafe.Pointer) error {
+	conn, err := file.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var errno syscall.Errno
+	if err := conn.Control(func(fd uintptr) {
+		_, _, errno = syscall.Syscall(syscall.SYS_IOCTL, fd, request, uintptr(arg))
+	}); err != nil {
+		return err
+	}
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+// +build !linux
+
+package hardware
+
+import "fmt"
+
+// OpenSerialPort - Serial ports are only supported on Linux
+func OpenSerialPort(path string, baud int) (SerialPort, error) {
+	return nil, fmt.Errorf("serial ports are not supported on this platform, cannot open %v", path)
+}
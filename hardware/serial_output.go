@@ -0,0 +1,316 @@
+package hardware
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The serial relays that can be used as pins, the identifier is the prefix, the port, then the relay
+const (
+	// ModbusPrefix - A coil on a Modbus RTU relay module, e.g. modbus:/dev/ttyUSB0:3:coil5 is coil 5 of slave 3
+	ModbusPrefix = "modbus"
+	// LCUSPrefix - A relay on a CH340 LCUS USB relay board, e.g. lcus:/dev/ttyUSB0:2 is its second relay
+	LCUSPrefix = "lcus"
+	// DefaultBaudRate is used when the identifier has no baud rate, e.g. modbus:/dev/ttyUSB0@19200:3:coil5 sets it
+	DefaultBaudRate = 9600
+)
+
+// SupportedBaudRates are the baud rates a serial port can be opened at
+var SupportedBaudRates = []int{1200, 2400, 4800, 9600, 19200, 38400, 57600, 115200}
+
+// SerialTimeout - How long to wait for a response on a serial port before the request is retried
+var SerialTimeout = 500 * time.Millisecond
+
+// Modbus function codes
+const (
+	modbusReadCoils       = 0x01
+	modbusWriteSingleCoil = 0x05
+)
+
+var modbusIdentifier = regexp.MustCompile(`(?i)^modbus:([^:@]+)(?:@(\d+))?:(\d+):coil(\d+)$`)
+var lcusIdentifier = regexp.MustCompile(`(?i)^lcus:([^:@]+)(?:@(\d+))?:(\d+)$`)
+
+var serialBuses = make(map[string]*serialBus)
+var serialBusesLock sync.Mutex
+
+// SerialPort is an open serial port, reads return an error once the deadline has passed
+type SerialPort interface {
+	io.ReadWriteCloser
+	SetReadDeadline(t time.Time) error
+}
+
+// IsSerialOutput - Returns true if the identifier is a relay on a serial port, e.g. modbus:/dev/ttyUSB0:3:coil5
+func IsSerialOutput(identifier string) bool {
+	lower := strings.ToLower(strings.TrimSpace(identifier))
+	return strings.HasPrefix(lower, ModbusPrefix+":") || strings.HasPrefix(lower, LCUSPrefix+":")
+}
+
+// newSerialDriver - The driver for a relay on a serial port
+func newSerialDriver(identifier string) (OutputDriver, error) {
+	if match := modbusIdentifier.FindStringSubmatch(identifier); match != nil {
+		slave, err := strconv.Atoi(match[3])
+		if err != nil || slave < 1 || slave > 247 {
+			return nil, fmt.Errorf("a Modbus slave address is between 1 and 247, got %v", match[3])
+		}
+		coil, err := strconv.Atoi(match[4])
+		if err != nil || coil > 0xffff {
+			return nil, fmt.Errorf("a Modbus coil is between 0 and 65535, got %v", match[4])
+		}
+		bus, err := openSerialBus(match[1], match[2])
+		if err != nil {
+			return nil, err
+		}
+		return &modbusDriver{bus: bus, name: identifier, slave: byte(slave), coil: uint16(coil)}, nil
+	}
+	if match := lcusIdentifier.FindStringSubmatch(identifier); match != nil {
+		relay, err := strconv.Atoi(match[3])
+		if err != nil || relay < 1 || relay > 8 {
+			return nil, fmt.Errorf("an LCUS relay is between 1 and 8, got %v", match[3])
+		}
+		bus, err := openSerialBus(match[1], match[2])
+		if err != nil {
+			return nil, err
+		}
+		return &lcusDriver{bus: bus, name: identifier, relay: byte(relay)}, nil
+	}
+	return nil, fmt.Errorf("unknown serial relay '%v', expected modbus:<port>:<slave>:coil<n> or lcus:<port>:<relay>", identifier)
+}
+
+// serialBus is a serial port shared by every relay on it, it is opened when it is first used and reopened after an error
+type serialBus struct {
+	path string
+	baud int
+	lock sync.Mutex
+	port SerialPort
+}
+
+// openSerialBus - The bus for a port, every relay on a port must use the same baud rate
+func openSerialBus(path string, baudRate string) (*serialBus, error) {
+	baud := DefaultBaudRate
+	if baudRate != "" {
+		var err error
+		if baud, err = strconv.Atoi(baudRate); err != nil {
+			return nil, fmt.Errorf("invalid baud rate '%v'", baudRate)
+		}
+	}
+	supported := false
+	for _, rate := range SupportedBaudRates {
+		supported = supported || rate == baud
+	}
+	if !supported {
+		return nil, fmt.Errorf("unsupported baud rate %v, expected one of %v", baud, SupportedBaudRates)
+	}
+
+	serialBusesLock.Lock()
+	defer serialBusesLock.Unlock()
+	bus, ok := serialBuses[path]
+	if !ok {
+		bus = &serialBus{path: path, baud: baud}
+		serialBuses[path] = bus
+	}
+	if bus.baud != baud {
+		return nil, fmt.Errorf("%v is used at %v baud, it cannot also be used at %v", path, bus.baud, baud)
+	}
+	return bus, nil
+}
+
+// CloseSerialPorts - Close every serial port that is open, they are opened again when they are next used
+func CloseSerialPorts() {
+	serialBusesLock.Lock()
+	defer serialBusesLock.Unlock()
+	for _, bus := range serialBuses {
+		bus.lock.Lock()
+		bus.close()
+		bus.lock.Unlock()
+	}
+}
+
+// transact writes a request and reads a response with read, stale bytes from an earlier request are discarded first
+// read is nil when the device does not respond
+func (b *serialBus) transact(request []byte, read func(port io.Reader) error) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.port == nil {
+		port, err := OpenSerialPort(b.path, b.baud)
+		if err != nil {
+			return err
+		}
+		b.port = port
+	}
+
+	err := b.exchange(request, read)
+	if err != nil {
+		// The device may have been unplugged, reopen it next time
+		b.close()
+	}
+	return err
+}
+
+func (b *serialBus) exchange(request []byte, read func(port io.Reader) error) error {
+	b.drain()
+	if _, err := b.port.Write(request); err != nil {
+		return err
+	}
+	if read == nil {
+		return nil
+	}
+	if err := b.port.SetReadDeadline(time.Now().Add(SerialTimeout)); err != nil {
+		return err
+	}
+	return read(b.port)
+}
+
+// drain discards anything waiting to be read, e.g. a late response to a request that timed out
+func (b *serialBus) drain() {
+	if err := b.port.SetReadDeadline(time.Now()); err != nil {
+		return
+	}
+	buffer := make([]byte, 64)
+	for {
+		if n, err := b.port.Read(buffer); n == 0 || err != nil {
+			return
+		}
+	}
+}
+
+func (b *serialBus) close() {
+	if b.port != nil {
+		b.port.Close()
+		b.port = nil
+	}
+}
+
+// modbusDriver switches a coil with Modbus RTU
+type modbusDriver struct {
+	bus   *serialBus
+	name  string
+	slave byte
+	coil  uint16
+}
+
+func (m *modbusDriver) String() string {
+	return m.name
+}
+
+// Set writes a single coil, the response echoes the request
+func (m *modbusDriver) Set(on bool) (bool, error) {
+	value := uint16(0x0000)
+	if on {
+		value = 0xff00
+	}
+	request := ModbusFrame(m.slave, modbusWriteSingleCoil, m.coil, value)
+	err := m.bus.transact(request, func(port io.Reader) error {
+		response, err := m.readResponse(port, modbusWriteSingleCoil, len(request))
+		if err != nil {
+			return err
+		}
+		if string(response) != string(request) {
+			return fmt.Errorf("%v did not confirm coil %v", m.name, m.coil)
+		}
+		return nil
+	})
+	return on, err
+}
+
+// State reads the coil
+func (m *modbusDriver) State() (bool, error) {
+	var on bool
+	err := m.bus.transact(ModbusFrame(m.slave, modbusReadCoils, m.coil, 1), func(port io.Reader) error {
+		// slave, function, byte count, coils, CRC
+		response, err := m.readResponse(port, modbusReadCoils, 6)
+		if err != nil {
+			return err
+		}
+		on = response[3]&0x01 != 0
+		return nil
+	})
+	return on, err
+}
+
+// readResponse reads a response of length bytes, or an exception response, and checks its CRC
+func (m *modbusDriver) readResponse(port io.Reader, function byte, length int) ([]byte, error) {
+	response := make([]byte, length)
+	if _, err := io.ReadFull(port, response[:2]); err != nil {
+		return nil, fmt.Errorf("no response from slave %v on %v: %w", m.slave, m.bus.path, err)
+	}
+	if response[0] != m.slave {
+		return nil, fmt.Errorf("response from slave %v when slave %v was asked", response[0], m.slave)
+	}
+	if response[1] == function|0x80 {
+		// slave, function, exception code, CRC
+		response = response[:5]
+	} else if response[1] != function {
+		return nil, fmt.Errorf("unexpected function %v in the response from slave %v", response[1], m.slave)
+	}
+	if _, err := io.ReadFull(port, response[2:]); err != nil {
+		return nil, fmt.Errorf("incomplete response from slave %v on %v: %w", m.slave, m.bus.path, err)
+	}
+	if ModbusCRC(response[:len(response)-2]) != binary.LittleEndian.Uint16(response[len(response)-2:]) {
+		return nil, fmt.Errorf("bad CRC in the response from slave %v", m.slave)
+	}
+	if response[1] == function|0x80 {
+		return nil, fmt.Errorf("slave %v responded with exception %v", m.slave, response[2])
+	}
+	return response, nil
+}
+
+// ModbusFrame - An RTU request with two 16 bit arguments, e.g. the coil and its value, followed by the CRC
+func ModbusFrame(slave byte, function byte, address uint16, value uint16) []byte {
+	frame := []byte{slave, function, 0, 0, 0, 0}
+	binary.BigEndian.PutUint16(frame[2:], address)
+	binary.BigEndian.PutUint16(frame[4:], value)
+	return AppendModbusCRC(frame)
+}
+
+// ModbusCRC - The Modbus CRC-16 of a frame
+func ModbusCRC(frame []byte) uint16 {
+	crc := uint16(0xffff)
+	for _, b := range frame {
+		crc ^= uint16(b)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xa001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
+
+// AppendModbusCRC - Append the CRC to a frame, low byte first
+func AppendModbusCRC(frame []byte) []byte {
+	crc := ModbusCRC(frame)
+	return append(frame, byte(crc), byte(crc>>8))
+}
+
+// lcusDriver switches a relay on an LCUS board, the board does not respond so it cannot be read back
+type lcusDriver struct {
+	bus   *serialBus
+	name  string
+	relay byte
+}
+
+func (l *lcusDriver) String() string {
+	return l.name
+}
+
+// Set sends A0, the relay, the state and the checksum
+func (l *lcusDriver) Set(on bool) (bool, error) {
+	state := byte(0)
+	if on {
+		state = 1
+	}
+	request := []byte{0xa0, l.relay, state, 0xa0 + l.relay + state}
+	return on, l.bus.transact(request, nil)
+}
+
+func (l *lcusDriver) State() (bool, error) {
+	return false, ErrNoReadBack
+}
@@ -0,0 +1,140 @@
+//go:build linux
+// +build linux
+
+package hardware_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/dougedey/elsinore/hardware/serialtest"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+)
+
+func openPty(t *testing.T) *serialtest.Pty {
+	pty, err := serialtest.OpenPty()
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		hardware.CloseSerialPorts()
+		pty.Close()
+	})
+	return pty
+}
+
+func TestModbusCRC(t *testing.T) {
+	// Write Single Coil 0x00AC on slave 0x11, from the Modbus specification examples
+	require.Equal(t, []byte{0x11, 0x05, 0x00, 0xac, 0xff, 0x00, 0x4e, 0x8b}, hardware.ModbusFrame(0x11, 0x05, 0xac, 0xff00))
+}
+
+func TestSerialOutputDrivers(t *testing.T) {
+	t.Run("Invalid serial relays are rejected", func(t *testing.T) {
+		for _, identifier := range []string{
+			"modbus:/dev/ttyUSB9:0:coil1",
+			"modbus:/dev/ttyUSB9:3:relay1",
+			"modbus:/dev/ttyUSB9@300:3:coil1",
+			"lcus:/dev/ttyUSB9:9",
+		} {
+			_, err := hardware.NewOutputDriver(identifier)
+			require.NotNil(t, err, identifier)
+		}
+
+		_, err := hardware.NewOutputDriver("modbus:/dev/ttyUSB9@19200:1:coil1")
+		require.Nil(t, err)
+		_, err = hardware.NewOutputDriver("lcus:/dev/ttyUSB9:1")
+		require.Equal(t, "/dev/ttyUSB9 is used at 19200 baud, it cannot also be used at 9600", err.Error())
+	})
+
+	t.Run("A file that is not a serial port cannot be used", func(t *testing.T) {
+		driver, err := hardware.NewOutputDriver("modbus:/dev/null:1:coil1")
+		require.Nil(t, err)
+		_, err = driver.Set(true)
+		require.NotNil(t, err)
+	})
+
+	t.Run("Modbus coils are switched and read back", func(t *testing.T) {
+		pty := openPty(t)
+		module := serialtest.NewModbusSlave(3, 8)
+		other := serialtest.NewModbusSlave(4, 8)
+		pty.ServeModbus(module, other)
+
+		coil5, err := hardware.NewOutputDriver(fmt.Sprintf("modbus:%v:3:coil5", pty.Path))
+		require.Nil(t, err)
+		on, err := coil5.Set(true)
+		require.Nil(t, err)
+		require.True(t, on)
+		require.True(t, module.Coil(5))
+		require.False(t, other.Coil(5))
+
+		module.SetCoil(5, false)
+		on, err = coil5.State()
+		require.Nil(t, err)
+		require.False(t, on)
+
+		otherCoil, err := hardware.NewOutputDriver(fmt.Sprintf("MODBUS:%v:4:COIL0", pty.Path))
+		require.Nil(t, err)
+		_, err = otherCoil.Set(true)
+		require.Nil(t, err)
+		require.True(t, other.Coil(0))
+	})
+
+	t.Run("Modbus exceptions and silent slaves are errors", func(t *testing.T) {
+		timeout := hardware.SerialTimeout
+		hardware.SerialTimeout = 100 * time.Millisecond
+		t.Cleanup(func() { hardware.SerialTimeout = timeout })
+		pty := openPty(t)
+		module := serialtest.NewModbusSlave(3, 8)
+		pty.ServeModbus(module)
+
+		missing, err := hardware.NewOutputDriver(fmt.Sprintf("modbus:%v:3:coil20", pty.Path))
+		require.Nil(t, err)
+		_, err = missing.Set(true)
+		require.Equal(t, "slave 3 responded with exception 2", err.Error())
+
+		module.SetSilent(true)
+		coil, err := hardware.NewOutputDriver(fmt.Sprintf("modbus:%v:3:coil1", pty.Path))
+		require.Nil(t, err)
+		_, err = coil.Set(true)
+		require.NotNil(t, err)
+
+		module.SetSilent(false)
+		_, err = coil.Set(true)
+		require.Nil(t, err)
+		require.True(t, module.Coil(1))
+	})
+
+	t.Run("LCUS relays are switched", func(t *testing.T) {
+		pty := openPty(t)
+		board := serialtest.NewLCUSBoard()
+		pty.ServeLCUS(board)
+
+		relay, err := hardware.NewOutputDriver(fmt.Sprintf("lcus:%v:2", pty.Path))
+		require.Nil(t, err)
+		_, err = relay.Set(true)
+		require.Nil(t, err)
+		require.Eventually(t, func() bool { return board.Relay(2) }, time.Second, 10*time.Millisecond)
+		require.False(t, board.Relay(1))
+
+		_, err = relay.State()
+		require.True(t, errors.Is(err, hardware.ErrNoReadBack))
+	})
+}
+
+func TestSerialPin(t *testing.T) {
+	pty := openPty(t)
+	module := serialtest.NewModbusSlave(1, 4)
+	pty.ServeModbus(module)
+
+	pin, ok := hardware.Pin(fmt.Sprintf("modbus:%v:1:coil2", pty.Path)).(*hardware.DriverPin)
+	require.True(t, ok)
+	require.Nil(t, pin.Out(gpio.High))
+	require.True(t, module.Coil(2))
+	require.Equal(t, gpio.High, pin.Read())
+	require.NotNil(t, pin.PWM(gpio.DutyHalf, 0))
+
+	require.Nil(t, pin.Out(gpio.Low))
+	require.False(t, module.Coil(2))
+}
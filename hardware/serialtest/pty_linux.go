@@ -0,0 +1,39 @@
+package serialtest
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// OpenPty - Open a new pty pair
+func OpenPty() (*Pty, error) {
+	master, err := os.OpenFile("/dev/ptmx", os.O_RDWR|syscall.O_NOCTTY, 0)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := master.SyscallConn()
+	if err != nil {
+		master.Close()
+		return nil, err
+	}
+
+	var unlock int32
+	var number uint32
+	var errno syscall.Errno
+	if err := conn.Control(func(fd uintptr) {
+		if _, _, errno = syscall.Syscall(syscall.SYS_IOCTL, fd, syscall.TIOCSPTLCK, uintptr(unsafe.Pointer(&unlock))); errno != 0 {
+			return
+		}
+		_, _, errno = syscall.Syscall(syscall.SYS_IOCTL, fd, syscall.TIOCGPTN, uintptr(unsafe.Pointer(&number)))
+	}); err != nil {
+		master.Close()
+		return nil, err
+	}
+	if errno != 0 {
+		master.Close()
+		return nil, errno
+	}
+	return &Pty{Master: master, Path: fmt.Sprintf("/dev/pts/%d", number)}, nil
+}
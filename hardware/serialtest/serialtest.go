@@ -0,0 +1,162 @@
+// Package serialtest has a pty pair standing in for a serial port, with fake relay boards serving the other end
+package serialtest
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dougedey/elsinore/hardware"
+)
+
+// Pty is a pseudo terminal pair, relays open Path as their serial port and a fake board serves Master
+type Pty struct {
+	Master *os.File
+	Path   string
+	lock   sync.Mutex
+	closed bool
+}
+
+// Close closes the master, stopping the fake board
+func (p *Pty) Close() error {
+	p.lock.Lock()
+	p.closed = true
+	p.lock.Unlock()
+	return p.Master.Close()
+}
+
+// serve reads frames of size bytes from the master and responds with handle, until the master is closed
+// Reads fail while the relay side is closed, e.g. when it reopens the port after an error, so they are retried
+func (p *Pty) serve(size int, handle func(frame []byte) []byte) {
+	go func() {
+		frame := make([]byte, size)
+		for {
+			if _, err := io.ReadFull(p.Master, frame); err != nil {
+				p.lock.Lock()
+				closed := p.closed
+				p.lock.Unlock()
+				if closed {
+					return
+				}
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			if response := handle(frame); response != nil {
+				p.Master.Write(response)
+			}
+		}
+	}()
+}
+
+// ModbusSlave is a Modbus RTU relay module with NumCoils coils
+// A Silent slave does not respond, as if it was unplugged
+type ModbusSlave struct {
+	sync.Mutex
+	Address  byte
+	NumCoils uint16
+	Coils    map[uint16]bool
+	Silent   bool
+	Requests int
+}
+
+// NewModbusSlave - A slave with every coil off
+func NewModbusSlave(address byte, numCoils uint16) *ModbusSlave {
+	return &ModbusSlave{Address: address, NumCoils: numCoils, Coils: map[uint16]bool{}}
+}
+
+// Coil - The state of a coil
+func (m *ModbusSlave) Coil(coil uint16) bool {
+	m.Lock()
+	defer m.Unlock()
+	return m.Coils[coil]
+}
+
+// SetCoil - Change a coil, as if it was switched on the module
+func (m *ModbusSlave) SetCoil(coil uint16, on bool) {
+	m.Lock()
+	defer m.Unlock()
+	m.Coils[coil] = on
+}
+
+// SetSilent - Stop or start responding
+func (m *ModbusSlave) SetSilent(silent bool) {
+	m.Lock()
+	defer m.Unlock()
+	m.Silent = silent
+}
+
+// handle responds to Read Coils for one coil and Write Single Coil, anything else is an illegal function
+func (m *ModbusSlave) handle(frame []byte) []byte {
+	m.Lock()
+	defer m.Unlock()
+	m.Requests++
+	if m.Silent {
+		return nil
+	}
+	function := frame[1]
+	coil := binary.BigEndian.Uint16(frame[2:])
+	value := binary.BigEndian.Uint16(frame[4:])
+	switch {
+	case function != 0x01 && function != 0x05:
+		return hardware.AppendModbusCRC([]byte{m.Address, function | 0x80, 0x01})
+	case coil >= m.NumCoils:
+		return hardware.AppendModbusCRC([]byte{m.Address, function | 0x80, 0x02})
+	case function == 0x01:
+		state := byte(0)
+		if m.Coils[coil] {
+			state = 1
+		}
+		return hardware.AppendModbusCRC([]byte{m.Address, function, 1, state})
+	default:
+		m.Coils[coil] = value == 0xff00
+		return append([]byte{}, frame...)
+	}
+}
+
+// ServeModbus - Serve Modbus RTU requests for the slaves, requests for other addresses or with a bad CRC are ignored
+func (p *Pty) ServeModbus(slaves ...*ModbusSlave) {
+	p.serve(8, func(frame []byte) []byte {
+		if hardware.ModbusCRC(frame[:6]) != binary.LittleEndian.Uint16(frame[6:]) {
+			return nil
+		}
+		for _, slave := range slaves {
+			if slave.Address == frame[0] {
+				return slave.handle(frame)
+			}
+		}
+		return nil
+	})
+}
+
+// LCUSBoard is a CH340 LCUS relay board, it switches relays without responding
+type LCUSBoard struct {
+	sync.Mutex
+	Relays map[byte]bool
+}
+
+// NewLCUSBoard - A board with every relay off
+func NewLCUSBoard() *LCUSBoard {
+	return &LCUSBoard{Relays: map[byte]bool{}}
+}
+
+// Relay - The state of a relay, from 1
+func (l *LCUSBoard) Relay(relay byte) bool {
+	l.Lock()
+	defer l.Unlock()
+	return l.Relays[relay]
+}
+
+// ServeLCUS - Switch the relays of the board, commands with a bad checksum are ignored
+func (p *Pty) ServeLCUS(board *LCUSBoard) {
+	p.serve(4, func(frame []byte) []byte {
+		if frame[0] != 0xa0 || frame[0]+frame[1]+frame[2] != frame[3] {
+			return nil
+		}
+		board.Lock()
+		board.Relays[frame[1]] = frame[2] == 1
+		board.Unlock()
+		return nil
+	})
+}
@@ -0,0 +1,170 @@
+package hardware
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"periph.io/x/periph/conn/physic"
+	"periph.io/x/periph/conn/spi"
+	"periph.io/x/periph/conn/spi/spireg"
+)
+
+// SPIBus is the Bus of every probe read over SPI
+const SPIBus = "spi"
+
+// The SPI temperature chips that can be used as probes
+const (
+	// MAX31865Driver - PT100/PT1000 RTD converter
+	MAX31865Driver = "max31865"
+	// MAX31855Driver - K-type thermocouple converter with a readable cold junction
+	MAX31855Driver = "max31855"
+	// MAX6675Driver - K-type thermocouple converter
+	MAX6675Driver = "max6675"
+)
+
+// SPIProbeConfig describes a temperature chip on an SPI port
+// Driver -> The chip, e.g. MAX31865Driver
+// Bus/ChipSelect -> The SPI port, e.g. 0 and 1 for /dev/spidev0.1
+// Wires/ReferenceResistor/NominalResistance/Filter50Hz -> RTD settings for the MAX31865, see MAX31865Opts
+type SPIProbeConfig struct {
+	Driver            string
+	Bus               int
+	ChipSelect        int
+	Wires             int
+	ReferenceResistor float64
+	NominalResistance float64
+	Filter50Hz        bool
+}
+
+// PhysAddr - The physical address of the probe for this chip, e.g. max31865-spi0.1
+func (c SPIProbeConfig) PhysAddr() string {
+	return fmt.Sprintf("%v-spi%v.%v", c.Driver, c.Bus, c.ChipSelect)
+}
+
+// PortName - The periph name of the SPI port for this chip, e.g. SPI0.1
+func (c SPIProbeConfig) PortName() string {
+	return fmt.Sprintf("SPI%v.%v", c.Bus, c.ChipSelect)
+}
+
+type spiSensor interface {
+	Temperature() (physic.Temperature, error)
+}
+
+// Thermocouple amplifiers that measure their own temperature for the cold junction compensation
+type coldJunctionSensor interface {
+	ColdJunction() *physic.Temperature
+}
+
+type spiProbe struct {
+	config SPIProbeConfig
+	port   spi.Port
+	sensor spiSensor
+}
+
+var spiProbes = make(map[string]*spiProbe)
+var spiLock sync.Mutex
+
+// AddSPIProbe - Open the SPI port for a chip and register it as a probe
+func AddSPIProbe(config SPIProbeConfig) (*TemperatureProbe, error) {
+	port, err := spireg.Open(config.PortName())
+	if err != nil {
+		return nil, err
+	}
+	probe, err := ConnectSPIProbe(config, port)
+	if err != nil {
+		port.Close()
+		return nil, err
+	}
+	return probe, nil
+}
+
+// ConnectSPIProbe - Register a chip on an open SPI port as a probe, replacing any probe already using the port
+func ConnectSPIProbe(config SPIProbeConfig, port spi.Port) (*TemperatureProbe, error) {
+	var sensor spiSensor
+	var err error
+	switch config.Driver {
+	case MAX31865Driver:
+		sensor, err = NewMAX31865(port, MAX31865Opts{
+			Wires:             config.Wires,
+			ReferenceResistor: config.ReferenceResistor,
+			NominalResistance: config.NominalResistance,
+			Filter50Hz:        config.Filter50Hz,
+		})
+	case MAX31855Driver:
+		sensor, err = NewMAX31855(port)
+	case MAX6675Driver:
+		sensor, err = NewMAX6675(port)
+	default:
+		err = fmt.Errorf("unknown SPI probe driver '%v'", config.Driver)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	removeSPIProbe(config.PhysAddr(), port)
+	spiLock.Lock()
+	spiProbes[config.PhysAddr()] = &spiProbe{config: config, port: port, sensor: sensor}
+	spiLock.Unlock()
+
+	probe := &TemperatureProbe{PhysAddr: config.PhysAddr(), Bus: SPIBus, Connected: true}
+	probesLock.Lock()
+	probes[probe.PhysAddr] = probe
+	probesLock.Unlock()
+	publishProbeEvent(ProbeEvent{Type: ProbeDiscovered, PhysAddr: probe.PhysAddr, Time: time.Now()})
+	return probe, nil
+}
+
+// RemoveSPIProbe - Close the SPI port for a probe and remove it
+func RemoveSPIProbe(physAddr string) {
+	removeSPIProbe(physAddr, nil)
+}
+
+// removeSPIProbe removes a probe, closing its port unless it is the port being reused
+func removeSPIProbe(physAddr string, reused spi.Port) {
+	spiLock.Lock()
+	existing := spiProbes[physAddr]
+	delete(spiProbes, physAddr)
+	spiLock.Unlock()
+	if existing == nil {
+		return
+	}
+
+	if closer, ok := existing.port.(spi.PortCloser); ok && existing.port != reused {
+		if err := closer.Close(); err != nil {
+			log.Error().Err(err).Msgf("Failed to close the SPI port for %v", physAddr)
+		}
+	}
+	probesLock.Lock()
+	delete(probes, physAddr)
+	probesLock.Unlock()
+}
+
+// ReadSPIProbes -> Update the SPI probes with the current value from their chip
+func ReadSPIProbes(messages *chan string) {
+	spiLock.Lock()
+	defer spiLock.Unlock()
+	for physAddr, spiProbe := range spiProbes {
+		probe := GetTemperature(physAddr)
+		if probe == nil {
+			continue
+		}
+
+		start := time.Now()
+		temp, err := spiProbe.sensor.Temperature()
+		probe.recordRead(temp, time.Since(start), err)
+		if sensor, ok := spiProbe.sensor.(coldJunctionSensor); ok {
+			probesLock.Lock()
+			probe.ColdJunction = sensor.ColdJunction()
+			probesLock.Unlock()
+		}
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed to read %v", physAddr)
+			continue
+		}
+		if messages != nil {
+			*messages <- fmt.Sprintf("Reading device %v: %v", physAddr, temp)
+		}
+	}
+}
@@ -1,10 +1,7 @@
 package hardware
 
 import (
-	"encoding/binary"
-	"encoding/hex"
-	"fmt"
-	"strconv"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -15,16 +12,35 @@ import (
 )
 
 var probes = make(map[string]*TemperatureProbe)
+var probesLock sync.RWMutex
 
 // TemperatureProbe holds data that represents a physical temperature probe
 // PhysAddr -> The Hex address of the probe on the filesystem
 // Address -> The unsigned int value for the readings
 // Reading -> The actual reading as a Physic.Temperature
+// Fault -> The error from the last read, empty when the last read succeeded
+// Bus -> The bus the probe was found on, empty for probes that were set directly
+// Connected -> False once a rescan no longer finds the probe, it keeps its last reading
+// ReadLatency -> How long the last read of the probe took, after the bus wide conversion
+// Reads/ReadErrors -> The number of reads attempted and the number that failed
+// ColdJunction -> The temperature of a thermocouple amplifier, nil for other probes
+// Gravity -> The specific gravity from a hydrometer, nil for other probes
 type TemperatureProbe struct {
-	PhysAddr   string
-	Address    onewire.Address
-	ReadingRaw physic.Temperature
-	Updated    time.Time
+	PhysAddr     string
+	Address      onewire.Address
+	ReadingRaw   physic.Temperature
+	Updated      time.Time
+	Fault        string
+	Bus          string
+	Connected    bool
+	ReadLatency  time.Duration
+	Reads        int64
+	ReadErrors   int64
+	ColdJunction *physic.Temperature
+	Gravity      *float64
+
+	sensor           *ds18b20.Dev
+	sensorResolution int
 }
 
 // UpdateTemperature Set the temperature on the Temperature Probe from a string
@@ -42,11 +58,15 @@ func (t *TemperatureProbe) Reading() string {
 
 // GetTemperature -> Get the probe object for a physical address
 func GetTemperature(physAddr string) *TemperatureProbe {
+	probesLock.RLock()
+	defer probesLock.RUnlock()
 	return probes[physAddr]
 }
 
 // GetProbes -> Get all the probes
 func GetProbes() []*TemperatureProbe {
+	probesLock.RLock()
+	defer probesLock.RUnlock()
 	values := make([]*TemperatureProbe, len(probes))
 	i := 0
 	for _, v := range probes {
@@ -56,37 +76,6 @@ func GetProbes() []*TemperatureProbe {
 	return values
 }
 
-// ReadAddresses -> Update the TemperatureProbes with the current value from the device
-func ReadAddresses(oneBus *netlink.OneWire, messages *chan string) {
-	for _, probe := range probes {
-		defer func() {
-			if err := recover(); err != nil {
-				log.Error().Msgf("Error reading temperature for %v", probe.PhysAddr)
-			}
-		}()
-		// init ds18b20
-		sensor, _ := ds18b20.New(oneBus, probe.Address, 10)
-		err := ds18b20.ConvertAll(oneBus, 10)
-		if err != nil {
-			log.Error().Err(err).Msgf("Failed to update probe %v", probe.PhysAddr)
-			continue
-		}
-
-		temp, err := sensor.LastTemp()
-		if err != nil {
-			log.Error().Err(err).Msgf("Failed to get the last temp for %v", probe.PhysAddr)
-			continue
-		}
-
-		probe := probes[probe.PhysAddr]
-		probe.Updated = time.Now()
-		probe.ReadingRaw = temp
-		if messages != nil {
-			*messages <- fmt.Sprintf("Reading device %v: %v", probe.PhysAddr, temp)
-		}
-	}
-}
-
 // ReadTemperatures Read the temperatures on an infinite ticker loop
 func ReadTemperatures(m *chan string, quit chan struct{}) {
 	if m != nil {
@@ -94,42 +83,51 @@ func ReadTemperatures(m *chan string, quit chan struct{}) {
 	}
 	log.Info().Msgf("Reading temps.")
 
-	oneBus, err := netlink.New(001)
+	// SPI probes are still read when there is no 1-Wire bus
+	var oneBus onewire.Bus
+	netlinkBus, err := netlink.New(001)
 	if err != nil {
 		log.Printf("Could not open Netlink host: %v", err)
-	}
-	defer oneBus.Close()
-
-	// get 1wire address
-	addresses, _ := oneBus.Search(false)
-	log.Info().Msgf("Reading temps from %v devices.", len(addresses))
-	for _, address := range addresses {
-		a := strconv.FormatUint(uint64(address), 16)
-
-		for len(a) < 16 {
-			// O(n²) but since digits is expected to run for a few loops, it doesn't
-			// matter.
-			a = "0" + a
-		}
-		addrBytes := make([]byte, 8)
-		binary.LittleEndian.PutUint64(addrBytes, uint64(address))
-		physAddr := "" + hex.EncodeToString(addrBytes[0:1]) + "-" + hex.EncodeToString(reverse(addrBytes[1:7]))
-		log.Info().Msgf("Found %v", physAddr)
-		probes[physAddr] = &TemperatureProbe{
-			PhysAddr: physAddr,
-			Address:  address,
+	} else {
+		defer netlinkBus.Close()
+		oneBus = netlinkBus
+
+		busLock.Lock()
+		currentBus = oneBus
+		busLock.Unlock()
+		defer func() {
+			busLock.Lock()
+			currentBus = nil
+			busLock.Unlock()
+		}()
+
+		// get 1wire address
+		if _, err := ScanBus(oneBus); err != nil {
+			log.Error().Err(err).Msg("Failed to search the 1-Wire bus")
 		}
 	}
-	duration, err := time.ParseDuration("5s")
-	if err != nil {
-		log.Fatal().Err(err)
+	log.Info().Msgf("Reading temps from %v devices.", len(GetProbes()))
+
+	ticker := time.NewTicker(pollInterval)
+
+	var rescan <-chan time.Time
+	if oneBus != nil && rescanInterval > 0 {
+		rescanTicker := time.NewTicker(rescanInterval)
+		defer rescanTicker.Stop()
+		rescan = rescanTicker.C
 	}
-	ticker := time.NewTicker(duration)
 
 	for {
 		select {
 		case <-ticker.C:
-			ReadAddresses(oneBus, m)
+			if oneBus != nil {
+				ReadAddresses(oneBus, m)
+			}
+			ReadSPIProbes(m)
+		case <-rescan:
+			if _, err := ScanBus(oneBus); err != nil {
+				log.Error().Err(err).Msg("Failed to rescan the 1-Wire bus")
+			}
 		case <-quit:
 			ticker.Stop()
 			log.Info().Msg("Stop")
@@ -147,8 +145,11 @@ func LogTemperatures(messages chan string) {
 	}
 }
 
-// SetProbe -> Used to set a probe in the master list
+// SetProbe -> Used to set a connected probe in the master list
 func SetProbe(probe *TemperatureProbe) {
+	probesLock.Lock()
+	defer probesLock.Unlock()
+	probe.Connected = true
 	probes[probe.PhysAddr] = probe
 }
 
@@ -0,0 +1,110 @@
+package hardware
+
+import (
+	"strings"
+	"sync"
+
+	"periph.io/x/periph/conn/physic"
+	"periph.io/x/periph/conn/spi"
+)
+
+// ThermocoupleFault is returned when a thermocouple amplifier reports a wiring fault
+type ThermocoupleFault struct {
+	Open       bool
+	ShortToGND bool
+	ShortToVCC bool
+}
+
+func (f *ThermocoupleFault) Error() string {
+	faults := []string{}
+	if f.Open {
+		faults = append(faults, "open circuit")
+	}
+	if f.ShortToGND {
+		faults = append(faults, "short to GND")
+	}
+	if f.ShortToVCC {
+		faults = append(faults, "short to VCC")
+	}
+	return "thermocouple: " + strings.Join(faults, ", ")
+}
+
+// MAX31855 is a cold-junction compensated K-type thermocouple converter on an SPI port
+type MAX31855 struct {
+	conn         spi.Conn
+	lock         sync.Mutex
+	coldJunction *physic.Temperature
+}
+
+// NewMAX31855 - Connect to a MAX31855 on the port, it converts continuously so no setup is needed
+func NewMAX31855(port spi.Port) (*MAX31855, error) {
+	conn, err := port.Connect(physic.MegaHertz, spi.Mode0, 8)
+	if err != nil {
+		return nil, err
+	}
+	return &MAX31855{conn: conn}, nil
+}
+
+// Temperature - Read the temperature at the thermocouple, returning a *ThermocoupleFault for wiring faults
+// The cold junction temperature is read at the same time, see ColdJunction
+func (d *MAX31855) Temperature() (physic.Temperature, error) {
+	r := make([]byte, 4)
+	if err := d.conn.Tx(make([]byte, 4), r); err != nil {
+		return 0, err
+	}
+	frame := uint32(r[0])<<24 | uint32(r[1])<<16 | uint32(r[2])<<8 | uint32(r[3])
+
+	// D15-D4 is the 12 bit signed internal temperature in 0.0625°C steps
+	internal := int32(int16(uint16(frame>>4)<<4)) >> 4
+	coldJunction := physic.ZeroCelsius + physic.Temperature(internal)*physic.Celsius/16
+	d.lock.Lock()
+	d.coldJunction = &coldJunction
+	d.lock.Unlock()
+
+	// D16 is set for any fault, D2-D0 say which
+	if frame&0x10000 != 0 {
+		return 0, &ThermocoupleFault{Open: frame&0x1 != 0, ShortToGND: frame&0x2 != 0, ShortToVCC: frame&0x4 != 0}
+	}
+
+	// D31-D18 is the 14 bit signed thermocouple temperature in 0.25°C steps
+	thermocouple := int32(frame) >> 18
+	return physic.ZeroCelsius + physic.Temperature(thermocouple)*physic.Celsius/4, nil
+}
+
+// ColdJunction - The temperature of the chip from the last read, nil before the first read
+func (d *MAX31855) ColdJunction() *physic.Temperature {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.coldJunction
+}
+
+// MAX6675 is a cold-junction compensated K-type thermocouple converter on an SPI port, it only reports open faults
+type MAX6675 struct {
+	conn spi.Conn
+}
+
+// NewMAX6675 - Connect to a MAX6675 on the port
+func NewMAX6675(port spi.Port) (*MAX6675, error) {
+	conn, err := port.Connect(physic.MegaHertz, spi.Mode0, 8)
+	if err != nil {
+		return nil, err
+	}
+	return &MAX6675{conn: conn}, nil
+}
+
+// Temperature - Read the temperature at the thermocouple, returning a *ThermocoupleFault when it is disconnected
+func (d *MAX6675) Temperature() (physic.Temperature, error) {
+	r := make([]byte, 2)
+	if err := d.conn.Tx(make([]byte, 2), r); err != nil {
+		return 0, err
+	}
+	frame := uint16(r[0])<<8 | uint16(r[1])
+
+	// D2 is set when the thermocouple input is open
+	if frame&0x4 != 0 {
+		return 0, &ThermocoupleFault{Open: true}
+	}
+
+	// D14-D3 is the 12 bit unsigned temperature in 0.25°C steps
+	return physic.ZeroCelsius + physic.Temperature(frame>>3)*physic.Celsius/4, nil
+}
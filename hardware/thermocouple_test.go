@@ -0,0 +1,97 @@
+package hardware_test
+
+import (
+	"testing"
+
+	"github.com/dougedey/elsinore/hardware"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/conntest"
+	"periph.io/x/periph/conn/spi/spitest"
+)
+
+func thermocouplePort(frames ...[]byte) *spitest.Playback {
+	port := spitest.Playback{}
+	for _, frame := range frames {
+		port.Ops = append(port.Ops, conntest.IO{W: make([]byte, len(frame)), R: frame})
+	}
+	return &port
+}
+
+func TestMAX31855(t *testing.T) {
+	t.Run("The thermocouple and cold junction are read from one frame", func(t *testing.T) {
+		port := thermocouplePort(
+			[]byte{0x06, 0x40, 0x19, 0x00},
+			[]byte{0xff, 0x60, 0xff, 0x00},
+		)
+		dev, err := hardware.NewMAX31855(port)
+		require.Nil(t, err)
+		require.Nil(t, dev.ColdJunction())
+
+		temperature, err := dev.Temperature()
+		require.Nil(t, err)
+		require.Equal(t, 100.0, celsiusOf(temperature))
+		require.Equal(t, 25.0, celsiusOf(*dev.ColdJunction()))
+
+		temperature, err = dev.Temperature()
+		require.Nil(t, err)
+		require.Equal(t, -10.0, celsiusOf(temperature))
+		require.Equal(t, -1.0, celsiusOf(*dev.ColdJunction()))
+		require.Nil(t, port.Close())
+	})
+
+	t.Run("Open and short faults are reported", func(t *testing.T) {
+		port := thermocouplePort(
+			[]byte{0x00, 0x01, 0x19, 0x01},
+			[]byte{0x00, 0x01, 0x19, 0x02},
+			[]byte{0x00, 0x01, 0x19, 0x04},
+		)
+		dev, err := hardware.NewMAX31855(port)
+		require.Nil(t, err)
+
+		_, err = dev.Temperature()
+		require.Equal(t, &hardware.ThermocoupleFault{Open: true}, err)
+		require.Equal(t, "thermocouple: open circuit", err.Error())
+		require.Equal(t, 25.0, celsiusOf(*dev.ColdJunction()))
+
+		_, err = dev.Temperature()
+		require.Equal(t, &hardware.ThermocoupleFault{ShortToGND: true}, err)
+
+		_, err = dev.Temperature()
+		require.Equal(t, "thermocouple: short to VCC", err.Error())
+		require.Nil(t, port.Close())
+	})
+
+	t.Run("The cold junction is exposed on the probe", func(t *testing.T) {
+		config := hardware.SPIProbeConfig{Driver: hardware.MAX31855Driver, Bus: 1, ChipSelect: 0}
+		port := thermocouplePort([]byte{0x06, 0x40, 0x19, 0x00})
+		_, err := hardware.ConnectSPIProbe(config, port)
+		require.Nil(t, err)
+		t.Cleanup(func() { hardware.RemoveSPIProbe(config.PhysAddr()) })
+
+		hardware.ReadSPIProbes(nil)
+		probe := hardware.GetTemperature("max31855-spi1.0")
+		require.Equal(t, 100.0, celsiusOf(probe.ReadingRaw))
+		require.Equal(t, 25.0, celsiusOf(*probe.ColdJunction))
+	})
+}
+
+func TestMAX6675(t *testing.T) {
+	port := thermocouplePort(
+		[]byte{0x1f, 0x40},
+		[]byte{0x00, 0x04},
+	)
+	dev, err := hardware.NewMAX6675(port)
+	require.Nil(t, err)
+
+	t.Run("The thermocouple is read", func(t *testing.T) {
+		temperature, err := dev.Temperature()
+		require.Nil(t, err)
+		require.Equal(t, 250.0, celsiusOf(temperature))
+	})
+
+	t.Run("An open thermocouple is reported", func(t *testing.T) {
+		_, err := dev.Temperature()
+		require.Equal(t, &hardware.ThermocoupleFault{Open: true}, err)
+		require.Nil(t, port.Close())
+	})
+}
@@ -12,11 +12,13 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/dougedey/elsinore/api"
 	"github.com/dougedey/elsinore/database"
 	"github.com/dougedey/elsinore/devices"
 	"github.com/dougedey/elsinore/graph"
 	"github.com/dougedey/elsinore/graph/generated"
 	"github.com/dougedey/elsinore/hardware"
+	"github.com/dougedey/elsinore/modbus"
 	"github.com/dougedey/elsinore/system"
 	"github.com/go-chi/chi"
 	"github.com/rs/cors"
@@ -28,14 +30,26 @@ import (
 	"periph.io/x/periph/host"
 
 	"net/http"
+
+	// Timezones work on devices without a zoneinfo database
+	_ "time/tzdata"
 )
 
 func main() {
 	portPtr := flag.String("port", "8080", "The port to listen on")
 	graphiqlFlag := flag.Bool("graphiql", true, "Disable GraphiQL web UI")
 	dbName := flag.String("db_name", "elsinore", "The path/name of the local database")
+	dbDSN := flag.String("db", "", "The database DSN (sqlite://, postgres:// or mysql://), overrides db_name")
+	instanceID := flag.String("instance_id", "", "Scope controllers, switches and settings to this instance when sharing a database")
 	testDeviceFlag := flag.Bool("test_device", false, "Create a test device")
 	autostartFlag := flag.Bool("autostart", false, "Autostart controllers from their previous state on startup")
+	backupDir := flag.String("backup_dir", "backups", "The directory to write database snapshots to")
+	backupInterval := flag.Duration("backup_interval", 24*time.Hour, "How often to snapshot the database, 0 to disable")
+	backupKeep := flag.Int("backup_keep", 7, "The number of database snapshots to keep, 0 to keep all of them")
+	restoreFile := flag.String("restore", "", "Restore the database from this snapshot before starting")
+	pollInterval := flag.Duration("poll_interval", hardware.DefaultPollInterval, "How often to read the temperature probes")
+	rescanInterval := flag.Duration("rescan_interval", hardware.DefaultRescanInterval, "How often to search the 1-Wire bus for new or removed probes, 0 to disable")
+	modbusAddress := flag.String("modbus", "", "Serve Modbus TCP on this address (e.g. :502), disabled when empty")
 	flag.Parse()
 
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
@@ -50,11 +64,34 @@ func main() {
 		})
 	}
 
+	if len(strings.TrimSpace(*dbDSN)) > 0 {
+		dbName = dbDSN
+	}
+	database.SetInstanceID(*instanceID)
 	database.InitDatabase(dbName,
 		&devices.TempProbeDetail{}, &devices.PidSettings{}, &devices.HysteriaSettings{},
 		&devices.ManualSettings{}, &devices.TemperatureController{}, &system.Settings{},
-		&devices.Switch{},
+		&devices.Switch{}, &devices.ProbeSettings{}, &devices.SPIProbe{},
+		&devices.InPin{}, &devices.FlowMeter{}, &devices.Expander{},
+		&devices.Fermentation{}, &devices.FermentationStep{}, &devices.GravityReading{},
+		&devices.Webhook{}, &devices.WebhookDelivery{}, &devices.AlertRule{}, &devices.AlertChannel{},
+		&devices.AutomationRule{}, &devices.AutomationCondition{}, &devices.AutomationAction{},
+		&devices.Schedule{}, &devices.SwitchGroup{}, &devices.SwitchGroupMember{}, &devices.Scene{}, &devices.SceneStep{},
 	)
+	database.ConfigureBackups(database.BackupSettings{
+		Directory: *backupDir,
+		Interval:  *backupInterval,
+		Keep:      *backupKeep,
+	})
+
+	if len(strings.TrimSpace(*restoreFile)) > 0 {
+		err := database.RestoreBackup(*restoreFile)
+		if err != nil {
+			log.Fatal().Err(err).Msgf("Failed to restore the database from %v", *restoreFile)
+		}
+		log.Info().Msgf("Restored the database from %v", *restoreFile)
+	}
+	go database.ScheduleBackups(devices.Context)
 
 	if len(strings.TrimSpace(system.CurrentSettings().BreweryName)) == 0 {
 		system.CurrentSettings().BreweryName = "Elsinore"
@@ -68,9 +105,21 @@ func main() {
 			Msgf("failed to initialize periph: %v", err)
 	}
 
+	log.Printf("Loaded %v GPIO expanders.", len(devices.AllExpanders()))
+	log.Printf("Loaded %v SPI probes.", len(devices.AllSPIProbes()))
 	log.Print("Loaded and looking for temperatures")
 	// messages := make(chan string)
+	hardware.SetRescanInterval(*rescanInterval)
+	if err := hardware.SetPollInterval(*pollInterval); err != nil {
+		log.Fatal().Err(err).Msg("Invalid poll interval")
+	}
 	go hardware.ReadTemperatures(nil, quit)
+	go devices.WatchProbes(quit)
+	go devices.WatchAlerts(quit)
+	go devices.WatchAutomations(quit)
+	go devices.WatchSchedules(quit)
+	go devices.WatchSwitchTimers(quit)
+	go devices.WatchScenes(quit)
 	for _, controller := range devices.AllTemperatureControllers() {
 		if *autostartFlag {
 			continue
@@ -80,14 +129,28 @@ func main() {
 	go temperatureControllerRunner()
 
 	log.Printf("Loaded %v switches.", len(devices.AllSwitches()))
+	log.Printf("Loaded %v inputs.", len(devices.AllInPins()))
+	log.Printf("Loaded %v flow meters.", len(devices.AllFlowMeters()))
 
 	httpServerExitDone := &sync.WaitGroup{}
 
 	httpServerExitDone.Add(1)
 	srv := startHTTPServer(portPtr, graphiqlFlag, httpServerExitDone)
 
+	var modbusServer *modbus.Server
+	if len(strings.TrimSpace(*modbusAddress)) > 0 {
+		modbusServer, err = modbus.Listen(*modbusAddress)
+		if err != nil {
+			log.Fatal().Err(err).Msgf("Failed to serve Modbus TCP on %v", *modbusAddress)
+		}
+		log.Printf("Modbus TCP Listening on: %v", modbusServer.Addr())
+	}
+
 	shutdown.Add(func() {
 		devices.CancelFunc()
+		if modbusServer != nil {
+			modbusServer.Close()
+		}
 		shutdownErr := srv.Shutdown(devices.Context)
 		if shutdownErr != nil {
 			log.Print(shutdownErr)
@@ -121,6 +184,9 @@ func startHTTPServer(portPtr *string, graphiqlFlag *bool, wg *sync.WaitGroup) *h
 		router.Handle("/", playground.Handler("GraphQL playground", "/graphiql"))
 	}
 	router.Handle("/graphql", srv)
+	api.IngestRoutes(router)
+	api.ModbusRoutes(router)
+	api.RestRoutes(router)
 
 	go func() {
 		defer wg.Done()
@@ -135,6 +201,7 @@ func startHTTPServer(portPtr *string, graphiqlFlag *bool, wg *sync.WaitGroup) *h
 		log.Error().Err(err).Msg("Failed to get hostname: %v")
 	} else {
 		fmt.Printf("CORS API Listening on: http://%v%v/graphql \n", name, fullPort)
+		fmt.Printf("REST API: http://%v%v%v \n", name, fullPort, api.OpenAPIPath)
 		if *graphiqlFlag {
 			fmt.Printf("GraphiQL interface: http://%v%v/graphiql \n", name, fullPort)
 		}
@@ -154,6 +221,9 @@ func temperatureControllerRunner() {
 	for {
 		select {
 		case <-ticker.C:
+			if devices.TemperatureControllersSuspended() {
+				continue
+			}
 			for _, controller := range devices.AllTemperatureControllers() {
 				if !controller.Running {
 					log.Info().Msgf("Starting %v!\n", controller.Name)
@@ -0,0 +1,342 @@
+// Package modbus serves the probes, temperature controllers and switches to PLCs and SCADA systems over Modbus TCP
+package modbus
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"periph.io/x/periph/conn/physic"
+)
+
+// Table is one of the four Modbus data tables
+type Table string
+
+const (
+	// Coils are read/write bits, one per switch
+	Coils Table = "coil"
+	// DiscreteInputs are read only bits, one per digital input
+	DiscreteInputs Table = "discrete input"
+	// InputRegisters are read only words with the controller and probe readings
+	InputRegisters Table = "input register"
+	// HoldingRegisters are read/write words with the controller settings
+	HoldingRegisters Table = "holding register"
+)
+
+const (
+	// ControllerStride is the number of registers in the block of each temperature controller, controller N starts at (N-1)*ControllerStride
+	ControllerStride = 10
+	// ProbeBase is the first input register of the probe blocks, probe N starts at ProbeBase+(N-1)*ProbeStride
+	ProbeBase = 10000
+	// ProbeStride is the number of input registers in the block of each probe
+	ProbeStride = 4
+	// NoReading is the register value of a temperature that is not known, or a set point that is not set
+	NoReading = 0x8000
+)
+
+// controllerModes are the register values of the controller modes
+var controllerModes = []model.ControllerMode{"off", "auto", "manual", "hysteria"}
+
+// probeStatuses are the register values of the probe statuses
+var probeStatuses = []model.ProbeStatus{model.ProbeStatusOk, model.ProbeStatusStale, model.ProbeStatusFaulted, model.ProbeStatusDisabled}
+
+// Register is one coil, discrete input or register of the register map
+// Writes to holding registers fill in a settings update for their controller, and writes to coils switch their switch
+type Register struct {
+	Table       Table
+	Address     uint16
+	Name        string
+	Description string
+	read        func() uint16
+	controller  *devices.TemperatureController
+	update      func(value uint16, settings *model.TemperatureControllerSettingsInput) error
+	device      *devices.Switch
+}
+
+// Writable - Whether a Modbus client can write this register
+func (r *Register) Writable() bool {
+	return r.update != nil || r.device != nil
+}
+
+// Read - The current value of the register, 0 or 1 for coils and discrete inputs
+func (r *Register) Read() uint16 {
+	return r.read()
+}
+
+// RegisterMap - The register map of the current devices, ordered by table then address
+// Devices whose ID does not fit in the address space are left out
+func RegisterMap() []*Register {
+	registers := []*Register{}
+	for _, controller := range devices.AllTemperatureControllers() {
+		registers = append(registers, controllerRegisters(controller)...)
+		for _, probe := range controller.TempProbeDetails {
+			registers = append(registers, probeRegisters(controller, probe)...)
+		}
+	}
+	for _, s := range devices.AllSwitches() {
+		registers = append(registers, switchCoil(s)...)
+	}
+	for _, input := range devices.AllInPins() {
+		registers = append(registers, inputBit(input)...)
+	}
+
+	order := map[Table]int{Coils: 0, DiscreteInputs: 1, InputRegisters: 2, HoldingRegisters: 3}
+	sort.SliceStable(registers, func(i, j int) bool {
+		if registers[i].Table != registers[j].Table {
+			return order[registers[i].Table] < order[registers[j].Table]
+		}
+		return registers[i].Address < registers[j].Address
+	})
+	return registers
+}
+
+// WriteRegisterMap - Write the register map of the current devices as a markdown table
+func WriteRegisterMap(w io.Writer) error {
+	_, err := fmt.Fprintln(w, "| Table | Address | Access | Name | Description |\n| --- | --- | --- | --- | --- |")
+	if err != nil {
+		return err
+	}
+	for _, register := range RegisterMap() {
+		access := "read"
+		if register.Writable() {
+			access = "read/write"
+		}
+		_, err = fmt.Fprintf(w, "| %v | %v | %v | %v | %v |\n", register.Table, register.Address, access, register.Name, register.Description)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// block - The first address of the block of a device, false when it does not fit in the address space
+func block(base uint, id uint, stride uint) (uint16, bool) {
+	if id == 0 {
+		return 0, false
+	}
+	address := base + (id-1)*stride
+	if address+stride-1 > math.MaxUint16 {
+		return 0, false
+	}
+	return uint16(address), true
+}
+
+func controllerRegisters(c *devices.TemperatureController) []*Register {
+	base, ok := block(0, c.ID, ControllerStride)
+	if !ok {
+		return nil
+	}
+	return []*Register{
+		{
+			Table: HoldingRegisters, Address: base, Name: c.Name + " mode",
+			Description: "0 off, 1 auto, 2 manual, 3 hysteria",
+			read: func() uint16 {
+				for value, mode := range controllerModes {
+					if mode == c.Mode {
+						return uint16(value)
+					}
+				}
+				return 0
+			},
+			controller: c,
+			update: func(value uint16, input *model.TemperatureControllerSettingsInput) error {
+				if int(value) >= len(controllerModes) {
+					return fmt.Errorf("%v is not a controller mode", value)
+				}
+				input.Mode = &controllerModes[value]
+				return nil
+			},
+		},
+		{
+			Table: HoldingRegisters, Address: base + 1, Name: c.Name + " set point",
+			Description: "°C x10, signed, 32768 (0x8000) when not set, write 32768 to clear it",
+			read: func() uint16 {
+				if c.SetPointRaw == nil {
+					return NoReading
+				}
+				return temperatureValue(*c.SetPointRaw)
+			},
+			controller: c,
+			update: func(value uint16, input *model.TemperatureControllerSettingsInput) error {
+				setPoint := ""
+				if value != NoReading {
+					setPoint = fmt.Sprintf("%.1fC", float64(int16(value))/10)
+				}
+				input.SetPoint = &setPoint
+				return nil
+			},
+		},
+		{
+			Table: HoldingRegisters, Address: base + 2, Name: c.Name + " manual duty",
+			Description: "Percent, signed, -100 (cooling) to 100 (heating), writing it configures manual mode",
+			read:        func() uint16 { return uint16(int16(c.ManualSettings.DutyCycle)) },
+			controller:  c,
+			update: func(value uint16, input *model.TemperatureControllerSettingsInput) error {
+				manual := manualSettings(input)
+				duty := int(int16(value))
+				manual.DutyCycle = &duty
+				return nil
+			},
+		},
+		{
+			Table: HoldingRegisters, Address: base + 3, Name: c.Name + " manual cycle time",
+			Description: "Seconds, writing it configures manual mode",
+			read:        func() uint16 { return clamp(c.ManualSettings.CycleTime) },
+			controller:  c,
+			update: func(value uint16, input *model.TemperatureControllerSettingsInput) error {
+				manual := manualSettings(input)
+				cycleTime := int(value)
+				manual.CycleTime = &cycleTime
+				return nil
+			},
+		},
+		{
+			Table: InputRegisters, Address: base, Name: c.Name + " temperature",
+			Description: "°C x10, signed, 32768 (0x8000) when there are no usable probes",
+			read: func() uint16 {
+				temperature, _, err := c.AggregateTemperature()
+				if err != nil {
+					return NoReading
+				}
+				return temperatureValue(temperature)
+			},
+		},
+		{
+			Table: InputRegisters, Address: base + 1, Name: c.Name + " output duty",
+			Description: "Percent, signed, the duty cycle the outputs are running at",
+			read: func() uint16 {
+				if c.OutputControl == nil {
+					return 0
+				}
+				return uint16(int16(c.OutputControl.DutyCycle))
+			},
+		},
+		{
+			Table: InputRegisters, Address: base + 2, Name: c.Name + " calculated duty",
+			Description: "Percent, signed, the PID duty cycle for auto mode",
+			read:        func() uint16 { return uint16(int16(c.CalculatedDuty)) },
+		},
+		{
+			Table: InputRegisters, Address: base + 3, Name: c.Name + " running",
+			Description: "1 when the controller is running",
+			read:        func() uint16 { return bit(c.Running) },
+		},
+		{
+			Table: InputRegisters, Address: base + 4, Name: c.Name + " interlocked",
+			Description: "1 when an interlock input is holding the outputs off",
+			read:        func() uint16 { return bit(c.Interlocked()) },
+		},
+	}
+}
+
+func probeRegisters(c *devices.TemperatureController, probe *devices.TempProbeDetail) []*Register {
+	base, ok := block(ProbeBase, probe.ID, ProbeStride)
+	if !ok {
+		return nil
+	}
+	name := probe.FriendlyName
+	if len(name) == 0 {
+		name = probe.PhysAddr
+	}
+
+	return []*Register{
+		{
+			Table: InputRegisters, Address: base, Name: name + " temperature",
+			Description: fmt.Sprintf("°C x10, signed, calibrated, 32768 (0x8000) when faulted, on %v", c.Name),
+			read: func() uint16 {
+				if len(probe.Fault) > 0 {
+					return NoReading
+				}
+				return temperatureValue(probe.ReadingRaw)
+			},
+		},
+		{
+			Table: InputRegisters, Address: base + 1, Name: name + " gravity",
+			Description: "Specific gravity x1000, 0 for probes without a hydrometer",
+			read: func() uint16 {
+				if probe.Gravity == nil {
+					return 0
+				}
+				return clamp(int64(math.Round(*probe.Gravity * 1000)))
+			},
+		},
+		{
+			Table: InputRegisters, Address: base + 2, Name: name + " status",
+			Description: "0 ok, 1 stale, 2 faulted, 3 disabled",
+			read: func() uint16 {
+				status := c.ProbeStatus(probe)
+				for value, s := range probeStatuses {
+					if s == status {
+						return uint16(value)
+					}
+				}
+				return 0
+			},
+		},
+	}
+}
+
+func switchCoil(s *devices.Switch) []*Register {
+	address, ok := block(0, s.ID, 1)
+	if !ok {
+		return nil
+	}
+	return []*Register{{
+		Table: Coils, Address: address, Name: s.Name(),
+		Description: fmt.Sprintf("Switch on %v", s.Gpio()),
+		read:        func() uint16 { return bit(s.State() == model.SwitchModeOn) },
+		device:      s,
+	}}
+}
+
+func inputBit(input *devices.InPin) []*Register {
+	address, ok := block(0, input.ID, 1)
+	if !ok {
+		return nil
+	}
+	return []*Register{{
+		Table: DiscreteInputs, Address: address, Name: input.Name(),
+		Description: fmt.Sprintf("Input on %v, 1 when active", input.Gpio()),
+		read:        func() uint16 { return bit(input.Active()) },
+	}}
+}
+
+// manualSettings - The manual settings of a settings update, writing them marks manual mode as configured
+func manualSettings(input *model.TemperatureControllerSettingsInput) *model.ManualSettingsInput {
+	if input.ManualSettings == nil {
+		configured := true
+		input.ManualSettings = &model.ManualSettingsInput{Configured: &configured}
+	}
+	return input.ManualSettings
+}
+
+// temperatureValue - A temperature in tenths of a degree Celsius, as a signed register
+func temperatureValue(temperature physic.Temperature) uint16 {
+	tenths := math.Round(temperature.Celsius() * 10)
+	if tenths <= math.MinInt16 {
+		tenths = math.MinInt16 + 1
+	} else if tenths > math.MaxInt16 {
+		tenths = math.MaxInt16
+	}
+	return uint16(int16(tenths))
+}
+
+func clamp(value int64) uint16 {
+	if value < 0 {
+		return 0
+	}
+	if value > math.MaxUint16 {
+		return math.MaxUint16
+	}
+	return uint16(value)
+}
+
+func bit(on bool) uint16 {
+	if on {
+		return 1
+	}
+	return 0
+}
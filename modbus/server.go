@@ -0,0 +1,354 @@
+package modbus
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/rs/zerolog/log"
+)
+
+// Function codes that are served
+const (
+	readCoils              = 0x01
+	readDiscreteInputs     = 0x02
+	readHoldingRegisters   = 0x03
+	readInputRegisters     = 0x04
+	writeSingleCoil        = 0x05
+	writeSingleRegister    = 0x06
+	writeMultipleCoils     = 0x0f
+	writeMultipleRegisters = 0x10
+)
+
+// Exception codes sent back for requests that cannot be served
+const (
+	illegalFunction     = 0x01
+	illegalDataAddress  = 0x02
+	illegalDataValue    = 0x03
+	serverDeviceFailure = 0x04
+)
+
+// The largest reads and writes allowed by the Modbus specification
+const (
+	maxReadBits      = 2000
+	maxReadRegisters = 125
+	maxWriteBits     = 1968
+	maxWriteWords    = 123
+)
+
+// IdleTimeout is how long a connection is kept open without a request
+var IdleTimeout = 5 * time.Minute
+
+// writeLock serializes writes, so the registers of a multiple register write are applied together
+var writeLock sync.Mutex
+
+// exception is a Modbus exception response
+type exception byte
+
+func (e exception) Error() string {
+	return [...]string{"", "illegal function", "illegal data address", "illegal data value", "server device failure"}[e]
+}
+
+// Server is a Modbus TCP server for the register map, requests for any unit ID are served
+type Server struct {
+	listener    net.Listener
+	lock        sync.Mutex
+	connections map[net.Conn]struct{}
+	closed      bool
+	wg          sync.WaitGroup
+}
+
+// Listen - Start serving Modbus TCP on address, e.g. ":502"
+func Listen(address string) (*Server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{listener: listener, connections: map[net.Conn]struct{}{}}
+	s.wg.Add(1)
+	go s.accept()
+	return s, nil
+}
+
+// Addr - The address the server is listening on
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Close - Stop listening and close every connection
+func (s *Server) Close() error {
+	s.lock.Lock()
+	s.closed = true
+	err := s.listener.Close()
+	for conn := range s.connections {
+		conn.Close()
+	}
+	s.lock.Unlock()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) accept() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			s.lock.Lock()
+			closed := s.closed
+			s.lock.Unlock()
+			if closed {
+				return
+			}
+			log.Warn().Err(err).Msg("Failed to accept a Modbus connection")
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+
+		s.lock.Lock()
+		if s.closed {
+			s.lock.Unlock()
+			conn.Close()
+			return
+		}
+		s.connections[conn] = struct{}{}
+		s.wg.Add(1)
+		s.lock.Unlock()
+		go s.serve(conn)
+	}
+}
+
+// serve responds to the requests on a connection until it is closed
+// Each request is a MBAP header (transaction, protocol 0, length, unit) followed by the PDU
+func (s *Server) serve(conn net.Conn) {
+	defer func() {
+		s.lock.Lock()
+		delete(s.connections, conn)
+		s.lock.Unlock()
+		conn.Close()
+		s.wg.Done()
+	}()
+
+	header := make([]byte, 7)
+	for {
+		conn.SetReadDeadline(time.Now().Add(IdleTimeout))
+		if _, err := io.ReadFull(conn, header); err != nil {
+			return
+		}
+		length := binary.BigEndian.Uint16(header[4:])
+		if binary.BigEndian.Uint16(header[2:]) != 0 || length < 2 || length > 254 {
+			log.Warn().Msgf("Closing Modbus connection from %v after an invalid header", conn.RemoteAddr())
+			return
+		}
+		pdu := make([]byte, length-1)
+		if _, err := io.ReadFull(conn, pdu); err != nil {
+			return
+		}
+
+		response := respond(pdu)
+		frame := make([]byte, 7, 7+len(response))
+		copy(frame, header[:4])
+		binary.BigEndian.PutUint16(frame[4:], uint16(len(response)+1))
+		frame[6] = header[6]
+		if _, err := conn.Write(append(frame, response...)); err != nil {
+			return
+		}
+	}
+}
+
+// respond - Respond to a request PDU (function code and data) with a response or exception PDU
+func respond(pdu []byte) []byte {
+	function := pdu[0]
+	response, err := handle(function, pdu[1:])
+	if err != nil {
+		code := exception(serverDeviceFailure)
+		if !errors.As(err, &code) {
+			log.Warn().Err(err).Msgf("Failed to handle Modbus function %v", function)
+		}
+		return []byte{function | 0x80, byte(code)}
+	}
+	return append([]byte{function}, response...)
+}
+
+func handle(function byte, data []byte) ([]byte, error) {
+	switch function {
+	case readCoils:
+		return readBits(Coils, data)
+	case readDiscreteInputs:
+		return readBits(DiscreteInputs, data)
+	case readHoldingRegisters:
+		return readWords(HoldingRegisters, data)
+	case readInputRegisters:
+		return readWords(InputRegisters, data)
+	case writeSingleCoil:
+		if len(data) != 4 {
+			return nil, exception(illegalDataValue)
+		}
+		value := binary.BigEndian.Uint16(data[2:])
+		if value != 0xff00 && value != 0 {
+			return nil, exception(illegalDataValue)
+		}
+		return data, writeBits(binary.BigEndian.Uint16(data), []bool{value == 0xff00})
+	case writeSingleRegister:
+		if len(data) != 4 {
+			return nil, exception(illegalDataValue)
+		}
+		return data, writeWords(binary.BigEndian.Uint16(data), []uint16{binary.BigEndian.Uint16(data[2:])})
+	case writeMultipleCoils:
+		start, quantity, values, err := multiple(data, maxWriteBits, func(quantity uint16) int { return int(quantity+7) / 8 })
+		if err != nil {
+			return nil, err
+		}
+		states := make([]bool, quantity)
+		for i := range states {
+			states[i] = values[i/8]&(1<<(i%8)) != 0
+		}
+		return data[:4], writeBits(start, states)
+	case writeMultipleRegisters:
+		start, quantity, values, err := multiple(data, maxWriteWords, func(quantity uint16) int { return int(quantity) * 2 })
+		if err != nil {
+			return nil, err
+		}
+		words := make([]uint16, quantity)
+		for i := range words {
+			words[i] = binary.BigEndian.Uint16(values[i*2:])
+		}
+		return data[:4], writeWords(start, words)
+	}
+	return nil, exception(illegalFunction)
+}
+
+// span - The start and quantity of a read request
+func span(data []byte, max uint16) (uint16, uint16, error) {
+	if len(data) != 4 {
+		return 0, 0, exception(illegalDataValue)
+	}
+	start, quantity := binary.BigEndian.Uint16(data), binary.BigEndian.Uint16(data[2:])
+	if quantity == 0 || quantity > max {
+		return 0, 0, exception(illegalDataValue)
+	}
+	if int(start)+int(quantity) > 0x10000 {
+		return 0, 0, exception(illegalDataAddress)
+	}
+	return start, quantity, nil
+}
+
+// multiple - The start, quantity and values of a write multiple request, size is the byte count for the quantity
+func multiple(data []byte, max uint16, size func(quantity uint16) int) (uint16, uint16, []byte, error) {
+	if len(data) < 5 {
+		return 0, 0, nil, exception(illegalDataValue)
+	}
+	start, quantity, err := span(data[:4], max)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if int(data[4]) != size(quantity) || len(data) != 5+int(data[4]) {
+		return 0, 0, nil, exception(illegalDataValue)
+	}
+	return start, quantity, data[5:], nil
+}
+
+// lookup - The registers of a table by address
+func lookup(table Table) map[uint16]*Register {
+	registers := map[uint16]*Register{}
+	for _, register := range RegisterMap() {
+		if register.Table == table {
+			registers[register.Address] = register
+		}
+	}
+	return registers
+}
+
+// readBits - Read coils or discrete inputs, addresses without a device read as 0 so blocks with gaps can be read
+func readBits(table Table, data []byte) ([]byte, error) {
+	start, quantity, err := span(data, maxReadBits)
+	if err != nil {
+		return nil, err
+	}
+	registers := lookup(table)
+	response := make([]byte, 1+(quantity+7)/8)
+	response[0] = byte(len(response) - 1)
+	for i := uint16(0); i < quantity; i++ {
+		if register, ok := registers[start+i]; ok && register.Read() != 0 {
+			response[1+i/8] |= 1 << (i % 8)
+		}
+	}
+	return response, nil
+}
+
+// readWords - Read holding or input registers, addresses without a register read as 0 so blocks with gaps can be read
+func readWords(table Table, data []byte) ([]byte, error) {
+	start, quantity, err := span(data, maxReadRegisters)
+	if err != nil {
+		return nil, err
+	}
+	registers := lookup(table)
+	response := make([]byte, 1+quantity*2)
+	response[0] = byte(quantity * 2)
+	for i := uint16(0); i < quantity; i++ {
+		if register, ok := registers[start+i]; ok {
+			binary.BigEndian.PutUint16(response[1+i*2:], register.Read())
+		}
+	}
+	return response, nil
+}
+
+// writeBits - Switch the switches of the coils, like the toggleSwitch mutation
+func writeBits(start uint16, states []bool) error {
+	writeLock.Lock()
+	defer writeLock.Unlock()
+	registers := lookup(Coils)
+	for i := range states {
+		if _, ok := registers[start+uint16(i)]; !ok {
+			return exception(illegalDataAddress)
+		}
+	}
+	for i, on := range states {
+		s := registers[start+uint16(i)].device
+		if on {
+			s.On()
+		} else {
+			s.Off()
+		}
+	}
+	return nil
+}
+
+// writeWords - Update the controllers of the holding registers, each controller is updated once with every register written to it,
+// like an updateTemperatureController mutation
+func writeWords(start uint16, values []uint16) error {
+	writeLock.Lock()
+	defer writeLock.Unlock()
+	registers := lookup(HoldingRegisters)
+	controllers := []*devices.TemperatureController{}
+	settings := map[*devices.TemperatureController]*model.TemperatureControllerSettingsInput{}
+	for i, value := range values {
+		register, ok := registers[start+uint16(i)]
+		if !ok || !register.Writable() {
+			return exception(illegalDataAddress)
+		}
+		input, ok := settings[register.controller]
+		if !ok {
+			input = &model.TemperatureControllerSettingsInput{ID: fmt.Sprint(register.controller.ID)}
+			settings[register.controller] = input
+			controllers = append(controllers, register.controller)
+		}
+		if err := register.update(value, input); err != nil {
+			log.Info().Err(err).Msgf("Rejected a Modbus write to %v", register.Name)
+			return exception(illegalDataValue)
+		}
+	}
+
+	for _, controller := range controllers {
+		if err := controller.ApplySettings(*settings[controller]); err != nil {
+			log.Info().Err(err).Msgf("Rejected a Modbus update of %v", controller.Name)
+			return exception(illegalDataValue)
+		}
+	}
+	return nil
+}
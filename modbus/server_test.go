@@ -0,0 +1,182 @@
+package modbus_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/devices"
+	"github.com/dougedey/elsinore/graph/model"
+	"github.com/dougedey/elsinore/modbus"
+	"github.com/stretchr/testify/require"
+	"periph.io/x/periph/conn/gpio"
+	"periph.io/x/periph/conn/gpio/gpioreg"
+	"periph.io/x/periph/conn/gpio/gpiotest"
+	"periph.io/x/periph/conn/physic"
+)
+
+func setupTestDb(t *testing.T) {
+	dbName := "test"
+	database.InitDatabase(&dbName,
+		&devices.TempProbeDetail{}, &devices.PidSettings{}, &devices.HysteriaSettings{},
+		&devices.ManualSettings{}, &devices.TemperatureController{}, &devices.Switch{},
+		&devices.ProbeSettings{}, &devices.SPIProbe{}, &devices.InPin{}, &devices.FlowMeter{},
+		&devices.Expander{},
+		&devices.Fermentation{}, &devices.FermentationStep{}, &devices.GravityReading{},
+		&devices.Webhook{}, &devices.WebhookDelivery{}, &devices.AlertRule{}, &devices.AlertChannel{},
+		&devices.AutomationRule{}, &devices.AutomationCondition{}, &devices.AutomationAction{},
+		&devices.Schedule{}, &devices.SwitchGroup{}, &devices.SwitchGroupMember{}, &devices.Scene{}, &devices.SceneStep{},
+	)
+	t.Cleanup(func() {
+		database.Close()
+		require.Nil(t, os.Remove("test.db"))
+	})
+}
+
+func celsius(value float64) physic.Temperature {
+	return physic.ZeroCelsius + physic.Temperature(value*float64(physic.Celsius))
+}
+
+// client sends Modbus TCP requests, returning the response PDU
+type client struct {
+	conn        net.Conn
+	transaction uint16
+}
+
+func (c *client) request(t *testing.T, pdu ...byte) []byte {
+	c.transaction++
+	frame := make([]byte, 7)
+	binary.BigEndian.PutUint16(frame, c.transaction)
+	binary.BigEndian.PutUint16(frame[4:], uint16(len(pdu)+1))
+	frame[6] = 1
+	_, err := c.conn.Write(append(frame, pdu...))
+	require.Nil(t, err)
+
+	header := make([]byte, 7)
+	c.conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, err = io.ReadFull(c.conn, header)
+	require.Nil(t, err)
+	require.Equal(t, c.transaction, binary.BigEndian.Uint16(header))
+	require.Equal(t, byte(1), header[6])
+	response := make([]byte, binary.BigEndian.Uint16(header[4:])-1)
+	_, err = io.ReadFull(c.conn, response)
+	require.Nil(t, err)
+	return response
+}
+
+func (c *client) readRegisters(t *testing.T, function byte, address uint16, quantity uint16) []int16 {
+	response := c.request(t, function, byte(address>>8), byte(address), byte(quantity>>8), byte(quantity))
+	require.Equal(t, function, response[0], "exception %v", response)
+	values := []int16{}
+	for i := 0; i < int(quantity); i++ {
+		values = append(values, int16(binary.BigEndian.Uint16(response[2+i*2:])))
+	}
+	return values
+}
+
+func TestModbusServer(t *testing.T) {
+	setupTestDb(t)
+	devices.ClearControllers()
+
+	pumpPin := &gpiotest.Pin{N: "MODBUS_PUMP", Num: 301}
+	require.Nil(t, gpioreg.Register(pumpPin))
+	pump, err := devices.CreateSwitch("MODBUS_PUMP", "Glycol Pump")
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_, err := devices.DeleteSwitchByID(fmt.Sprint(pump.ID))
+		require.Nil(t, err)
+	})
+
+	gravity := 1.048
+	probe := &devices.TempProbeDetail{PhysAddr: "ModbusProbe", ReadingRaw: celsius(20.5), Gravity: &gravity, Updated: time.Now()}
+	fermenter, err := devices.CreateTemperatureController("Fermenter", probe)
+	require.Nil(t, err)
+	require.Nil(t, fermenter.UpdateSetPoint("18C"))
+
+	controllerBase := uint16((fermenter.ID - 1) * modbus.ControllerStride)
+	probeBase := uint16(modbus.ProbeBase + (probe.ID-1)*modbus.ProbeStride)
+	coil := uint16(pump.ID - 1)
+
+	server, err := modbus.Listen("127.0.0.1:0")
+	require.Nil(t, err)
+	t.Cleanup(func() { server.Close() })
+	conn, err := net.Dial("tcp", server.Addr().String())
+	require.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+	c := &client{conn: conn}
+
+	t.Run("The register map is generated from the devices", func(t *testing.T) {
+		buffer := &bytes.Buffer{}
+		require.Nil(t, modbus.WriteRegisterMap(buffer))
+		require.Contains(t, buffer.String(), fmt.Sprintf("| holding register | %v | read/write | Fermenter set point |", controllerBase+1))
+		require.Contains(t, buffer.String(), fmt.Sprintf("| input register | %v | read | ModbusProbe gravity |", probeBase+1))
+		require.Contains(t, buffer.String(), fmt.Sprintf("| coil | %v | read/write | Glycol Pump | Switch on MODBUS_PUMP |", coil))
+	})
+
+	t.Run("Controller settings and readings are read", func(t *testing.T) {
+		require.Equal(t, []int16{0, 180, 0, 0}, c.readRegisters(t, 0x03, controllerBase, 4))
+		require.Equal(t, []int16{205, 0, 0, 0, 0, 0}, c.readRegisters(t, 0x04, controllerBase, 6))
+		require.Equal(t, []int16{205, 1048, 0}, c.readRegisters(t, 0x04, probeBase, 3))
+	})
+
+	t.Run("Faulted probes have no reading", func(t *testing.T) {
+		probe.Fault = "CRC error"
+		t.Cleanup(func() { probe.Fault = "" })
+		require.Equal(t, []int16{-32768}, c.readRegisters(t, 0x04, controllerBase, 1))
+		require.Equal(t, []int16{-32768, 1048, 2}, c.readRegisters(t, 0x04, probeBase, 3))
+	})
+
+	t.Run("Writes update the controller settings", func(t *testing.T) {
+		response := c.request(t, 0x06, byte(controllerBase>>8), byte(controllerBase), 0, 2)
+		require.Equal(t, []byte{0x06, byte(controllerBase >> 8), byte(controllerBase), 0, 2}, response)
+		require.Equal(t, model.ControllerMode("manual"), fermenter.Mode)
+
+		setPoint := int16(-25)
+		c.request(t, 0x06, byte((controllerBase+1)>>8), byte(controllerBase+1), byte(uint16(setPoint)>>8), byte(setPoint))
+		require.Equal(t, -2.5, fermenter.SetPointRaw.Celsius())
+
+		// Duty -40% and a 30 second cycle in one request
+		duty := int16(-40)
+		response = c.request(t, 0x10, byte((controllerBase+2)>>8), byte(controllerBase+2), 0, 2, 4, byte(uint16(duty)>>8), byte(duty), 0, 30)
+		require.Equal(t, []byte{0x10, byte((controllerBase + 2) >> 8), byte(controllerBase + 2), 0, 2}, response)
+		require.Equal(t, int64(-40), fermenter.ManualSettings.DutyCycle)
+		require.Equal(t, int64(30), fermenter.ManualSettings.CycleTime)
+		require.True(t, fermenter.ManualSettings.Configured)
+		require.Equal(t, []int16{2, -25, -40, 30}, c.readRegisters(t, 0x03, controllerBase, 4))
+
+		c.request(t, 0x06, byte((controllerBase+1)>>8), byte(controllerBase+1), 0x80, 0)
+		require.Nil(t, fermenter.SetPointRaw)
+	})
+
+	t.Run("Invalid writes are rejected with an exception", func(t *testing.T) {
+		require.Equal(t, []byte{0x86, 0x03}, c.request(t, 0x06, byte(controllerBase>>8), byte(controllerBase), 0, 7))
+		require.Equal(t, []byte{0x86, 0x03}, c.request(t, 0x06, byte((controllerBase+2)>>8), byte(controllerBase+2), 0, 150))
+		require.Equal(t, int64(-40), fermenter.ManualSettings.DutyCycle)
+		require.Equal(t, model.ControllerMode("manual"), fermenter.Mode)
+
+		require.Equal(t, []byte{0x86, 0x02}, c.request(t, 0x06, byte((controllerBase+8)>>8), byte(controllerBase+8), 0, 1))
+		require.Equal(t, []byte{0x85, 0x02}, c.request(t, 0x05, 0x7f, 0xff, 0xff, 0x00))
+		require.Equal(t, []byte{0x85, 0x03}, c.request(t, 0x05, byte(coil>>8), byte(coil), 0x12, 0x34))
+		require.Equal(t, []byte{0x83, 0x03}, c.request(t, 0x03, 0, 0, 0, 200))
+		require.Equal(t, []byte{0xab, 0x01}, c.request(t, 0x2b, 0x0e, 0x01, 0x00))
+	})
+
+	t.Run("Coils switch the switches", func(t *testing.T) {
+		response := c.request(t, 0x05, byte(coil>>8), byte(coil), 0xff, 0x00)
+		require.Equal(t, []byte{0x05, byte(coil >> 8), byte(coil), 0xff, 0x00}, response)
+		require.Equal(t, gpio.High, pumpPin.Read())
+		require.Equal(t, model.SwitchModeOn, pump.State())
+		require.Equal(t, []byte{0x01, 1, 1}, c.request(t, 0x01, byte(coil>>8), byte(coil), 0, 1))
+
+		response = c.request(t, 0x0f, byte(coil>>8), byte(coil), 0, 1, 1, 0)
+		require.Equal(t, []byte{0x0f, byte(coil >> 8), byte(coil), 0, 1}, response)
+		require.Equal(t, gpio.Low, pumpPin.Read())
+		require.Equal(t, []byte{0x01, 1, 0}, c.request(t, 0x01, byte(coil>>8), byte(coil), 0, 1))
+	})
+}
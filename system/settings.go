@@ -1,16 +1,22 @@
 package system
 
 import (
+	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/dougedey/elsinore/database"
+	"github.com/dougedey/elsinore/graph/model"
 	"gorm.io/gorm"
 )
 
 // Settings - The Global settings for this brewery
 type Settings struct {
 	gorm.Model
+	database.InstanceScoped
 	BreweryName string
+	Timezone    string // An IANA timezone name such as America/Toronto, empty for the local time of the server
 }
 
 var once sync.Once
@@ -28,6 +34,13 @@ func CurrentSettings() *Settings {
 	return &instance
 }
 
+// ReloadSettings - Discard the cached settings and load them from the database again
+func ReloadSettings() *Settings {
+	instance = Settings{}
+	instance.load()
+	return &instance
+}
+
 func (s *Settings) load() {
 	database.FetchDatabase().Debug().First(s)
 }
@@ -36,3 +49,40 @@ func (s *Settings) load() {
 func (s *Settings) Save() {
 	database.Save(s)
 }
+
+// Update - Apply the new settings and save them, nothing changes when the timezone is unknown
+func (s *Settings) Update(settings model.SettingsInput) error {
+	timezone := s.Timezone
+	if settings.Timezone != nil {
+		timezone = strings.TrimSpace(*settings.Timezone)
+		if _, err := loadLocation(timezone); err != nil {
+			return err
+		}
+	}
+	if settings.BreweryName != nil {
+		s.BreweryName = *settings.BreweryName
+	}
+	s.Timezone = timezone
+	s.Save()
+	return nil
+}
+
+// Location - The timezone schedules and automations use, the local time of the server when none is set
+func (s *Settings) Location() *time.Location {
+	location, err := loadLocation(s.Timezone)
+	if err != nil {
+		return time.Local
+	}
+	return location
+}
+
+func loadLocation(timezone string) (*time.Location, error) {
+	if len(timezone) == 0 {
+		return time.Local, nil
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("'%v' is not a known timezone", timezone)
+	}
+	return location, nil
+}